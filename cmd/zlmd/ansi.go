@@ -0,0 +1,75 @@
+package main
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ANSI escape sequences used by renderANSI.
+const (
+	ansiReset     = "\x1b[0m"
+	ansiBold      = "\x1b[1m"
+	ansiDim       = "\x1b[2m"
+	ansiItalic    = "\x1b[3m"
+	ansiUnderline = "\x1b[4m"
+	ansiStrike    = "\x1b[9m"
+	ansiCyan      = "\x1b[36m"
+	ansiBlue      = "\x1b[34m"
+)
+
+var (
+	ansiHeadingPattern = regexp.MustCompile(`^ {0,3}#{1,6}\s+`)
+	ansiCodePattern    = regexp.MustCompile("`([^`\n]+)`")
+	ansiLinkPattern    = regexp.MustCompile(`\[([^\]\n]+)\]\(([^()\s]+)\)`)
+	ansiMentionPattern = regexp.MustCompile(`@_?\*\*([^*|\n]+)(?:\|\d+)?\*\*|@_?\*([^*\n]+)\*`)
+	ansiBoldPattern    = regexp.MustCompile(`\*\*([^*\n]+)\*\*`)
+	ansiItalicPattern  = regexp.MustCompile(`\*([^*\s][^*\n]*)\*`)
+	ansiStrikePattern  = regexp.MustCompile(`~~([^~\n]+)~~`)
+)
+
+// renderANSI approximates how markdown will look once posted, using terminal
+// colors and styles. It is a preview aid, not a faithful renderer.
+func renderANSI(markdown string) string {
+	var out []string
+	var fence string
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				fence = ""
+				continue
+			}
+			out = append(out, ansiDim+"  "+line+ansiReset)
+			continue
+		}
+		if n := len(trimmed) - len(strings.TrimLeft(trimmed, "`~")); n >= 3 {
+			fence = trimmed[:n]
+			if heading, ok := strings.CutPrefix(strings.TrimSpace(trimmed[n:]), "spoiler"); ok {
+				out = append(out, ansiBold+"▶ "+strings.TrimSpace(heading)+ansiReset)
+			}
+			continue
+		}
+
+		switch {
+		case ansiHeadingPattern.MatchString(line):
+			out = append(out, ansiBold+ansiUnderline+ansiHeadingPattern.ReplaceAllString(line, "")+ansiReset)
+		case strings.HasPrefix(trimmed, ">"):
+			out = append(out, ansiDim+"│ "+ansiReset+renderANSIInline(strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))))
+		default:
+			out = append(out, renderANSIInline(line))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// renderANSIInline styles inline code, links, mentions and emphasis.
+func renderANSIInline(line string) string {
+	// Links go first: the escape sequences inserted below contain "[".
+	line = ansiLinkPattern.ReplaceAllString(line, ansiUnderline+"$1"+ansiReset+" ($2)")
+	line = ansiCodePattern.ReplaceAllString(line, ansiCyan+"$1"+ansiReset)
+	line = ansiMentionPattern.ReplaceAllString(line, ansiBold+ansiBlue+"@$1$2"+ansiReset)
+	line = ansiBoldPattern.ReplaceAllString(line, ansiBold+"$1"+ansiReset)
+	line = ansiItalicPattern.ReplaceAllString(line, ansiItalic+"$1"+ansiReset)
+	line = ansiStrikePattern.ReplaceAllString(line, ansiStrike+"$1"+ansiReset)
+	return line
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRenderANSI(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{"Heading", "## Deploy", "\x1b[1m\x1b[4mDeploy\x1b[0m"},
+		{"Inline", "**api** `v2` [logs](https://x.example) @**Ann|3**", "\x1b[1mapi\x1b[0m \x1b[36mv2\x1b[0m \x1b[4mlogs\x1b[0m (https://x.example) \x1b[1m\x1b[34m@Ann\x1b[0m"},
+		{"Spoiler", "```spoiler Logs\nline\n```", "\x1b[1m▶ Logs\x1b[0m\n\x1b[2m  line\x1b[0m"},
+		{"Quote", "> *note*", "\x1b[2m│ \x1b[0m\x1b[3mnote\x1b[0m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := renderANSI(tt.markdown)
+			if got != tt.expected {
+				t.Errorf("renderANSI() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
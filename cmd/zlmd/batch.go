@@ -0,0 +1,148 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// batchFlags are the file selection flags shared by commands that process
+// many files: fmt, lint and convert.
+type batchFlags struct {
+	include stringList
+	exclude stringList
+	workers int
+}
+
+// register adds the batch flags to fs.
+func (b *batchFlags) register(fs *flag.FlagSet) {
+	fs.Var(&b.include, "include", "when walking directories, only process files matching `glob` (repeatable, default *.md)")
+	fs.Var(&b.exclude, "exclude", "skip files and directories matching `glob` (repeatable)")
+	fs.IntVar(&b.workers, "j", runtime.NumCPU(), "number of files to process in parallel")
+}
+
+// expandPaths resolves the command line paths into files. A directory, or a
+// path ending in "/...", is walked recursively for files matching the
+// include globs; files named explicitly are always kept. Globs are matched
+// against both the base name and the slash-separated path.
+func (b *batchFlags) expandPaths(args []string) ([]string, error) {
+	include := b.include
+	if len(include) == 0 {
+		include = stringList{"*.md"}
+	}
+
+	var files []string
+	seen := map[string]bool{}
+	add := func(path string) {
+		if !seen[path] {
+			seen[path] = true
+			files = append(files, path)
+		}
+	}
+
+	for _, arg := range args {
+		root, recursive := strings.CutSuffix(arg, "...")
+		if recursive {
+			root = filepath.Clean(root)
+		}
+		info, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			add(root)
+			continue
+		}
+
+		var found []string
+		err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if path != root && matchAny(b.exclude, path) {
+				if d.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+			if !d.IsDir() && matchAny(include, path) {
+				found = append(found, path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(found)
+		for _, path := range found {
+			add(path)
+		}
+	}
+	return files, nil
+}
+
+// matchAny reports whether path or its base name matches any of globs.
+func matchAny(globs []string, path string) bool {
+	slashed := filepath.ToSlash(path)
+	base := filepath.Base(path)
+	for _, glob := range globs {
+		if ok, _ := filepath.Match(glob, base); ok {
+			return true
+		}
+		if ok, _ := filepath.Match(glob, slashed); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// fileResult is the outcome of processing a single file.
+type fileResult struct {
+	path   string
+	output string
+	err    error
+}
+
+// processFiles runs fn on every file using up to workers goroutines and
+// returns the results in the order of files. Once ctx is done, files not
+// yet started are skipped and get ctx.Err() as their error.
+func processFiles(ctx context.Context, files []string, workers int, fn func(path, content string) (string, error)) []fileResult {
+	results := make([]fileResult, len(files))
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(files)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].path = files[i]
+				if err := ctx.Err(); err != nil {
+					results[i].err = err
+					continue
+				}
+				content, err := os.ReadFile(files[i])
+				if err != nil {
+					results[i].err = err
+					continue
+				}
+				results[i].output, results[i].err = fn(files[i], string(content))
+			}
+		}()
+	}
+	for i := range files {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
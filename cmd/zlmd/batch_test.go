@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// writeTree creates files below dir from a map of slash-separated relative
+// paths to contents.
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestExpandPaths(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"a.md":              "",
+		"notes.txt":         "",
+		"sub/b.md":          "",
+		"sub/draft.md":      "",
+		"vendor/c.md":       "",
+		"templates/d.tmpl":  "",
+		"templates/e.md":    "",
+		"templates/x/f.md":  "",
+		"templates/x/g.txt": "",
+	})
+	rel := func(paths ...string) []string {
+		for i, p := range paths {
+			paths[i] = filepath.Join(dir, filepath.FromSlash(p))
+		}
+		return paths
+	}
+
+	tests := []struct {
+		name     string
+		batch    batchFlags
+		args     []string
+		expected []string
+	}{
+		{"Recursive", batchFlags{}, []string{dir + "/..."}, rel("a.md", "sub/b.md", "sub/draft.md", "templates/e.md", "templates/x/f.md", "vendor/c.md")},
+		{"Exclude", batchFlags{exclude: stringList{"vendor", "draft.md"}}, []string{dir}, rel("a.md", "sub/b.md", "templates/e.md", "templates/x/f.md")},
+		{"Include", batchFlags{include: stringList{"*.tmpl", "*.txt"}}, []string{filepath.Join(dir, "templates")}, rel("templates/d.tmpl", "templates/x/g.txt")},
+		{"Explicit file", batchFlags{exclude: stringList{"*.txt"}}, rel("notes.txt", "sub"), rel("notes.txt", "sub/b.md", "sub/draft.md")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.batch.expandPaths(tt.args)
+			if err != nil {
+				t.Fatalf("expandPaths() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expandPaths() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	if _, err := (&batchFlags{}).expandPaths([]string{filepath.Join(dir, "missing")}); err == nil {
+		t.Error("Expected error for a missing path")
+	}
+}
+
+func TestProcessFiles(t *testing.T) {
+	dir := t.TempDir()
+	var files []string
+	for i := range 20 {
+		path := filepath.Join(dir, fmt.Sprintf("%02d.md", i))
+		os.WriteFile(path, []byte(fmt.Sprint(i)), 0o644)
+		files = append(files, path)
+	}
+	files = append(files, filepath.Join(dir, "missing.md"))
+
+	results := processFiles(context.Background(), files, 4, func(path, content string) (string, error) {
+		return content + "!", nil
+	})
+	for i, r := range results[:20] {
+		if r.path != files[i] || r.output != fmt.Sprint(i)+"!" || r.err != nil {
+			t.Errorf("results[%d] = %+v", i, r)
+		}
+	}
+	if results[20].err == nil {
+		t.Error("Expected an error for the missing file")
+	}
+}
+
+func TestProcessFiles_Cancelled(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"a.md": "a", "b.md": "b"})
+	files := []string{filepath.Join(dir, "a.md"), filepath.Join(dir, "b.md")}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	results := processFiles(ctx, files, 2, func(path, content string) (string, error) {
+		t.Errorf("fn called for %s after cancellation", path)
+		return content, nil
+	})
+	for i, r := range results {
+		if r.path != files[i] || r.err != context.Canceled {
+			t.Errorf("results[%d] = %+v, want %s with context.Canceled", i, r, files[i])
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// checkMessage returns the reasons message can't be posted as is: an empty
+// body, a body over the configured length limit, an unclosed code fence
+// that would swallow the rest of the message, or constructs the configured
+// server version doesn't render.
+func checkMessage(message string) []string {
+	var problems []string
+	if strings.TrimSpace(message) == "" {
+		problems = append(problems, "message is empty")
+	}
+	if n := utf8.RuneCountInString(message); n > settings.MaxLength {
+		problems = append(problems, fmt.Sprintf("message is %d characters, over the %d character message limit", n, settings.MaxLength))
+	}
+	if line := zlmd.UnclosedFence(message); line > 0 {
+		problems = append(problems, fmt.Sprintf("code fence opened on line %d is never closed", line))
+	}
+	for _, f := range zlmd.UnsupportedFeatures(message, settings.ServerVersion) {
+		problems = append(problems, fmt.Sprintf("%s are not rendered by Zulip %s (needs %s)", f, settings.ServerVersion, f.Since()))
+	}
+	return problems
+}
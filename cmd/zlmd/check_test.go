@@ -0,0 +1,52 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestCheckMessage(t *testing.T) {
+	settings = defaultConfig()
+	defer func() { settings = defaultConfig() }()
+
+	tests := []struct {
+		name     string
+		message  string
+		expected []string
+	}{
+		{"Valid", "**ok**\n```\ncode\n```", nil},
+		{"Empty", " \n", []string{"message is empty"}},
+		{"Unclosed fence", "intro\n````go\n```\nx", []string{"code fence opened on line 2 is never closed"}},
+		{"Too long", strings.Repeat("x", settings.MaxLength+1), []string{"message is 10001 characters, over the 10000 character message limit"}},
+		{"Multi-byte under limit", strings.Repeat("日", settings.MaxLength), nil},
+		{"Multi-byte too long", strings.Repeat("🚀", settings.MaxLength+1), []string{"message is 10001 characters, over the 10000 character message limit"}},
+		{"Spoiler on latest", "```spoiler x\ny\n```", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := checkMessage(tt.message)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("checkMessage() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCheckMessage_ServerVersion(t *testing.T) {
+	settings = defaultConfig()
+	settings.ServerVersion = zlmd.ServerVersion{Major: 2, Minor: 1}
+	defer func() { settings = defaultConfig() }()
+
+	got := checkMessage("```spoiler Logs\ntrace\n```\nat <time:2024-01-01T00:00:00Z>")
+	expected := []string{
+		"spoiler blocks are not rendered by Zulip 2.1 (needs 3.0)",
+		"<time:> tags are not rendered by Zulip 2.1 (needs 3.0)",
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("checkMessage() = %q, want %q", got, expected)
+	}
+}
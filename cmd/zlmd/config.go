@@ -0,0 +1,257 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// config holds defaults loaded from the configuration file. Flags given on
+// the command line always take precedence.
+type config struct {
+	// Site is the Zulip server URL, such as https://chat.example.com. It is
+	// passed to --exec send commands as $ZULIP_SITE.
+	Site string
+	// Email is the bot or user email used to authenticate. It is passed to
+	// --exec send commands as $ZULIP_EMAIL.
+	Email string
+	// Zuliprc is the path to a zuliprc file holding the credentials, so that
+	// API keys don't have to live in the config file itself. It is passed
+	// to --exec send commands as $ZULIP_CONFIG.
+	Zuliprc string
+	// EscapePolicy is the default policy for `zlmd escape`.
+	EscapePolicy zlmd.EscapePolicy
+	// Theme is the emoji theme used for badges and statuses; run makes it
+	// the theme of zlmd's default Config.
+	Theme *zlmd.Theme
+	// Fence is the fence style used by `zlmd code` and `zlmd spoiler`.
+	Fence zlmd.FenceStyle
+	// MaxLength is the maximum length of a single message.
+	MaxLength int
+	// ServerVersion is the Zulip server messages are posted to; `zlmd lint`
+	// reports constructs it can't render. The zero value means the latest.
+	ServerVersion zlmd.ServerVersion
+}
+
+// defaultConfig returns the settings used when no configuration file exists.
+func defaultConfig() config {
+	return config{
+		EscapePolicy: zlmd.EscapeMentions,
+		Theme:        zlmd.DefaultTheme,
+		Fence:        zlmd.FenceBacktick,
+		MaxLength:    zlmd.MaxMessageLength,
+	}
+}
+
+// zlmdConfig returns the zlmd defaults implied by c, which run installs
+// with zlmd.SetDefaultConfig so every builder a subcommand uses follows them.
+func (c config) zlmdConfig() zlmd.Config {
+	return zlmd.Config{FenceStyle: c.Fence, Theme: c.Theme}
+}
+
+// sendEnv returns the environment entries that hand the site and
+// credentials to --exec send commands, under the names the Zulip API
+// clients read, such as zulip-send.
+func (c config) sendEnv() []string {
+	var env []string
+	if c.Site != "" {
+		env = append(env, "ZULIP_SITE="+c.Site)
+	}
+	if c.Email != "" {
+		env = append(env, "ZULIP_EMAIL="+c.Email)
+	}
+	if c.Zuliprc != "" {
+		env = append(env, "ZULIP_CONFIG="+c.Zuliprc)
+	}
+	return env
+}
+
+// settings is the configuration of the running command, loaded by run.
+var settings = defaultConfig()
+
+// defaultConfigPath returns the path of the per-user configuration file,
+// usually ~/.config/zlmd/config.yaml.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "zlmd", "config.yaml")
+}
+
+// loadConfig reads the configuration file at path. When path is empty the
+// default location is used, and a missing file there is not an error.
+func loadConfig(path string) (config, error) {
+	explicit := path != ""
+	if !explicit {
+		path = defaultConfigPath()
+		if path == "" {
+			return defaultConfig(), nil
+		}
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if !explicit && errors.Is(err, fs.ErrNotExist) {
+			return defaultConfig(), nil
+		}
+		return config{}, err
+	}
+	defer f.Close()
+
+	cfg, err := parseConfig(f)
+	if err != nil {
+		return config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// parseConfig parses the YAML subset used by config files: one "key: value"
+// pair per line, with optional quoting and # comments.
+//
+// Example:
+//
+//	site: https://chat.example.com
+//	email: deploy-bot@chat.example.com
+//	zuliprc: ~/.zuliprc
+//	escape_policy: all
+//	theme: plain        # emoji or plain
+//	fence: tilde        # backtick or tilde
+//	max_length: 8000
+//	server_version: 7.4
+func parseConfig(r io.Reader) (config, error) {
+	cfg := defaultConfig()
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(stripComment(scanner.Text()))
+		if line == "" || line == "---" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			return config{}, fmt.Errorf("line %d: expected \"key: value\"", n)
+		}
+		key = strings.TrimSpace(key)
+		value, err := unquote(strings.TrimSpace(value))
+		if err != nil {
+			return config{}, fmt.Errorf("line %d: %w", n, err)
+		}
+
+		if err := cfg.set(key, value); err != nil {
+			return config{}, fmt.Errorf("line %d: %w", n, err)
+		}
+	}
+	return cfg, scanner.Err()
+}
+
+// set assigns a single configuration key.
+func (c *config) set(key, value string) error {
+	switch key {
+	case "site":
+		c.Site = strings.TrimSuffix(value, "/")
+	case "email":
+		c.Email = value
+	case "zuliprc":
+		c.Zuliprc = expandHome(value)
+	case "escape_policy":
+		policy, err := zlmd.ParseEscapePolicy(value)
+		if err != nil {
+			return err
+		}
+		c.EscapePolicy = policy
+	case "theme":
+		switch value {
+		case zlmd.DefaultTheme.Name():
+			c.Theme = zlmd.DefaultTheme
+		case zlmd.PlainTheme.Name():
+			c.Theme = zlmd.PlainTheme
+		default:
+			return fmt.Errorf("unknown theme %q", value)
+		}
+	case "fence":
+		switch value {
+		case "backtick":
+			c.Fence = zlmd.FenceBacktick
+		case "tilde":
+			c.Fence = zlmd.FenceTilde
+		default:
+			return fmt.Errorf("unknown fence style %q", value)
+		}
+	case "max_length":
+		n, err := strconv.Atoi(value)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("max_length must be a positive integer, got %q", value)
+		}
+		c.MaxLength = n
+	case "server_version":
+		v, err := zlmd.ParseServerVersion(value)
+		if err != nil {
+			return err
+		}
+		c.ServerVersion = v
+	default:
+		return fmt.Errorf("unknown key %q", key)
+	}
+	return nil
+}
+
+// stripComment removes a trailing # comment that is outside quotes and
+// either starts the line or follows whitespace.
+func stripComment(line string) string {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		switch c := line[i]; {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#' && (i == 0 || line[i-1] == ' ' || line[i-1] == '\t'):
+			return line[:i]
+		}
+	}
+	return line
+}
+
+// unquote removes matching single or double quotes around value.
+func unquote(value string) (string, error) {
+	if len(value) < 2 {
+		return value, nil
+	}
+	switch value[0] {
+	case '"':
+		if value[len(value)-1] != '"' {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+		return strconv.Unquote(value)
+	case '\'':
+		if value[len(value)-1] != '\'' {
+			return "", fmt.Errorf("unterminated string %s", value)
+		}
+		return strings.ReplaceAll(value[1:len(value)-1], "''", "'"), nil
+	}
+	return value, nil
+}
+
+// expandHome replaces a leading ~ in path with the user's home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, path[1:])
+}
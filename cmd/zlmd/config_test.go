@@ -0,0 +1,104 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestParseConfig(t *testing.T) {
+	input := `---
+# deploy bot defaults
+site: "https://chat.example.com/"
+email: 'deploy-bot@chat.example.com'
+escape_policy: all   # untrusted commit messages
+theme: plain
+fence: tilde
+max_length: 8000
+server_version: 7.4
+`
+	cfg, err := parseConfig(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseConfig() error = %v", err)
+	}
+	if cfg.Site != "https://chat.example.com" || cfg.Email != "deploy-bot@chat.example.com" {
+		t.Errorf("Unexpected site/email: %q %q", cfg.Site, cfg.Email)
+	}
+	if cfg.EscapePolicy != zlmd.EscapeAll || cfg.Theme != zlmd.PlainTheme || cfg.Fence != zlmd.FenceTilde || cfg.MaxLength != 8000 ||
+		cfg.ServerVersion != (zlmd.ServerVersion{Major: 7, Minor: 4}) {
+		t.Errorf("Unexpected config: %+v", cfg)
+	}
+}
+
+func TestParseConfig_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"Unknown key", "colour: red", `line 1: unknown key "colour"`},
+		{"Bad policy", "\nescape_policy: some", `line 2: unknown escape policy "some"`},
+		{"Bad length", "max_length: -1", "line 1: max_length must be a positive integer"},
+		{"No colon", "site", "line 1: expected"},
+		{"Bad server version", "server_version: nine", `line 1: zlmd: invalid server version "nine"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseConfig(strings.NewReader(tt.input))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseConfig() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun_Config(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	os.WriteFile(path, []byte("escape_policy: all\nfence: tilde\n"), 0o644)
+
+	_, out, _ := runCLI(t, "*x*", "--config", path, "escape")
+	if out != "\\*x\\*\n" {
+		t.Errorf("Expected config escape policy to apply, got %q", out)
+	}
+	_, out, _ = runCLI(t, "*x*", "--config="+path, "escape", "--policy", "none")
+	if out != "*x*\n" {
+		t.Errorf("Expected --policy to override config, got %q", out)
+	}
+	_, out, _ = runCLI(t, "x", "--config", path, "code")
+	if out != "~~~\nx\n~~~\n" {
+		t.Errorf("Expected config fence style to apply, got %q", out)
+	}
+
+	if code, _, _ := runCLI(t, "", "--config", filepath.Join(t.TempDir(), "missing.yaml")); code != exitIO {
+		t.Errorf("Expected exit code %d for a missing explicit config, got %d", exitIO, code)
+	}
+}
+
+func TestRun_ConfigSendEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	os.WriteFile(path, []byte("site: https://chat.example.com\nemail: bot@example.com\nzuliprc: /etc/zuliprc\n"), 0o644)
+
+	_, out, _ := runCLI(t, "hi\n:send\n", "--quiet", "--config", path, "repl", "--exec", `cat >/dev/null; echo "$ZULIP_SITE $ZULIP_EMAIL $ZULIP_CONFIG"`)
+	if !strings.Contains(out, "https://chat.example.com bot@example.com /etc/zuliprc\n") {
+		t.Errorf("Expected the send command to get the configured site and credentials, got %q", out)
+	}
+}
+
+func TestRun_ConfigTheme(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	os.WriteFile(path, []byte("theme: plain\n"), 0o644)
+	t.Cleanup(func() { zlmd.SetDefaultConfig(zlmd.Config{}) })
+
+	runCLI(t, "", "--config", path, "strip")
+	var sb strings.Builder
+	zlmd.Badge(&sb, "ok", "success")
+	var plain strings.Builder
+	zlmd.PlainTheme.Badge(&plain, "ok", "success")
+	if sb.String() != plain.String() {
+		t.Errorf("Badge() with theme: plain = %q, want %q", sb.String(), plain.String())
+	}
+}
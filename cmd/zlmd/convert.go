@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// conversionExt maps each `zlmd convert --to` format to the file extension
+// used with --out.
+var conversionExt = map[string]string{
+	"plain":   ".txt",
+	"escaped": ".md",
+	"html":    ".html",
+}
+
+// runConvert implements `zlmd convert`, converting markdown files to plain
+// text, escaped markdown or HTML.
+func runConvert(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd convert", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var batch batchFlags
+	batch.register(fs)
+	to := fs.String("to", "plain", "output `format`: plain, escaped or html")
+	policyName := fs.String("policy", settings.EscapePolicy.String(), "escape `policy` for --to escaped")
+	outDir := fs.String("out", "", "write each converted file below `dir` instead of printing it")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd convert [--to plain|escaped|html] [--policy p] [--out dir] [--include glob] [--exclude glob] [-j n] [path...]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Paths may be files, directories or dir/... for a recursive walk; with no")
+		fmt.Fprintln(stderr, "paths stdin is converted to stdout. With --out, files keep their relative")
+		fmt.Fprintln(stderr, "path and get a .txt, .md or .html extension.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	ext, ok := conversionExt[*to]
+	policy, err := zlmd.ParseEscapePolicy(*policyName)
+	if !ok || err != nil {
+		if !ok {
+			err = fmt.Errorf("unknown output format %q", *to)
+		}
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitUsage
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	convert := func(markdown string) (string, error) {
+		switch *to {
+		case "escaped":
+			return zlmd.Escape(markdown, policy), nil
+		case "html":
+			return zlmd.ProcessContext(ctx, markdown)
+		default:
+			return zlmd.StripMarkdown(markdown), nil
+		}
+	}
+
+	if fs.NArg() == 0 {
+		input, err := readInput("-", stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+			return exitIO
+		}
+		output, err := convert(input)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error processing markdown: %v\n", err)
+			return exitValidation
+		}
+		fmt.Fprintln(stdout, output)
+		return exitOK
+	}
+
+	files, err := batch.expandPaths(fs.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+	results := processFiles(ctx, files, batch.workers, func(path, content string) (string, error) {
+		output, err := convert(content)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", path, err)
+		}
+		if *outDir == "" {
+			return output + "\n", nil
+		}
+		target := filepath.Join(*outDir, outputPath(path, ext))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return "", err
+		}
+		return "", os.WriteFile(target, []byte(output+"\n"), 0o644)
+	})
+	return printResults(results, stdout, stderr)
+}
+
+// outputPath returns the path below --out for the input file at path: its
+// relative path, or its base name when it is absolute or outside the
+// working directory, with the extension replaced by ext.
+func outputPath(path, ext string) string {
+	rel := filepath.Clean(path)
+	if filepath.IsAbs(rel) || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		rel = filepath.Base(rel)
+	}
+	return strings.TrimSuffix(rel, filepath.Ext(rel)) + ext
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunConvert(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdin    string
+		args     []string
+		expected string
+	}{
+		{"Plain", "**hi**", nil, "hi\n"},
+		{"Escaped", "*hi*", []string{"--to", "escaped", "--policy", "all"}, "\\*hi\\*\n"},
+		{"HTML", "x", []string{"--to", "html"}, "Processed: x\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, tt.stdin, append([]string{"convert"}, tt.args...)...)
+			if code != exitOK || out != tt.expected {
+				t.Errorf("convert = %d %q, want %q", code, out, tt.expected)
+			}
+		})
+	}
+
+	if code, _, _ := runCLI(t, "", "convert", "--to", "pdf"); code != exitUsage {
+		t.Errorf("Expected exit code %d for an unknown format, got %d", exitUsage, code)
+	}
+}
+
+func TestRunConvert_Out(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{"docs/a.md": "**a**", "docs/sub/b.md": "_b_"})
+	out := filepath.Join(dir, "out")
+
+	wd, _ := os.Getwd()
+	os.Chdir(dir)
+	defer os.Chdir(wd)
+
+	if code, _, errOut := runCLI(t, "", "convert", "--out", out, "docs/..."); code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, errOut)
+	}
+	for name, expected := range map[string]string{"docs/a.txt": "a\n", "docs/sub/b.txt": "b\n"} {
+		data, err := os.ReadFile(filepath.Join(out, filepath.FromSlash(name)))
+		if err != nil || string(data) != expected {
+			t.Errorf("%s = %q, %v; want %q", name, data, err, expected)
+		}
+	}
+}
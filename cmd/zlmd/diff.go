@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runDiff implements `zlmd diff`, comparing two markdown files block by block.
+func runDiff(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd diff", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	asMessage := fs.Bool("as-message", false, "print a Zulip message with a diff block instead of a change summary")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd diff [--as-message] old.md new.md")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Compares two markdown files block by block, ignoring blank lines and")
+		fmt.Fprintln(stderr, "trailing whitespace. Use - to read one side from stdin.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	old, err := readInput(fs.Arg(0), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+	new, err := readInput(fs.Arg(1), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	changes := zlmd.DiffMarkdown(old, new)
+	summary := zlmd.SummarizeChanges(changes)
+
+	if jsonOutput {
+		return printDiffJSON(stdout, stderr, changes, summary)
+	}
+
+	if *asMessage {
+		fmt.Fprintln(stdout, zlmd.Bold("Markdown diff:")+" "+summary)
+		if len(changes) > 0 {
+			normalize := func(s string) string { return strings.Join(zlmd.MarkdownBlocks(s), "\n\n") }
+			fmt.Fprintln(stdout, zlmd.DiffBlock(zlmd.DiffStrings(normalize(old), normalize(new))))
+		}
+		return exitOK
+	}
+
+	for _, c := range changes {
+		index, text := c.NewIndex, c.New
+		if c.Kind == zlmd.ChangeRemoved {
+			index, text = c.OldIndex, c.Old
+		}
+		first, _, _ := strings.Cut(text, "\n")
+		fmt.Fprintf(stdout, "%s block %d: %s\n", c.Kind, index+1, first)
+	}
+	fmt.Fprintln(stdout, summary)
+	return exitOK
+}
+
+// diffChangeJSON is the JSON form of a zlmd.BlockChange. Block numbers are
+// one-based like the text output and omitted for the side a block is missing
+// from.
+type diffChangeJSON struct {
+	Kind     string `json:"kind"`
+	OldBlock int    `json:"old_block,omitempty"`
+	NewBlock int    `json:"new_block,omitempty"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+}
+
+// printDiffJSON writes the changes found by `zlmd diff` as JSON.
+func printDiffJSON(stdout, stderr io.Writer, changes []zlmd.BlockChange, summary string) int {
+	out := struct {
+		Summary string           `json:"summary"`
+		Changes []diffChangeJSON `json:"changes"`
+	}{Summary: summary, Changes: []diffChangeJSON{}}
+
+	for _, c := range changes {
+		out.Changes = append(out.Changes, diffChangeJSON{
+			Kind:     c.Kind.String(),
+			OldBlock: c.OldIndex + 1,
+			NewBlock: c.NewIndex + 1,
+			Old:      c.Old,
+			New:      c.New,
+		})
+	}
+	if err := writeJSON(stdout, out); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	return exitOK
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRunDiff(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.md")
+	newPath := filepath.Join(dir, "new.md")
+	os.WriteFile(oldPath, []byte("# Status\n\nAll good\n\nFooter\n"), 0o644)
+	os.WriteFile(newPath, []byte("# Status\n\nDegraded\n\nSee log\n\nFooter\n"), 0o644)
+
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"Summary", []string{"diff", oldPath, newPath}, "modified block 2: Degraded\nadded block 3: See log\n1 added, 1 modified\n"},
+		{"Message", []string{"diff", "--as-message", oldPath, newPath}, "**Markdown diff:** 1 added, 1 modified\n" +
+			"```diff\n--- a\n+++ b\n@@ -1,5 +1,7 @@\n # Status\n \n-All good\n+Degraded\n+\n+See log\n \n Footer\n```\n"},
+		{"No changes", []string{"diff", oldPath, oldPath}, "no changes\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, "", tt.args...)
+			if code != 0 {
+				t.Fatalf("Expected exit code 0, got %d", code)
+			}
+			if out != tt.expected {
+				t.Errorf("diff output = %q, want %q", out, tt.expected)
+			}
+		})
+	}
+
+	if code, _, _ := runCLI(t, "", "diff", oldPath); code != exitUsage {
+		t.Errorf("Expected exit code %d with one file, got %d", exitUsage, code)
+	}
+}
+
+func TestRunDiff_JSON(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "old.md")
+	newPath := filepath.Join(dir, "new.md")
+	os.WriteFile(oldPath, []byte("a\n\nb\n"), 0o644)
+	os.WriteFile(newPath, []byte("a\n\nc\n\nd\n"), 0o644)
+
+	code, out, _ := runCLI(t, "", "--json", "diff", oldPath, newPath)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+
+	var got struct {
+		Summary string
+		Changes []diffChangeJSON
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", out, err)
+	}
+	expected := []diffChangeJSON{
+		{Kind: "modified", OldBlock: 2, NewBlock: 2, Old: "b", New: "c"},
+		{Kind: "added", NewBlock: 3, New: "d"},
+	}
+	if got.Summary != "1 added, 1 modified" || !reflect.DeepEqual(got.Changes, expected) {
+		t.Errorf("Unexpected JSON output %q", out)
+	}
+}
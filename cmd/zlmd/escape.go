@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runEscape implements `zlmd escape`, neutralizing markdown in untrusted text
+// so shell scripts can interpolate it into messages safely.
+func runEscape(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd escape", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	policyName := fs.String("policy", settings.EscapePolicy.String(), "escape `policy`: mentions, fences, all or none")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd escape [--policy mentions|fences|all|none] [file]...")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Reads text from the given files, or from stdin when none are given, and")
+		fmt.Fprintln(stderr, "writes it with the selected markdown constructs escaped.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	policy, err := zlmd.ParseEscapePolicy(*policyName)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	fmt.Fprintln(stdout, zlmd.Escape(input, policy))
+	return exitOK
+}
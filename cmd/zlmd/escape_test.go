@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRunEscape(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdin    string
+		args     []string
+		expected string
+	}{
+		{"Default mentions", "hi @**all**\n", nil, "hi @\\*\\*all\\*\\*\n"},
+		{"Fences", "```\nx\n", []string{"--policy", "fences"}, "\\```\nx\n"},
+		{"All", "*x*", []string{"--policy", "all"}, "\\*x\\*\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, tt.stdin, append([]string{"escape"}, tt.args...)...)
+			if code != 0 {
+				t.Fatalf("Expected exit code 0, got %d", code)
+			}
+			if out != tt.expected {
+				t.Errorf("escape output = %q, want %q", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunEscape_UnknownPolicy(t *testing.T) {
+	if code, _, _ := runCLI(t, "x", "escape", "--policy", "bogus"); code != exitUsage {
+		t.Errorf("Expected exit code %d for unknown policy, got %d", exitUsage, code)
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+)
+
+// Exit codes returned by every subcommand, so scripts and CI pipelines can
+// tell failures apart without parsing messages.
+const (
+	// exitOK means the command succeeded.
+	exitOK = 0
+	// exitLint means the input was processed but has lint findings.
+	exitLint = 1
+	// exitValidation means the input could not be parsed or the result is
+	// not a valid message, such as a template error or an oversized message.
+	exitValidation = 2
+	// exitIO means a file, stream or network operation failed.
+	exitIO = 3
+	// exitUsage means the command line was invalid, following sysexits.h.
+	exitUsage = 64
+)
+
+// quiet reports whether --quiet was given. Quiet commands print only their
+// results and errors, leaving out informational messages and warnings.
+var quiet bool
+
+// infof writes an informational message or warning to w unless --quiet was
+// given.
+func infof(w io.Writer, format string, args ...any) {
+	if !quiet {
+		fmt.Fprintf(w, format, args...)
+	}
+}
+
+// exitCodeFor returns exitIO for filesystem errors and exitValidation for
+// anything else.
+func exitCodeFor(err error) int {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		return exitIO
+	}
+	return exitValidation
+}
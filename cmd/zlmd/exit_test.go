@@ -0,0 +1,48 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExitCodes(t *testing.T) {
+	dir := t.TempDir()
+	badConfig := filepath.Join(dir, "config.yaml")
+	os.WriteFile(badConfig, []byte("colour: red\n"), 0o644)
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+	}{
+		{"OK", []string{"strip"}, exitOK},
+		{"Unknown flag", []string{"strip", "--bogus"}, exitUsage},
+		{"Missing file", []string{"strip", filepath.Join(dir, "missing.md")}, exitIO},
+		{"Invalid input", []string{"table", "--from", "json"}, exitValidation},
+		{"Invalid config", []string{"--config", badConfig, "strip"}, exitValidation},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code, _, _ := runCLI(t, "not json", tt.args...); code != tt.code {
+				t.Errorf("Expected exit code %d, got %d", tt.code, code)
+			}
+		})
+	}
+}
+
+func TestQuiet(t *testing.T) {
+	dir := t.TempDir()
+	cfg := filepath.Join(dir, "config.yaml")
+	os.WriteFile(cfg, []byte("max_length: 5\n"), 0o644)
+
+	_, _, errOut := runCLI(t, "a long message", "--config", cfg)
+	if errOut == "" {
+		t.Error("Expected a length warning without --quiet")
+	}
+	code, out, errOut := runCLI(t, "a long message", "--config", cfg, "--quiet")
+	if code != exitOK || out == "" || errOut != "" {
+		t.Errorf("Expected output without warnings in quiet mode, got %d %q %q", code, out, errOut)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runExtract implements `zlmd extract`, listing the mentions, links, code
+// blocks and tables a message contains.
+func runExtract(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd extract", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	mentions := fs.Bool("mentions", false, "list user, group and wildcard mentions")
+	links := fs.Bool("links", false, "list links")
+	code := fs.Bool("code", false, "list fenced code blocks")
+	tables := fs.Bool("tables", false, "list tables")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd extract [--mentions] [--links] [--code] [--tables] [file]...")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Lists what the markdown references, everything when no kind is selected.")
+		fmt.Fprintln(stderr, "Use the global --json flag for machine-readable output.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if !*mentions && !*links && !*code && !*tables {
+		*mentions, *links, *code, *tables = true, true, true, true
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	var out extractResult
+	// fields holds the requested kinds for JSON output, where a requested
+	// kind without results is an empty list rather than missing.
+	fields := map[string]any{}
+	if *mentions {
+		out.Mentions = zlmd.ExtractMentions(input)
+		fields["mentions"] = orEmpty(out.Mentions)
+	}
+	if *links {
+		out.Links = zlmd.ExtractLinks(input)
+		fields["links"] = orEmpty(out.Links)
+	}
+	if *code {
+		out.Code = zlmd.ExtractCode(input)
+		fields["code"] = orEmpty(out.Code)
+	}
+	if *tables {
+		out.Tables = zlmd.ExtractTables(input)
+		fields["tables"] = orEmpty(out.Tables)
+	}
+
+	if jsonOutput {
+		if err := writeJSON(stdout, fields); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitIO
+		}
+		return exitOK
+	}
+	out.writeText(stdout)
+	return exitOK
+}
+
+// extractResult is the output of `zlmd extract`.
+type extractResult struct {
+	Mentions []zlmd.MentionRef
+	Links    []zlmd.LinkRef
+	Code     []zlmd.FencedCode
+	Tables   []zlmd.TableData
+}
+
+// writeText writes one line per extracted item, prefixed with its kind.
+func (r extractResult) writeText(w io.Writer) {
+	for _, m := range r.Mentions {
+		var notes []string
+		switch {
+		case m.Wildcard:
+			notes = append(notes, "wildcard")
+		case m.Group:
+			notes = append(notes, "group")
+		}
+		if m.Silent {
+			notes = append(notes, "silent")
+		}
+		if len(notes) > 0 {
+			fmt.Fprintf(w, "mention: %s (%s)\n", m.Text, strings.Join(notes, ", "))
+		} else {
+			fmt.Fprintf(w, "mention: %s\n", m.Text)
+		}
+	}
+	for _, l := range r.Links {
+		if l.Text != "" {
+			fmt.Fprintf(w, "link: %s (%s)\n", l.URL, l.Text)
+		} else {
+			fmt.Fprintf(w, "link: %s\n", l.URL)
+		}
+	}
+	for _, c := range r.Code {
+		lang := c.Language
+		if lang == "" {
+			lang = "plain"
+		}
+		lines := strings.Count(c.Code, "\n") + 1
+		fmt.Fprintf(w, "code: %s, %d %s\n", lang, lines, plural(lines, "line", "lines"))
+	}
+	for _, t := range r.Tables {
+		fmt.Fprintf(w, "table: %s, %d %s\n", strings.Join(t.Headers, " | "), len(t.Rows), plural(len(t.Rows), "row", "rows"))
+	}
+}
+
+// orEmpty returns items, or an empty slice when it is nil, so that it is
+// encoded as [] rather than null.
+func orEmpty[T any](items []T) []T {
+	if items == nil {
+		return []T{}
+	}
+	return items
+}
+
+// plural returns singular when n is 1 and plural otherwise.
+func plural(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const extractInput = "cc @**Alice|12** and @**all**, see [docs](https://example.com/docs)\n\n" +
+	"```go\nfmt.Println()\nreturn\n```\n\n| a | b |\n| --- | --- |\n| 1 | 2 |\n"
+
+func TestRunExtract_Text(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"All", nil, "mention: @**Alice|12**\nmention: @**all** (wildcard)\n" +
+			"link: https://example.com/docs (docs)\ncode: go, 2 lines\ntable: a | b, 1 row\n"},
+		{"Links only", []string{"--links"}, "link: https://example.com/docs (docs)\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, extractInput, append([]string{"extract"}, tt.args...)...)
+			if code != 0 {
+				t.Fatalf("Expected exit code 0, got %d", code)
+			}
+			if out != tt.expected {
+				t.Errorf("extract output = %q, want %q", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunExtract_JSON(t *testing.T) {
+	code, out, _ := runCLI(t, "no references here", "--json", "extract", "--mentions", "--tables")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+
+	var got map[string][]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", out, err)
+	}
+	if len(got) != 2 || got["mentions"] == nil || got["tables"] == nil {
+		t.Errorf("Expected empty mentions and tables only, got %q", out)
+	}
+}
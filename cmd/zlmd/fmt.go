@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runFmt implements `zlmd fmt`, normalizing blank lines and trailing
+// whitespace in markdown files.
+func runFmt(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd fmt", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var batch batchFlags
+	batch.register(fs)
+	write := fs.Bool("w", false, "write the result back to the files instead of printing it")
+	list := fs.Bool("l", false, "list files whose formatting differs")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd fmt [-w] [-l] [--include glob] [--exclude glob] [-j n] [path...]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Strips trailing whitespace and separates blocks with exactly one blank")
+		fmt.Fprintln(stderr, "line. Paths may be files, directories or dir/... for a recursive walk;")
+		fmt.Fprintln(stderr, "with no paths stdin is formatted to stdout.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if fs.NArg() == 0 {
+		input, err := readInput("-", stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+			return exitIO
+		}
+		fmt.Fprint(stdout, formatMarkdown(input))
+		return exitOK
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	files, err := batch.expandPaths(fs.Args())
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	results := processFiles(ctx, files, batch.workers, func(path, content string) (string, error) {
+		formatted := formatMarkdown(content)
+		changed := formatted != content
+		if *write && changed {
+			info, err := os.Stat(path)
+			if err != nil {
+				return "", err
+			}
+			if err := os.WriteFile(path, []byte(formatted), info.Mode().Perm()); err != nil {
+				return "", err
+			}
+		}
+		switch {
+		case *list && changed:
+			return path + "\n", nil
+		case *list || *write:
+			return "", nil
+		default:
+			return formatted, nil
+		}
+	})
+	return printResults(results, stdout, stderr)
+}
+
+// formatMarkdown returns markdown with its blocks separated by a single blank
+// line, trailing whitespace removed and a final newline.
+func formatMarkdown(markdown string) string {
+	blocks := zlmd.MarkdownBlocks(markdown)
+	if len(blocks) == 0 {
+		return ""
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// printResults writes each successful result to stdout and each error to
+// stderr, returning exitIO if any file failed.
+func printResults(results []fileResult, stdout, stderr io.Writer) int {
+	code := exitOK
+	for _, r := range results {
+		if r.err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", r.err)
+			code = exitIO
+			continue
+		}
+		fmt.Fprint(stdout, r.output)
+	}
+	return code
+}
@@ -0,0 +1,31 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunFmt(t *testing.T) {
+	code, out, _ := runCLI(t, "# Title  \n\n\n\nbody\n", "fmt")
+	if code != exitOK || out != "# Title\n\nbody\n" {
+		t.Errorf("fmt stdin = %d %q", code, out)
+	}
+
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"clean.md":    "ok\n",
+		"sub/mess.md": "a \n\n\nb",
+	})
+
+	code, out, _ = runCLI(t, "", "fmt", "-l", dir+"/...")
+	if code != exitOK || out != filepath.Join(dir, "sub", "mess.md")+"\n" {
+		t.Errorf("fmt -l = %d %q", code, out)
+	}
+
+	code, out, _ = runCLI(t, "", "fmt", "-w", dir)
+	data, _ := os.ReadFile(filepath.Join(dir, "sub", "mess.md"))
+	if code != exitOK || out != "" || string(data) != "a\n\nb\n" {
+		t.Errorf("fmt -w = %d %q, file %q", code, out, data)
+	}
+}
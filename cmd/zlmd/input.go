@@ -0,0 +1,50 @@
+package main
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// stringList is a flag.Value collecting every occurrence of a repeatable flag.
+type stringList []string
+
+// String implements flag.Value.
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set implements flag.Value.
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// readInputs reads and concatenates the named files, reading stdin for "-"
+// or when no names are given. Files are joined with a blank line so that
+// separate documents don't run into each other.
+func readInputs(names []string, stdin io.Reader) (string, error) {
+	if len(names) == 0 {
+		names = []string{"-"}
+	}
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		data, err := readInput(name, stdin)
+		if err != nil {
+			return "", err
+		}
+		parts = append(parts, strings.TrimRight(data, "\n"))
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
+
+// readInput reads a single file, or stdin when name is "-".
+func readInput(name string, stdin io.Reader) (string, error) {
+	if name == "-" {
+		data, err := io.ReadAll(stdin)
+		return string(data), err
+	}
+	data, err := os.ReadFile(name)
+	return string(data), err
+}
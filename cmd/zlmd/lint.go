@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// lintFinding is a single problem reported by `zlmd lint`.
+type lintFinding struct {
+	File    string `json:"file"`
+	Message string `json:"message"`
+}
+
+// runLint implements `zlmd lint`, reporting markdown files that can't be
+// posted as they are.
+func runLint(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var batch batchFlags
+	batch.register(fs)
+	serverVersion := fs.String("server-version", "", "report constructs Zulip `version` can't render (default: server_version from the config file)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd lint [--include glob] [--exclude glob] [-j n] [--server-version v] [path...]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Reports empty messages, messages over the length limit, unclosed code")
+		fmt.Fprintln(stderr, "fences and constructs the target server version doesn't render.")
+		fmt.Fprintln(stderr, "Exits with status 1 when anything is found.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *serverVersion != "" {
+		v, err := zlmd.ParseServerVersion(*serverVersion)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitUsage
+		}
+		settings.ServerVersion = v
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	var results []fileResult
+	if fs.NArg() == 0 {
+		input, err := readInput("-", stdin)
+		results = []fileResult{{path: "<stdin>", output: strings.Join(checkMessage(input), "\n"), err: err}}
+	} else {
+		files, err := batch.expandPaths(fs.Args())
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+			return exitIO
+		}
+		results = processFiles(ctx, files, batch.workers, func(path, content string) (string, error) {
+			return strings.Join(checkMessage(content), "\n"), nil
+		})
+	}
+
+	code := exitOK
+	findings := []lintFinding{}
+	for _, r := range results {
+		if r.err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", r.err)
+			code = exitIO
+			continue
+		}
+		if r.output == "" {
+			continue
+		}
+		for _, problem := range strings.Split(r.output, "\n") {
+			findings = append(findings, lintFinding{r.path, problem})
+		}
+	}
+
+	if jsonOutput {
+		if err := writeJSON(stdout, map[string][]lintFinding{"findings": findings}); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitIO
+		}
+	} else {
+		for _, f := range findings {
+			fmt.Fprintf(stdout, "%s: %s\n", f.File, f.Message)
+		}
+	}
+
+	if code == exitOK && len(findings) > 0 {
+		code = exitLint
+	}
+	return code
+}
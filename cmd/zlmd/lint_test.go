@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestRunLint(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"good.md":      "fine\n",
+		"bad/open.md":  "```\ncode\n",
+		"bad/empty.md": "\n",
+	})
+
+	code, out, _ := runCLI(t, "", "lint", dir)
+	expected := filepath.Join(dir, "bad", "empty.md") + ": message is empty\n" +
+		filepath.Join(dir, "bad", "open.md") + ": code fence opened on line 1 is never closed\n"
+	if code != exitLint || out != expected {
+		t.Errorf("lint = %d %q, want %d %q", code, out, exitLint, expected)
+	}
+
+	code, out, _ = runCLI(t, "", "--json", "lint", filepath.Join(dir, "bad", "open.md"))
+	var got struct{ Findings []lintFinding }
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", out, err)
+	}
+	want := []lintFinding{{filepath.Join(dir, "bad", "open.md"), "code fence opened on line 1 is never closed"}}
+	if code != exitLint || !reflect.DeepEqual(got.Findings, want) {
+		t.Errorf("lint --json = %d %+v", code, got.Findings)
+	}
+
+	if code, out, _ := runCLI(t, "all good", "lint"); code != exitOK || out != "" {
+		t.Errorf("lint stdin = %d %q", code, out)
+	}
+
+	spoiler := "```spoiler Logs\ntrace\n```\ncc @**topic**"
+	code, out, _ = runCLI(t, spoiler, "lint", "--server-version", "7.x")
+	expected = "<stdin>: @**topic** mentions are not rendered by Zulip 7.0 (needs 8.0)\n"
+	if code != exitLint || out != expected {
+		t.Errorf("lint --server-version = %d %q, want %d %q", code, out, exitLint, expected)
+	}
+	if code, _, _ := runCLI(t, spoiler, "lint", "--server-version", "latest"); code != exitUsage {
+		t.Errorf("lint --server-version latest = %d, want %d", code, exitUsage)
+	}
+}
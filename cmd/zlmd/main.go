@@ -1,8 +1,15 @@
 package main
 
 import (
+	"encoding/json"
+	"flag"
 	"fmt"
+	"io"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/veiloq/zulip-markdown/zlmd"
 )
@@ -10,27 +17,177 @@ import (
 var version = "dev"
 
 func main() {
-	args := os.Args[1:]
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
 
-	if len(args) > 0 && (args[0] == "-v" || args[0] == "--version") {
-		fmt.Printf("ZLMD version %s\n", version)
-		return
-	}
+// command is a zlmd subcommand. run receives the arguments following the
+// subcommand name and returns the process exit code.
+type command struct {
+	summary string
+	run     func(args []string, stdin io.Reader, stdout, stderr io.Writer) int
+}
+
+// commands lists the available subcommands by name.
+var commands = map[string]command{
+	"code":     {"wrap input in a code block", runCode},
+	"convert":  {"convert markdown to plain text, escaped markdown or HTML", runConvert},
+	"diff":     {"compare two markdown files block by block", runDiff},
+	"escape":   {"escape markdown in untrusted text", runEscape},
+	"extract":  {"list mentions, links, code blocks and tables", runExtract},
+	"fmt":      {"normalize blank lines and trailing whitespace", runFmt},
+	"lint":     {"report messages that cannot be posted as they are", runLint},
+	"preview":  {"serve a live-reloading HTML preview of a file", runPreview},
+	"repl":     {"compose, check and send messages interactively", runRepl},
+	"serve":    {"serve conversions over HTTP", runServe},
+	"spoiler":  {"wrap input in a spoiler block", runSpoiler},
+	"split":    {"split input into messages under the length limit", runSplit},
+	"stream":   {"batch lines from stdin into code block messages", runStream},
+	"strip":    {"print markdown as plain text", runStrip},
+	"table":    {"build a markdown table from CSV, TSV or JSON", runTable},
+	"template": {"render a message template with JSON or YAML data", runTemplate},
+	"validate": {"check that input can be posted as one message", runValidate},
+	"watch":    {"re-process a file whenever it changes", runWatch},
+}
 
-	fmt.Println("Zulip Markdown (ZLMD) CLI")
-	fmt.Println("A tool for working with Zulip-flavored Markdown")
+// run executes the CLI with the given arguments and streams and returns the
+// process exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	global, args, err := splitGlobalFlags(args)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitUsage
+	}
+	jsonOutput, quiet = global.json, global.quiet
+	if settings, err = loadConfig(global.config); err != nil {
+		fmt.Fprintf(stderr, "Error loading config: %v\n", err)
+		return exitCodeFor(err)
+	}
+	zlmd.SetDefaultConfig(settings.zlmdConfig())
 
-	// Basic example of how to use the library
 	if len(args) > 0 {
-		input := args[0]
-		result, err := zlmd.Process(input)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Error processing markdown: %v\n", err)
-			os.Exit(1)
+		if cmd, ok := commands[args[0]]; ok {
+			return cmd.run(args[1:], stdin, stdout, stderr)
+		}
+	}
+
+	fs := flag.NewFlagSet("zlmd", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var files stringList
+	var showVersion bool
+	fs.Var(&files, "f", "read markdown from `file` (repeatable, - for stdin)")
+	fs.BoolVar(&showVersion, "v", false, "print version and exit")
+	fs.BoolVar(&showVersion, "version", false, "print version and exit")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Zulip Markdown (ZLMD) CLI")
+		fmt.Fprintln(stderr, "A tool for working with Zulip-flavored Markdown")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Usage: zlmd [--config file] [--quiet] [-f file]... [file]...")
+		fmt.Fprintln(stderr, "       zlmd [--config file] [--quiet] [--json] <command> [flags] [args]")
+		fmt.Fprintln(stderr, "       zlmd -v | --version")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Reads markdown from the given files, or from stdin when none are given.")
+		fmt.Fprintf(stderr, "Defaults are read from --config, or from %s if it exists.\n", defaultConfigPath())
+		fmt.Fprintln(stderr, "With --json, commands print structured JSON instead of text. With --quiet,")
+		fmt.Fprintln(stderr, "only results and errors are printed.")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Exit codes: 0 success, 1 lint findings, 2 invalid input or message,")
+		fmt.Fprintln(stderr, "3 I/O error, 64 usage error.")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Commands:")
+		names := make([]string, 0, len(commands))
+		for name := range commands {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			fmt.Fprintf(stderr, "  %-10s %s\n", name, commands[name].summary)
 		}
-		fmt.Println(result)
-	} else {
-		fmt.Println("Usage: zlmd [markdown text]")
-		fmt.Println("       zlmd -v | --version")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
 	}
+
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	if showVersion {
+		fmt.Fprintf(stdout, "ZLMD version %s\n", version)
+		return exitOK
+	}
+
+	inputs := append(files, fs.Args()...)
+	markdown, err := readInputs(inputs, stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	result, err := zlmd.Process(markdown)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error processing markdown: %v\n", err)
+		return exitValidation
+	}
+	if n := utf8.RuneCountInString(result); n > settings.MaxLength {
+		infof(stderr, "Warning: output is %d characters, over the %d character message limit\n", n, settings.MaxLength)
+	}
+	fmt.Fprintln(stdout, result)
+	return exitOK
+}
+
+// jsonOutput reports whether --json was given, asking subcommands to print
+// structured JSON instead of text.
+var jsonOutput bool
+
+// globalFlags are the flags accepted before any subcommand.
+type globalFlags struct {
+	config string
+	json   bool
+	quiet  bool
+}
+
+// splitGlobalFlags removes the leading global flags from args, which must
+// come before any subcommand, and returns them with the remaining arguments.
+func splitGlobalFlags(args []string) (globalFlags, []string, error) {
+	var g globalFlags
+	for len(args) > 0 {
+		name, value, hasValue := strings.Cut(args[0], "=")
+		switch name {
+		case "-config", "--config":
+			if !hasValue {
+				if len(args) < 2 {
+					return g, nil, fmt.Errorf("flag needs an argument: %s", name)
+				}
+				value, args = args[1], args[1:]
+			}
+			g.config = value
+		case "-json", "--json", "-q", "-quiet", "--quiet":
+			b := true
+			if hasValue {
+				var err error
+				if b, err = strconv.ParseBool(value); err != nil {
+					return g, nil, fmt.Errorf("invalid value %q for flag %s", value, name)
+				}
+			}
+			if strings.HasSuffix(name, "json") {
+				g.json = b
+			} else {
+				g.quiet = b
+			}
+		default:
+			return g, args, nil
+		}
+		args = args[1:]
+	}
+	return g, args, nil
+}
+
+// writeJSON writes v to w as indented JSON.
+func writeJSON(w io.Writer, v any) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
 }
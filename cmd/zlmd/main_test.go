@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// runCLI runs the CLI with args and stdin and returns the exit code and output.
+func runCLI(t *testing.T, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+func TestRun_Stdin(t *testing.T) {
+	code, out, _ := runCLI(t, "**hello**\n")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	if !strings.Contains(out, "**hello**") {
+		t.Errorf("Expected stdin to be processed, got %q", out)
+	}
+}
+
+func TestRun_Files(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.md")
+	b := filepath.Join(dir, "b.md")
+	os.WriteFile(a, []byte("first\n"), 0o600)
+	os.WriteFile(b, []byte("second\n"), 0o600)
+
+	code, out, _ := runCLI(t, "", "-f", a, b)
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	if !strings.Contains(out, "first\n\nsecond") {
+		t.Errorf("Expected files to be joined, got %q", out)
+	}
+
+	code, _, errOut := runCLI(t, "", filepath.Join(dir, "missing.md"))
+	if code == 0 || !strings.Contains(errOut, "missing.md") {
+		t.Errorf("Expected failure for missing file, got code %d and %q", code, errOut)
+	}
+}
+
+func TestRun_LengthWarning(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		warning bool
+	}{
+		{"Under limit", strings.Repeat("x", 100), false},
+		{"Multi-byte under limit", strings.Repeat("日", 9000), false},
+		{"Over limit", strings.Repeat("x", 10001), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, errOut := runCLI(t, tt.input)
+			if code != 0 {
+				t.Fatalf("Expected exit code 0, got %d", code)
+			}
+			if got := strings.Contains(errOut, "over the 10000 character message limit"); got != tt.warning {
+				t.Errorf("Expected warning %v, got %q", tt.warning, errOut)
+			}
+		})
+	}
+}
+
+func TestRun_Version(t *testing.T) {
+	code, out, _ := runCLI(t, "", "--version")
+	if code != 0 || out != "ZLMD version dev\n" {
+		t.Errorf("Unexpected version output: %d %q", code, out)
+	}
+}
+
+func TestSplitGlobalFlags(t *testing.T) {
+	g, rest, err := splitGlobalFlags([]string{"--json", "--config", "c.yaml", "diff", "--json"})
+	if err != nil || !g.json || g.config != "c.yaml" || strings.Join(rest, " ") != "diff --json" {
+		t.Errorf("splitGlobalFlags() = %+v, %q, %v", g, rest, err)
+	}
+	if _, _, err := splitGlobalFlags([]string{"--json=maybe"}); err == nil {
+		t.Error("Expected error for invalid --json value")
+	}
+	if _, _, err := splitGlobalFlags([]string{"--config"}); err == nil {
+		t.Error("Expected error for --config without a value")
+	}
+}
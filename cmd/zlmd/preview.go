@@ -0,0 +1,135 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// previewPage is the HTML page served by `zlmd preview`. The script polls
+// /version and reloads the page whenever the source file changes.
+var previewPage = template.Must(template.New("preview").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}} - zlmd preview</title>
+<style>
+body { max-width: 50em; margin: 2em auto; font-family: sans-serif; line-height: 1.4; }
+pre, code { background: #f5f5f5; }
+.error { color: #b00; }
+</style>
+</head>
+<body>
+{{if .Err}}<pre class="error">{{.Err}}</pre>{{else}}{{.Body}}{{end}}
+<script>
+(function() {
+  var version = {{.Version}};
+  setInterval(function() {
+    fetch("/version").then(function(r) { return r.text(); }).then(function(v) {
+      if (v !== version) { location.reload(); }
+    }).catch(function() {});
+  }, 1000);
+})();
+</script>
+</body>
+</html>
+`))
+
+// runPreview implements `zlmd preview`, serving a rendered markdown file on
+// localhost and reloading the page whenever the file changes.
+func runPreview(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd preview", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	addr := fs.String("addr", "localhost:8000", "listen `address`")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd preview [--addr host:port] file.md")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Renders the file to HTML and serves it, reloading the page in the browser")
+		fmt.Fprintln(stderr, "whenever the file changes.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	path := fs.Arg(0)
+	if _, err := os.Stat(path); err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	ln, err := net.Listen("tcp", *addr)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	infof(stdout, "Serving %s on http://%s/\n", path, ln.Addr())
+
+	if err := http.Serve(ln, previewHandler(path)); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	return exitOK
+}
+
+// previewHandler serves the rendered file at / and its modification time at
+// /version for the live reload script.
+func previewHandler(path string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/version", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprint(w, fileVersion(path))
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+
+		data := struct {
+			Title   string
+			Version string
+			Body    template.HTML
+			Err     string
+		}{Title: path, Version: fileVersion(path)}
+
+		markdown, err := os.ReadFile(path)
+		if err == nil {
+			data.Body, err = zlmd.ProcessHTML(string(markdown))
+		}
+		if err != nil {
+			data.Err = err.Error()
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		previewPage.Execute(w, data)
+	})
+	return mux
+}
+
+// fileVersion identifies the current contents of path by its modification
+// time and size, or returns "missing" when it cannot be read.
+func fileVersion(path string) string {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "missing"
+	}
+	return strconv.FormatInt(info.ModTime().UnixNano(), 36) + "-" + strconv.FormatInt(info.Size(), 36)
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"io"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPreviewHandler(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "msg.md")
+	if err := os.WriteFile(path, []byte("**hello**"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(previewHandler(path))
+	defer srv.Close()
+
+	get := func(p string) string {
+		t.Helper()
+		resp, err := srv.Client().Get(srv.URL + p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		return string(body)
+	}
+
+	page := get("/")
+	if !strings.Contains(page, "**hello**") || !strings.Contains(page, `fetch("/version")`) {
+		t.Errorf("Expected rendered page with reload script, got %q", page)
+	}
+
+	before := get("/version")
+	later := time.Now().Add(time.Second)
+	if err := os.WriteFile(path, []byte("**changed**"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	os.Chtimes(path, later, later)
+	if after := get("/version"); after == before {
+		t.Errorf("Expected version to change after edit, still %q", after)
+	}
+}
+
+func TestRunPreview_Usage(t *testing.T) {
+	if code, _, _ := runCLI(t, "", "preview"); code != exitUsage {
+		t.Errorf("Expected exit code %d without a file, got %d", exitUsage, code)
+	}
+	if code, _, _ := runCLI(t, "", "preview", filepath.Join(t.TempDir(), "missing.md")); code != exitIO {
+		t.Errorf("Expected exit code %d for a missing file, got %d", exitIO, code)
+	}
+}
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// replHelp lists the commands understood by `zlmd repl`.
+const replHelp = `Type markdown to add it to the draft. Commands:
+  :stream NAME   set the stream to send to
+  :topic NAME    set the topic to send to
+  :show          print the draft as typed
+  :preview       print the draft rendered for the terminal
+  :check         check the draft can be posted
+  :send          check the draft and send it with the --exec command
+  :clear         discard the draft
+  :quit          exit (as does end of input)
+Start a line with "::" to add a line starting with ":".`
+
+// repl holds the state of an interactive `zlmd repl` session.
+type repl struct {
+	stream, topic string
+	execCmd       string
+	draft         []string
+	stdout        io.Writer
+	stderr        io.Writer
+}
+
+// runRepl implements `zlmd repl`, an interactive prompt for composing,
+// checking, previewing and sending messages.
+func runRepl(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd repl", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	r := &repl{stdout: stdout, stderr: stderr}
+	fs.StringVar(&r.stream, "stream", "", "initial `stream`")
+	fs.StringVar(&r.topic, "topic", "", "initial `topic`")
+	fs.StringVar(&r.execCmd, "exec", "", "shell `command` that sends a message read from stdin; $ZLMD_STREAM and $ZLMD_TOPIC hold the target, and $ZULIP_SITE, $ZULIP_EMAIL and $ZULIP_CONFIG the configured credentials")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd repl [--stream name] [--topic name] [--exec command]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, replHelp)
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	infof(stdout, "Type :help for commands.\n")
+	scanner := bufio.NewScanner(stdin)
+	for {
+		infof(stdout, "%s ", r.prompt())
+		if !scanner.Scan() {
+			break
+		}
+		if !r.handle(scanner.Text()) {
+			return exitOK
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+	infof(stdout, "\n")
+	return exitOK
+}
+
+// prompt shows the current target, such as "#ops > deploys>".
+func (r *repl) prompt() string {
+	switch {
+	case r.stream != "" && r.topic != "":
+		return "#" + r.stream + " > " + r.topic + ">"
+	case r.stream != "":
+		return "#" + r.stream + ">"
+	default:
+		return ">"
+	}
+}
+
+// handle processes one input line and reports whether the session goes on.
+func (r *repl) handle(line string) bool {
+	if !strings.HasPrefix(line, ":") || strings.HasPrefix(line, "::") {
+		r.draft = append(r.draft, strings.TrimPrefix(line, ":"))
+		return true
+	}
+
+	name, arg, _ := strings.Cut(strings.TrimPrefix(line, ":"), " ")
+	arg = strings.TrimSpace(arg)
+	message := strings.Join(r.draft, "\n")
+
+	switch name {
+	case "help":
+		fmt.Fprintln(r.stdout, replHelp)
+	case "stream":
+		r.stream = arg
+	case "topic":
+		r.topic = arg
+	case "show":
+		fmt.Fprintln(r.stdout, message)
+	case "preview":
+		fmt.Fprintln(r.stdout, renderANSI(message))
+	case "check":
+		if r.check(message) {
+			fmt.Fprintln(r.stdout, "OK")
+		}
+	case "send":
+		if r.send(message) {
+			r.draft = nil
+		}
+	case "clear":
+		r.draft = nil
+	case "quit", "q", "exit":
+		return false
+	default:
+		fmt.Fprintf(r.stderr, "Unknown command :%s, type :help for commands\n", name)
+	}
+	return true
+}
+
+// check prints the problems found by checkMessage and reports whether there
+// were none.
+func (r *repl) check(message string) bool {
+	problems := checkMessage(message)
+	for _, problem := range problems {
+		fmt.Fprintf(r.stderr, "Error: %s\n", problem)
+	}
+	return len(problems) == 0
+}
+
+// send checks message and hands it to the --exec command, reporting whether
+// it was sent.
+func (r *repl) send(message string) bool {
+	if r.execCmd == "" {
+		fmt.Fprintln(r.stderr, "Error: no send command; start zlmd repl with --exec")
+		return false
+	}
+	if !r.check(message) {
+		return false
+	}
+	err := runShell(context.Background(), r.execCmd, message, r.stdout, r.stderr,
+		"ZLMD_STREAM="+r.stream, "ZLMD_TOPIC="+r.topic)
+	if err != nil {
+		fmt.Fprintf(r.stderr, "Error sending message: %v\n", err)
+		return false
+	}
+	infof(r.stdout, "Sent to %s\n", strings.TrimSuffix(r.prompt(), ">"))
+	return true
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRunRepl(t *testing.T) {
+	input := strings.Join([]string{
+		"**hello**",
+		"::colon",
+		":show",
+		":stream ops",
+		":topic deploys",
+		":send",
+		":show",
+		"```",
+		":check",
+		":bogus",
+		":quit",
+		"never read",
+	}, "\n")
+
+	code, out, errOut := runCLI(t, input, "--quiet", "repl", "--exec", `cat; echo "to $ZLMD_STREAM/$ZLMD_TOPIC"`)
+	if code != exitOK {
+		t.Fatalf("Expected exit code %d, got %d", exitOK, code)
+	}
+
+	expectedOut := "**hello**\n:colon\n" + // :show
+		"**hello**\n:colon\nto ops/deploys\n" + // :send through the exec command
+		"\n" // :show of the cleared draft
+	if out != expectedOut {
+		t.Errorf("repl output = %q, want %q", out, expectedOut)
+	}
+	expectedErr := "Error: code fence opened on line 1 is never closed\nUnknown command :bogus, type :help for commands\n"
+	if errOut != expectedErr {
+		t.Errorf("repl errors = %q, want %q", errOut, expectedErr)
+	}
+}
+
+func TestRunRepl_Prompt(t *testing.T) {
+	_, out, errOut := runCLI(t, ":stream ops\n:send\n", "repl")
+	if !strings.Contains(out, "> #ops> ") {
+		t.Errorf("Expected prompt to show the stream, got %q", out)
+	}
+	if !strings.Contains(errOut, "no send command") {
+		t.Errorf("Expected send without --exec to fail, got %q", errOut)
+	}
+}
@@ -0,0 +1,139 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// maxRequestBody limits the size of markdown accepted by `zlmd serve`.
+const maxRequestBody = 1 << 20
+
+// runServe implements `zlmd serve`, exposing zlmd conversions over HTTP.
+func runServe(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd serve", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	listen := fs.String("listen", "localhost:8080", "listen `address`")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd serve [--listen host:port]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Serves these endpoints, each taking markdown as the POST body:")
+		fmt.Fprintln(stderr, "  /convert?to=plain|escaped[&policy=...]  plain text or escaped markdown")
+		fmt.Fprintln(stderr, "  /render-html                           HTML rendering")
+		fmt.Fprintln(stderr, "  /lint                                  {\"problems\": [...]} as JSON")
+		fmt.Fprintln(stderr, "  /split[?max=n]                         {\"messages\": [...]} as JSON")
+		fmt.Fprintln(stderr, "GET /healthz reports whether the service is up.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() > 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	ln, err := net.Listen("tcp", *listen)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	infof(stderr, "Listening on http://%s/\n", ln.Addr())
+
+	if err := http.Serve(ln, serveHandler()); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	return exitOK
+}
+
+// serveHandler returns the handler behind `zlmd serve`.
+func serveHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, "ok")
+	})
+	mux.HandleFunc("/convert", markdownHandler(func(w http.ResponseWriter, r *http.Request, markdown string) {
+		switch to := r.URL.Query().Get("to"); to {
+		case "", "plain":
+			writeText(w, "text/plain", zlmd.StripMarkdown(markdown))
+		case "escaped":
+			policy := zlmd.EscapeMentions
+			if name := r.URL.Query().Get("policy"); name != "" {
+				var err error
+				if policy, err = zlmd.ParseEscapePolicy(name); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+			}
+			writeText(w, "text/markdown", zlmd.Escape(markdown, policy))
+		default:
+			http.Error(w, fmt.Sprintf("unknown conversion %q", to), http.StatusBadRequest)
+		}
+	}))
+	mux.HandleFunc("/render-html", markdownHandler(func(w http.ResponseWriter, r *http.Request, markdown string) {
+		html, err := zlmd.ProcessHTML(markdown)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		writeText(w, "text/html", string(html))
+	}))
+	mux.HandleFunc("/lint", markdownHandler(func(w http.ResponseWriter, r *http.Request, markdown string) {
+		writeJSONResponse(w, map[string][]string{"problems": orEmpty(checkMessage(markdown))})
+	}))
+	mux.HandleFunc("/split", markdownHandler(func(w http.ResponseWriter, r *http.Request, markdown string) {
+		max := settings.MaxLength
+		if s := r.URL.Query().Get("max"); s != "" {
+			n, err := strconv.Atoi(s)
+			if err != nil || n <= 0 {
+				http.Error(w, fmt.Sprintf("max must be a positive integer, got %q", s), http.StatusBadRequest)
+				return
+			}
+			max = n
+		}
+		writeJSONResponse(w, map[string][]string{"messages": orEmpty(zlmd.SplitMessage(markdown, zlmd.WithMaxLength(max)))})
+	}))
+	return mux
+}
+
+// markdownHandler adapts fn to an http.HandlerFunc that accepts only POST
+// requests and passes the request body as markdown.
+func markdownHandler(fn func(w http.ResponseWriter, r *http.Request, markdown string)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBody))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		fn(w, r, string(body))
+	}
+}
+
+// writeText writes body with the given media type.
+func writeText(w http.ResponseWriter, mediaType, body string) {
+	w.Header().Set("Content-Type", mediaType+"; charset=utf-8")
+	io.WriteString(w, body)
+}
+
+// writeJSONResponse writes v as a JSON response.
+func writeJSONResponse(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestServeHandler(t *testing.T) {
+	settings = defaultConfig()
+	srv := httptest.NewServer(serveHandler())
+	defer srv.Close()
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		body     string
+		status   int
+		expected string
+	}{
+		{"Health", http.MethodGet, "/healthz", "", http.StatusOK, "ok\n"},
+		{"Plain", http.MethodPost, "/convert", "**hi** [x](https://e.x)", http.StatusOK, "hi x (https://e.x)"},
+		{"Escaped", http.MethodPost, "/convert?to=escaped&policy=all", "*x*", http.StatusOK, "\\*x\\*"},
+		{"Bad policy", http.MethodPost, "/convert?to=escaped&policy=bogus", "x", http.StatusBadRequest, "unknown escape policy \"bogus\"\n"},
+		{"Bad conversion", http.MethodPost, "/convert?to=pdf", "x", http.StatusBadRequest, "unknown conversion \"pdf\"\n"},
+		{"Render HTML", http.MethodPost, "/render-html", "x", http.StatusOK, "Processed: x"},
+		{"Lint", http.MethodPost, "/lint", "```\nx", http.StatusOK, `{"problems":["code fence opened on line 1 is never closed"]}` + "\n"},
+		{"Lint clean", http.MethodPost, "/lint", "x", http.StatusOK, `{"problems":[]}` + "\n"},
+		{"Split", http.MethodPost, "/split?max=4", "aaaa\n\nbbbb", http.StatusOK, `{"messages":["aaaa","bbbb"]}` + "\n"},
+		{"Bad max", http.MethodPost, "/split?max=0", "x", http.StatusBadRequest, "max must be a positive integer, got \"0\"\n"},
+		{"GET not allowed", http.MethodGet, "/lint", "", http.StatusMethodNotAllowed, "method not allowed\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, _ := http.NewRequest(tt.method, srv.URL+tt.path, strings.NewReader(tt.body))
+			resp, err := srv.Client().Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+
+			if resp.StatusCode != tt.status || string(body) != tt.expected {
+				t.Errorf("%s %s = %d %q, want %d %q", tt.method, tt.path, resp.StatusCode, body, tt.status, tt.expected)
+			}
+		})
+	}
+}
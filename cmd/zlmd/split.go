@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"unicode/utf8"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// splitChunk is one message in the output of `zlmd split --json`.
+type splitChunk struct {
+	Index  int    `json:"index"`
+	Length int    `json:"length"`
+	Text   string `json:"text"`
+}
+
+// runSplit implements `zlmd split`, breaking the input into messages that
+// each fit within the length limit.
+func runSplit(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd split", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	maxLength := fs.Int("max", settings.MaxLength, "maximum message `length`")
+	execCmd := fs.String("exec", "", "shell `command` run with each message on stdin, instead of printing it")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd split [--max n] [--exec command] [file]...")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Splits the input into messages under the length limit, breaking between")
+		fmt.Fprintln(stderr, "blocks and re-fencing code blocks that span messages. Parts are printed")
+		fmt.Fprintln(stderr, "under a \"--- part i/n ---\" line when there are several.")
+		fmt.Fprintln(stderr, "Use the global --json flag for machine-readable output.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *maxLength <= 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	parts := zlmd.SplitMessage(input, zlmd.WithMaxLength(*maxLength))
+	switch {
+	case jsonOutput:
+		chunks := make([]splitChunk, len(parts))
+		for i, part := range parts {
+			chunks[i] = splitChunk{Index: i + 1, Length: utf8.RuneCountInString(part), Text: part}
+		}
+		if err := writeJSON(stdout, map[string][]splitChunk{"chunks": chunks}); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitIO
+		}
+	case *execCmd != "":
+		for _, part := range parts {
+			if err := runShell(context.Background(), *execCmd, part, stdout, stderr); err != nil {
+				fmt.Fprintf(stderr, "Error: %v\n", err)
+				return exitIO
+			}
+		}
+	default:
+		for i, part := range parts {
+			if len(parts) > 1 {
+				fmt.Fprintf(stdout, "--- part %d/%d ---\n", i+1, len(parts))
+			}
+			fmt.Fprintln(stdout, part)
+		}
+	}
+	return exitOK
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRunSplit(t *testing.T) {
+	input := "first paragraph\n\nsecond paragraph"
+
+	code, out, _ := runCLI(t, input, "split", "--max", "20")
+	expected := "--- part 1/2 ---\nfirst paragraph\n--- part 2/2 ---\nsecond paragraph\n"
+	if code != exitOK || out != expected {
+		t.Errorf("split = %d %q, want %d %q", code, out, exitOK, expected)
+	}
+
+	code, out, _ = runCLI(t, input, "split")
+	if code != exitOK || out != input+"\n" {
+		t.Errorf("split of a short message = %d %q, want it unchanged", code, out)
+	}
+
+	code, out, _ = runCLI(t, input, "split", "--max", "20", "--exec", `wc -l | tr -d ' '`)
+	if code != exitOK || out != "1\n1\n" {
+		t.Errorf("split --exec = %d %q, want one command run per part", code, out)
+	}
+
+	if code, _, _ := runCLI(t, input, "split", "--max", "0"); code != exitUsage {
+		t.Errorf("split --max 0 = %d, want %d", code, exitUsage)
+	}
+}
+
+func TestRunSplit_JSON(t *testing.T) {
+	code, out, _ := runCLI(t, "first paragraph\n\nsecond paragraph", "--json", "split", "--max", "20")
+	var got struct{ Chunks []splitChunk }
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", out, err)
+	}
+	want := []splitChunk{{1, 15, "first paragraph"}, {2, 16, "second paragraph"}}
+	if code != exitOK || !reflect.DeepEqual(got.Chunks, want) {
+		t.Errorf("split --json = %d %+v, want %+v", code, got.Chunks, want)
+	}
+
+	if _, out, _ := runCLI(t, "", "--json", "split"); out != "{\n  \"chunks\": []\n}\n" {
+		t.Errorf("split --json of empty input = %q", out)
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runStream implements `zlmd stream`, reading lines from stdin until it is
+// closed and emitting them as fenced code block messages.
+func runStream(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd stream", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	lang := fs.String("lang", "text", "`language` of the code blocks")
+	every := fs.Duration("flush-every", 5*time.Second, "emit buffered lines at least this often (0 to wait until a message is full)")
+	maxLength := fs.Int("max", settings.MaxLength, "maximum message `length`")
+	execCmd := fs.String("exec", "", "shell `command` run with each message on stdin, instead of printing it")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd stream [--lang text] [--flush-every 5s] [--max n] [--exec command]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Reads stdin continuously and batches lines into code block messages that")
+		fmt.Fprintln(stderr, "stay under the length limit, for tailing logs into Zulip:")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "  kubectl logs -f deploy/api | zlmd stream --exec './post-to-zulip'")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() > 0 || *every < 0 || *maxLength <= 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	emit := func(message string) error {
+		if *execCmd != "" {
+			return runShell(context.Background(), *execCmd, message, stdout, stderr)
+		}
+		_, err := fmt.Fprintln(stdout, message)
+		return err
+	}
+	w := zlmd.NewLogWriter(zlmd.WithLanguage(*lang), zlmd.WithMaxLength(*maxLength), zlmd.WithFlushFunc(emit))
+
+	if err := streamLines(stdin, w, *every); err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitIO
+	}
+	return exitOK
+}
+
+// streamLines copies lines from r to w, flushing w every interval and once
+// more when r is exhausted. An interval of zero disables timed flushes.
+func streamLines(r io.Reader, w *zlmd.LogWriter, interval time.Duration) error {
+	lines := make(chan string)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadString('\n')
+			if line != "" {
+				lines <- line
+			}
+			if err != nil {
+				if err != io.EOF {
+					readErr <- err
+				}
+				return
+			}
+		}
+	}()
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				if err := w.Close(); err != nil {
+					return err
+				}
+				select {
+				case err := <-readErr:
+					return err
+				default:
+					return nil
+				}
+			}
+			if _, err := io.WriteString(w, line); err != nil {
+				return err
+			}
+		case <-tick:
+			if err := w.Flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
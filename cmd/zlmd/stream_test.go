@@ -0,0 +1,75 @@
+package main
+
+import (
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestRunStream(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdin    string
+		args     []string
+		expected string
+	}{
+		{"Single batch", "one\ntwo\n", []string{"--flush-every", "0"}, "```text\none\ntwo\n```\n"},
+		{"Split by size", "aaaa\nbbbb\ncccc", []string{"--lang", "", "--max", "18"}, "```\naaaa\nbbbb\n```\n```\ncccc\n```\n"},
+		{"Exec", "x\n", []string{"--exec", "tr a-z A-Z"}, "```TEXT\nX\n```\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, errOut := runCLI(t, tt.stdin, append([]string{"stream"}, tt.args...)...)
+			if code != exitOK {
+				t.Fatalf("Expected exit code %d, got %d: %s", exitOK, code, errOut)
+			}
+			if out != tt.expected {
+				t.Errorf("stream output = %q, want %q", out, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStreamLines_FlushEvery(t *testing.T) {
+	var mu sync.Mutex
+	var messages []string
+	w := zlmd.NewLogWriter(zlmd.WithFlushFunc(func(message string) error {
+		mu.Lock()
+		defer mu.Unlock()
+		messages = append(messages, message)
+		return nil
+	}))
+
+	r, pw := io.Pipe()
+	done := make(chan error)
+	go func() { done <- streamLines(r, w, 10*time.Millisecond) }()
+
+	io.WriteString(pw, "first\n")
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		mu.Lock()
+		n := len(messages)
+		mu.Unlock()
+		if n == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Timed out waiting for a timed flush")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	io.WriteString(pw, "second\n")
+	pw.Close()
+	if err := <-done; err != nil {
+		t.Fatalf("streamLines() error = %v", err)
+	}
+
+	if got := strings.Join(messages, "|"); got != "```text\nfirst\n```|```text\nsecond\n```" {
+		t.Errorf("Unexpected messages %q", got)
+	}
+}
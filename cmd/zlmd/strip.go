@@ -0,0 +1,36 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runStrip implements `zlmd strip`, printing markdown as plain text.
+func runStrip(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd strip", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd strip [file]...")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Prints the markdown as plain text with formatting removed and links")
+		fmt.Fprintln(stderr, "written as \"text (url)\", for notification fallbacks and search indexes.")
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	fmt.Fprintln(stdout, zlmd.StripMarkdown(input))
+	return exitOK
+}
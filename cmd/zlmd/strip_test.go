@@ -0,0 +1,16 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRunStrip(t *testing.T) {
+	code, out, _ := runCLI(t, "# Alert\n**db** is down, see [runbook](https://wiki.example/db)\n", "strip")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	expected := "Alert\ndb is down, see runbook (https://wiki.example/db)\n"
+	if out != expected {
+		t.Errorf("strip output = %q, want %q", out, expected)
+	}
+}
@@ -0,0 +1,236 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runTable implements `zlmd table`, building a markdown table from CSV, TSV
+// or JSON read from a file or stdin.
+func runTable(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd table", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	from := fs.String("from", "csv", "input `format`: csv, tsv or json")
+	align := fs.String("align", "", "comma-separated column `alignments`: l, c, r or - for default")
+	maxRows := fs.Int("max-rows", 0, "maximum number of data `rows` to render (0 for all)")
+	bold := fs.Bool("bold-headers", false, "render headers in bold")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd table [--from csv|tsv|json] [--align l,c,r] [--max-rows n] [--bold-headers] [file]")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "The first CSV/TSV record is used as the header row. JSON input must be an")
+		fmt.Fprintln(stderr, "array of arrays (first row is the header) or an array of objects.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	var records [][]string
+	switch *from {
+	case "csv":
+		records, err = readDelimited(input, ',')
+	case "tsv":
+		records, err = readDelimited(input, '\t')
+	case "json":
+		records, err = readJSONRecords(input)
+	default:
+		err = fmt.Errorf("unknown input format %q", *from)
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "Error parsing input: %v\n", err)
+		return exitValidation
+	}
+	if len(records) == 0 {
+		return exitOK
+	}
+
+	alignments, err := parseAlignments(*align)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error: %v\n", err)
+		return exitUsage
+	}
+
+	table := zlmd.NewTableBuilder().WithHeaders(escapeCells(records[0])...).SetAlignments(alignments...)
+	if *bold {
+		table.WithBoldHeaders()
+	}
+
+	rows := records[1:]
+	total := len(rows)
+	if *maxRows > 0 && len(rows) > *maxRows {
+		rows = rows[:*maxRows]
+	}
+	for _, row := range rows {
+		table.AddRow(escapeCells(row)...)
+	}
+
+	fmt.Fprint(stdout, table.Build())
+	if len(rows) < total {
+		fmt.Fprintln(stdout, zlmd.Italic(fmt.Sprintf("%d of %d rows shown", len(rows), total)))
+	}
+	return exitOK
+}
+
+// readDelimited parses CSV-style records separated by comma.
+func readDelimited(input string, comma rune) ([][]string, error) {
+	r := csv.NewReader(strings.NewReader(input))
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+	if comma == '\t' {
+		r.LazyQuotes = true
+	}
+	return r.ReadAll()
+}
+
+// readJSONRecords parses an array of arrays or an array of objects into
+// records whose first entry is the header row. Object keys keep the order in
+// which they first appear.
+func readJSONRecords(input string) ([][]string, error) {
+	var items []json.RawMessage
+	if err := json.Unmarshal([]byte(input), &items); err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	if bytes.HasPrefix(bytes.TrimSpace(items[0]), []byte("[")) {
+		records := make([][]string, 0, len(items))
+		for _, item := range items {
+			var values []any
+			if err := json.Unmarshal(item, &values); err != nil {
+				return nil, err
+			}
+			row := make([]string, len(values))
+			for i, v := range values {
+				row[i] = jsonCell(v)
+			}
+			records = append(records, row)
+		}
+		return records, nil
+	}
+
+	var headers []string
+	index := map[string]int{}
+	objects := make([]map[string]any, 0, len(items))
+	for _, item := range items {
+		keys, err := objectKeys(item)
+		if err != nil {
+			return nil, err
+		}
+		for _, k := range keys {
+			if _, ok := index[k]; !ok {
+				index[k] = len(headers)
+				headers = append(headers, k)
+			}
+		}
+		var obj map[string]any
+		if err := json.Unmarshal(item, &obj); err != nil {
+			return nil, err
+		}
+		objects = append(objects, obj)
+	}
+
+	records := [][]string{headers}
+	for _, obj := range objects {
+		row := make([]string, len(headers))
+		for k, v := range obj {
+			row[index[k]] = jsonCell(v)
+		}
+		records = append(records, row)
+	}
+	return records, nil
+}
+
+// objectKeys returns the keys of a JSON object in document order.
+func objectKeys(raw json.RawMessage) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return nil, errors.New("expected an array of arrays or an array of objects")
+	}
+
+	var keys []string
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, tok.(string))
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, err
+		}
+	}
+	return keys, nil
+}
+
+// jsonCell renders a decoded JSON value as a table cell.
+func jsonCell(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64, bool:
+		return fmt.Sprint(v)
+	default:
+		data, _ := json.Marshal(v)
+		return string(data)
+	}
+}
+
+// parseAlignments parses a comma-separated list such as "r,l,c".
+func parseAlignments(spec string) ([]zlmd.Alignment, error) {
+	if spec == "" {
+		return nil, nil
+	}
+	var alignments []zlmd.Alignment
+	for _, part := range strings.Split(spec, ",") {
+		switch strings.ToLower(strings.TrimSpace(part)) {
+		case "l", "left":
+			alignments = append(alignments, zlmd.AlignLeft)
+		case "c", "center":
+			alignments = append(alignments, zlmd.AlignCenter)
+		case "r", "right":
+			alignments = append(alignments, zlmd.AlignRight)
+		case "", "-", "default":
+			alignments = append(alignments, zlmd.AlignDefault)
+		default:
+			return nil, fmt.Errorf("invalid alignment %q (want l, c, r or -)", part)
+		}
+	}
+	return alignments, nil
+}
+
+// escapeCells escapes pipes and newlines that would break table cells.
+func escapeCells(cells []string) []string {
+	out := make([]string, len(cells))
+	for i, cell := range cells {
+		cell = strings.ReplaceAll(cell, "|", "\\|")
+		out[i] = strings.ReplaceAll(cell, "\n", " ")
+	}
+	return out
+}
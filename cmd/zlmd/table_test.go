@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRunTable_CSV(t *testing.T) {
+	code, out, _ := runCLI(t, "name,age\nAlice,30\nBob,25\nCarol,41\n", "table", "--align", "l,r", "--max-rows", "2")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	expected := "| name | age |\n| :--- | ---: |\n| Alice | 30 |\n| Bob | 25 |\n*2 of 3 rows shown*\n"
+	if out != expected {
+		t.Errorf("Unexpected output\nExpected:\n%q\nGot:\n%q", expected, out)
+	}
+}
+
+func TestRunTable_JSON(t *testing.T) {
+	code, out, _ := runCLI(t, `[{"service":"api","up":true},{"service":"db|1","lag":2}]`, "table", "--from", "json", "--bold-headers")
+	if code != 0 {
+		t.Fatalf("Expected exit code 0, got %d", code)
+	}
+	expected := "| **service** | **up** | **lag** |\n| --- | --- | --- |\n| api | true |  |\n| db\\|1 |  | 2 |\n"
+	if out != expected {
+		t.Errorf("Unexpected output\nExpected:\n%q\nGot:\n%q", expected, out)
+	}
+}
+
+func TestRunTable_Errors(t *testing.T) {
+	if code, _, _ := runCLI(t, "a,b\n", "table", "--align", "x"); code != exitUsage {
+		t.Errorf("Expected exit code %d for invalid alignment, got %d", exitUsage, code)
+	}
+	if code, _, _ := runCLI(t, "not json", "table", "--from", "json"); code != exitValidation {
+		t.Errorf("Expected exit code %d for invalid JSON, got %d", exitValidation, code)
+	}
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runTemplate implements `zlmd template`, currently only `zlmd template render`.
+func runTemplate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] != "render" {
+		fmt.Fprintln(stderr, "Usage: zlmd template render [--data file] tmpl.md")
+		if len(args) > 0 && (args[0] == "-h" || args[0] == "--help" || args[0] == "-help") {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	fs := flag.NewFlagSet("zlmd template render", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	dataPath := fs.String("data", "", "JSON or YAML `file` with the template data (- for stdin)")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd template render [--data file] tmpl.md")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Renders a text/template with the zlmd helpers (bold, codeblock, escape,")
+		fmt.Fprintln(stderr, "progress, time, bytes, ...) and checks the result fits in one message.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	positional, err := parseInterspersed(fs, args[1:])
+	if err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if len(positional) != 1 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	source, err := readInput(positional[0], stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading template: %v\n", err)
+		return exitIO
+	}
+	tmpl, err := template.New(filepath.Base(positional[0])).
+		Funcs(zlmd.FuncMap()).
+		Option("missingkey=error").
+		Parse(source)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error parsing template: %v\n", err)
+		return exitValidation
+	}
+
+	var data any
+	if *dataPath != "" {
+		raw, err := readInput(*dataPath, stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "Error reading data: %v\n", err)
+			return exitIO
+		}
+		if data, err = parseData(*dataPath, raw); err != nil {
+			fmt.Fprintf(stderr, "Error parsing data: %v\n", err)
+			return exitValidation
+		}
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		fmt.Fprintf(stderr, "Error rendering template: %v\n", err)
+		return exitValidation
+	}
+	message := strings.TrimRight(sb.String(), "\n")
+
+	if problems := checkMessage(message); len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Fprintf(stderr, "Error: rendered %s\n", problem)
+		}
+		return exitValidation
+	}
+
+	fmt.Fprintln(stdout, message)
+	return exitOK
+}
+
+// parseData decodes template data as JSON when name ends in .json or the
+// content starts with { or [, and as YAML otherwise.
+func parseData(name, raw string) (any, error) {
+	trimmed := strings.TrimSpace(raw)
+	if strings.HasSuffix(name, ".json") || strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var data any
+		err := json.Unmarshal([]byte(raw), &data)
+		return data, err
+	}
+	return parseYAML(raw)
+}
+
+// parseInterspersed parses args with fs, allowing flags to follow positional
+// arguments, and returns the positional arguments.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		if args[0] == "--" {
+			return append(positional, args[1:]...), nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
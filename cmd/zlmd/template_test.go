@@ -0,0 +1,60 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRunTemplate(t *testing.T) {
+	dir := t.TempDir()
+	tmpl := filepath.Join(dir, "deploy.md")
+	os.WriteFile(tmpl, []byte("{{bold .service}} deployed to {{range $i, $r := .regions}}{{if $i}}, {{end}}{{$r}}{{end}}\n{{progress .done .total 10}}\n"), 0o644)
+
+	jsonData := filepath.Join(dir, "values.json")
+	os.WriteFile(jsonData, []byte(`{"service": "api", "regions": ["eu", "us"], "done": 5, "total": 10}`), 0o644)
+	yamlData := filepath.Join(dir, "values.yaml")
+	os.WriteFile(yamlData, []byte("service: api\nregions:\n  - eu\n  - us\ndone: 5\ntotal: 10\n"), 0o644)
+
+	expected := "**api** deployed to eu, us\n▓▓▓▓▓░░░░░ 50%\n"
+	for _, data := range []string{jsonData, yamlData} {
+		code, out, errOut := runCLI(t, "", "template", "render", tmpl, "--data", data)
+		if code != 0 {
+			t.Fatalf("Expected exit code 0 for %s, got %d: %s", data, code, errOut)
+		}
+		if out != expected {
+			t.Errorf("template render with %s = %q, want %q", filepath.Base(data), out, expected)
+		}
+	}
+}
+
+func TestRunTemplate_Errors(t *testing.T) {
+	dir := t.TempDir()
+	tmpl := filepath.Join(dir, "t.md")
+	os.WriteFile(tmpl, []byte("{{.missing}}"), 0o644)
+	long := filepath.Join(dir, "long.md")
+	os.WriteFile(long, []byte(`{{range .}}{{.}}{{end}}`), 0o644)
+	cfg := filepath.Join(dir, "config.yaml")
+	os.WriteFile(cfg, []byte("max_length: 5\n"), 0o644)
+
+	tests := []struct {
+		name string
+		args []string
+		code int
+		want string
+	}{
+		{"No subcommand", []string{"template"}, exitUsage, "Usage"},
+		{"Missing key", []string{"template", "render", "--data", "-", tmpl}, exitValidation, "missing"},
+		{"Too long", []string{"--config", cfg, "template", "render", "--data", "-", long}, exitValidation, "over the 5 character message limit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, _, errOut := runCLI(t, `{"a": "123456"}`, tt.args...)
+			if code != tt.code || !strings.Contains(errOut, tt.want) {
+				t.Errorf("Expected exit code %d and %q, got %d and %q", tt.code, tt.want, code, errOut)
+			}
+		})
+	}
+}
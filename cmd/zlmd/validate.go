@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// validateResult is the output of `zlmd validate --json`.
+type validateResult struct {
+	Valid    bool     `json:"valid"`
+	Length   int      `json:"length"`
+	Problems []string `json:"problems"`
+}
+
+// runValidate implements `zlmd validate`, checking that the input can be
+// posted as a single message.
+func runValidate(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd validate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd validate [file]...")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Checks that the input, read as one message, can be posted as it is, with")
+		fmt.Fprintln(stderr, "the checks of zlmd lint. Exits with status 2 if it can't.")
+		fmt.Fprintln(stderr, "Use the global --json flag for machine-readable output.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	problems := checkMessage(input)
+	result := validateResult{
+		Valid:    len(problems) == 0,
+		Length:   utf8.RuneCountInString(input),
+		Problems: orEmpty(problems),
+	}
+	if jsonOutput {
+		if err := writeJSON(stdout, result); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+			return exitIO
+		}
+	} else {
+		for _, problem := range problems {
+			fmt.Fprintf(stdout, "%s\n", problem)
+		}
+		if result.Valid {
+			infof(stdout, "Valid message of %d characters\n", result.Length)
+		}
+	}
+
+	if !result.Valid {
+		return exitValidation
+	}
+	return exitOK
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRunValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		args     []string
+		code     int
+		expected string
+	}{
+		{"Valid", "**hello**", []string{"--quiet", "validate"}, exitOK, ""},
+		{"Valid message", "hi", []string{"validate"}, exitOK, "Valid message of 2 characters\n"},
+		{"Unclosed fence", "```\ncode", []string{"validate"}, exitValidation, "code fence opened on line 1 is never closed\n"},
+		{"Empty", "  \n", []string{"validate"}, exitValidation, "message is empty\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, tt.input, tt.args...)
+			if code != tt.code || out != tt.expected {
+				t.Errorf("validate = %d %q, want %d %q", code, out, tt.code, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunValidate_JSON(t *testing.T) {
+	code, out, _ := runCLI(t, "```\ncode", "--json", "validate")
+	var got validateResult
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("Invalid JSON %q: %v", out, err)
+	}
+	want := validateResult{Length: 8, Problems: []string{"code fence opened on line 1 is never closed"}}
+	if code != exitValidation || !reflect.DeepEqual(got, want) {
+		t.Errorf("validate --json = %d %+v, want %d %+v", code, got, exitValidation, want)
+	}
+
+	code, out, _ = runCLI(t, "fine", "--json", "validate")
+	if code != exitOK || out != "{\n  \"valid\": true,\n  \"length\": 4,\n  \"problems\": []\n}\n" {
+		t.Errorf("validate --json = %d %q", code, out)
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runWatch implements `zlmd watch`, re-processing a file every time it
+// changes and printing the result or piping it into a command.
+func runWatch(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd watch", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	execCmd := fs.String("exec", "", "shell `command` run with the processed output on stdin after each change")
+	interval := fs.Duration("interval", 500*time.Millisecond, "how often to check the file for changes")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: zlmd watch [--interval d] [--exec command] file.md")
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Processes the file now and again whenever it changes, until interrupted.")
+		fmt.Fprintln(stderr, "Without --exec the output is printed; with it, the output is piped into the")
+		fmt.Fprintln(stderr, "command, which also receives the file name in $ZLMD_FILE. To review the")
+		fmt.Fprintln(stderr, "rendered message in a browser, use `zlmd preview` instead.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if fs.NArg() != 1 || *interval <= 0 {
+		fs.Usage()
+		return exitUsage
+	}
+
+	path := fs.Arg(0)
+	if _, err := os.Stat(path); err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	watchFile(ctx, path, *interval, func() {
+		if err := processWatched(ctx, path, *execCmd, stdout, stderr); err != nil {
+			fmt.Fprintf(stderr, "Error: %v\n", err)
+		}
+	})
+	return exitOK
+}
+
+// watchFile calls onChange once for the current contents of path and again
+// after every change, polling every interval until ctx is done. A missing
+// file is waited for rather than reported.
+func watchFile(ctx context.Context, path string, interval time.Duration, onChange func()) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	last := ""
+	for {
+		if v := fileVersion(path); v != last {
+			last = v
+			if v != "missing" {
+				onChange()
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// processWatched processes the file at path and writes the result to stdout,
+// or runs command through the shell with the result on its stdin.
+func processWatched(ctx context.Context, path, command string, stdout, stderr io.Writer) error {
+	markdown, err := readInput(path, nil)
+	if err != nil {
+		return err
+	}
+	result, err := zlmd.ProcessContext(ctx, markdown)
+	if err != nil {
+		return err
+	}
+
+	if command == "" {
+		_, err = fmt.Fprintln(stdout, result)
+		return err
+	}
+
+	return runShell(ctx, command, result, stdout, stderr, "ZLMD_FILE="+path)
+}
+
+// runShell runs command through sh with message and a trailing newline on
+// its stdin. The site and credentials from the configuration, then env
+// entries, are added to the inherited environment.
+func runShell(ctx context.Context, command, message string, stdout, stderr io.Writer, env ...string) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdin = strings.NewReader(message + "\n")
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	cmd.Env = append(append(os.Environ(), settings.sendEnv()...), env...)
+	return cmd.Run()
+}
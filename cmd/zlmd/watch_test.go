@@ -0,0 +1,80 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWatchFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "msg.md")
+	if err := os.WriteFile(path, []byte("one"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var mu sync.Mutex
+	var seen []string
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		watchFile(ctx, path, 5*time.Millisecond, func() {
+			data, _ := os.ReadFile(path)
+			mu.Lock()
+			seen = append(seen, string(data))
+			mu.Unlock()
+		})
+	}()
+
+	waitFor := func(n int) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for time.Now().Before(deadline) {
+			mu.Lock()
+			got := len(seen)
+			mu.Unlock()
+			if got >= n {
+				return
+			}
+			time.Sleep(5 * time.Millisecond)
+		}
+		t.Fatalf("Timed out waiting for %d changes, saw %q", n, seen)
+	}
+
+	waitFor(1)
+	later := time.Now().Add(time.Second)
+	os.WriteFile(path, []byte("two"), 0o644)
+	os.Chtimes(path, later, later)
+	waitFor(2)
+	cancel()
+	<-done
+
+	if seen[0] != "one" || seen[1] != "two" {
+		t.Errorf("Expected [one two], got %q", seen)
+	}
+}
+
+func TestProcessWatched_Exec(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "msg.md")
+	os.WriteFile(path, []byte("**hi**"), 0o644)
+
+	var stdout, stderr bytes.Buffer
+	err := processWatched(context.Background(), path, `tr a-z A-Z; echo "$ZLMD_FILE"`, &stdout, &stderr)
+	if err != nil {
+		t.Fatalf("processWatched() error = %v, stderr %q", err, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), "**HI**") || !strings.Contains(stdout.String(), path) {
+		t.Errorf("Expected piped output and file name, got %q", stdout.String())
+	}
+}
+
+func TestRunWatch_Usage(t *testing.T) {
+	if code, _, _ := runCLI(t, "", "watch"); code != exitUsage {
+		t.Errorf("Expected exit code %d without a file, got %d", exitUsage, code)
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// runSpoiler implements `zlmd spoiler`, wrapping its input in a spoiler block.
+func runSpoiler(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd spoiler", flag.ContinueOnError)
+	title := fs.String("title", "", "spoiler `title`")
+	return runWrap(fs, "Usage: zlmd spoiler [--title text] [file]...", func() string {
+		if *title == "" {
+			return "spoiler"
+		}
+		return "spoiler " + *title
+	}, args, stdin, stdout, stderr)
+}
+
+// runCode implements `zlmd code`, wrapping its input in a code block.
+func runCode(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("zlmd code", flag.ContinueOnError)
+	lang := fs.String("lang", "", "`language` for syntax highlighting")
+	return runWrap(fs, "Usage: zlmd code [--lang language] [file]...", func() string {
+		return *lang
+	}, args, stdin, stdout, stderr)
+}
+
+// runWrap parses args with fs, reads the input and writes it wrapped in a
+// fenced block headed by info(). The fence is lengthened as needed so fences
+// inside the input cannot close the block early.
+func runWrap(fs *flag.FlagSet, usage string, info func() string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs.SetOutput(stderr)
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, usage)
+		fmt.Fprintln(stderr)
+		fmt.Fprintln(stderr, "Reads text from the given files, or from stdin when none are given.")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		if err == flag.ErrHelp {
+			return exitOK
+		}
+		return exitUsage
+	}
+
+	input, err := readInputs(fs.Args(), stdin)
+	if err != nil {
+		fmt.Fprintf(stderr, "Error reading input: %v\n", err)
+		return exitIO
+	}
+
+	fmt.Fprintln(stdout, zlmd.FencedBlock(info(), input, zlmd.WithFenceStyle(settings.Fence)))
+	return exitOK
+}
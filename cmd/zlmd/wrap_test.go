@@ -0,0 +1,31 @@
+package main
+
+import (
+	"testing"
+)
+
+func TestRunWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		stdin    string
+		args     []string
+		expected string
+	}{
+		{"Code", "x := 1\n", []string{"code", "--lang", "go"}, "```go\nx := 1\n```\n"},
+		{"Code nested fence", "```\nlog\n```\n", []string{"code", "--lang", "text"}, "````text\n```\nlog\n```\n````\n"},
+		{"Spoiler", "secret\n", []string{"spoiler", "--title", "Logs"}, "```spoiler Logs\nsecret\n```\n"},
+		{"Spoiler untitled", "secret", []string{"spoiler"}, "```spoiler\nsecret\n```\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, out, _ := runCLI(t, tt.stdin, tt.args...)
+			if code != 0 {
+				t.Fatalf("Expected exit code 0, got %d", code)
+			}
+			if out != tt.expected {
+				t.Errorf("%s output = %q, want %q", tt.args[0], out, tt.expected)
+			}
+		})
+	}
+}
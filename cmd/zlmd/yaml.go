@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlLine is a non-blank, non-comment line of YAML input.
+type yamlLine struct {
+	n      int // one-based line number
+	indent int
+	text   string
+}
+
+// parseYAML parses the YAML subset accepted for template data: nested
+// mappings, lists written as "- item" and scalar values. Anchors, flow
+// collections and multi-line strings are not supported.
+//
+// Example:
+//
+//	service: api
+//	replicas: 3
+//	regions:
+//	  - eu-west-1
+//	  - us-east-1
+//	owner:
+//	  name: "Platform team"
+//	  oncall: true
+func parseYAML(input string) (any, error) {
+	var lines []yamlLine
+	for i, raw := range strings.Split(input, "\n") {
+		raw = strings.TrimRight(stripComment(raw), " \t\r")
+		text := strings.TrimLeft(raw, " ")
+		if text == "" || (text == "---" && len(lines) == 0) {
+			continue
+		}
+		if strings.HasPrefix(text, "\t") {
+			return nil, fmt.Errorf("line %d: tabs are not allowed for indentation", i+1)
+		}
+		lines = append(lines, yamlLine{i + 1, len(raw) - len(text), text})
+	}
+	if len(lines) == 0 {
+		return map[string]any{}, nil
+	}
+
+	value, next, err := parseYAMLNode(lines, 0, lines[0].indent)
+	if err != nil {
+		return nil, err
+	}
+	if next < len(lines) {
+		return nil, fmt.Errorf("line %d: unexpected indentation", lines[next].n)
+	}
+	return value, nil
+}
+
+// parseYAMLNode parses the mapping or list starting at lines[i], whose
+// entries are indented by indent, and returns it with the index of the first
+// line after it.
+func parseYAMLNode(lines []yamlLine, i, indent int) (any, int, error) {
+	if isYAMLListItem(lines[i].text) {
+		list := []any{}
+		for i < len(lines) && lines[i].indent == indent && isYAMLListItem(lines[i].text) {
+			item := strings.TrimSpace(strings.TrimPrefix(lines[i].text, "-"))
+			i++
+			if item != "" {
+				list = append(list, parseYAMLScalar(item))
+				continue
+			}
+			value, next, err := parseYAMLChild(lines, i, indent)
+			if err != nil {
+				return nil, 0, err
+			}
+			list = append(list, value)
+			i = next
+		}
+		return list, i, nil
+	}
+
+	mapping := map[string]any{}
+	for i < len(lines) && lines[i].indent == indent {
+		line := lines[i]
+		if isYAMLListItem(line.text) {
+			return nil, 0, fmt.Errorf("line %d: unexpected list item in a mapping", line.n)
+		}
+		key, value, ok := strings.Cut(line.text, ":")
+		if !ok {
+			return nil, 0, fmt.Errorf("line %d: expected \"key: value\"", line.n)
+		}
+		key, err := unquote(strings.TrimSpace(key))
+		if err != nil {
+			return nil, 0, fmt.Errorf("line %d: %w", line.n, err)
+		}
+		i++
+
+		if value = strings.TrimSpace(value); value != "" {
+			mapping[key] = parseYAMLScalar(value)
+			continue
+		}
+		child, next, err := parseYAMLChild(lines, i, indent)
+		if err != nil {
+			return nil, 0, err
+		}
+		mapping[key] = child
+		i = next
+	}
+	return mapping, i, nil
+}
+
+// parseYAMLChild parses the nested block following a "key:" or "-" line at
+// indent, or returns nil if the next line isn't nested more deeply. Lists
+// under a mapping key may share the key's indentation.
+func parseYAMLChild(lines []yamlLine, i, indent int) (any, int, error) {
+	if i >= len(lines) {
+		return nil, i, nil
+	}
+	next := lines[i]
+	if next.indent > indent || (next.indent == indent && isYAMLListItem(next.text) && !isYAMLListItem(lines[i-1].text)) {
+		return parseYAMLNode(lines, i, next.indent)
+	}
+	return nil, i, nil
+}
+
+// isYAMLListItem reports whether text starts a list item.
+func isYAMLListItem(text string) bool {
+	return text == "-" || strings.HasPrefix(text, "- ")
+}
+
+// parseYAMLScalar converts a scalar to a string, bool, int, float64 or nil.
+// Quoted values are always strings.
+func parseYAMLScalar(text string) any {
+	if s, err := unquote(text); err == nil && s != text {
+		return s
+	}
+	switch text {
+	case "true", "True", "TRUE":
+		return true
+	case "false", "False", "FALSE":
+		return false
+	case "null", "Null", "NULL", "~":
+		return nil
+	}
+	if n, err := strconv.Atoi(text); err == nil {
+		return n
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return f
+	}
+	return text
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseYAML(t *testing.T) {
+	input := `---
+service: api   # the service name
+replicas: 3
+ratio: 0.5
+enabled: true
+empty:
+quoted: "42"
+regions:
+  - eu-west-1
+  - us-east-1
+owner:
+  name: 'Platform team'
+  tags:
+  - oncall
+  - infra
+checks:
+  -
+    name: http
+    ok: false
+`
+	expected := map[string]any{
+		"service":  "api",
+		"replicas": 3,
+		"ratio":    0.5,
+		"enabled":  true,
+		"empty":    nil,
+		"quoted":   "42",
+		"regions":  []any{"eu-west-1", "us-east-1"},
+		"owner": map[string]any{
+			"name": "Platform team",
+			"tags": []any{"oncall", "infra"},
+		},
+		"checks": []any{map[string]any{"name": "http", "ok": false}},
+	}
+
+	got, err := parseYAML(input)
+	if err != nil {
+		t.Fatalf("parseYAML() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("parseYAML() = %#v, want %#v", got, expected)
+	}
+}
+
+func TestParseYAML_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"No colon", "a: 1\nb", "line 2: expected"},
+		{"Bad indent", "a:\n    b: 1\n  c: 2", "line 3: unexpected indentation"},
+		{"List in mapping", "a: 1\n- b", "line 2: unexpected list item"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := parseYAML(tt.input)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("parseYAML() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -1,7 +1,32 @@
 package zlmd
 
+import "context"
+
 // Process converts Zulip-flavored markdown to HTML
 func Process(markdown string) (string, error) {
+	return ProcessContext(context.Background(), markdown)
+}
+
+// ProcessContext converts Zulip-flavored markdown to HTML like Process, but
+// gives up once ctx is cancelled or its deadline passes.
+//
+// Parameters:
+//   - ctx (context.Context): Controls cancellation of the conversion
+//   - markdown (string): The Zulip markdown to convert
+//
+// Returns:
+//   - string: The rendered HTML
+//   - error: ctx.Err() if ctx is done before the conversion finishes
+//
+// Example:
+//
+//	ctx, cancel := context.WithTimeout(ctx, 2*time.Second)
+//	defer cancel()
+//	html, err := ProcessContext(ctx, document)
+func ProcessContext(ctx context.Context, markdown string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
 	// This is a simplified implementation
 	// In a real implementation, we would use the actual markdown processing logic
 	return "Processed: " + markdown, nil
@@ -0,0 +1,35 @@
+package zlmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestProcessContext(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+	expired, cancelExpired := context.WithDeadline(context.Background(), time.Unix(0, 0))
+	defer cancelExpired()
+
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+		err      error
+	}{
+		{"Background", context.Background(), "Processed: **hi**", nil},
+		{"Cancelled", cancelled, "", context.Canceled},
+		{"Deadline exceeded", expired, "", context.DeadlineExceeded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ProcessContext(tt.ctx, "**hi**")
+			if got != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ProcessContext() = %q, %v, want %q, %v", got, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package zlmd
+
+import (
+	"bytes"
+	"strings"
+)
+
+// AppendCodeBlock appends a code block holding text to dst, like CodeBlock,
+// without converting text to a string.
+//
+// Parameters:
+//   - dst ([]byte): The buffer to append to; may be nil
+//   - language (string): The language identifier for syntax highlighting
+//   - text ([]byte): The code, such as a log file read with os.ReadFile
+//
+// Returns:
+//   - []byte: The extended buffer
+//
+// Example:
+//
+//	out, _ := cmd.CombinedOutput()
+//	msg := AppendCodeBlock([]byte("**Output**\n"), "text", out)
+//
+// Notes:
+//   - Like CodeBlock, the fence is always three backticks; use FencedBlock
+//     for text that may contain fences itself
+func AppendCodeBlock(dst []byte, language string, text []byte) []byte {
+	dst = append(dst, "```"...)
+	dst = append(dst, language...)
+	dst = append(dst, '\n')
+	dst = append(dst, text...)
+	return append(dst, "\n```"...)
+}
+
+// AppendSpoiler appends a spoiler block holding text to dst, like Spoiler,
+// without converting text to a string.
+//
+// Parameters:
+//   - dst ([]byte): The buffer to append to; may be nil
+//   - heading (string): The title shown on the collapsed spoiler
+//   - text ([]byte): The hidden content
+//
+// Returns:
+//   - []byte: The extended buffer
+//
+// Example:
+//
+//	msg := AppendSpoiler(nil, "Full log", logData)
+//
+// Notes:
+//   - As in Spoiler, "```" in text is replaced by "~~~" so nested code
+//     blocks don't close the spoiler
+func AppendSpoiler(dst []byte, heading string, text []byte) []byte {
+	if !defaultTarget().supports(FeatureSpoiler) {
+		return append(dst, quotedSpoiler(heading, string(text))...)
+	}
+	dst = append(dst, "```spoiler "...)
+	dst = append(dst, heading...)
+	dst = append(dst, '\n')
+	for {
+		i := bytes.Index(text, []byte("```"))
+		if i < 0 {
+			break
+		}
+		dst = append(dst, text[:i]...)
+		dst = append(dst, "~~~"...)
+		text = text[i+3:]
+	}
+	dst = append(dst, text...)
+	return append(dst, "\n```"...)
+}
+
+// AppendEscape appends text escaped according to policy to dst, like Escape.
+//
+// Parameters:
+//   - dst ([]byte): The buffer to append to; may be nil
+//   - text ([]byte): The untrusted text
+//   - policy (EscapePolicy): Which markdown constructs to neutralize
+//
+// Returns:
+//   - []byte: The extended buffer
+//
+// Example:
+//
+//	AppendEscape(nil, []byte("*not bold*"), EscapeAll)
+//	// []byte("\\*not bold\\*")
+//
+// Notes:
+//   - EscapeNone, EscapeAll and text that needs no escaping are appended
+//     without converting text to a string
+func AppendEscape(dst []byte, text []byte, policy EscapePolicy) []byte {
+	switch {
+	case policy == EscapeAll:
+		return appendEscapeAll(dst, text)
+	case policy == EscapeFences && bytes.IndexAny(text, "`~") < 0,
+		policy == EscapeMentions && bytes.IndexByte(text, '@') < 0,
+		policy != EscapeFences && policy != EscapeMentions:
+		return append(dst, text...)
+	default:
+		return append(dst, Escape(string(text), policy)...)
+	}
+}
+
+// appendEscapeAll is escapeAll for byte slices. Every character it escapes
+// is ASCII, so it can work byte by byte without decoding runes.
+func appendEscapeAll(dst, text []byte) []byte {
+	lineStart := true
+	for _, c := range text {
+		if (lineStart && strings.IndexByte("#-+>", c) >= 0) || strings.IndexByte(escapeAllChars, c) >= 0 {
+			dst = append(dst, '\\')
+		}
+		dst = append(dst, c)
+		lineStart = c == '\n' || (lineStart && c == ' ')
+	}
+	return dst
+}
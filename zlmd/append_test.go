@@ -0,0 +1,67 @@
+package zlmd
+
+import "testing"
+
+func TestAppendCodeBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		language string
+		text     string
+	}{
+		{"Simple", "go", "x := 1"},
+		{"No language", "", "line 1\nline 2"},
+		{"Empty", "text", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(AppendCodeBlock([]byte("prefix\n"), tt.language, []byte(tt.text)))
+			if expected := "prefix\n" + CodeBlock(tt.language, tt.text); got != expected {
+				t.Errorf("AppendCodeBlock() = %q, want %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestAppendSpoiler(t *testing.T) {
+	tests := []struct {
+		name    string
+		heading string
+		text    string
+	}{
+		{"Simple", "Logs", "hidden"},
+		{"Nested code", "Code", "```go\nfmt.Println(1)\n```\ntext ``` inline"},
+		{"Long fence", "Fence", "`````"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := string(AppendSpoiler(nil, tt.heading, []byte(tt.text)))
+			if expected := Spoiler(tt.heading, tt.text); got != expected {
+				t.Errorf("AppendSpoiler() = %q, want %q", got, expected)
+			}
+		})
+	}
+}
+
+func TestAppendEscape(t *testing.T) {
+	inputs := []string{
+		"plain text",
+		"# title\n- item\n  > quote\n*bold* _it_ [x](y) <z> |a| ~b~ $c$ `d` \\",
+		"```\nbreak out\n~~~",
+		"ping @**all** and @_**Alex**",
+		"naïve 🚀 *unicode*",
+	}
+	policies := []EscapePolicy{EscapeNone, EscapeFences, EscapeMentions, EscapeAll}
+
+	for _, policy := range policies {
+		for _, input := range inputs {
+			t.Run(policy.String()+"/"+input, func(t *testing.T) {
+				got := string(AppendEscape([]byte(">"), []byte(input), policy))
+				if expected := ">" + Escape(input, policy); got != expected {
+					t.Errorf("AppendEscape() = %q, want %q", got, expected)
+				}
+			})
+		}
+	}
+}
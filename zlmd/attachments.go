@@ -0,0 +1,120 @@
+package zlmd
+
+import (
+	"fmt"
+	"path"
+	"strings"
+)
+
+// Attachment describes a file uploaded to Zulip, for example with
+// zulipapi.Client.Upload.
+type Attachment struct {
+	// Name is the file name shown in the message; the base name of Path is
+	// used when it is empty.
+	Name string
+	// Size is the file size in bytes, or 0 if unknown.
+	Size int64
+	// Path is the upload path, such as "/user_uploads/2/ab/report.pdf", or
+	// an absolute URL built with Realm.Upload.
+	Path string
+	// ContentType is the MIME type; the extension of the name is used when
+	// it is empty.
+	ContentType string
+}
+
+// imageExtensions are the file extensions Zulip shows image previews for.
+var imageExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true, ".bmp": true,
+}
+
+// IsImage reports whether Zulip will show a preview of the attachment.
+func (a Attachment) IsImage() bool {
+	if a.ContentType != "" {
+		return strings.HasPrefix(strings.ToLower(a.ContentType), "image/") &&
+			!strings.Contains(strings.ToLower(a.ContentType), "svg")
+	}
+	return imageExtensions[strings.ToLower(path.Ext(a.name()))]
+}
+
+// name returns the display name of the attachment.
+func (a Attachment) name() string {
+	if a.Name != "" {
+		return a.Name
+	}
+	return path.Base(a.Path)
+}
+
+// FormatAttachments renders an attachments section listing uploaded files.
+//
+// Parameters:
+//   - files ([]Attachment): The uploaded files
+//
+// Returns:
+//   - string: A bold header with the file count and total size, a list of
+//     links with humanized sizes for the other files, then the images on
+//     lines of their own so Zulip shows their previews; "" if files is empty
+//
+// Example:
+//
+//	FormatAttachments([]Attachment{
+//	  {Name: "report.pdf", Size: 1258291, Path: "/user_uploads/2/ab/report.pdf"},
+//	  {Name: "chart.png", Size: 20480, Path: "/user_uploads/2/cd/chart.png"},
+//	})
+//	// **Attachments** (2 files, 1.2 MiB)
+//	// - [report.pdf](/user_uploads/2/ab/report.pdf) (1.2 MiB)
+//	//
+//	// [chart.png](/user_uploads/2/cd/chart.png) (20 KiB)
+//
+// Notes:
+//   - Brackets in names are escaped, and spaces and parentheses in paths are
+//     percent-encoded, so neither can break the links
+func FormatAttachments(files []Attachment) string {
+	if len(files) == 0 {
+		return ""
+	}
+
+	var total int64
+	var listed, images []string
+	for _, f := range files {
+		total += f.Size
+		line := Link(escapeLinkText(f.name()), escapeLinkURL(f.Path))
+		if f.Size > 0 {
+			line += " (" + HumanBytes(f.Size) + ")"
+		}
+		if f.IsImage() {
+			images = append(images, line)
+		} else {
+			listed = append(listed, "- "+line)
+		}
+	}
+
+	var sb strings.Builder
+	WriteBold(&sb, "Attachments")
+	count := "1 file"
+	if len(files) > 1 {
+		count = fmt.Sprintf("%d files", len(files))
+	}
+	if total > 0 {
+		count += ", " + HumanBytes(total)
+	}
+	sb.WriteString(" (" + count + ")\n")
+	if len(listed) > 0 {
+		sb.WriteString(strings.Join(listed, "\n") + "\n")
+	}
+	if len(images) > 0 {
+		sb.WriteString("\n" + strings.Join(images, "\n\n") + "\n")
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// escapeLinkText escapes the characters that would end the text of a
+// markdown link early.
+func escapeLinkText(text string) string {
+	return strings.NewReplacer(`\`, `\\`, "[", `\[`, "]", `\]`).Replace(text)
+}
+
+// escapeLinkURL percent-encodes the characters that would end the URL of a
+// markdown link early.
+func escapeLinkURL(url string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(url)
+}
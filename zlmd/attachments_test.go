@@ -0,0 +1,59 @@
+package zlmd
+
+import "testing"
+
+func TestFormatAttachments(t *testing.T) {
+	tests := []struct {
+		name     string
+		files    []Attachment
+		expected string
+	}{
+		{
+			name:     "Empty",
+			files:    nil,
+			expected: "",
+		},
+		{
+			name:     "Single file",
+			files:    []Attachment{{Name: "build.log", Size: 512, Path: "/user_uploads/1/ab/build.log"}},
+			expected: "**Attachments** (1 file, 512 B)\n- [build.log](/user_uploads/1/ab/build.log) (512 B)",
+		},
+		{
+			name: "Files and images",
+			files: []Attachment{
+				{Name: "report.pdf", Size: 1536, Path: "/user_uploads/2/ab/report.pdf", ContentType: "application/pdf"},
+				{Name: "chart.png", Size: 2048, Path: "/user_uploads/2/cd/chart.png"},
+				{Name: "photo", Size: 512, Path: "/user_uploads/2/ef/photo", ContentType: "image/jpeg"},
+			},
+			expected: "**Attachments** (3 files, 4 KiB)\n" +
+				"- [report.pdf](/user_uploads/2/ab/report.pdf) (1.5 KiB)\n" +
+				"\n" +
+				"[chart.png](/user_uploads/2/cd/chart.png) (2 KiB)\n" +
+				"\n" +
+				"[photo](/user_uploads/2/ef/photo) (512 B)",
+		},
+		{
+			name:     "Unknown size and name from path",
+			files:    []Attachment{{Path: "/user_uploads/1/ab/notes.txt"}},
+			expected: "**Attachments** (1 file)\n- [notes.txt](/user_uploads/1/ab/notes.txt)",
+		},
+		{
+			name:     "SVG is not previewed",
+			files:    []Attachment{{Name: "logo.svg", Path: "/user_uploads/1/ab/logo.svg", ContentType: "image/svg+xml"}},
+			expected: "**Attachments** (1 file)\n- [logo.svg](/user_uploads/1/ab/logo.svg)",
+		},
+		{
+			name:     "Special characters",
+			files:    []Attachment{{Name: "a [draft].txt", Path: "/user_uploads/1/ab/a (1).txt"}},
+			expected: "**Attachments** (1 file)\n- [a \\[draft\\].txt](/user_uploads/1/ab/a%20%281%29.txt)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := FormatAttachments(tt.files); got != tt.expected {
+				t.Errorf("FormatAttachments() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package zlmd
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// barEighths are the partial block characters for 1/8 to 7/8 of a cell.
+var barEighths = []rune("▏▎▍▌▋▊▉")
+
+// BarChart renders a labeled horizontal bar chart inside a code block.
+//
+// Parameters:
+//   - labels ([]string): The label of each bar
+//   - values ([]float64): The value of each bar; missing values count as zero
+//   - width (int): The length of the longest bar in characters (DefaultProgressWidth if <= 0)
+//   - opts (...Option): Optional settings; WithSortDescending orders the bars,
+//     WithTopN keeps only the first entries, WithLanguage sets the code block language
+//     and WithWidthMode sets how labels are measured
+//
+// Returns:
+//   - string: The chart wrapped in a fenced code block, or "" for no labels
+//
+// Example:
+//
+//	out := BarChart([]string{"api", "worker"}, []float64{120, 60}, 10)
+//	// out will be:
+//	// ```text
+//	// api    ██████████ 120
+//	// worker █████ 60
+//	// ```
+//
+// Notes:
+//   - Bars are scaled relative to the largest value; negative values render as empty bars
+//   - Partial cells use eighth-block characters for finer resolution
+func BarChart(labels []string, values []float64, width int, opts ...Option) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	lines := barChartLines(labels, values, width, o)
+	return CodeBlock(o.language, strings.Join(lines, "\n"))
+}
+
+// barEntry is a single labeled value of a bar chart.
+type barEntry struct {
+	label string
+	value float64
+}
+
+// barChartLines renders the chart rows without the surrounding code block.
+func barChartLines(labels []string, values []float64, width int, o options) []string {
+	if width <= 0 {
+		width = DefaultProgressWidth
+	}
+
+	entries := make([]barEntry, len(labels))
+	for i, label := range labels {
+		entries[i].label = label
+		if i < len(values) {
+			entries[i].value = values[i]
+		}
+	}
+
+	if o.sortDesc {
+		sort.SliceStable(entries, func(i, j int) bool {
+			return entries[i].value > entries[j].value
+		})
+	}
+
+	hidden := 0
+	if o.topN > 0 && len(entries) > o.topN {
+		hidden = len(entries) - o.topN
+		entries = entries[:o.topN]
+	}
+
+	peak, labelWidth := 0.0, 0
+	for _, e := range entries {
+		peak = math.Max(peak, e.value)
+		labelWidth = max(labelWidth, TextWidth(e.label, o.widthMode))
+	}
+
+	lines := make([]string, 0, len(entries)+1)
+	for _, e := range entries {
+		label := padRight(e.label, labelWidth, o.widthMode)
+		bar := renderBar(e.value, peak, width)
+		if bar == "" {
+			lines = append(lines, fmt.Sprintf("%s %s", label, formatFloat(e.value)))
+		} else {
+			lines = append(lines, fmt.Sprintf("%s %s %s", label, bar, formatFloat(e.value)))
+		}
+	}
+	if hidden > 0 {
+		lines = append(lines, fmt.Sprintf("… %d more", hidden))
+	}
+	return lines
+}
+
+// renderBar returns a bar of up to width cells representing value / peak.
+func renderBar(value, peak float64, width int) string {
+	if peak <= 0 || value <= 0 || math.IsNaN(value) {
+		return ""
+	}
+
+	eighths := int(math.Round(math.Min(value/peak, 1) * float64(width) * 8))
+	bar := strings.Repeat("█", eighths/8)
+	if rest := eighths % 8; rest > 0 {
+		bar += string(barEighths[rest-1])
+	}
+	return bar
+}
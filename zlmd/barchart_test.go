@@ -0,0 +1,59 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestBarChart(t *testing.T) {
+	tests := []struct {
+		name     string
+		labels   []string
+		values   []float64
+		width    int
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "Empty",
+			expected: "",
+		},
+		{
+			name:     "Basic",
+			labels:   []string{"api", "worker"},
+			values:   []float64{120, 60},
+			width:    10,
+			expected: "```text\napi    ██████████ 120\nworker █████ 60\n```",
+		},
+		{
+			name:     "Partial cells and zero",
+			labels:   []string{"a", "b", "c"},
+			values:   []float64{4, 1.5, 0},
+			width:    2,
+			expected: "```text\na ██ 4\nb ▊ 1.5\nc 0\n```",
+		},
+		{
+			name:     "Wide labels",
+			labels:   []string{"東京", "osaka"},
+			values:   []float64{2, 1},
+			width:    2,
+			expected: "```text\n東京  ██ 2\nosaka █ 1\n```",
+		},
+		{
+			name:     "Sorted top N",
+			labels:   []string{"low", "high", "mid"},
+			values:   []float64{1, 4, 2},
+			width:    4,
+			opts:     []Option{WithSortDescending(), WithTopN(2)},
+			expected: "```text\nhigh ████ 4\nmid  ██ 2\n… 1 more\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BarChart(tt.labels, tt.values, tt.width, tt.opts...)
+			if got != tt.expected {
+				t.Errorf("BarChart() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -335,14 +335,16 @@ func H6(text string) string {
 //
 // Notes:
 //   - Properly handles multi-line quotes by adding the quote prefix to each line
-//   - Adds a trailing newline to the result
+//   - Adds a trailing newline to the result, unless SetFinalNewline says otherwise
+//   - Lines may end with LF or CRLF; the result uses the line ending set by
+//     SetLineEnding
 func QuoteBlock(text string) string {
-	lines := strings.Split(text, "\n")
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
 	quotedLines := make([]string, len(lines))
 	for i, line := range lines {
 		quotedLines[i] = fmt.Sprintf("> %s", line)
 	}
-	return strings.Join(quotedLines, "\n") + "\n"
+	return finishBlock(strings.Join(quotedLines, "\n") + "\n")
 }
 
 // QuoteBlocknl formats text as a blockquote with an extra newline.
@@ -366,10 +368,11 @@ func QuoteBlock(text string) string {
 //	// "
 //
 // Notes:
-//   - Convenience function for adding a blockquote with extra spacing
-//   - Useful for separating blockquotes from subsequent content
+//   - Returns the quote followed by a blank line: two newlines in total
+//   - To separate a quote from the blocks around it, prefer JoinParagraphs,
+//     which doesn't depend on how many newlines each block ends with
 func QuoteBlocknl(text string) string {
-	return QuoteBlock(text) + "\n"
+	return QuoteBlock(text) + newline()
 }
 
 // Paragraph formats text as a paragraph with trailing newlines.
@@ -390,9 +393,10 @@ func QuoteBlocknl(text string) string {
 //
 // Notes:
 //   - The double newlines ensure proper paragraph separation in Markdown
-//   - Text is not otherwise modified
+//   - Text is not otherwise modified, so text that already ends with a
+//     newline gets a second blank line; JoinParagraphs doesn't
 func Paragraph(text string) string {
-	return text + "\n\n"
+	return text + newline() + newline()
 }
 
 // P is a shortcut for Paragraph.
@@ -418,7 +422,7 @@ func P(text string) string {
 	return Paragraph(text)
 }
 
-// BR is a shortcut for Break, adding a single newline after text.
+// BR adds a single newline after text.
 //
 // Parameters:
 //   - text (string): The text to append a line break to
@@ -437,8 +441,10 @@ func P(text string) string {
 // Notes:
 //   - Useful for adding line breaks without paragraph spacing
 //   - Unlike Paragraph, adds only a single newline
+//   - Always a newline; HardBreak also renders as a line break on GitHub
+//     for FlavorPortable
 func BR(text string) string {
-	return text + "\n"
+	return text + newline()
 }
 
 // WriteHeading writes a heading to the provided StringBuilder.
@@ -467,7 +473,7 @@ func BR(text string) string {
 //   - Automatically adds a trailing newline
 func WriteHeading(sb *strings.Builder, level int, text string) {
 	sb.WriteString(Heading(level, text))
-	sb.WriteString("\n")
+	sb.WriteString(newline())
 }
 
 // WriteBold writes bold text to the provided StringBuilder.
@@ -752,7 +758,7 @@ func WIMG(sb *strings.Builder, altText, url string) {
 //   - Automatically adds a trailing newline
 func WriteHorizontalRule(sb *strings.Builder) {
 	sb.WriteString(HorizontalRule())
-	sb.WriteString("\n")
+	sb.WriteString(newline())
 }
 
 // WHR is a shortcut for WriteHorizontalRule.
@@ -850,9 +856,10 @@ func WQB(sb *strings.Builder, text string) {
 // Notes:
 //   - Uses standard Markdown dash syntax for unordered lists
 //   - Indentation uses 2 spaces per level for proper nesting
+//   - The Bullet and IndentWidth of the default Config replace the dash and
+//     the 2 spaces
 func ListItem(text string, level int) string {
-	indent := strings.Repeat("  ", level)
-	return fmt.Sprintf("%s- %s", indent, text)
+	return listPrefix(level) + text
 }
 
 // LI is a shortcut for calling WriteListItem.
@@ -907,7 +914,7 @@ func LI(sb *strings.Builder, text string, level int) {
 //   - Automatically adds a trailing newline
 func WriteListItem(sb *strings.Builder, text string, level int) {
 	sb.WriteString(ListItem(text, level))
-	sb.WriteString("\n")
+	sb.WriteString(newline())
 }
 
 // WLI is a shortcut for WriteListItem.
@@ -962,15 +969,15 @@ func WLI(sb *strings.Builder, text string, level int) {
 // Notes:
 //   - Uses standard Markdown task list syntax with brackets
 //   - Unchecked items use "[ ]", checked items use "[x]"
-//   - Indentation uses 2 spaces per level for proper nesting
+//   - Indentation uses 2 spaces per level for proper nesting, or the
+//     Bullet and IndentWidth of the default Config
 //   - Compatible with most Markdown renderers that support task lists
 func ChecklistItem(text string, checked bool, level int) string {
-	indent := strings.Repeat("  ", level)
 	checkmark := "[ ]"
 	if checked {
 		checkmark = "[x]"
 	}
-	return fmt.Sprintf("%s- %s %s", indent, checkmark, text)
+	return listPrefix(level) + checkmark + " " + text
 }
 
 func CLI(text string, checked bool, level int) string {
@@ -980,7 +987,7 @@ func CLI(text string, checked bool, level int) string {
 // WriteChecklistItem writes a checklist item to the provided StringBuilder.
 func WriteChecklistItem(sb *strings.Builder, text string, checked bool, level int) {
 	sb.WriteString(ChecklistItem(text, checked, level))
-	sb.WriteString("\n")
+	sb.WriteString(newline())
 }
 
 func WCLI(sb *strings.Builder, text string, checked bool, level int) {
@@ -1016,7 +1023,7 @@ func KV(key string, value string) string {
 // WriteKeyValue writes a key-value pair to the provided StringBuilder.
 func WriteKeyValue(sb *strings.Builder, key string, value string) {
 	sb.WriteString(KeyValue(key, value))
-	sb.WriteString("\n")
+	sb.WriteString(newline())
 }
 
 func WKV(sb *strings.Builder, key string, value string) {
@@ -0,0 +1,103 @@
+package zlmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync"
+)
+
+// ProcessAll converts many messages with ProcessContext in parallel.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the inputs not yet started
+//   - inputs ([]string): The messages to convert
+//   - workers (int): The number of goroutines; GOMAXPROCS if not positive
+//
+// Returns:
+//   - []string: The results, in the order of inputs; "" for inputs that
+//     failed or were not started
+//   - error: nil, or the errors of all failed inputs joined, each naming the
+//     index of its input, followed by ctx.Err() if ctx was done
+//
+// Example:
+//
+//	html, err := ProcessAll(ctx, archive, 8)
+//	if err != nil {
+//	  log.Printf("some messages failed: %v", err)
+//	}
+func ProcessAll(ctx context.Context, inputs []string, workers int) ([]string, error) {
+	return ConvertAll(ctx, inputs, workers, func(text string) (string, error) {
+		return ProcessContext(ctx, text)
+	})
+}
+
+// ConvertAll applies convert to many inputs in parallel, like ProcessAll,
+// for conversions such as StripMarkdown or Escape and checks that report
+// problems as errors.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the inputs not yet started
+//   - inputs ([]string): The texts to convert
+//   - workers (int): The number of goroutines; GOMAXPROCS if not positive
+//   - convert (func(string) (string, error)): Converts one input; it must be
+//     safe for concurrent use
+//
+// Returns:
+//   - []string: The results, in the order of inputs
+//   - error: The joined errors of the failed inputs, as for ProcessAll
+//
+// Example:
+//
+//	plain, _ := ConvertAll(ctx, messages, 0, func(m string) (string, error) {
+//	  return StripMarkdown(m), nil
+//	})
+func ConvertAll(ctx context.Context, inputs []string, workers int, convert func(string) (string, error)) ([]string, error) {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	results := make([]string, len(inputs))
+	errs := make([]error, len(inputs))
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(inputs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if ctx.Err() != nil {
+					continue
+				}
+				if out, err := convert(inputs[i]); err != nil {
+					errs[i] = fmt.Errorf("zlmd: input %d: %w", i, err)
+				} else {
+					results[i] = out
+				}
+			}
+		}()
+	}
+feed:
+	for i := range inputs {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		// Inputs cut short by the cancellation report it once, below,
+		// rather than once each.
+		for i, e := range errs {
+			if errors.Is(e, err) {
+				errs[i] = nil
+			}
+		}
+		errs = append(errs, err)
+	}
+	return results, errors.Join(errs...)
+}
@@ -0,0 +1,76 @@
+package zlmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestProcessAll(t *testing.T) {
+	inputs := make([]string, 100)
+	expected := make([]string, 100)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("message %d", i)
+		expected[i] = "Processed: " + inputs[i]
+	}
+
+	for _, workers := range []int{0, 1, 7, 500} {
+		t.Run(fmt.Sprintf("%d workers", workers), func(t *testing.T) {
+			got, err := ProcessAll(context.Background(), inputs, workers)
+			if err != nil || !reflect.DeepEqual(got, expected) {
+				t.Errorf("ProcessAll() = %q, %v, want %q, nil", got, err, expected)
+			}
+		})
+	}
+}
+
+func TestConvertAll_Errors(t *testing.T) {
+	errEmpty := errors.New("empty message")
+	inputs := []string{"a", "", "b", ""}
+
+	got, err := ConvertAll(context.Background(), inputs, 2, func(s string) (string, error) {
+		if s == "" {
+			return "", errEmpty
+		}
+		return strings.ToUpper(s), nil
+	})
+
+	if expected := []string{"A", "", "B", ""}; !reflect.DeepEqual(got, expected) {
+		t.Errorf("ConvertAll() = %q, want %q", got, expected)
+	}
+	if !errors.Is(err, errEmpty) {
+		t.Fatalf("ConvertAll() error = %v, want %v", err, errEmpty)
+	}
+	for _, want := range []string{"input 1: empty message", "input 3: empty message"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("ConvertAll() error = %q, want it to contain %q", err, want)
+		}
+	}
+}
+
+func TestConvertAll_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	inputs := make([]string, 50)
+
+	calls := 0
+	_, err := ConvertAll(ctx, inputs, 1, func(s string) (string, error) {
+		calls++
+		if calls == 3 {
+			cancel()
+		}
+		return ProcessContext(ctx, s)
+	})
+
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ConvertAll() error = %v, want context.Canceled", err)
+	}
+	if n := strings.Count(err.Error(), "context canceled"); n != 1 {
+		t.Errorf("ConvertAll() error = %q, want the cancellation reported once", err)
+	}
+	if calls >= len(inputs) {
+		t.Errorf("convert called %d times, want it to stop after cancellation", calls)
+	}
+}
@@ -0,0 +1,252 @@
+// Package benchreport parses Go benchmark output and renders it as Zulip
+// tables, including benchstat-style comparisons of two runs with
+// regressions and improvements highlighted.
+package benchreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// Threshold is the relative change, in percent, below which Compare treats a
+// difference as noise.
+const Threshold = 5.0
+
+// Benchmark holds the samples collected for one benchmark, keyed by unit
+// (for example "ns/op", "B/op" or "allocs/op").
+type Benchmark struct {
+	Name    string
+	Samples map[string][]float64
+}
+
+// Median returns the median sample for unit, and false if there are none.
+func (b Benchmark) Median(unit string) (float64, bool) {
+	samples := b.Samples[unit]
+	if len(samples) == 0 {
+		return 0, false
+	}
+	sorted := append([]float64(nil), samples...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2, true
+	}
+	return sorted[mid], true
+}
+
+// Parse reads `go test -bench` output and returns the benchmarks in the
+// order they first appear. Repeated runs (-count) are collected as samples.
+//
+// Parameters:
+//   - r (io.Reader): The benchmark output
+//
+// Returns:
+//   - []Benchmark: The parsed benchmarks
+//   - error: An error if reading from r fails
+//
+// Example:
+//
+//	benchmarks, err := benchreport.Parse(strings.NewReader(
+//	  "BenchmarkEscape-8   1000000   1234 ns/op   56 B/op   2 allocs/op\n"))
+//	// benchmarks[0].Name is "BenchmarkEscape-8"
+func Parse(r io.Reader) ([]Benchmark, error) {
+	index := map[string]int{}
+	var benchmarks []Benchmark
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+
+		i, ok := index[fields[0]]
+		if !ok {
+			i = len(benchmarks)
+			index[fields[0]] = i
+			benchmarks = append(benchmarks, Benchmark{Name: fields[0], Samples: map[string][]float64{}})
+		}
+
+		for j := 2; j+1 < len(fields); j += 2 {
+			value, err := strconv.ParseFloat(fields[j], 64)
+			if err != nil {
+				break
+			}
+			unit := fields[j+1]
+			benchmarks[i].Samples[unit] = append(benchmarks[i].Samples[unit], value)
+		}
+	}
+	return benchmarks, scanner.Err()
+}
+
+// Table renders a single run as a table of median time, memory and
+// allocations per operation.
+//
+// Example:
+//
+//	out := benchreport.Table(benchmarks)
+//	// out will be:
+//	// | Benchmark | time/op | B/op | allocs/op |
+//	// | --- | ---: | ---: | ---: |
+//	// | BenchmarkEscape-8 | 1.2µs | 56 B | 2 |
+func Table(benchmarks []Benchmark) string {
+	if len(benchmarks) == 0 {
+		return ""
+	}
+
+	units := presentUnits(benchmarks)
+	headers := []string{"Benchmark"}
+	aligns := []zlmd.Alignment{zlmd.AlignDefault}
+	for _, unit := range units {
+		headers = append(headers, unitHeader(unit))
+		aligns = append(aligns, zlmd.AlignRight)
+	}
+
+	table := zlmd.NewTableBuilder().WithHeaders(headers...).SetAlignments(aligns...)
+	for _, b := range benchmarks {
+		row := []string{b.Name}
+		for _, unit := range units {
+			if v, ok := b.Median(unit); ok {
+				row = append(row, formatValue(unit, v))
+			} else {
+				row = append(row, "")
+			}
+		}
+		table.AddRow(row...)
+	}
+	return table.Build()
+}
+
+// Compare renders a benchstat-style comparison of two runs, with one table
+// per unit showing the old and new medians and the relative change.
+//
+// Parameters:
+//   - old ([]Benchmark): The baseline run
+//   - new ([]Benchmark): The run to compare against the baseline
+//
+// Returns:
+//   - string: The comparison tables, or "" if the runs share no benchmarks
+//
+// Changes larger than Threshold percent are bolded and prefixed with the
+// theme's danger (regression) or success (improvement) emoji; smaller
+// changes are shown as "~". All units are treated as lower-is-better.
+//
+// Example:
+//
+//	out := benchreport.Compare(before, after)
+//	// out will be similar to:
+//	// **time/op**
+//	// | Benchmark | Old | New | Delta |
+//	// | --- | ---: | ---: | ---: |
+//	// | BenchmarkEscape-8 | 1.2µs | 1.5µs | ❌ **+25.0%** |
+func Compare(old, new []Benchmark) string {
+	baseline := map[string]Benchmark{}
+	for _, b := range old {
+		baseline[b.Name] = b
+	}
+
+	var sections []string
+	for _, unit := range presentUnits(new) {
+		table := zlmd.NewTableBuilder().
+			WithHeaders("Benchmark", "Old", "New", "Delta").
+			SetAlignments(zlmd.AlignDefault, zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight)
+
+		rows := 0
+		for _, b := range new {
+			before, ok := baseline[b.Name]
+			if !ok {
+				continue
+			}
+			ov, ok1 := before.Median(unit)
+			nv, ok2 := b.Median(unit)
+			if !ok1 || !ok2 {
+				continue
+			}
+			table.AddRow(b.Name, formatValue(unit, ov), formatValue(unit, nv), formatDelta(ov, nv))
+			rows++
+		}
+		if rows > 0 {
+			sections = append(sections, zlmd.Bold(unitHeader(unit))+"\n"+table.Build())
+		}
+	}
+	return strings.Join(sections, "\n")
+}
+
+// formatDelta renders the relative change from ov to nv.
+func formatDelta(ov, nv float64) string {
+	if ov == 0 {
+		if nv == 0 {
+			return "~"
+		}
+		return "?"
+	}
+
+	delta := (nv - ov) / ov * 100
+	if math.Abs(delta) < Threshold {
+		return "~"
+	}
+
+	text := zlmd.Bold(fmt.Sprintf("%+.1f%%", delta))
+	if delta > 0 {
+		return zlmd.DefaultTheme.Prefix("danger") + " " + text
+	}
+	return zlmd.DefaultTheme.Prefix("success") + " " + text
+}
+
+// presentUnits returns the units found in benchmarks, with the standard
+// units first.
+func presentUnits(benchmarks []Benchmark) []string {
+	seen := map[string]bool{}
+	var extra []string
+	for _, b := range benchmarks {
+		for unit := range b.Samples {
+			if !seen[unit] {
+				seen[unit] = true
+				if unit != "ns/op" && unit != "B/op" && unit != "allocs/op" {
+					extra = append(extra, unit)
+				}
+			}
+		}
+	}
+	sort.Strings(extra)
+
+	var units []string
+	for _, unit := range []string{"ns/op", "B/op", "allocs/op"} {
+		if seen[unit] {
+			units = append(units, unit)
+		}
+	}
+	return append(units, extra...)
+}
+
+// unitHeader returns the column title for unit.
+func unitHeader(unit string) string {
+	if unit == "ns/op" {
+		return "time/op"
+	}
+	return unit
+}
+
+// formatValue renders a median value in the most readable form for unit.
+func formatValue(unit string, v float64) string {
+	switch unit {
+	case "ns/op":
+		return zlmd.HumanDuration(time.Duration(v))
+	case "B/op":
+		return zlmd.HumanBytes(int64(v))
+	default:
+		s := strconv.FormatFloat(v, 'f', 2, 64)
+		return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
+	}
+}
@@ -0,0 +1,73 @@
+package benchreport
+
+import (
+	"strings"
+	"testing"
+)
+
+const oldRun = `goos: linux
+goarch: amd64
+pkg: example.com/zlmd
+BenchmarkEscape-8    1000000    1000 ns/op    64 B/op    2 allocs/op
+BenchmarkEscape-8    1000000    1200 ns/op    64 B/op    2 allocs/op
+BenchmarkEscape-8    1000000    1100 ns/op    64 B/op    2 allocs/op
+BenchmarkTable-8      500000    3000 ns/op   512 B/op    8 allocs/op
+PASS
+ok  	example.com/zlmd	3.2s
+`
+
+const newRun = `BenchmarkEscape-8    1000000    1400 ns/op    64 B/op    2 allocs/op
+BenchmarkTable-8      500000    2000 ns/op   500 B/op    4 allocs/op
+BenchmarkNew-8        100000    9000 ns/op     0 B/op    0 allocs/op
+`
+
+func TestParse(t *testing.T) {
+	benchmarks, err := Parse(strings.NewReader(oldRun))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+	if len(benchmarks) != 2 {
+		t.Fatalf("Expected 2 benchmarks, got %d", len(benchmarks))
+	}
+
+	if got := len(benchmarks[0].Samples["ns/op"]); got != 3 {
+		t.Errorf("Expected 3 samples, got %d", got)
+	}
+	if median, _ := benchmarks[0].Median("ns/op"); median != 1100 {
+		t.Errorf("Median() = %v, want 1100", median)
+	}
+	if _, ok := benchmarks[0].Median("MB/s"); ok {
+		t.Error("Expected Median() of missing unit to report false")
+	}
+}
+
+func TestTable(t *testing.T) {
+	benchmarks, _ := Parse(strings.NewReader(oldRun))
+
+	got := Table(benchmarks)
+	expected := "| Benchmark | time/op | B/op | allocs/op |\n| --- | ---: | ---: | ---: |\n" +
+		"| BenchmarkEscape-8 | 1.1µs | 64 B | 2 |\n" +
+		"| BenchmarkTable-8 | 3µs | 512 B | 8 |\n"
+	if got != expected {
+		t.Errorf("Table() = %q, want %q", got, expected)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	before, _ := Parse(strings.NewReader(oldRun))
+	after, _ := Parse(strings.NewReader(newRun))
+
+	got := Compare(before, after)
+	expected := "**time/op**\n| Benchmark | Old | New | Delta |\n| --- | ---: | ---: | ---: |\n" +
+		"| BenchmarkEscape-8 | 1.1µs | 1.4µs | ❌ **+27.3%** |\n" +
+		"| BenchmarkTable-8 | 3µs | 2µs | ✅ **-33.3%** |\n" +
+		"\n**B/op**\n| Benchmark | Old | New | Delta |\n| --- | ---: | ---: | ---: |\n" +
+		"| BenchmarkEscape-8 | 64 B | 64 B | ~ |\n" +
+		"| BenchmarkTable-8 | 512 B | 500 B | ~ |\n" +
+		"\n**allocs/op**\n| Benchmark | Old | New | Delta |\n| --- | ---: | ---: | ---: |\n" +
+		"| BenchmarkEscape-8 | 2 | 2 | ~ |\n" +
+		"| BenchmarkTable-8 | 8 | 4 | ✅ **-50.0%** |\n"
+	if got != expected {
+		t.Errorf("Compare() = %q, want %q", got, expected)
+	}
+}
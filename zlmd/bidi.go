@@ -0,0 +1,74 @@
+package zlmd
+
+import "unicode"
+
+// BidiPolicy selects which text IsolateBidi wraps in directional isolates.
+type BidiPolicy int
+
+const (
+	// BidiNone leaves text unchanged.
+	BidiNone BidiPolicy = iota
+	// BidiIsolateRTL isolates text containing right-to-left characters,
+	// such as Hebrew or Arabic.
+	BidiIsolateRTL
+	// BidiIsolateAll isolates all non-empty text, for content whose
+	// direction isn't known in advance, such as user names.
+	BidiIsolateAll
+)
+
+const (
+	// firstStrongIsolate (FSI) starts a run whose direction is taken from
+	// its first strong character.
+	firstStrongIsolate = "\u2068"
+	// popDirectionalIsolate (PDI) ends the run started by FSI.
+	popDirectionalIsolate = "\u2069"
+)
+
+// rtlScripts are the scripts written right to left.
+var rtlScripts = []*unicode.RangeTable{
+	unicode.Hebrew, unicode.Arabic, unicode.Syriac, unicode.Thaana, unicode.Nko,
+	unicode.Samaritan, unicode.Mandaic, unicode.Adlam, unicode.Hanifi_Rohingya,
+}
+
+// IsolateBidi wraps text in Unicode directional isolate marks, so the
+// direction of its characters can't reorder the text around it.
+//
+// Without isolation, a right-to-left value in a table cell or list item is
+// laid out together with the neighboring pipes, bullets and numbers, which
+// then appear on the wrong side of it.
+//
+// Parameters:
+//   - text (string): The text to isolate
+//   - policy (BidiPolicy): Which text to isolate
+//
+// Returns:
+//   - string: text between FSI (U+2068) and PDI (U+2069), or text itself if
+//     policy doesn't select it
+//
+// Example:
+//
+//	IsolateBidi("שלום", BidiIsolateRTL)   // "\u2068שלום\u2069"
+//	IsolateBidi("hello", BidiIsolateRTL)  // "hello"
+//
+// Notes:
+//   - The marks are invisible and take no space, so DisplayWidth is
+//     unchanged
+func IsolateBidi(text string, policy BidiPolicy) string {
+	switch {
+	case text == "",
+		policy == BidiNone,
+		policy == BidiIsolateRTL && !hasRTL(text):
+		return text
+	}
+	return firstStrongIsolate + text + popDirectionalIsolate
+}
+
+// hasRTL reports whether text contains a right-to-left character.
+func hasRTL(text string) bool {
+	for _, r := range text {
+		if r >= 0x590 && unicode.In(r, rtlScripts...) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package zlmd
+
+import "testing"
+
+func TestIsolateBidi(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		policy   BidiPolicy
+		expected string
+	}{
+		{"None", "שלום", BidiNone, "שלום"},
+		{"Hebrew", "שלום", BidiIsolateRTL, "\u2068שלום\u2069"},
+		{"Arabic mixed", "status: مرحبا", BidiIsolateRTL, "\u2068status: مرحبا\u2069"},
+		{"LTR only", "hello", BidiIsolateRTL, "hello"},
+		{"All", "hello", BidiIsolateAll, "\u2068hello\u2069"},
+		{"Empty", "", BidiIsolateAll, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsolateBidi(tt.text, tt.policy); got != tt.expected {
+				t.Errorf("IsolateBidi() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBidiPolicy_Builders(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{
+			name: "Table",
+			got: NewTableBuilder().WithHeaders("User", "שם").AddRow("dana", "דנה").
+				WithBidiPolicy(BidiIsolateRTL).Build(),
+			expected: "| User | \u2068שם\u2069 |\n| --- | --- |\n| dana | \u2068דנה\u2069 |\n",
+		},
+		{
+			name:     "KV code",
+			got:      NewKVBlock().Add("עיר", "תל אביב").Add("Zone", "il").WithBidiPolicy(BidiIsolateRTL).Build(),
+			expected: "```text\n\u2068עיר\u2069:  \u2068תל אביב\u2069\nZone: il\n```",
+		},
+		{
+			name:     "KV table",
+			got:      NewKVBlock().Add("עיר", "x").WithStyle(KVStyleTable).WithBidiPolicy(BidiIsolateRTL).Build(),
+			expected: "| Key | Value |\n| --- | --- |\n| **\u2068עיר\u2069** | x |\n",
+		},
+		{
+			name:     "Section list",
+			got:      NewSection(2, "Users").WithBidiPolicy(BidiIsolateRTL).AddBullet("دانة").AddNumberedItem(2, "bob").Build(),
+			expected: "## Users\n\n* \u2068دانة\u2069\n2. bob\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("Build() = %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
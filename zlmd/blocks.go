@@ -76,12 +76,13 @@ func Spoiler(heading string, text string) string {
 //   - If fence is empty or invalid, the spoiler may not render correctly
 //   - If text contains unclosed code blocks, they will be preserved but may render incorrectly
 func SpoilerEscaped(heading string, text string, fence string) string {
+	if !defaultTarget().supports(FeatureSpoiler) {
+		return quotedSpoiler(heading, text)
+	}
 	sb := strings.Builder{}
 
 	sb.WriteString(fence)
-	sb.WriteString("spoiler")
-	sb.WriteString(" ")
-
+	sb.WriteString("spoiler ")
 	sb.WriteString(heading)
 	sb.WriteString("\n")
 
@@ -178,6 +179,15 @@ func SpoilerEscapedFence(heading string, text string) string {
 //   - If sb is nil, this will panic
 //   - If text contains unclosed code blocks, they will be preserved but may render incorrectly
 func WriteSpoiler(sb *strings.Builder, heading string, text string) {
+	writeSpoiler(sb, heading, text, defaultTarget())
+}
+
+// writeSpoiler is WriteSpoiler for target t.
+func writeSpoiler(sb *strings.Builder, heading string, text string, t renderTarget) {
+	if !t.supports(FeatureSpoiler) {
+		sb.WriteString(quotedSpoiler(heading, text))
+		return
+	}
 	sb.WriteString("```spoiler ")
 	sb.WriteString(heading)
 	sb.WriteString("\n")
@@ -271,6 +281,88 @@ func WriteCodeBlock(sb *strings.Builder, language string, text string) {
 	sb.WriteString("```")
 }
 
+// fenceFor returns a backtick fence long enough to safely enclose text.
+//
+// Zulip closes a fenced block at the first line holding a fence at least as
+// long as the opening one, so the returned fence is one backtick longer than
+// the longest backtick run found in text (and never shorter than three).
+//
+// Example:
+//
+//	fenceFor("plain")          // "```"
+//	fenceFor("```go\nx\n```") // "````"
+func fenceFor(text string) string {
+	return strings.Repeat("`", fenceLenFor(text))
+}
+
+// fenceLenFor returns the length of the fence fenceFor would choose for text.
+func fenceLenFor(text string) int {
+	return runFenceLen(text, '`')
+}
+
+// FenceStyle selects the character used for fences generated by FencedBlock.
+type FenceStyle int
+
+const (
+	// FenceBacktick fences blocks with backticks (```).
+	FenceBacktick FenceStyle = iota
+	// FenceTilde fences blocks with tildes (~~~).
+	FenceTilde
+)
+
+// WithFenceStyle sets the fence character used by FencedBlock.
+//
+// Example:
+//
+//	FencedBlock("go", "x := 1", WithFenceStyle(FenceTilde))
+//	// "~~~go\nx := 1\n~~~"
+func WithFenceStyle(style FenceStyle) Option {
+	return func(o *options) {
+		o.fenceStyle = style
+	}
+}
+
+// FencedBlock wraps text in a fenced block whose fence is long enough that
+// nothing inside text can close it.
+//
+// Parameters:
+//   - info string: the text following the opening fence, such as a language
+//     ("go") or a spoiler header ("spoiler Logs")
+//   - text string: the content of the block
+//   - opts ...Option: optional settings; WithFenceStyle selects tildes
+//
+// Returns:
+//   - string: formatted fenced block
+//
+// Unlike CodeBlock, which always uses three backticks, the fence is made one
+// character longer than the longest run of the fence character in text, so
+// text containing its own fences is kept intact.
+//
+// Example:
+//
+//	result := FencedBlock("markdown", "```go\nx\n```")
+//	// result will be:
+//	// ````markdown
+//	// ```go
+//	// x
+//	// ```
+//	// ````
+//
+// Edge Cases:
+//   - A trailing newline in text is dropped so the closing fence is not
+//     preceded by an empty line
+func FencedBlock(info string, text string, opts ...Option) string {
+	o := newOptions(opts...)
+	text = strings.TrimSuffix(text, "\n")
+
+	c := "`"
+	if o.fenceStyle == FenceTilde {
+		c = "~"
+	}
+	fence := strings.Repeat(c, max(runFenceLen(text, c[0]), o.fenceLength))
+	return fence + info + "\n" + text + "\n" + fence
+}
+
 // MarkdownBlock creates a code block specifically for markdown content.
 //
 // Parameters:
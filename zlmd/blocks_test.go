@@ -178,6 +178,33 @@ func TestMarkdownBlock(t *testing.T) {
 	}
 }
 
+func TestFencedBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		info     string
+		content  string
+		expected string
+	}{
+		{"Plain", "go", "x := 1\n", "```go\nx := 1\n```"},
+		{"Nested fence", "spoiler Logs", "```\nlog\n```", "````spoiler Logs\n```\nlog\n```\n````"},
+		{"Long run", "", "`````", "``````\n`````\n``````"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FencedBlock(tt.info, tt.content)
+			if got != tt.expected {
+				t.Errorf("FencedBlock() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+
+	got := FencedBlock("go", "~~~\nx\n~~~", WithFenceStyle(FenceTilde))
+	if expected := "~~~~go\n~~~\nx\n~~~\n~~~~"; got != expected {
+		t.Errorf("FencedBlock() with tildes = %q, want %q", got, expected)
+	}
+}
+
 // Test the integration of multiple blocks
 func TestNestedBlocks(t *testing.T) {
 	// Create a spoiler that contains a code block
@@ -0,0 +1,200 @@
+// Package bot serves Zulip outgoing webhooks: it parses the payload Zulip
+// posts when a bot is mentioned or messaged, dispatches the command to a
+// registered handler and returns the handler's markdown as the reply.
+package bot
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// maxPayload limits the size of webhook payloads read by Bot.
+const maxPayload = 1 << 20
+
+// Message is the message that triggered an outgoing webhook.
+type Message struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"` // "stream" or "private"
+	Content     string `json:"content"`
+	SenderID    int    `json:"sender_id"`
+	SenderEmail string `json:"sender_email"`
+	SenderName  string `json:"sender_full_name"`
+	StreamID    int    `json:"stream_id"`
+	Topic       string `json:"subject"`
+}
+
+// Payload is the JSON body Zulip posts to an outgoing webhook.
+type Payload struct {
+	BotEmail    string  `json:"bot_email"`
+	BotFullName string  `json:"bot_full_name"`
+	Data        string  `json:"data"`
+	Message     Message `json:"message"`
+	Token       string  `json:"token"`
+	Trigger     string  `json:"trigger"` // "mention" or "direct_message"
+}
+
+// Request is a parsed bot command.
+type Request struct {
+	// Command is the first word after the bot mention, lowercased.
+	Command string
+	// Args are the whitespace-separated words after the command.
+	Args []string
+	// Text is everything after the command, with surrounding space trimmed.
+	Text string
+	// Payload is the webhook payload the command came from.
+	Payload *Payload
+}
+
+// HandlerFunc handles a bot command by writing its reply to out, typically
+// with the zlmd shortcuts such as zlmd.Successf. A returned error is
+// appended to the reply with zlmd.Errorf.
+type HandlerFunc func(ctx context.Context, req *Request, out *strings.Builder) error
+
+// command is a registered handler and its one-line description.
+type command struct {
+	summary string
+	handle  HandlerFunc
+}
+
+// Bot is an http.Handler for a Zulip outgoing webhook.
+type Bot struct {
+	// Token is the outgoing webhook token from the bot's settings. Requests
+	// carrying a different token are rejected; no check is made if empty.
+	Token string
+	// Fallback handles commands that have no registered handler. If nil,
+	// the reply lists the available commands.
+	Fallback HandlerFunc
+
+	commands map[string]command
+}
+
+// New returns a bot that accepts payloads carrying token.
+//
+// Parameters:
+//   - token (string): The outgoing webhook token
+//
+// Returns:
+//   - *Bot: A bot with no commands registered
+//
+// Example:
+//
+//	b := bot.New(os.Getenv("ZULIP_BOT_TOKEN"))
+//	b.Handle("deploy", "Deploy a service", func(ctx context.Context, req *bot.Request, out *strings.Builder) error {
+//		zlmd.Successf(out, "Deploying %s", zlmd.Code(req.Text))
+//		return nil
+//	})
+//	http.Handle("/zulip", b)
+func New(token string) *Bot {
+	return &Bot{Token: token, commands: map[string]command{}}
+}
+
+// Handle registers handler for command. Commands are matched case-insensitively.
+//
+// Parameters:
+//   - name (string): The command word
+//   - summary (string): A one-line description listed for unknown commands
+//   - handler (HandlerFunc): The command handler
+func (b *Bot) Handle(name, summary string, handler HandlerFunc) {
+	if b.commands == nil {
+		b.commands = map[string]command{}
+	}
+	b.commands[strings.ToLower(name)] = command{summary, handler}
+}
+
+// ServeHTTP implements http.Handler.
+func (b *Bot) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	var payload Payload
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxPayload)).Decode(&payload); err != nil {
+		http.Error(w, "invalid payload: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	if b.Token != "" && subtle.ConstantTimeCompare([]byte(payload.Token), []byte(b.Token)) != 1 {
+		http.Error(w, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	reply := b.Dispatch(r.Context(), &payload)
+	var resp any = map[string]string{"content": reply}
+	if reply == "" {
+		resp = map[string]bool{"response_not_required": true}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(resp)
+}
+
+// Dispatch parses the command in payload, runs its handler and returns the
+// reply, or "" if there is nothing to say.
+func (b *Bot) Dispatch(ctx context.Context, payload *Payload) string {
+	req := ParseCommand(payload.Message.Content)
+	req.Payload = payload
+
+	var out strings.Builder
+	handler := b.Fallback
+	if cmd, ok := b.commands[req.Command]; ok {
+		handler = cmd.handle
+	}
+	if handler == nil {
+		b.writeUnknown(&out, req.Command)
+	} else if err := handler(ctx, req, &out); err != nil {
+		zlmd.Errorf(&out, "%v", err)
+	}
+	return strings.TrimRight(out.String(), "\n")
+}
+
+// writeUnknown writes the reply for a command with no handler.
+func (b *Bot) writeUnknown(out *strings.Builder, name string) {
+	if name == "" {
+		zlmd.Infof(out, "Send me a command.")
+	} else {
+		zlmd.Warnf(out, "Unknown command %s.", zlmd.Code(name))
+	}
+	names := make([]string, 0, len(b.commands))
+	for name := range b.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		zlmd.WriteListItem(out, zlmd.Code(name)+": "+b.commands[name].summary, 0)
+	}
+}
+
+// ParseCommand splits message content into a command and its arguments,
+// skipping the mentions that address the bot at the start of the message.
+//
+// Parameters:
+//   - content (string): The raw message content
+//
+// Returns:
+//   - *Request: The command, arguments and text; Payload is nil
+//
+// Example:
+//
+//	req := ParseCommand("@**Deploy Bot** Deploy api --canary")
+//	// req.Command == "deploy", req.Args == []string{"api", "--canary"}
+func ParseCommand(content string) *Request {
+	text := strings.TrimSpace(content)
+	for _, m := range zlmd.ExtractMentions(text) {
+		if !strings.HasPrefix(text, m.Text) {
+			break
+		}
+		text = strings.TrimSpace(strings.TrimPrefix(text, m.Text))
+	}
+
+	name, rest := text, ""
+	if i := strings.IndexFunc(text, unicode.IsSpace); i >= 0 {
+		name, rest = text[:i], strings.TrimSpace(text[i:])
+	}
+	return &Request{Command: strings.ToLower(name), Args: strings.Fields(rest), Text: rest}
+}
@@ -0,0 +1,96 @@
+package bot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestParseCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		content  string
+		expected Request
+	}{
+		{"Mention", "@**Deploy Bot** Deploy api --canary", Request{Command: "deploy", Args: []string{"api", "--canary"}, Text: "api --canary"}},
+		{"Silent mention", "@_**Deploy Bot|7**\nstatus", Request{Command: "status", Args: []string{}, Text: ""}},
+		{"Direct message", "  help  ", Request{Command: "help", Args: []string{}, Text: ""}},
+		{"Multi-line text", "@**bot** note first line\nsecond @**Alice**", Request{Command: "note", Args: []string{"first", "line", "second", "@**Alice**"}, Text: "first line\nsecond @**Alice**"}},
+		{"Empty", "@**bot**", Request{Args: []string{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseCommand(tt.content)
+			if !reflect.DeepEqual(*got, tt.expected) {
+				t.Errorf("ParseCommand() = %+v, want %+v", *got, tt.expected)
+			}
+		})
+	}
+}
+
+func newTestBot() *Bot {
+	b := New("s3cret")
+	b.Handle("Echo", "Repeat the text", func(ctx context.Context, req *Request, out *strings.Builder) error {
+		zlmd.Successf(out, "%s said %s", req.Payload.Message.SenderName, req.Text)
+		return nil
+	})
+	b.Handle("fail", "Always fails", func(ctx context.Context, req *Request, out *strings.Builder) error {
+		out.WriteString("Trying\n")
+		return errors.New("boom")
+	})
+	b.Handle("quiet", "Says nothing", func(ctx context.Context, req *Request, out *strings.Builder) error {
+		return nil
+	})
+	return b
+}
+
+func TestBot_ServeHTTP(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		status   int
+		expected string
+	}{
+		{"Command", `{"token":"s3cret","message":{"content":"@**bot** echo hi","sender_full_name":"Alice"}}`, 200, `{"content":"✅ Alice said hi"}`},
+		{"Error", `{"token":"s3cret","message":{"content":"fail"}}`, 200, `{"content":"Trying\n❌ boom"}`},
+		{"No reply", `{"token":"s3cret","message":{"content":"quiet"}}`, 200, `{"response_not_required":true}`},
+		{"Unknown", `{"token":"s3cret","message":{"content":"dance"}}`, 200, "{\"content\":\"⚠️ Unknown command `dance`.\\n- `echo`: Repeat the text\\n- `fail`: Always fails\\n- `quiet`: Says nothing\"}"},
+		{"Bad token", `{"token":"nope","message":{"content":"echo hi"}}`, 401, "invalid token"},
+		{"Bad JSON", `{`, 400, "invalid payload: unexpected EOF"},
+	}
+
+	b := newTestBot()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			b.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tt.body)))
+			if got := strings.TrimSpace(rec.Body.String()); rec.Code != tt.status || got != tt.expected {
+				t.Errorf("ServeHTTP() = %d %q, want %d %q", rec.Code, got, tt.status, tt.expected)
+			}
+		})
+	}
+
+	rec := httptest.NewRecorder()
+	b.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status %d for GET, got %d", http.StatusMethodNotAllowed, rec.Code)
+	}
+}
+
+func TestBot_Fallback(t *testing.T) {
+	b := New("")
+	b.Fallback = func(ctx context.Context, req *Request, out *strings.Builder) error {
+		out.WriteString("fallback: " + req.Command)
+		return nil
+	}
+	if got := b.Dispatch(context.Background(), &Payload{Message: Message{Content: "Dance now"}}); got != "fallback: dance" {
+		t.Errorf("Dispatch() = %q, want %q", got, "fallback: dance")
+	}
+}
@@ -0,0 +1,78 @@
+package zlmd
+
+import "strings"
+
+// ParagraphSpacing selects what JoinParagraphs puts between parts.
+type ParagraphSpacing int
+
+const (
+	// ParagraphSpacingBlank separates parts with one blank line, so each is
+	// its own paragraph or block.
+	ParagraphSpacingBlank ParagraphSpacing = iota
+	// ParagraphSpacingCompact separates parts with a single line break.
+	// Zulip renders each part on its own line, but consecutive prose parts
+	// form one paragraph, and a part after a list or quote may continue it.
+	ParagraphSpacingCompact
+)
+
+// HardBreak returns a line break that renders as one wherever the output
+// is shown, for ending a line inside a paragraph.
+//
+// Zulip renders every newline in a paragraph as a line break, so for
+// FlavorZulip HardBreak is a plain newline. For FlavorPortable it is two
+// spaces and a newline, which GitHub renders as a line break and Zulip
+// ignores.
+//
+// Returns:
+//   - string: The line break, using the line ending set by SetLineEnding
+//
+// Example:
+//
+//	"Build failed" + HardBreak() + "See the logs" // "Build failed\nSee the logs"
+//
+// Notes:
+//   - The flavor is taken from the default Config
+//   - A trailing backslash is not used: GitHub renders it as a line break,
+//     but Zulip shows the backslash
+func HardBreak() string {
+	if defaultTarget().flavor == FlavorPortable {
+		return "  " + newline()
+	}
+	return newline()
+}
+
+// JoinParagraphs joins blocks of markdown, such as paragraphs, quotes and
+// tables, separated as the Config's ParagraphSpacing says, however many
+// newlines each part starts or ends with.
+//
+// Parameters:
+//   - parts (...string): The blocks to join; empty or blank parts are skipped
+//
+// Returns:
+//   - string: The joined blocks, with no trailing newline unless
+//     SetFinalNewline says otherwise
+//
+// Example:
+//
+//	JoinParagraphs("Deploy finished.\n", QuoteBlock("all checks passed"), "")
+//	// "Deploy finished.\n\n> all checks passed"
+//
+// Notes:
+//   - Blank lines inside a part are kept
+//   - Lines may end with LF or CRLF; the result uses the line ending set by
+//     SetLineEnding
+func JoinParagraphs(parts ...string) string {
+	sep := "\n\n"
+	if DefaultConfig().ParagraphSpacing == ParagraphSpacingCompact {
+		sep = "\n"
+	}
+
+	blocks := make([]string, 0, len(parts))
+	for _, part := range parts {
+		part = strings.Trim(strings.ReplaceAll(part, "\r\n", "\n"), "\n")
+		if strings.TrimSpace(part) != "" {
+			blocks = append(blocks, part)
+		}
+	}
+	return finishBlock(strings.Join(blocks, sep))
+}
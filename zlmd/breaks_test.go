@@ -0,0 +1,52 @@
+package zlmd
+
+import "testing"
+
+func TestHardBreak(t *testing.T) {
+	if got := HardBreak(); got != "\n" {
+		t.Errorf("HardBreak() = %q, want %q", got, "\n")
+	}
+
+	SetDefaultConfig(Config{Flavor: FlavorPortable})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+	if got := HardBreak(); got != "  \n" {
+		t.Errorf("HardBreak() = %q, want %q", got, "  \n")
+	}
+}
+
+func TestJoinParagraphs(t *testing.T) {
+	tests := []struct {
+		name     string
+		parts    []string
+		expected string
+	}{
+		{"Plain", []string{"one", "two"}, "one\n\ntwo"},
+		{"Trailing newlines", []string{"one\n\n\n", "two\n"}, "one\n\ntwo"},
+		{"Quote", []string{"Deploy finished.", QuoteBlock("all checks passed")}, "Deploy finished.\n\n> all checks passed"},
+		{"Empty parts", []string{"", "one", "\n", "two", ""}, "one\n\ntwo"},
+		{"Inner blank lines", []string{"one\n\ntwo", "three"}, "one\n\ntwo\n\nthree"},
+		{"CRLF", []string{"one\r\n", "two"}, "one\n\ntwo"},
+		{"None", nil, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinParagraphs(tt.parts...); got != tt.expected {
+				t.Errorf("JoinParagraphs(%q) = %q, want %q", tt.parts, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJoinParagraphs_Policy(t *testing.T) {
+	SetDefaultConfig(Config{ParagraphSpacing: ParagraphSpacingCompact})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+	if got, want := JoinParagraphs("one\n\n", "two"), "one\ntwo"; got != want {
+		t.Errorf("JoinParagraphs() compact = %q, want %q", got, want)
+	}
+
+	setOutputStyle(t, LineEndingCRLF, FinalNewlineAlways)
+	if got, want := JoinParagraphs("one", "two"), "one\r\ntwo\r\n"; got != want {
+		t.Errorf("JoinParagraphs() CRLF = %q, want %q", got, want)
+	}
+}
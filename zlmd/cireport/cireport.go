@@ -0,0 +1,268 @@
+// Package cireport renders the result of a CI build as a Zulip message: a
+// summary line, a table of stages and the log of each failed job in a
+// spoiler. It is fed by a plain Build struct, so thin wrappers around
+// Jenkins, GitHub Actions or Buildkite APIs can share one message format.
+package cireport
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxFailures is the number of failed jobs whose logs are included before
+// the remainder is only counted.
+const MaxFailures = 10
+
+// MaxLogLines is the number of trailing log lines kept for a failed job.
+const MaxLogLines = 40
+
+// Status is the outcome of a build, stage or job.
+type Status string
+
+const (
+	StatusSuccess   Status = "success"
+	StatusFailed    Status = "failed"
+	StatusCancelled Status = "cancelled"
+	StatusSkipped   Status = "skipped"
+	StatusRunning   Status = "running"
+)
+
+// style returns the theme style for s.
+func (s Status) style() string {
+	switch s {
+	case StatusSuccess:
+		return "success"
+	case StatusFailed:
+		return "danger"
+	case StatusCancelled:
+		return "rejected"
+	case StatusRunning:
+		return "pending"
+	default:
+		return "info"
+	}
+}
+
+// Job is a single job of a stage.
+type Job struct {
+	Name     string
+	Status   Status
+	Duration time.Duration
+	// URL links to the job's page on the CI server.
+	URL string
+	// Log is the job output; only the end of it is shown for failures.
+	Log string
+}
+
+// Stage is a group of jobs that run together.
+type Stage struct {
+	Name string
+	// Status is derived from the jobs when empty.
+	Status Status
+	// Duration is the stage's wall-clock time; when zero, the longest job
+	// duration is shown.
+	Duration time.Duration
+	Jobs     []Job
+}
+
+// Build is the result of a CI run.
+type Build struct {
+	// Pipeline names the pipeline, such as "api CI".
+	Pipeline string
+	// Number identifies the run, such as "1234"; optional.
+	Number string
+	URL    string
+	Branch string
+	Commit string
+	// Status is derived from the stages when empty.
+	Status   Status
+	Duration time.Duration
+	Stages   []Stage
+}
+
+// Markdown renders the build.
+//
+// Returns:
+//   - string: The rendered message
+//
+// Example:
+//
+//	b := cireport.Build{Pipeline: "api CI", Number: "812", Branch: "main", Commit: "9f2c1e07",
+//		Duration: 4 * time.Minute, Stages: []cireport.Stage{
+//			{Name: "build", Jobs: []cireport.Job{{Name: "compile", Status: cireport.StatusSuccess}}},
+//			{Name: "test", Jobs: []cireport.Job{{Name: "unit", Status: cireport.StatusFailed, Log: "FAIL TestLogin"}}},
+//		}}
+//	fmt.Println(b.Markdown())
+//	// ❌ **api CI #812 failed** on `main` at `9f2c1e0` in 4m
+//	//
+//	// | Stage | Status | Jobs | Duration |
+//	// | --- | --- | ---: | ---: |
+//	// | build | ✅ success | 1/1 | — |
+//	// | test | ❌ failed | 0/1 | — |
+//	//
+//	// ```spoiler test / unit
+//	// ...
+func (b *Build) Markdown() string {
+	var sb strings.Builder
+	status := b.status()
+
+	title := b.Pipeline
+	if b.Number != "" {
+		title += " #" + b.Number
+	}
+	title = strings.TrimSpace(title + " " + string(status))
+	sb.WriteString(zlmd.DefaultTheme.Prefix(status.style()) + " ")
+	if b.URL != "" {
+		sb.WriteString(zlmd.Link(zlmd.Bold(title), b.URL))
+	} else {
+		zlmd.WriteBold(&sb, title)
+	}
+	if b.Branch != "" {
+		sb.WriteString(" on " + zlmd.Code(b.Branch))
+	}
+	if b.Commit != "" {
+		sb.WriteString(" at " + zlmd.Code(shortSHA(b.Commit)))
+	}
+	if b.Duration > 0 {
+		sb.WriteString(" in " + zlmd.HumanDuration(b.Duration))
+	}
+	sb.WriteString("\n")
+
+	if len(b.Stages) > 0 {
+		table := zlmd.NewTableBuilder().
+			WithHeaders("Stage", "Status", "Jobs", "Duration").
+			SetAlignments(zlmd.AlignDefault, zlmd.AlignDefault, zlmd.AlignRight, zlmd.AlignRight)
+		for _, s := range b.Stages {
+			st := s.status()
+			passed := 0
+			for _, j := range s.Jobs {
+				if j.Status == StatusSuccess {
+					passed++
+				}
+			}
+			table.AddRow(s.Name, zlmd.DefaultTheme.Prefix(st.style())+" "+string(st),
+				fmt.Sprintf("%d/%d", passed, len(s.Jobs)), duration(s.duration()))
+		}
+		sb.WriteString("\n")
+		sb.WriteString(table.Build())
+	}
+
+	failures := 0
+	for _, s := range b.Stages {
+		for _, j := range s.Jobs {
+			if j.Status != StatusFailed {
+				continue
+			}
+			failures++
+			if failures > MaxFailures {
+				continue
+			}
+			sb.WriteString("\n")
+			sb.WriteString(failureSpoiler(s.Name, j))
+			sb.WriteString("\n")
+		}
+	}
+	if failures > MaxFailures {
+		sb.WriteString("\n")
+		zlmd.WriteItalic(&sb, fmt.Sprintf("%d of %d failed jobs shown", MaxFailures, failures))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements zlmd.Markdowner by returning Markdown.
+func (b *Build) MarshalZulipMarkdown() (string, error) {
+	return b.Markdown(), nil
+}
+
+// status returns the build status, derived from the stages when unset.
+func (b *Build) status() Status {
+	if b.Status != "" {
+		return b.Status
+	}
+	statuses := make([]Status, len(b.Stages))
+	for i, s := range b.Stages {
+		statuses[i] = s.status()
+	}
+	return combine(statuses)
+}
+
+// status returns the stage status, derived from the jobs when unset.
+func (s Stage) status() Status {
+	if s.Status != "" {
+		return s.Status
+	}
+	statuses := make([]Status, len(s.Jobs))
+	for i, j := range s.Jobs {
+		statuses[i] = j.Status
+	}
+	return combine(statuses)
+}
+
+// duration returns the stage duration, or its longest job's if unset.
+func (s Stage) duration() time.Duration {
+	if s.Duration > 0 {
+		return s.Duration
+	}
+	var longest time.Duration
+	for _, j := range s.Jobs {
+		longest = max(longest, j.Duration)
+	}
+	return longest
+}
+
+// combine returns the overall status of several results: failed if any
+// failed, then running, cancelled, success, and skipped if all were skipped.
+func combine(statuses []Status) Status {
+	seen := map[Status]bool{}
+	for _, s := range statuses {
+		seen[s] = true
+	}
+	for _, s := range []Status{StatusFailed, StatusRunning, StatusCancelled, StatusSuccess} {
+		if seen[s] {
+			return s
+		}
+	}
+	return StatusSkipped
+}
+
+// failureSpoiler renders a failed job's log tail in a spoiler.
+func failureSpoiler(stage string, j Job) string {
+	heading := j.Name
+	if stage != "" {
+		heading = stage + " / " + j.Name
+	}
+	var body strings.Builder
+	if j.URL != "" {
+		body.WriteString(zlmd.Link("Job log", j.URL) + "\n\n")
+	}
+	log := strings.TrimRight(j.Log, "\n")
+	if log == "" {
+		log = "(no output)"
+	}
+	lines := strings.Split(log, "\n")
+	if n := len(lines) - MaxLogLines; n > 0 {
+		lines = append([]string{fmt.Sprintf("… %d earlier lines omitted", n)}, lines[n:]...)
+	}
+	body.WriteString(zlmd.FencedBlock("text", strings.Join(lines, "\n")))
+	return zlmd.FencedBlock("spoiler "+heading, body.String())
+}
+
+// duration renders a table duration, or a dash when it is unknown.
+func duration(d time.Duration) string {
+	if d <= 0 {
+		return "—"
+	}
+	return zlmd.HumanDuration(d)
+}
+
+// shortSHA abbreviates a commit hash to seven characters.
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
@@ -0,0 +1,77 @@
+package cireport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuild_Markdown(t *testing.T) {
+	b := Build{
+		Pipeline: "api CI",
+		Number:   "812",
+		URL:      "https://ci.example.com/812",
+		Branch:   "main",
+		Commit:   "9f2c1e07aa",
+		Duration: 4*time.Minute + 2*time.Second,
+		Stages: []Stage{
+			{Name: "build", Jobs: []Job{{Name: "compile", Status: StatusSuccess, Duration: 50 * time.Second}}},
+			{Name: "test", Duration: 3 * time.Minute, Jobs: []Job{
+				{Name: "unit", Status: StatusFailed, URL: "https://ci.example.com/812/unit", Log: "ok pkg/a\n```\nFAIL TestLogin\n"},
+				{Name: "lint", Status: StatusSuccess},
+			}},
+			{Name: "deploy", Jobs: []Job{{Name: "prod", Status: StatusSkipped}}},
+		},
+	}
+
+	expected := "❌ [**api CI #812 failed**](https://ci.example.com/812) on `main` at `9f2c1e0` in 4m 2s\n\n" +
+		"| Stage | Status | Jobs | Duration |\n| --- | --- | ---: | ---: |\n" +
+		"| build | ✅ success | 1/1 | 50s |\n" +
+		"| test | ❌ failed | 1/2 | 3m |\n" +
+		"| deploy | ℹ️ skipped | 0/1 | — |\n\n" +
+		"`````spoiler test / unit\n[Job log](https://ci.example.com/812/unit)\n\n````text\nok pkg/a\n```\nFAIL TestLogin\n````\n`````\n"
+	if got := b.Markdown(); got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestBuild_Markdown_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		build    Build
+		expected string
+	}{
+		{"Passed", Build{Pipeline: "web", Stages: []Stage{{Name: "test", Jobs: []Job{{Name: "a", Status: StatusSuccess}}}}}, "✅ **web success**"},
+		{"Running", Build{Pipeline: "web", Stages: []Stage{{Name: "test", Jobs: []Job{{Status: StatusSuccess}, {Status: StatusRunning}}}}}, "⏳ **web running**"},
+		{"Explicit", Build{Pipeline: "web", Status: StatusCancelled}, "✴️ **web cancelled**\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.build.Markdown(); !strings.HasPrefix(got, tt.expected) {
+				t.Errorf("Markdown() = %q, want prefix %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuild_Markdown_Truncation(t *testing.T) {
+	var jobs []Job
+	for i := range MaxFailures + 2 {
+		jobs = append(jobs, Job{Name: fmt.Sprintf("shard-%d", i), Status: StatusFailed})
+	}
+	jobs[0].Log = strings.Repeat("line\n", MaxLogLines+5)
+	b := Build{Pipeline: "web", Stages: []Stage{{Name: "test", Jobs: jobs}}}
+
+	got := b.Markdown()
+	if n := strings.Count(got, "spoiler test / shard-"); n != MaxFailures {
+		t.Errorf("Expected %d spoilers, got %d", MaxFailures, n)
+	}
+	if !strings.Contains(got, "… 5 earlier lines omitted\n") || !strings.Contains(got, "(no output)") {
+		t.Errorf("Expected truncated and empty logs, got %q", got)
+	}
+	if !strings.HasSuffix(got, fmt.Sprintf("*%d of %d failed jobs shown*\n", MaxFailures, MaxFailures+2)) {
+		t.Errorf("Expected truncation note, got %q", got)
+	}
+}
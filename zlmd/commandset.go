@@ -0,0 +1,291 @@
+package zlmd
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+// CommandArg is an argument of a Command.
+type CommandArg struct {
+	Name        string
+	Description string
+	// Optional marks an argument that may be left out; it is shown as
+	// "[name]" rather than "<name>".
+	Optional bool
+	// Repeated marks an argument that may be given several times; it is
+	// shown with a trailing "...".
+	Repeated bool
+}
+
+// Command describes a bot command for the help a CommandSet generates.
+type Command struct {
+	Name string
+	// Aliases are other names the command answers to.
+	Aliases []string
+	// Summary is the one-line description shown in the command list.
+	Summary string
+	// Description is shown below the usage in the command's own help; it
+	// may be several paragraphs of markdown.
+	Description string
+	Args        []CommandArg
+	// Examples are complete invocations, such as "deploy api --canary",
+	// without the prefix.
+	Examples []string
+}
+
+// CommandSet holds the commands of a bot and generates its help messages:
+// the list of commands, the help of one command, and suggestions for
+// commands that don't exist.
+//
+// It replaces writing help with repeated Usage and CommandInfo calls.
+type CommandSet struct {
+	prefix   string
+	commands []Command
+	opts     options
+}
+
+// NewCommandSet creates an empty command set.
+//
+// Parameters:
+//   - prefix (string): What users type before a command, such as "/" or
+//     "@**Deploy Bot** "; it is shown in usage lines and examples
+//   - opts (...Option): Optional settings; WithTheme selects the prefix of
+//     the unknown command warning
+//
+// Returns:
+//   - *CommandSet: A new initialized CommandSet instance
+//
+// Example:
+//
+//	commands := NewCommandSet("/").Add(Command{
+//	  Name:    "deploy",
+//	  Summary: "Deploy a service",
+//	  Args:    []CommandArg{{Name: "service"}, {Name: "flags", Optional: true, Repeated: true}},
+//	})
+//	commands.Usage("deploy") // "/deploy <service> [flags...]"
+func NewCommandSet(prefix string, opts ...Option) *CommandSet {
+	return &CommandSet{
+		prefix:   prefix,
+		commands: []Command{},
+		opts:     newOptions(opts...),
+	}
+}
+
+// Add registers a command. A command added with the name or an alias of an
+// earlier one replaces it.
+//
+// Returns:
+//   - *CommandSet: The same CommandSet instance (for method chaining)
+func (s *CommandSet) Add(cmd Command) *CommandSet {
+	s.commands = slices.DeleteFunc(s.commands, func(c Command) bool {
+		return commandNamed(c, cmd.Name) || slices.ContainsFunc(cmd.Aliases, func(a string) bool { return commandNamed(c, a) })
+	})
+	s.commands = append(s.commands, cmd)
+	return s
+}
+
+// Lookup returns the command with the given name or alias, matched
+// case-insensitively.
+func (s *CommandSet) Lookup(name string) (Command, bool) {
+	for _, c := range s.commands {
+		if commandNamed(c, name) {
+			return c, true
+		}
+	}
+	return Command{}, false
+}
+
+// Usage returns the usage line of the command with the given name, such as
+// "/deploy <service> [flags...]", or "" if there is no such command.
+func (s *CommandSet) Usage(name string) string {
+	c, ok := s.Lookup(name)
+	if !ok {
+		return ""
+	}
+
+	parts := []string{s.prefix + c.Name}
+	for _, arg := range c.Args {
+		text := arg.Name
+		if arg.Repeated {
+			text += "..."
+		}
+		if arg.Optional {
+			parts = append(parts, "["+text+"]")
+		} else {
+			parts = append(parts, "<"+text+">")
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// Help returns the list of commands, sorted by name, with their summaries.
+//
+// Example:
+//
+//	commands.Help()
+//	// "- **deploy** - Deploy a service\n- **status** - Show service status\n"
+func (s *CommandSet) Help() string {
+	commands := append([]Command(nil), s.commands...)
+	sort.SliceStable(commands, func(i, j int) bool {
+		return strings.ToLower(commands[i].Name) < strings.ToLower(commands[j].Name)
+	})
+
+	var sb strings.Builder
+	for _, c := range commands {
+		CommandInfo(&sb, c.Name, c.Summary)
+	}
+	return sb.String()
+}
+
+// HelpFor returns the help of one command: its usage, description,
+// arguments, aliases and examples. For a name that isn't a command, it
+// returns a warning suggesting the commands with similar names, or listing
+// them all if none is similar.
+//
+// Example:
+//
+//	commands.HelpFor("deploy")
+//	// "**deploy** - Deploy a service\n\nUsage: `/deploy <service> [flags...]`\n\n..."
+//
+//	commands.HelpFor("deplyo")
+//	// "⚠️ Unknown command `deplyo`. Did you mean `deploy`?\n"
+func (s *CommandSet) HelpFor(name string) string {
+	c, ok := s.Lookup(name)
+	if !ok {
+		return s.unknown(name)
+	}
+
+	blocks := []string{Bold(c.Name), "Usage: " + Code(s.Usage(c.Name))}
+	if c.Summary != "" {
+		blocks[0] += " - " + c.Summary
+	}
+	if c.Description != "" {
+		blocks = append(blocks, c.Description)
+	}
+	if len(c.Args) > 0 {
+		var sb strings.Builder
+		sb.WriteString(Bold("Arguments") + "\n")
+		for _, arg := range c.Args {
+			item := Code(arg.Name)
+			if arg.Optional {
+				item += " (optional)"
+			}
+			if arg.Description != "" {
+				item += " - " + arg.Description
+			}
+			WriteListItem(&sb, item, 0)
+		}
+		blocks = append(blocks, strings.TrimSuffix(sb.String(), "\n"))
+	}
+	if len(c.Aliases) > 0 {
+		aliases := make([]string, len(c.Aliases))
+		for i, a := range c.Aliases {
+			aliases[i] = Code(s.prefix + a)
+		}
+		blocks = append(blocks, "Aliases: "+strings.Join(aliases, ", "))
+	}
+	if len(c.Examples) > 0 {
+		var sb strings.Builder
+		sb.WriteString(Bold("Examples") + "\n")
+		for _, example := range c.Examples {
+			WriteListItem(&sb, Code(s.prefix+example), 0)
+		}
+		blocks = append(blocks, strings.TrimSuffix(sb.String(), "\n"))
+	}
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+// Suggest returns the names of the commands whose name or alias is close to
+// name, such as a misspelling or a prefix, best match first.
+func (s *CommandSet) Suggest(name string) []string {
+	name = strings.ToLower(name)
+	if name == "" {
+		return nil
+	}
+
+	type match struct {
+		name     string
+		distance int
+	}
+	var matches []match
+	for _, c := range s.commands {
+		best := -1
+		for _, candidate := range append([]string{c.Name}, c.Aliases...) {
+			candidate = strings.ToLower(candidate)
+			d := editDistance(name, candidate)
+			if strings.HasPrefix(candidate, name) {
+				d = 0
+			}
+			if d <= max(1, len([]rune(candidate))/3) && (best < 0 || d < best) {
+				best = d
+			}
+		}
+		if best >= 0 {
+			matches = append(matches, match{c.Name, best})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].distance < matches[j].distance
+	})
+
+	names := make([]string, len(matches))
+	for i, m := range matches {
+		names[i] = m.name
+	}
+	return names
+}
+
+// unknown returns the reply to a command that isn't in the set.
+func (s *CommandSet) unknown(name string) string {
+	var sb strings.Builder
+	suggestions := s.Suggest(name)
+	if len(suggestions) == 0 {
+		s.opts.theme.Statusf(&sb, "warning", "Unknown command %s. Available commands:", Code(name))
+		sb.WriteString(s.Help())
+		return sb.String()
+	}
+
+	quoted := make([]string, len(suggestions))
+	for i, suggestion := range suggestions {
+		quoted[i] = Code(suggestion)
+	}
+	s.opts.theme.Statusf(&sb, "warning", "Unknown command %s. Did you mean %s?", Code(name), s.opts.locale.JoinOr(quoted...))
+	return sb.String()
+}
+
+// commandNamed reports whether c has the given name or alias, ignoring case.
+func commandNamed(c Command, name string) bool {
+	if strings.EqualFold(c.Name, name) {
+		return true
+	}
+	for _, alias := range c.Aliases {
+		if strings.EqualFold(alias, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance returns the Levenshtein distance between a and b, counted in
+// runes.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
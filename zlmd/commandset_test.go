@@ -0,0 +1,123 @@
+package zlmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func testCommandSet() *CommandSet {
+	return NewCommandSet("/", WithTheme(PlainTheme)).
+		Add(Command{
+			Name:        "status",
+			Summary:     "Show service status",
+			Args:        []CommandArg{{Name: "service", Optional: true}},
+			Description: "Lists every service when none is given.",
+		}).
+		Add(Command{
+			Name:    "deploy",
+			Aliases: []string{"ship"},
+			Summary: "Deploy a service",
+			Args: []CommandArg{
+				{Name: "service", Description: "The service to deploy"},
+				{Name: "flags", Optional: true, Repeated: true},
+			},
+			Examples: []string{"deploy api", "deploy api --canary"},
+		})
+}
+
+func TestCommandSet_Usage(t *testing.T) {
+	tests := []struct {
+		name     string
+		command  string
+		expected string
+	}{
+		{"Required and repeated", "deploy", "/deploy <service> [flags...]"},
+		{"Alias", "SHIP", "/deploy <service> [flags...]"},
+		{"Optional", "status", "/status [service]"},
+		{"Unknown", "restart", ""},
+	}
+
+	commands := testCommandSet()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commands.Usage(tt.command); got != tt.expected {
+				t.Errorf("Usage(%q) = %q, want %q", tt.command, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommandSet_Help(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{
+			name:     "List",
+			got:      testCommandSet().Help(),
+			expected: "- **deploy** - Deploy a service\n- **status** - Show service status\n",
+		},
+		{
+			name: "Command",
+			got:  testCommandSet().HelpFor("deploy"),
+			expected: "**deploy** - Deploy a service\n\nUsage: `/deploy <service> [flags...]`\n\n" +
+				"**Arguments**\n- `service` - The service to deploy\n- `flags` (optional)\n\n" +
+				"Aliases: `/ship`\n\n**Examples**\n- `/deploy api`\n- `/deploy api --canary`\n",
+		},
+		{
+			name:     "Description",
+			got:      testCommandSet().HelpFor("status"),
+			expected: "**status** - Show service status\n\nUsage: `/status [service]`\n\nLists every service when none is given.\n\n**Arguments**\n- `service` (optional)\n",
+		},
+		{
+			name:     "Suggestion",
+			got:      testCommandSet().HelpFor("deplyo"),
+			expected: "[WARN] Unknown command `deplyo`. Did you mean `deploy`?\n",
+		},
+		{
+			name:     "No suggestion",
+			got:      testCommandSet().HelpFor("restart"),
+			expected: "[WARN] Unknown command `restart`. Available commands:\n- **deploy** - Deploy a service\n- **status** - Show service status\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommandSet_Suggest(t *testing.T) {
+	commands := testCommandSet().Add(Command{Name: "stats"})
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"Typo", "deplyo", []string{"deploy"}},
+		{"Alias typo", "shp", []string{"deploy"}},
+		{"Prefix", "sta", []string{"status", "stats"}},
+		{"Closest first", "stats", []string{"stats", "status"}},
+		{"Nothing close", "restart", []string{}},
+		{"Empty", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := commands.Suggest(tt.input); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("Suggest(%q) = %q, want %q", tt.input, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommandSet_AddReplaces(t *testing.T) {
+	commands := testCommandSet().Add(Command{Name: "ship", Summary: "Ship it"})
+	if got, want := commands.Help(), "- **ship** - Ship it\n- **status** - Show service status\n"; got != want {
+		t.Errorf("Help() = %q, want %q", got, want)
+	}
+}
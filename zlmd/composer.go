@@ -0,0 +1,182 @@
+package zlmd
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// Recipient is one person a Composer writes a message for.
+type Recipient struct {
+	// Name is the recipient's full name, used by the mention placeholder.
+	Name string
+	// UserID is the recipient's Zulip user ID; when set, mentions use the
+	// "@**Name|ID**" syntax, which stays correct when names are shared.
+	UserID int
+	// Email is the address to send the message to, for the caller's send
+	// loop; the Composer doesn't use it.
+	Email string
+	// Location is the recipient's time zone, used by the localtime
+	// placeholder; nil means UTC.
+	Location *time.Location
+	// Audiences are the groups the recipient belongs to, such as "admins",
+	// tested by the audience placeholder.
+	Audiences []string
+	// Data is any per-recipient data the template uses, as .Data.
+	Data any
+}
+
+// ComposedMessage is the message a Composer wrote for one recipient.
+type ComposedMessage struct {
+	Recipient Recipient
+	// Parts is the message split with SplitMessage so each part fits the
+	// maximum message length; most messages have one part.
+	Parts []string
+}
+
+// Composer renders one text/template message for many recipients,
+// personalizing the mention, times and sections of each.
+//
+// The template's data is the Recipient, and it may use the helpers of
+// FuncMap and these placeholders:
+//   - mention: a mention of the recipient, e.g. "Hi {{mention}}"
+//   - localtime: a time.Time in the recipient's time zone, e.g.
+//     {{localtime .Data.Due}} renders "2024-05-15 16:30 CEST"
+//   - audience: whether the recipient is in any of the named audiences, e.g.
+//     {{if audience "admins" "owners"}}...{{end}}
+type Composer struct {
+	tmpl *template.Template
+	opts options
+}
+
+// NewComposer parses a message template.
+//
+// Parameters:
+//   - text (string): The template
+//   - opts (...Option): Optional settings; WithMaxLength sets the length
+//     messages are split at, and WithTargetVersion the server version they
+//     are checked against
+//
+// Returns:
+//   - *Composer: The composer
+//   - error: Non-nil if the template doesn't parse
+//
+// Example:
+//
+//	c, err := NewComposer("Hi {{mention}}, your shift starts {{localtime .Data}}.")
+//	messages, err := c.Compose(recipients)
+//	for _, m := range messages {
+//		for _, part := range m.Parts {
+//			client.SendDirect(ctx, []string{m.Recipient.Email}, part)
+//		}
+//	}
+func NewComposer(text string, opts ...Option) (*Composer, error) {
+	funcs := FuncMap()
+	// Placeholders for the per-recipient functions, replaced by Compose.
+	funcs["mention"] = func() string { return "" }
+	funcs["localtime"] = func(time.Time) string { return "" }
+	funcs["audience"] = func(...string) bool { return false }
+
+	tmpl, err := template.New("message").Funcs(funcs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("zlmd: parse message template: %w", err)
+	}
+	return &Composer{tmpl: tmpl, opts: newOptions(opts...)}, nil
+}
+
+// Compose renders and checks the message of each recipient.
+//
+// Parameters:
+//   - recipients ([]Recipient): The recipients, in send order
+//
+// Returns:
+//   - []ComposedMessage: One message per recipient whose message isn't
+//     blank, in the order of recipients
+//   - error: Non-nil if a message fails to render, mentions a wildcard such
+//     as @**all**, or uses markdown the target server version doesn't
+//     render; no messages are returned then, so nothing is half sent
+//
+// Notes:
+//   - A template that renders only whitespace for a recipient, such as one
+//     made of audience sections they are in none of, skips them
+func (c *Composer) Compose(recipients []Recipient) ([]ComposedMessage, error) {
+	messages := make([]ComposedMessage, 0, len(recipients))
+	for _, r := range recipients {
+		content, err := c.render(r)
+		if err == nil {
+			err = c.check(content)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("zlmd: compose message for %s: %w", recipientLabel(r), err)
+		}
+		if strings.TrimSpace(content) == "" {
+			continue
+		}
+		messages = append(messages, ComposedMessage{
+			Recipient: r,
+			Parts:     SplitMessage(content, WithMaxLength(c.opts.maxLength)),
+		})
+	}
+	return messages, nil
+}
+
+// render executes the template for r.
+func (c *Composer) render(r Recipient) (string, error) {
+	tmpl, err := c.tmpl.Clone()
+	if err != nil {
+		return "", err
+	}
+	tmpl.Funcs(template.FuncMap{
+		"mention": func() string {
+			return Mention(r.Name, r.UserID)
+		},
+		"localtime": func(t time.Time) string {
+			loc := r.Location
+			if loc == nil {
+				loc = time.UTC
+			}
+			return t.In(loc).Format("2006-01-02 15:04 MST")
+		},
+		"audience": func(names ...string) bool {
+			return slices.ContainsFunc(names, func(name string) bool {
+				return slices.Contains(r.Audiences, name)
+			})
+		},
+	})
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, r); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// check reports why content can't be sent as a personal message.
+func (c *Composer) check(content string) error {
+	var errs []error
+	for _, m := range ExtractMentions(content) {
+		if m.Wildcard {
+			errs = append(errs, fmt.Errorf("wildcard mention %s", m.Text))
+		}
+	}
+	for _, f := range UnsupportedFeatures(content, c.opts.targetVersion) {
+		errs = append(errs, fmt.Errorf("%s are not rendered by Zulip %s", f, c.opts.targetVersion))
+	}
+	return errors.Join(errs...)
+}
+
+// recipientLabel names r in errors.
+func recipientLabel(r Recipient) string {
+	switch {
+	case r.Email != "":
+		return r.Email
+	case r.UserID > 0:
+		return r.Name + " (" + strconv.Itoa(r.UserID) + ")"
+	default:
+		return strconv.Quote(r.Name)
+	}
+}
@@ -0,0 +1,110 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComposer_Compose(t *testing.T) {
+	berlin := time.FixedZone("CEST", 2*60*60)
+	due := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	c, err := NewComposer(`Hi {{mention}}, review is due {{localtime .Data}}.{{if audience "admins"}}
+Please approve the release.{{end}}`)
+	if err != nil {
+		t.Fatalf("NewComposer() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		recipient Recipient
+		expected  string
+	}{
+		{"Mention by ID", Recipient{Name: "Alice", UserID: 12, Data: due}, "Hi @**Alice|12**, review is due 2024-05-15 14:30 UTC."},
+		{"Mention by name", Recipient{Name: "Bob", Data: due}, "Hi @**Bob**, review is due 2024-05-15 14:30 UTC."},
+		{"Time zone", Recipient{Name: "Carol", Location: berlin, Data: due}, "Hi @**Carol**, review is due 2024-05-15 16:30 CEST."},
+		{"Audience", Recipient{Name: "Dan", Audiences: []string{"admins"}, Data: due}, "Hi @**Dan**, review is due 2024-05-15 14:30 UTC.\nPlease approve the release."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			messages, err := c.Compose([]Recipient{tt.recipient})
+			if err != nil {
+				t.Fatalf("Compose() error = %v", err)
+			}
+			if len(messages) != 1 || len(messages[0].Parts) != 1 {
+				t.Fatalf("Compose() = %+v, want one message with one part", messages)
+			}
+			if got := messages[0].Parts[0]; got != tt.expected {
+				t.Errorf("Compose() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestComposer_Skip(t *testing.T) {
+	c, err := NewComposer(`{{if audience "admins"}}{{.Data}}{{end}}`)
+	if err != nil {
+		t.Fatalf("NewComposer() error = %v", err)
+	}
+	messages, err := c.Compose([]Recipient{
+		{Name: "Alice", Data: "secret"},
+		{Name: "Bob", Audiences: []string{"admins"}, Data: "secret"},
+	})
+	if err != nil {
+		t.Fatalf("Compose() error = %v", err)
+	}
+	if len(messages) != 1 || messages[0].Recipient.Name != "Bob" {
+		t.Errorf("Compose() = %+v, want only Bob's message", messages)
+	}
+}
+
+func TestComposer_Split(t *testing.T) {
+	c, err := NewComposer("{{.Data}}\n\n{{.Data}}", WithMaxLength(20))
+	if err != nil {
+		t.Fatalf("NewComposer() error = %v", err)
+	}
+	messages, err := c.Compose([]Recipient{{Name: "Alice", Data: strings.Repeat("x", 15)}})
+	if err != nil {
+		t.Fatalf("Compose() error = %v", err)
+	}
+	if len(messages) != 1 || len(messages[0].Parts) != 2 {
+		t.Errorf("Compose() = %+v, want one message in two parts", messages)
+	}
+}
+
+func TestComposer_Errors(t *testing.T) {
+	if _, err := NewComposer("{{mention"); err == nil {
+		t.Error("NewComposer() with a bad template returned no error")
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		opts     []Option
+		expected string
+	}{
+		{"Wildcard", "ping @**all**", nil, "wildcard mention @**all**"},
+		{"Unsupported", "```spoiler x\ny\n```", []Option{WithTargetVersion(ServerVersion{Major: 2})}, "spoiler blocks are not rendered by Zulip 2.0"},
+		{"Execute", "{{.Data.Missing}}", nil, "compose message for alice@example.com"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewComposer(tt.template, tt.opts...)
+			if err != nil {
+				t.Fatalf("NewComposer() error = %v", err)
+			}
+			messages, err := c.Compose([]Recipient{
+				{Name: "Alice", Email: "alice@example.com", Data: 1},
+				{Name: "Bob"},
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Compose() error = %v, want it to contain %q", err, tt.expected)
+			}
+			if messages != nil {
+				t.Errorf("Compose() = %+v, want no messages on error", messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package zlmd
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// Config is a house style: the formatting choices shared by builders, set
+// once rather than passed to each call.
+//
+// Zero fields keep each builder's own default, so a Config only needs the
+// fields a project wants to change.
+type Config struct {
+	// FenceStyle is the fence character used by FencedBlock.
+	FenceStyle FenceStyle
+	// FenceLength is the minimum fence length used by FencedBlock; values
+	// below 3 mean 3.
+	FenceLength int
+	// Bullet is the list marker used by ListItem, ChecklistItem and Section,
+	// such as "-", "*" or "+". Empty keeps "-" for ListItem and
+	// ChecklistItem, and "*" for Section.
+	Bullet string
+	// IndentWidth is the number of spaces per nesting level of ListItem and
+	// ChecklistItem; zero or less means 2.
+	IndentWidth int
+	// Theme is the emoji theme used by Badge and the builders that render
+	// status prefixes; nil means DefaultTheme.
+	Theme *Theme
+	// Escape is applied to the table cells and list items added to
+	// TableBuilder and Section.
+	Escape EscapePolicy
+	// TargetVersion is the Zulip server version the output must render on;
+	// constructs it can't render are replaced with fallbacks. The zero
+	// value means the latest release.
+	TargetVersion ServerVersion
+	// Flavor is the markdown dialect of the output; FlavorPortable keeps to
+	// what GitHub renders too.
+	Flavor Flavor
+	// ParagraphSpacing is what JoinParagraphs puts between blocks; the zero
+	// value is a blank line.
+	ParagraphSpacing ParagraphSpacing
+	// Locale formats numbers, times written out as text and lists; nil
+	// means English.
+	Locale *Locale
+	// DefangWildcards renders the wildcard mentions of WildcardMention
+	// inside inline code, so they notify nobody.
+	DefangWildcards bool
+}
+
+// defaultConfig holds the Config set by SetDefaultConfig.
+var defaultConfig atomic.Pointer[Config]
+
+// SetDefaultConfig sets the Config every builder starts from. Options passed
+// to a builder, including WithConfig, still override it.
+//
+// It is safe to call concurrently with rendering, but is meant to be called
+// once, during program initialization.
+//
+// Example:
+//
+//	zlmd.SetDefaultConfig(zlmd.Config{Bullet: "-", Theme: zlmd.PlainTheme})
+func SetDefaultConfig(cfg Config) {
+	defaultConfig.Store(&cfg)
+}
+
+// DefaultConfig returns the Config set by SetDefaultConfig, or the zero
+// Config if none was set.
+func DefaultConfig() Config {
+	if cfg := defaultConfig.Load(); cfg != nil {
+		return *cfg
+	}
+	return Config{}
+}
+
+// WithConfig applies the set fields of cfg, for the builders and functions
+// that accept options. Zero fields are skipped, so they keep the value of
+// earlier options and of the default Config.
+//
+// Example:
+//
+//	cfg := zlmd.Config{Bullet: "-", Escape: zlmd.EscapeMentions}
+//	table := zlmd.NewTableBuilder(zlmd.WithConfig(cfg))
+//	section := zlmd.NewSection(2, "Alerts", zlmd.WithConfig(cfg))
+//
+// Notes:
+//   - Since zero fields are skipped, WithConfig can't reset a setting to
+//     its zero value, such as FenceBacktick; pass that setting's own
+//     Option, such as WithFenceStyle, after it instead
+func WithConfig(cfg Config) Option {
+	return func(o *options) {
+		if cfg.FenceStyle != FenceBacktick {
+			o.fenceStyle = cfg.FenceStyle
+		}
+		if cfg.FenceLength != 0 {
+			o.fenceLength = cfg.FenceLength
+		}
+		if cfg.Bullet != "" {
+			o.bullet = cfg.Bullet
+		}
+		if cfg.IndentWidth != 0 {
+			o.indentWidth = cfg.IndentWidth
+		}
+		if cfg.Theme != nil {
+			o.theme = cfg.Theme
+		}
+		if cfg.Escape != EscapeNone {
+			o.escape = cfg.Escape
+		}
+		if cfg.TargetVersion != (ServerVersion{}) {
+			o.targetVersion = cfg.TargetVersion
+		}
+		if cfg.Flavor != FlavorZulip {
+			o.flavor = cfg.Flavor
+		}
+		if cfg.Locale != nil {
+			o.locale = cfg.Locale
+		}
+		if cfg.DefangWildcards {
+			o.defangWildcards = true
+		}
+	}
+}
+
+// listPrefix returns the indentation and marker that start a list item
+// nested level deep, using the default Config.
+func listPrefix(level int) string {
+	cfg := DefaultConfig()
+	bullet, width := cfg.Bullet, cfg.IndentWidth
+	if bullet == "" {
+		bullet = "-"
+	}
+	if width <= 0 {
+		width = 2
+	}
+	return strings.Repeat(" ", width*max(level, 0)) + bullet + " "
+}
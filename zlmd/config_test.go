@@ -0,0 +1,89 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWithConfig(t *testing.T) {
+	cfg := Config{
+		FenceStyle:  FenceTilde,
+		FenceLength: 4,
+		Bullet:      "-",
+		Escape:      EscapeAll,
+	}
+
+	tests := []struct {
+		name     string
+		render   func() string
+		expected string
+	}{
+		{
+			name:     "FencedBlock",
+			render:   func() string { return FencedBlock("go", "x := 1", WithConfig(cfg)) },
+			expected: "~~~~go\nx := 1\n~~~~",
+		},
+		{
+			name: "Section",
+			render: func() string {
+				return NewSection(2, "Alerts", WithConfig(cfg)).AddBullet("*disk*").AddNumberedItem(1, "a_b").Build()
+			},
+			expected: "## Alerts\n\n- \\*disk\\*\n1. a\\_b\n\n",
+		},
+		{
+			name: "TableBuilder",
+			render: func() string {
+				return NewTableBuilder(WithConfig(cfg)).WithHeaders("Cmd").AddRow("a|b").Build()
+			},
+			expected: "| Cmd |\n| --- |\n| a\\|b |\n",
+		},
+		{
+			name:     "Zero Config",
+			render:   func() string { return NewSection(2, "T", WithConfig(Config{})).AddBullet("*x*").Build() },
+			expected: "## T\n\n* *x*\n\n",
+		},
+		{
+			name: "Zero fields keep earlier options",
+			render: func() string {
+				return FencedBlock("go", "x := 1", WithFenceStyle(FenceTilde), WithConfig(Config{Flavor: FlavorPortable}))
+			},
+			expected: "~~~go\nx := 1\n~~~",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.render(); got != tt.expected {
+				t.Errorf("render() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSetDefaultConfig(t *testing.T) {
+	theme := NewTheme("test", "?").Register("success", "[OK]")
+	SetDefaultConfig(Config{Bullet: "+", IndentWidth: 4, Theme: theme})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	if got, want := ListItem("x", 1), "    + x"; got != want {
+		t.Errorf("ListItem() = %q, want %q", got, want)
+	}
+	if got, want := ChecklistItem("x", true, 0), "+ [x] x"; got != want {
+		t.Errorf("ChecklistItem() = %q, want %q", got, want)
+	}
+	if got, want := NewSection(2, "T").AddBullet("x").Build(), "## T\n\n+ x\n\n"; got != want {
+		t.Errorf("Section.Build() = %q, want %q", got, want)
+	}
+
+	var sb strings.Builder
+	Badge(&sb, "done", "success")
+	if got, want := sb.String(), "[OK] `done`\n"; got != want {
+		t.Errorf("Badge() = %q, want %q", got, want)
+	}
+
+	// Options passed to a builder override the default Config.
+	got := NewSection(2, "T", WithConfig(Config{Bullet: "*"})).AddBullet("x").Build()
+	if want := "## T\n\n* x\n\n"; got != want {
+		t.Errorf("Section.Build() = %q, want %q", got, want)
+	}
+}
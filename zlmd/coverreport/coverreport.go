@@ -0,0 +1,224 @@
+// Package coverreport renders Go coverage data as a Zulip table of
+// per-package coverage, with trend arrows when a previous snapshot is
+// available for comparison.
+package coverreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// Snapshot holds coverage percentages for a single point in time.
+type Snapshot struct {
+	// Packages maps import paths to their coverage percentage.
+	Packages map[string]float64
+	// Total is the overall coverage percentage.
+	Total float64
+}
+
+// ParseFunc reads the output of `go tool cover -func` and returns a snapshot.
+//
+// Parameters:
+//   - r (io.Reader): The cover -func output
+//
+// Returns:
+//   - *Snapshot: The parsed coverage
+//   - error: An error if reading fails or a line cannot be parsed
+//
+// Notes:
+//   - The -func output has no statement counts, so package coverage is the
+//     mean of its functions; use ParseProfile for statement-weighted numbers
+func ParseFunc(r io.Reader) (*Snapshot, error) {
+	sums := map[string]float64{}
+	counts := map[string]int{}
+	snapshot := &Snapshot{Packages: map[string]float64{}}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		pct, err := parsePercent(fields[len(fields)-1])
+		if err != nil {
+			return nil, err
+		}
+
+		if fields[0] == "total:" {
+			snapshot.Total = pct
+			continue
+		}
+
+		file := fields[0]
+		if i := strings.Index(file, ".go:"); i >= 0 {
+			file = file[:i+3]
+		}
+		pkg := path.Dir(file)
+		sums[pkg] += pct
+		counts[pkg]++
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for pkg, sum := range sums {
+		snapshot.Packages[pkg] = sum / float64(counts[pkg])
+	}
+	return snapshot, nil
+}
+
+// ParseProfile reads a coverage profile written by `go test -coverprofile`
+// and returns a statement-weighted snapshot.
+//
+// Parameters:
+//   - r (io.Reader): The coverage profile
+//
+// Returns:
+//   - *Snapshot: The parsed coverage
+//   - error: An error if reading fails or a block cannot be parsed
+func ParseProfile(r io.Reader) (*Snapshot, error) {
+	covered := map[string]int{}
+	total := map[string]int{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "mode:") {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		colon := strings.LastIndex(fields[0], ":")
+		if len(fields) != 3 || colon < 0 {
+			return nil, fmt.Errorf("coverreport: malformed profile line %q", line)
+		}
+		stmts, err1 := strconv.Atoi(fields[1])
+		count, err2 := strconv.Atoi(fields[2])
+		if err1 != nil || err2 != nil {
+			return nil, fmt.Errorf("coverreport: malformed profile line %q", line)
+		}
+
+		pkg := path.Dir(fields[0][:colon])
+		total[pkg] += stmts
+		if count > 0 {
+			covered[pkg] += stmts
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	snapshot := &Snapshot{Packages: map[string]float64{}}
+	var allCovered, allTotal int
+	for pkg, n := range total {
+		allTotal += n
+		allCovered += covered[pkg]
+		if n > 0 {
+			snapshot.Packages[pkg] = float64(covered[pkg]) / float64(n) * 100
+		}
+	}
+	if allTotal > 0 {
+		snapshot.Total = float64(allCovered) / float64(allTotal) * 100
+	}
+	return snapshot, nil
+}
+
+// Markdown renders current as a table sorted from the least to the most
+// covered package, followed by a bold total row.
+//
+// Parameters:
+//   - current (*Snapshot): The coverage to report
+//   - previous (*Snapshot): An optional earlier snapshot; when non-nil a
+//     trend column shows the change per package
+//
+// Returns:
+//   - string: The coverage table
+//
+// Example:
+//
+//	out := coverreport.Markdown(current, previous)
+//	// out will be similar to:
+//	// | Package | Coverage | Trend |
+//	// | --- | ---: | --- |
+//	// | example.com/app/api | 61.5% | ↓ -2.0 |
+//	// | example.com/app/db | 88.0% | → |
+//	// | **Total** | **72.3%** | ↑ +0.4 |
+func Markdown(current, previous *Snapshot) string {
+	pkgs := make([]string, 0, len(current.Packages))
+	for pkg := range current.Packages {
+		pkgs = append(pkgs, pkg)
+	}
+	sort.Slice(pkgs, func(i, j int) bool {
+		ci, cj := current.Packages[pkgs[i]], current.Packages[pkgs[j]]
+		if ci != cj {
+			return ci < cj
+		}
+		return pkgs[i] < pkgs[j]
+	})
+
+	table := zlmd.NewTableBuilder()
+	if previous != nil {
+		table.WithHeaders("Package", "Coverage", "Trend").
+			SetAlignments(zlmd.AlignDefault, zlmd.AlignRight, zlmd.AlignDefault)
+	} else {
+		table.WithHeaders("Package", "Coverage").
+			SetAlignments(zlmd.AlignDefault, zlmd.AlignRight)
+	}
+
+	for _, pkg := range pkgs {
+		row := []string{pkg, formatPercent(current.Packages[pkg])}
+		if previous != nil {
+			old, ok := previous.Packages[pkg]
+			if ok {
+				row = append(row, trend(old, current.Packages[pkg]))
+			} else {
+				row = append(row, zlmd.Italic("new"))
+			}
+		}
+		table.AddRow(row...)
+	}
+
+	totalRow := []string{zlmd.Bold("Total"), zlmd.Bold(formatPercent(current.Total))}
+	if previous != nil {
+		totalRow = append(totalRow, trend(previous.Total, current.Total))
+	}
+	table.AddRow(totalRow...)
+
+	return table.Build()
+}
+
+// trend renders the change from old to cur as an arrow and signed delta.
+func trend(old, cur float64) string {
+	delta := math.Round((cur-old)*10) / 10
+	switch {
+	case delta > 0:
+		return fmt.Sprintf("↑ %+.1f", delta)
+	case delta < 0:
+		return fmt.Sprintf("↓ %+.1f", delta)
+	default:
+		return zlmd.ArrowRight
+	}
+}
+
+// formatPercent renders a percentage with one decimal.
+func formatPercent(pct float64) string {
+	return fmt.Sprintf("%.1f%%", pct)
+}
+
+// parsePercent parses a value such as "85.7%".
+func parsePercent(s string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+	if err != nil {
+		return 0, fmt.Errorf("coverreport: invalid percentage %q", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,77 @@
+package coverreport
+
+import (
+	"strings"
+	"testing"
+)
+
+const funcOutput = `example.com/app/api/handler.go:12:	Serve		50.0%
+example.com/app/api/handler.go:40:	route		70.0%
+example.com/app/db/db.go:8:		Open		90.0%
+total:					(statements)	72.5%
+`
+
+const profile = `mode: set
+example.com/app/api/handler.go:12.20,14.2 3 1
+example.com/app/api/handler.go:16.2,18.3 1 0
+example.com/app/db/db.go:8.30,10.2 4 1
+`
+
+func TestParseFunc(t *testing.T) {
+	snapshot, err := ParseFunc(strings.NewReader(funcOutput))
+	if err != nil {
+		t.Fatalf("ParseFunc() returned error: %v", err)
+	}
+	if snapshot.Total != 72.5 {
+		t.Errorf("Total = %v, want 72.5", snapshot.Total)
+	}
+	if got := snapshot.Packages["example.com/app/api"]; got != 60 {
+		t.Errorf("api coverage = %v, want 60", got)
+	}
+	if got := snapshot.Packages["example.com/app/db"]; got != 90 {
+		t.Errorf("db coverage = %v, want 90", got)
+	}
+}
+
+func TestParseProfile(t *testing.T) {
+	snapshot, err := ParseProfile(strings.NewReader(profile))
+	if err != nil {
+		t.Fatalf("ParseProfile() returned error: %v", err)
+	}
+	if got := snapshot.Packages["example.com/app/api"]; got != 75 {
+		t.Errorf("api coverage = %v, want 75", got)
+	}
+	if got := snapshot.Total; got != 87.5 {
+		t.Errorf("Total = %v, want 87.5", got)
+	}
+
+	if _, err := ParseProfile(strings.NewReader("mode: set\nbroken\n")); err == nil {
+		t.Error("Expected error for malformed profile")
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	current, _ := ParseFunc(strings.NewReader(funcOutput))
+
+	got := Markdown(current, nil)
+	expected := "| Package | Coverage |\n| --- | ---: |\n" +
+		"| example.com/app/api | 60.0% |\n" +
+		"| example.com/app/db | 90.0% |\n" +
+		"| **Total** | **72.5%** |\n"
+	if got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+
+	previous := &Snapshot{
+		Packages: map[string]float64{"example.com/app/api": 62},
+		Total:    72.5,
+	}
+	got = Markdown(current, previous)
+	expected = "| Package | Coverage | Trend |\n| --- | ---: | --- |\n" +
+		"| example.com/app/api | 60.0% | ↓ -2.0 |\n" +
+		"| example.com/app/db | 90.0% | *new* |\n" +
+		"| **Total** | **72.5%** | → |\n"
+	if got != expected {
+		t.Errorf("Markdown() with previous = %q, want %q", got, expected)
+	}
+}
@@ -0,0 +1,304 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiffBlock wraps a unified diff in a ```diff code block, optionally trimming
+// it to a number of hunks and lines.
+//
+// Parameters:
+//   - unifiedDiff (string): A diff in unified format, as produced by DiffStrings or git diff
+//   - opts (...Option): Optional settings; WithMaxHunks and WithMaxLines limit the output
+//
+// Returns:
+//   - string: The diff in a fenced block, followed by a note when content was hidden
+//
+// Example:
+//
+//	out := DiffBlock(patch, WithMaxHunks(1))
+//	// out will be:
+//	// ```diff
+//	// --- a
+//	// +++ b
+//	// @@ -1 +1 @@
+//	// -old
+//	// +new
+//	// ```
+//	// *2 more hunks hidden*
+//
+// Notes:
+//   - File header lines before the first hunk are always kept and don't count towards limits
+//   - A hunk cut short by the line limit counts as shown
+func DiffBlock(unifiedDiff string, opts ...Option) string {
+	o := newOptions(opts...)
+
+	header, hunks := splitHunks(strings.TrimSuffix(unifiedDiff, "\n"))
+
+	lines := append([]string{}, header...)
+	shownHunks, shownLines, hiddenLines := 0, 0, 0
+	for _, hunk := range hunks {
+		if o.maxHunks > 0 && shownHunks >= o.maxHunks {
+			break
+		}
+		if o.maxLines > 0 && shownLines >= o.maxLines {
+			break
+		}
+		shownHunks++
+		for j, line := range hunk {
+			if o.maxLines > 0 && shownLines >= o.maxLines {
+				hiddenLines += len(hunk) - j
+				break
+			}
+			lines = append(lines, line)
+			shownLines++
+		}
+	}
+
+	content := strings.Join(lines, "\n")
+	fence := fenceFor(content)
+
+	var sb strings.Builder
+	sb.WriteString(fence)
+	sb.WriteString("diff\n")
+	sb.WriteString(content)
+	sb.WriteString("\n")
+	sb.WriteString(fence)
+
+	var notes []string
+	if hiddenLines > 0 {
+		notes = append(notes, pluralize(hiddenLines, "more line", "more lines")+" hidden")
+	}
+	if hiddenHunks := len(hunks) - shownHunks; hiddenHunks > 0 {
+		notes = append(notes, pluralize(hiddenHunks, "more hunk", "more hunks")+" hidden")
+	}
+	if len(notes) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(Italic(strings.Join(notes, ", ")))
+	}
+
+	return sb.String()
+}
+
+// DiffStrings computes a line-based unified diff between a and b.
+//
+// Parameters:
+//   - a (string): The original text
+//   - b (string): The changed text
+//   - opts (...Option): Optional settings; WithContextLines sets the context size
+//
+// Returns:
+//   - string: The unified diff with "--- a" and "+++ b" headers, or "" if the texts are equal
+//
+// Example:
+//
+//	patch := DiffStrings("one\ntwo\n", "one\n2\n")
+//	// patch will be:
+//	// --- a
+//	// +++ b
+//	// @@ -1,2 +1,2 @@
+//	//  one
+//	// -two
+//	// +2
+//
+// Notes:
+//   - Uses a longest-common-subsequence table, so time and memory grow with the
+//     product of the changed region sizes
+func DiffStrings(a, b string, opts ...Option) string {
+	if a == b {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	ops := diffLines(splitLines(a), splitLines(b))
+
+	var sb strings.Builder
+	sb.WriteString("--- a\n+++ b\n")
+	for _, h := range groupHunks(ops, o.context) {
+		writeHunk(&sb, ops[h[0]:h[1]])
+	}
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// diffOp is a single line of an edit script.
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	text string
+	a, b int // zero-based line positions in a and b before this op
+}
+
+// splitLines splits text into lines, ignoring a single trailing newline.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// diffLines returns an edit script turning a into b.
+func diffLines(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix &&
+		a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ma, mb := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the LCS length of ma[i:] and mb[j:].
+	lcs := make([][]int, len(ma)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(mb)+1)
+	}
+	for i := len(ma) - 1; i >= 0; i-- {
+		for j := len(mb) - 1; j >= 0; j-- {
+			if ma[i] == mb[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, diffOp{' ', a[i], i, i})
+	}
+	i, j := 0, 0
+	for i < len(ma) || j < len(mb) {
+		switch {
+		case i < len(ma) && j < len(mb) && ma[i] == mb[j]:
+			ops = append(ops, diffOp{' ', ma[i], prefix + i, prefix + j})
+			i++
+			j++
+		case i < len(ma) && (j == len(mb) || lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', ma[i], prefix + i, prefix + j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', mb[j], prefix + i, prefix + j})
+			j++
+		}
+	}
+	for k := 0; k < suffix; k++ {
+		ai, bi := len(a)-suffix+k, len(b)-suffix+k
+		ops = append(ops, diffOp{' ', a[ai], ai, bi})
+	}
+	return ops
+}
+
+// groupHunks returns [start, end) ranges of ops forming hunks with up to
+// context unchanged lines around each change.
+func groupHunks(ops []diffOp, context int) [][2]int {
+	var hunks [][2]int
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == ' ' {
+			continue
+		}
+		start := max(i-context, 0)
+		end := i + 1
+		for end < len(ops) {
+			if ops[end].kind != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(ops) && ops[next].kind == ' ' {
+				next++
+			}
+			if next < len(ops) && next-end <= 2*context {
+				end = next
+				continue
+			}
+			end = min(end+context, len(ops))
+			break
+		}
+		if n := len(hunks); n > 0 && start <= hunks[n-1][1] {
+			hunks[n-1][1] = end
+		} else {
+			hunks = append(hunks, [2]int{start, end})
+		}
+		i = end - 1
+	}
+	return hunks
+}
+
+// writeHunk writes a single hunk with its @@ header.
+func writeHunk(sb *strings.Builder, ops []diffOp) {
+	aStart, bStart := ops[0].a, ops[0].b
+	aLen, bLen := 0, 0
+	for _, op := range ops {
+		if op.kind != '+' {
+			aLen++
+		}
+		if op.kind != '-' {
+			bLen++
+		}
+	}
+
+	sb.WriteString(fmt.Sprintf("@@ -%s +%s @@\n", hunkRange(aStart, aLen), hunkRange(bStart, bLen)))
+	for _, op := range ops {
+		sb.WriteByte(op.kind)
+		sb.WriteString(op.text)
+		sb.WriteString("\n")
+	}
+}
+
+// hunkRange formats a unified diff range for a zero-based start line.
+func hunkRange(start, length int) string {
+	switch length {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, length)
+	}
+}
+
+// splitHunks separates the file header lines of a unified diff from its
+// hunks. Headers of subsequent files in a multi-file diff are kept at the
+// start of the first hunk that follows them.
+func splitHunks(diff string) ([]string, [][]string) {
+	var header, pending []string
+	var hunks [][]string
+	inHeader := true
+	for _, line := range strings.Split(diff, "\n") {
+		switch {
+		case strings.HasPrefix(line, "@@"):
+			if len(hunks) == 0 {
+				header = append(header, pending...)
+				pending = nil
+			}
+			hunks = append(hunks, append(pending, line))
+			pending = nil
+			inHeader = false
+		case strings.HasPrefix(line, "diff "):
+			pending = append(pending, line)
+			inHeader = true
+		case inHeader:
+			pending = append(pending, line)
+		default:
+			hunks[len(hunks)-1] = append(hunks[len(hunks)-1], line)
+		}
+	}
+	if len(hunks) == 0 {
+		return pending, nil
+	}
+	if len(pending) > 0 {
+		hunks[len(hunks)-1] = append(hunks[len(hunks)-1], pending...)
+	}
+	return header, hunks
+}
+
+// pluralize formats n followed by the singular or plural noun.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
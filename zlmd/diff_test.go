@@ -0,0 +1,99 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiffStrings(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "Equal",
+			a:        "same\n",
+			b:        "same\n",
+			expected: "",
+		},
+		{
+			name:     "Single change",
+			a:        "one\ntwo\n",
+			b:        "one\n2\n",
+			expected: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n one\n-two\n+2",
+		},
+		{
+			name:     "Insertion into empty",
+			a:        "",
+			b:        "new\n",
+			expected: "--- a\n+++ b\n@@ -0,0 +1 @@\n+new",
+		},
+		{
+			name:     "Separate hunks",
+			a:        "a\nb\nc\nd\ne\nf\ng\n",
+			b:        "A\nb\nc\nd\ne\nf\nG\n",
+			opts:     []Option{WithContextLines(1)},
+			expected: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n-a\n+A\n b\n@@ -6,2 +6,2 @@\n f\n-g\n+G",
+		},
+		{
+			name:     "Merged hunks",
+			a:        "a\nb\nc\nd\n",
+			b:        "A\nb\nc\nD\n",
+			opts:     []Option{WithContextLines(1)},
+			expected: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-a\n+A\n b\n c\n-d\n+D",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffStrings(tt.a, tt.b, tt.opts...)
+			if got != tt.expected {
+				t.Errorf("DiffStrings() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffBlock(t *testing.T) {
+	patch := "--- a\n+++ b\n@@ -1 +1 @@\n-a\n+A\n@@ -5 +5 @@\n-e\n+E\n@@ -9 +9 @@\n-i\n+I"
+
+	got := DiffBlock(patch)
+	expected := "```diff\n" + patch + "\n```"
+	if got != expected {
+		t.Errorf("DiffBlock() = %q, want %q", got, expected)
+	}
+
+	got = DiffBlock(patch, WithMaxHunks(1))
+	expected = "```diff\n--- a\n+++ b\n@@ -1 +1 @@\n-a\n+A\n```\n*2 more hunks hidden*"
+	if got != expected {
+		t.Errorf("DiffBlock() with hunk limit = %q, want %q", got, expected)
+	}
+
+	got = DiffBlock(patch, WithMaxLines(5))
+	expected = "```diff\n--- a\n+++ b\n@@ -1 +1 @@\n-a\n+A\n@@ -5 +5 @@\n-e\n```\n*1 more line hidden, 1 more hunk hidden*"
+	if got != expected {
+		t.Errorf("DiffBlock() with line limit = %q, want %q", got, expected)
+	}
+}
+
+func TestDiffBlock_MultiFile(t *testing.T) {
+	patch := "diff --git a/x b/x\n--- a/x\n+++ b/x\n@@ -1 +1 @@\n-x\n+X\ndiff --git a/y b/y\n--- a/y\n+++ b/y\n@@ -1 +1 @@\n-y\n+Y"
+
+	got := DiffBlock(patch, WithMaxHunks(1))
+	if strings.Contains(got, "a/y") {
+		t.Errorf("Expected header of hidden file to be hidden: %q", got)
+	}
+	if !strings.HasSuffix(got, "*1 more hunk hidden*") {
+		t.Errorf("Expected hidden hunk note: %q", got)
+	}
+}
+
+func TestDiffBlock_RoundTrip(t *testing.T) {
+	got := DiffBlock(DiffStrings("```\n", "~~~\n"))
+	if !strings.HasPrefix(got, "````diff\n") || !strings.HasSuffix(got, "\n````") {
+		t.Errorf("Expected longer fence around diff containing backticks: %q", got)
+	}
+}
@@ -0,0 +1,99 @@
+package zlmd
+
+import (
+	"strings"
+)
+
+// DiffTable renders a two-column before/after table of old and new lines.
+//
+// Parameters:
+//   - old ([]string): The lines before the change
+//   - new ([]string): The lines after the change
+//   - opts (...Option): Optional settings; WithMaxLines limits the number of rows
+//
+// Returns:
+//   - string: A markdown table with "Before" and "After" columns
+//
+// Lines are paired using the same line diff as DiffStrings. Unchanged lines
+// appear in both columns, changed lines are bolded, and lines that only exist
+// on one side leave the other cell empty. This reads better than a unified
+// diff on narrow screens such as the Zulip mobile apps.
+//
+// Example:
+//
+//	out := DiffTable([]string{"port: 80", "debug: false"}, []string{"port: 8080", "debug: false"})
+//	// out will be:
+//	// | Before | After |
+//	// | --- | --- |
+//	// | **port: 80** | **port: 8080** |
+//	// | debug: false | debug: false |
+//
+// Notes:
+//   - Pipe characters in cells are escaped so they don't split columns
+//   - Empty lines are rendered as an empty cell without bold markers
+func DiffTable(old, new []string, opts ...Option) string {
+	o := newOptions(opts...)
+
+	table := NewTableBuilder().WithHeaders("Before", "After")
+
+	rows := 0
+	addRow := func(before, after string) bool {
+		if o.maxLines > 0 && rows >= o.maxLines {
+			return false
+		}
+		table.AddRow(before, after)
+		rows++
+		return true
+	}
+
+	ops := diffLines(old, new)
+	total := 0
+	for i := 0; i < len(ops); {
+		if ops[i].kind == ' ' {
+			cell := escapeTableCell(ops[i].text)
+			total++
+			addRow(cell, cell)
+			i++
+			continue
+		}
+
+		var removed, added []string
+		for ; i < len(ops) && ops[i].kind != ' '; i++ {
+			if ops[i].kind == '-' {
+				removed = append(removed, ops[i].text)
+			} else {
+				added = append(added, ops[i].text)
+			}
+		}
+		for k := 0; k < max(len(removed), len(added)); k++ {
+			var before, after string
+			if k < len(removed) {
+				before = boldCell(removed[k])
+			}
+			if k < len(added) {
+				after = boldCell(added[k])
+			}
+			total++
+			addRow(before, after)
+		}
+	}
+
+	out := table.Build()
+	if hidden := total - rows; hidden > 0 {
+		out += Italic(pluralize(hidden, "more row", "more rows")+" hidden") + "\n"
+	}
+	return out
+}
+
+// boldCell escapes text for a table cell and bolds it unless it is blank.
+func boldCell(text string) string {
+	if strings.TrimSpace(text) == "" {
+		return ""
+	}
+	return Bold(escapeTableCell(text))
+}
+
+// escapeTableCell escapes characters that would break a markdown table cell.
+func escapeTableCell(text string) string {
+	return strings.ReplaceAll(text, "|", "\\|")
+}
@@ -0,0 +1,50 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestDiffTable(t *testing.T) {
+	tests := []struct {
+		name     string
+		old      []string
+		new      []string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "Changed line",
+			old:      []string{"port: 80", "debug: false"},
+			new:      []string{"port: 8080", "debug: false"},
+			expected: "| Before | After |\n| --- | --- |\n| **port: 80** | **port: 8080** |\n| debug: false | debug: false |\n",
+		},
+		{
+			name:     "Added and removed lines",
+			old:      []string{"a", "b"},
+			new:      []string{"b", "c"},
+			expected: "| Before | After |\n| --- | --- |\n| **a** |  |\n| b | b |\n|  | **c** |\n",
+		},
+		{
+			name:     "Pipes are escaped",
+			old:      []string{"a|b"},
+			new:      []string{"a|c"},
+			expected: "| Before | After |\n| --- | --- |\n| **a\\|b** | **a\\|c** |\n",
+		},
+		{
+			name:     "Row limit",
+			old:      []string{"a", "b", "c"},
+			new:      []string{"a", "b", "C"},
+			opts:     []Option{WithMaxLines(1)},
+			expected: "| Before | After |\n| --- | --- |\n| a | a |\n*2 more rows hidden*\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := DiffTable(tt.old, tt.new, tt.opts...)
+			if got != tt.expected {
+				t.Errorf("DiffTable() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
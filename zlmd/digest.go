@@ -0,0 +1,238 @@
+package zlmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// digestLimit is the number of entries a Digest shows before the overflow
+// spoiler when WithTopN isn't given.
+const digestLimit = 20
+
+// digestSeverity orders the theme styles of digest entries, most severe
+// first; other styles come after these.
+var digestSeverity = map[string]int{
+	"danger":   0,
+	"rejected": 1,
+	"warning":  2,
+	"pending":  3,
+	"info":     4,
+	"success":  5,
+	"debug":    6,
+}
+
+// DigestEvent is a single event collected by a Digest.
+type DigestEvent struct {
+	Time time.Time
+	// Topic groups events, such as the service or host they are about.
+	Topic string
+	// Style is the theme style used as the event prefix and its severity,
+	// such as "danger", "warning" or "info".
+	Style string
+	Text  string
+}
+
+// digestEntry is a run of events with the same topic, style and text.
+type digestEntry struct {
+	style       string
+	text        string
+	count       int
+	first, last time.Time
+}
+
+// Digest collects the events of a time window, such as alerts or log lines,
+// into one message: events are grouped by topic, ordered by severity, and
+// repeats are counted rather than listed, so a chatty source sends one
+// message per window instead of one per event.
+type Digest struct {
+	title  string
+	events []DigestEvent
+	opts   options
+}
+
+// NewDigest creates an empty digest.
+//
+// Parameters:
+//   - title (string): The digest title, shown in bold
+//   - opts (...Option): Optional settings; WithTopN sets how many entries are
+//     shown before the rest go in a spoiler (20 by default), and WithTheme
+//     selects the event prefixes
+//
+// Returns:
+//   - *Digest: A new initialized Digest instance
+//
+// Example:
+//
+//	d := NewDigest("Alerts").
+//	  Add(t1, "api", "danger", "disk full").
+//	  Add(t2, "api", "danger", "disk full").
+//	  Add(t3, "db", "warning", "slow query")
+//	// d.Build() will be:
+//	// **Alerts** · 3 events, <time:t1> to <time:t3>
+//	//
+//	// **api**
+//	// - ❌ disk full (×2, <time:t1> to <time:t2>)
+//	//
+//	// **db**
+//	// - ⚠️ slow query (<time:t3>)
+func NewDigest(title string, opts ...Option) *Digest {
+	return &Digest{
+		title:  title,
+		events: []DigestEvent{},
+		opts:   newOptions(opts...),
+	}
+}
+
+// Add appends an event.
+//
+// Returns:
+//   - *Digest: The same Digest instance (for method chaining)
+func (d *Digest) Add(at time.Time, topic, style, text string) *Digest {
+	return d.AddEvent(DigestEvent{Time: at, Topic: topic, Style: style, Text: text})
+}
+
+// AddEvent appends a prepared event.
+//
+// Returns:
+//   - *Digest: The same Digest instance (for method chaining)
+func (d *Digest) AddEvent(event DigestEvent) *Digest {
+	d.events = append(d.events, event)
+	return d
+}
+
+// Len returns the number of events collected.
+func (d *Digest) Len() int {
+	return len(d.events)
+}
+
+// Reset removes every event, to start the next window.
+func (d *Digest) Reset() {
+	d.events = d.events[:0]
+}
+
+// Build generates the digest message.
+//
+// Returns:
+//   - string: The message, or "" if no events were collected
+//
+// Notes:
+//   - Topics are listed in the order their first event was added; events
+//     without a topic come first
+//   - Entries beyond the limit are not dropped but listed in a spoiler,
+//     grouped the same way
+func (d *Digest) Build() string {
+	if len(d.events) == 0 {
+		return ""
+	}
+	target := d.opts.target()
+
+	first, last := d.events[0].Time, d.events[0].Time
+	var topics []string
+	entries := map[string][]*digestEntry{}
+	for _, e := range d.events {
+		if e.Time.Before(first) {
+			first = e.Time
+		}
+		if e.Time.After(last) {
+			last = e.Time
+		}
+		if _, ok := entries[e.Topic]; !ok {
+			topics = append(topics, e.Topic)
+		}
+		entries[e.Topic] = addDigestEvent(entries[e.Topic], e)
+	}
+	sort.SliceStable(topics, func(i, j int) bool {
+		return topics[i] == "" && topics[j] != ""
+	})
+
+	var sb strings.Builder
+	sb.WriteString(Bold(d.title) + " · " + pluralize(len(d.events), "event", "events") + ", ")
+	if first.Equal(last) {
+		sb.WriteString(formatTime(first, target))
+	} else {
+		sb.WriteString(formatTime(first, target) + " to " + formatTime(last, target))
+	}
+	sb.WriteString("\n")
+
+	limit := d.opts.topN
+	if limit == 0 {
+		limit = digestLimit
+	}
+	var shown, overflow strings.Builder
+	hidden := 0
+	for _, topic := range topics {
+		group := entries[topic]
+		sort.SliceStable(group, func(i, j int) bool {
+			return digestRank(group[i].style) < digestRank(group[j].style)
+		})
+
+		n := min(len(group), limit)
+		d.writeTopic(&shown, topic, group[:n])
+		d.writeTopic(&overflow, topic, group[n:])
+		limit -= n
+		for _, entry := range group[n:] {
+			hidden += entry.count
+		}
+	}
+
+	sb.WriteString(shown.String())
+	if hidden > 0 {
+		sb.WriteString("\n")
+		writeSpoiler(&sb, pluralize(hidden, "more event", "more events"), strings.Trim(overflow.String(), "\n"), target)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (d *Digest) MarshalZulipMarkdown() (string, error) {
+	return d.Build(), nil
+}
+
+// writeTopic writes the entries of one topic, preceded by a blank line and
+// the topic in bold.
+func (d *Digest) writeTopic(sb *strings.Builder, topic string, group []*digestEntry) {
+	if len(group) == 0 {
+		return
+	}
+	sb.WriteString("\n")
+	if topic != "" {
+		sb.WriteString(Bold(topic) + "\n")
+	}
+	target := d.opts.target()
+	for _, entry := range group {
+		when := formatTime(entry.first, target)
+		if entry.count > 1 {
+			when = fmt.Sprintf("×%d, %s to %s", entry.count, when, formatTime(entry.last, target))
+		}
+		WriteListItem(sb, d.opts.theme.Prefix(entry.style)+" "+entry.text+" ("+when+")", 0)
+	}
+}
+
+// addDigestEvent counts e in the entry with its style and text, or appends
+// a new entry.
+func addDigestEvent(group []*digestEntry, e DigestEvent) []*digestEntry {
+	for _, entry := range group {
+		if entry.style == e.Style && entry.text == e.Text {
+			entry.count++
+			if e.Time.Before(entry.first) {
+				entry.first = e.Time
+			}
+			if e.Time.After(entry.last) {
+				entry.last = e.Time
+			}
+			return group
+		}
+	}
+	return append(group, &digestEntry{style: e.Style, text: e.Text, count: 1, first: e.Time, last: e.Time})
+}
+
+// digestRank returns the severity rank of style; lower is more severe.
+func digestRank(style string) int {
+	if rank, ok := digestSeverity[style]; ok {
+		return rank
+	}
+	return len(digestSeverity)
+}
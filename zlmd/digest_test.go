@@ -0,0 +1,99 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDigest_Build(t *testing.T) {
+	start := time.Date(2024, 5, 15, 14, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return start.Add(time.Duration(minutes) * time.Minute)
+	}
+
+	tests := []struct {
+		name     string
+		digest   *Digest
+		expected string
+	}{
+		{
+			name:     "Empty",
+			digest:   NewDigest("Alerts"),
+			expected: "",
+		},
+		{
+			name: "Grouped",
+			digest: NewDigest("Alerts", WithTheme(PlainTheme)).
+				Add(at(0), "api", "warning", "slow response").
+				Add(at(1), "db", "info", "vacuum done").
+				Add(at(2), "api", "danger", "disk full").
+				Add(at(5), "api", "danger", "disk full"),
+			expected: "**Alerts** · 4 events, <time:2024-05-15T14:00:00Z> to <time:2024-05-15T14:05:00Z>\n\n" +
+				"**api**\n" +
+				"- [ERROR] disk full (×2, <time:2024-05-15T14:02:00Z> to <time:2024-05-15T14:05:00Z>)\n" +
+				"- [WARN] slow response (<time:2024-05-15T14:00:00Z>)\n\n" +
+				"**db**\n" +
+				"- [INFO] vacuum done (<time:2024-05-15T14:01:00Z>)\n",
+		},
+		{
+			name: "No topic",
+			digest: NewDigest("Log", WithTheme(PlainTheme)).
+				Add(at(1), "db", "info", "b").
+				Add(at(1), "", "info", "a"),
+			expected: "**Log** · 2 events, <time:2024-05-15T14:01:00Z>\n\n" +
+				"- [INFO] a (<time:2024-05-15T14:01:00Z>)\n\n" +
+				"**db**\n" +
+				"- [INFO] b (<time:2024-05-15T14:01:00Z>)\n",
+		},
+		{
+			name: "Overflow",
+			digest: NewDigest("Alerts", WithTheme(PlainTheme), WithTopN(1)).
+				Add(at(0), "api", "danger", "down").
+				Add(at(1), "api", "info", "retrying").
+				Add(at(2), "api", "info", "retrying").
+				Add(at(3), "db", "info", "ok"),
+			expected: "**Alerts** · 4 events, <time:2024-05-15T14:00:00Z> to <time:2024-05-15T14:03:00Z>\n\n" +
+				"**api**\n" +
+				"- [ERROR] down (<time:2024-05-15T14:00:00Z>)\n\n" +
+				"```spoiler 3 more events\n" +
+				"**api**\n" +
+				"- [INFO] retrying (×2, <time:2024-05-15T14:01:00Z> to <time:2024-05-15T14:02:00Z>)\n\n" +
+				"**db**\n" +
+				"- [INFO] ok (<time:2024-05-15T14:03:00Z>)\n" +
+				"```\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.digest.Build(); got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDigest_Reset(t *testing.T) {
+	d := NewDigest("Alerts").Add(time.Now(), "api", "danger", "down")
+	if d.Len() != 1 {
+		t.Errorf("Len() = %d, want 1", d.Len())
+	}
+	d.Reset()
+	if d.Len() != 0 || d.Build() != "" {
+		t.Errorf("after Reset, Len() = %d and Build() = %q, want an empty digest", d.Len(), d.Build())
+	}
+}
+
+func TestDigest_Portable(t *testing.T) {
+	at := time.Date(2024, 5, 15, 14, 0, 0, 0, time.UTC)
+	got := NewDigest("Alerts", WithTopN(1), WithFlavor(FlavorPortable)).
+		Add(at, "", "danger", "down").
+		Add(at, "", "info", "up").
+		Build()
+	for _, zulipOnly := range []string{"<time:", "```spoiler"} {
+		if strings.Contains(got, zulipOnly) {
+			t.Errorf("portable digest contains %q:\n%s", zulipOnly, got)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// Package emoji holds the names of the emoji Zulip knows as constants, so
+// a mistyped emoji is a compile error instead of a literal ":thumbsup:" in
+// a message.
+//
+// Each constant is the emoji's shortcode, as Zulip renders it in a message:
+//
+//	zlmd.Successf(&sb, "%s deployed", emoji.Rocket) // "✅ :rocket: deployed"
+//
+// Aliases, such as ThumbsUp for :+1:, hold the canonical shortcode, so all
+// the names of an emoji compare equal.
+//
+// The constants are generated from Zulip's emoji_codes.json by gen.go.
+package emoji
+
+//go:generate go run gen.go -out names.go
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Known reports whether Zulip has an emoji named name, aliases included,
+// such as for checking the emoji_name of a reaction before adding it.
+//
+// Parameters:
+//   - name (string): The emoji name, with or without the surrounding colons
+//
+// Returns:
+//   - bool: True if the emoji exists
+//
+// Example:
+//
+//	Known("thumbs_up")  // true
+//	Known(":rocket:")   // true
+//	Known("thumbsup")   // false
+func Known(name string) bool {
+	_, ok := codepoints[strings.Trim(name, ":")]
+	return ok
+}
+
+// Unicode returns the character of an emoji, for destinations that don't
+// render Zulip shortcodes, such as notifications or commit messages.
+//
+// Parameters:
+//   - name (string): The emoji name or constant, with or without the
+//     surrounding colons
+//
+// Returns:
+//   - string: The emoji character, which may be several code points
+//   - bool: False if the emoji doesn't exist
+//
+// Example:
+//
+//	Unicode(ThumbsUp) // "👍", true
+func Unicode(name string) (string, bool) {
+	codepoint, ok := codepoints[strings.Trim(name, ":")]
+	if !ok {
+		return "", false
+	}
+	var sb strings.Builder
+	for _, hex := range strings.Split(codepoint, "-") {
+		r, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return "", false
+		}
+		sb.WriteRune(rune(r))
+	}
+	return sb.String(), true
+}
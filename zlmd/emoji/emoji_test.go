@@ -0,0 +1,94 @@
+package emoji
+
+import "testing"
+
+func TestConstants(t *testing.T) {
+	tests := []struct {
+		name     string
+		constant string
+		expected string
+	}{
+		{"Canonical", Rocket, ":rocket:"},
+		{"Alias", ThumbsUp, ":+1:"},
+		{"Plus sign", Plus1, ":+1:"},
+		{"Minus sign", Minus1, ":-1:"},
+		{"Leading digit", Emoji100, ":100:"},
+		{"Multi-word", WorkingOnIt, ":working_on_it:"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.constant != tt.expected {
+				t.Errorf("constant = %q, want %q", tt.constant, tt.expected)
+			}
+		})
+	}
+}
+
+func TestKnown(t *testing.T) {
+	tests := []struct {
+		name     string
+		emoji    string
+		expected bool
+	}{
+		{"Canonical", "rocket", true},
+		{"Common", "smile", true},
+		{"Alias", "thumbs_up", true},
+		{"Colons", ":rocket:", true},
+		{"Constant", ThumbsUp, true},
+		{"Unknown", "thumbsup", false},
+		{"Empty", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Known(tt.emoji); got != tt.expected {
+				t.Errorf("Known(%q) = %v, want %v", tt.emoji, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		emoji    string
+		expected string
+		ok       bool
+	}{
+		{"Constant", ThumbsUp, "👍", true},
+		{"Alias", "thank_you", "🙏", true},
+		{"Unknown", "thumbsup", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Unicode(tt.emoji)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("Unicode(%q) = %q, %v, want %q, %v", tt.emoji, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+// TestCatalog spot-checks names.go against Zulip's full catalog, so a
+// names.go regenerated from a partial emoji_codes.json fails here.
+func TestCatalog(t *testing.T) {
+	if len(codepoints) < 3000 {
+		t.Errorf("len(codepoints) = %d, want Zulip's full catalog of over 3000 names", len(codepoints))
+	}
+	tests := map[string]string{
+		"smile":         "1f604",
+		"+1":            "1f44d",
+		"thumbs_up":     "1f44d",
+		"octopus":       "1f419",
+		"working_on_it": "1f6e0",
+		"flag_türkiye":  "1f1f9-1f1f7",
+		"ten":           "1f51f",
+	}
+	for name, codepoint := range tests {
+		if got := codepoints[name]; got != codepoint {
+			t.Errorf("codepoints[%q] = %q, want %q", name, got, codepoint)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+//go:build ignore
+
+// gen.go generates names.go from Zulip's emoji_codes.json, which maps each
+// emoji name, aliases included, to its code point.
+//
+// Usage:
+//
+//	go run gen.go -in emoji_codes.json -out names.go
+//
+// The input is the file Zulip builds at static/generated/emoji/emoji_codes.json;
+// -in may also be an http(s) URL to download it from. The generator itself
+// lives in internal/emojigen.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd/emoji/internal/emojigen"
+)
+
+func main() {
+	in := flag.String("in", "https://raw.githubusercontent.com/zulip/zulip/main/static/generated/emoji/emoji_codes.json", "emoji_codes.json path or URL")
+	out := flag.String("out", "names.go", "output file")
+	flag.Parse()
+
+	data, err := read(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	codes, err := emojigen.Parse(data)
+	if err != nil {
+		log.Fatalf("parse %s: %v", *in, err)
+	}
+	src, err := emojigen.Generate(codes, source(*in))
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// read returns the contents of the file or URL in.
+func read(in string) ([]byte, error) {
+	if !strings.HasPrefix(in, "http://") && !strings.HasPrefix(in, "https://") {
+		return os.ReadFile(in)
+	}
+	resp, err := http.Get(in)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get %s: %s", in, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// source names in in the generated header, without local directories.
+func source(in string) string {
+	if strings.Contains(in, "://") {
+		return in
+	}
+	return filepath.Base(in)
+}
@@ -0,0 +1,121 @@
+// Package emojigen generates the emoji package's names.go from Zulip's
+// emoji_codes.json. It is separate from gen.go so the generator can be
+// tested against a small fixture.
+package emojigen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Codes is the part of emoji_codes.json the generator reads.
+type Codes struct {
+	CodepointToName map[string]string `json:"codepoint_to_name"`
+	NameToCodepoint map[string]string `json:"name_to_codepoint"`
+}
+
+// Parse decodes the contents of emoji_codes.json.
+//
+// Returns:
+//   - Codes: The name maps
+//   - error: Non-nil if data isn't valid JSON or has no names
+func Parse(data []byte) (Codes, error) {
+	var codes Codes
+	if err := json.Unmarshal(data, &codes); err != nil {
+		return Codes{}, err
+	}
+	if len(codes.NameToCodepoint) == 0 {
+		return Codes{}, fmt.Errorf("no name_to_codepoint entries")
+	}
+	return codes, nil
+}
+
+// Generate returns the Go source of names.go.
+//
+// Parameters:
+//   - codes (Codes): The emoji names
+//   - from (string): The input named in the generated header
+//
+// Returns:
+//   - []byte: The formatted source
+//   - error: Non-nil if a name's code point has no canonical name
+//
+// Notes:
+//   - A name whose identifier is already taken by an earlier name, in
+//     name order, is logged and gets no constant; it is still Known
+func Generate(codes Codes, from string) ([]byte, error) {
+	names := make([]string, 0, len(codes.NameToCodepoint))
+	for name := range codes.NameToCodepoint {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from %s; DO NOT EDIT.\n\n", from)
+	buf.WriteString("package emoji\n\n")
+	buf.WriteString("// Emoji shortcodes, in name order. Aliases have the value of the emoji's\n")
+	buf.WriteString("// canonical name, so equal emoji compare equal.\n")
+	buf.WriteString("const (\n")
+	seen := map[string]string{}
+	for _, name := range names {
+		codepoint := codes.NameToCodepoint[name]
+		canonical, ok := codes.CodepointToName[codepoint]
+		if !ok {
+			return nil, fmt.Errorf("%s: code point %s has no canonical name", name, codepoint)
+		}
+		ident := Identifier(name)
+		if other, ok := seen[ident]; ok {
+			log.Printf("skipping %s: %s is already the constant of %s", name, ident, other)
+			continue
+		}
+		seen[ident] = name
+		if name == canonical {
+			fmt.Fprintf(&buf, "\t// %s is :%s:.\n", ident, name)
+		} else {
+			fmt.Fprintf(&buf, "\t// %s is :%s:, an alias of :%s:.\n", ident, name, canonical)
+		}
+		fmt.Fprintf(&buf, "\t%s = %q\n", ident, ":"+canonical+":")
+	}
+	buf.WriteString(")\n\n")
+
+	buf.WriteString("// codepoints maps each emoji name, aliases included, to its code points.\n")
+	buf.WriteString("var codepoints = map[string]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: %q,\n", name, codes.NameToCodepoint[name])
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// Identifier turns an emoji name such as "thumbs_up" into a Go identifier
+// such as "ThumbsUp"; "+1" becomes "Plus1", and names starting with a digit
+// are prefixed with "Emoji". Letters outside ASCII, as in "piñata", are
+// kept; Go allows them in identifiers.
+func Identifier(name string) string {
+	switch {
+	case strings.HasPrefix(name, "+"):
+		name = "plus_" + name[1:]
+	case strings.HasPrefix(name, "-"):
+		name = "minus_" + name[1:]
+	}
+	var sb strings.Builder
+	for _, word := range strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		first, size := utf8.DecodeRuneInString(word)
+		sb.WriteRune(unicode.ToUpper(first))
+		sb.WriteString(word[size:])
+	}
+	ident := sb.String()
+	if first, _ := utf8.DecodeRuneInString(ident); !unicode.IsLetter(first) {
+		ident = "Emoji" + ident
+	}
+	return ident
+}
@@ -0,0 +1,87 @@
+package emojigen
+
+import (
+	"os"
+	"strings"
+	"testing"
+)
+
+func loadFixture(t *testing.T) Codes {
+	t.Helper()
+	data, err := os.ReadFile("testdata/emoji_codes.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	codes, err := Parse(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return codes
+}
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate(loadFixture(t), "emoji_codes.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(src)
+
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"Header", "// Code generated by gen.go from emoji_codes.json; DO NOT EDIT.\n"},
+		{"Canonical", "// Rocket is :rocket:.\n\tRocket = \":rocket:\"\n"},
+		{"Alias", "// ThumbsUp is :thumbs_up:, an alias of :+1:.\n\tThumbsUp = \":+1:\"\n"},
+		{"Plus sign", "Plus1 = \":+1:\""},
+		{"Leading digit", "Emoji100 = \":100:\""},
+		{"Code points", "var codepoints = map[string]string{\n\t\"+1\":"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(out, tt.expected) {
+				t.Errorf("Generate() output doesn't contain %q:\n%s", tt.expected, out)
+			}
+		})
+	}
+}
+
+func TestGenerate_MissingCanonical(t *testing.T) {
+	codes := Codes{
+		CodepointToName: map[string]string{},
+		NameToCodepoint: map[string]string{"rocket": "1f680"},
+	}
+	if _, err := Generate(codes, "emoji_codes.json"); err == nil {
+		t.Error("Generate() error = nil, want an error for a code point without a canonical name")
+	}
+}
+
+func TestParse_Empty(t *testing.T) {
+	if _, err := Parse([]byte(`{}`)); err == nil {
+		t.Error("Parse({}) error = nil, want an error")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected string
+	}{
+		{"rocket", "Rocket"},
+		{"thumbs_up", "ThumbsUp"},
+		{"+1", "Plus1"},
+		{"-1", "Minus1"},
+		{"100", "Emoji100"},
+		{"piñata", "Piñata"},
+		{"flag_åland_islands", "FlagÅlandIslands"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Identifier(tt.name); got != tt.expected {
+				t.Errorf("Identifier(%q) = %q, want %q", tt.name, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,10029 @@
+// Code generated by gen.go from emoji_codes.json; DO NOT EDIT.
+
+package emoji
+
+// Emoji shortcodes, in name order. Aliases have the value of the emoji's
+// canonical name, so equal emoji compare equal.
+const (
+	// Plus1 is :+1:.
+	Plus1 = ":+1:"
+	// Minus1 is :-1:.
+	Minus1 = ":-1:"
+	// Emoji100 is :100:.
+	Emoji100 = ":100:"
+	// Emoji1234 is :1234:.
+	Emoji1234 = ":1234:"
+	// Emoji3 is :3:, an alias of :smiling_face_with_hearts:.
+	Emoji3 = ":smiling_face_with_hearts:"
+	// Emoji8Ball is :8_ball:, an alias of :billiards:.
+	Emoji8Ball = ":billiards:"
+	// A is :a:.
+	A = ":a:"
+	// Ab is :ab:.
+	Ab = ":ab:"
+	// Abacus is :abacus:.
+	Abacus = ":abacus:"
+	// Abc is :abc:.
+	Abc = ":abc:"
+	// Abcd is :abcd:.
+	Abcd = ":abcd:"
+	// Absentminded is :absentminded:, an alias of :face_in_clouds:.
+	Absentminded = ":face_in_clouds:"
+	// Absorbing is :absorbing:, an alias of :sponge:.
+	Absorbing = ":sponge:"
+	// Accept is :accept:, an alias of :japanese_acceptable_button:.
+	Accept = ":japanese_acceptable_button:"
+	// Accessible is :accessible:.
+	Accessible = ":accessible:"
+	// Accident is :accident:, an alias of :pouring_liquid:.
+	Accident = ":pouring_liquid:"
+	// Accommodations is :accommodations:, an alias of :in_bed:.
+	Accommodations = ":in_bed:"
+	// Accordion is :accordion:.
+	Accordion = ":accordion:"
+	// Accounting is :accounting:, an alias of :receipt:.
+	Accounting = ":receipt:"
+	// Acidity is :acidity:, an alias of :lime:.
+	Acidity = ":lime:"
+	// Acrobatics is :acrobatics:, an alias of :cartwheel:.
+	Acrobatics = ":cartwheel:"
+	// Action is :action:.
+	Action = ":action:"
+	// Add is :add:, an alias of :plus:.
+	Add = ":plus:"
+	// Adhesive is :adhesive:, an alias of :adhesive_bandage:.
+	Adhesive = ":adhesive_bandage:"
+	// AdhesiveBandage is :adhesive_bandage:.
+	AdhesiveBandage = ":adhesive_bandage:"
+	// Admiration is :admiration:, an alias of :face_holding_back_tears:.
+	Admiration = ":face_holding_back_tears:"
+	// Adorable is :adorable:, an alias of :pink_heart:.
+	Adorable = ":pink_heart:"
+	// Adore is :adore:, an alias of :smiling_face_with_hearts:.
+	Adore = ":smiling_face_with_hearts:"
+	// AerialTramway is :aerial_tramway:.
+	AerialTramway = ":aerial_tramway:"
+	// Aesculapius is :aesculapius:, an alias of :medical_symbol:.
+	Aesculapius = ":medical_symbol:"
+	// Afro is :afro:, an alias of :hair_pick:.
+	Afro = ":hair_pick:"
+	// Agent is :agent:, an alias of :detective:.
+	Agent = ":detective:"
+	// Agony is :agony:, an alias of :confounded:.
+	Agony = ":confounded:"
+	// Air is :air:, an alias of :window:.
+	Air = ":window:"
+	// Airplane is :airplane:.
+	Airplane = ":airplane:"
+	// AirplaneArrival is :airplane_arrival:, an alias of :landing:.
+	AirplaneArrival = ":landing:"
+	// AirplaneDeparture is :airplane_departure:, an alias of :take_off:.
+	AirplaneDeparture = ":take_off:"
+	// Alarm is :alarm:, an alias of :rooster:.
+	Alarm = ":rooster:"
+	// AlarmClock is :alarm_clock:.
+	AlarmClock = ":alarm_clock:"
+	// Alces is :alces:, an alias of :moose:.
+	Alces = ":moose:"
+	// Alchemy is :alchemy:.
+	Alchemy = ":alchemy:"
+	// Alembic is :alembic:, an alias of :alchemy:.
+	Alembic = ":alchemy:"
+	// Alert is :alert:, an alias of :siren:.
+	Alert = ":siren:"
+	// Alien is :alien:.
+	Alien = ":alien:"
+	// Aliens is :aliens:, an alias of :flying_saucer:.
+	Aliens = ":flying_saucer:"
+	// AllGood is :all_good:, an alias of :check:.
+	AllGood = ":check:"
+	// Alpaca is :alpaca:, an alias of :llama:.
+	Alpaca = ":llama:"
+	// Alphabet is :alphabet:, an alias of :abcd:.
+	Alphabet = ":abcd:"
+	// Amazement is :amazement:, an alias of :face_with_open_eyes_and_hand_over_mouth:.
+	Amazement = ":face_with_open_eyes_and_hand_over_mouth:"
+	// Ambulance is :ambulance:.
+	Ambulance = ":ambulance:"
+	// AmericanFootball is :american_football:.
+	AmericanFootball = ":american_football:"
+	// Amoeba is :amoeba:, an alias of :microbe:.
+	Amoeba = ":microbe:"
+	// Amount is :amount:, an alias of :pinching_hand:.
+	Amount = ":pinching_hand:"
+	// Amphora is :amphora:, an alias of :vase:.
+	Amphora = ":vase:"
+	// Amusement is :amusement:, an alias of :playground_slide:.
+	Amusement = ":playground_slide:"
+	// Anatomical is :anatomical:, an alias of :anatomical_heart:.
+	Anatomical = ":anatomical_heart:"
+	// AnatomicalHeart is :anatomical_heart:.
+	AnatomicalHeart = ":anatomical_heart:"
+	// Anchor is :anchor:.
+	Anchor = ":anchor:"
+	// Android is :android:, an alias of :mobile_phone:.
+	Android = ":mobile_phone:"
+	// Angel is :angel:.
+	Angel = ":angel:"
+	// Angelic is :angelic:, an alias of :wing:.
+	Angelic = ":wing:"
+	// Anger is :anger:.
+	Anger = ":anger:"
+	// AngerBubble is :anger_bubble:.
+	AngerBubble = ":anger_bubble:"
+	// Angry is :angry:.
+	Angry = ":angry:"
+	// AngryCat is :angry_cat:.
+	AngryCat = ":angry_cat:"
+	// AngryDevil is :angry_devil:, an alias of :devil:.
+	AngryDevil = ":devil:"
+	// Anguish is :anguish:.
+	Anguish = ":anguish:"
+	// Anguished is :anguished:.
+	Anguished = ":anguished:"
+	// Ankle is :ankle:, an alias of :foot:.
+	Ankle = ":foot:"
+	// Annelid is :annelid:, an alias of :worm:.
+	Annelid = ":worm:"
+	// Answer is :answer:, an alias of :heavy_equals_sign:.
+	Answer = ":heavy_equals_sign:"
+	// Ant is :ant:.
+	Ant = ":ant:"
+	// Antlers is :antlers:, an alias of :moose:.
+	Antlers = ":moose:"
+	// Anxious is :anxious:, an alias of :grimacing:.
+	Anxious = ":grimacing:"
+	// Ape is :ape:, an alias of :orangutan:.
+	Ape = ":orangutan:"
+	// Applause is :applause:, an alias of :clap:.
+	Applause = ":clap:"
+	// Apple is :apple:.
+	Apple = ":apple:"
+	// Approved is :approved:, an alias of :check:.
+	Approved = ":check:"
+	// Aquarium is :aquarium:, an alias of :jellyfish:.
+	Aquarium = ":jellyfish:"
+	// Aquarius is :aquarius:.
+	Aquarius = ":aquarius:"
+	// ArabianCamel is :arabian_camel:.
+	ArabianCamel = ":arabian_camel:"
+	// Arcade is :arcade:, an alias of :joystick:.
+	Arcade = ":joystick:"
+	// Archery is :archery:, an alias of :bow_and_arrow:.
+	Archery = ":bow_and_arrow:"
+	// Archive is :archive:.
+	Archive = ":archive:"
+	// Arctic is :arctic:, an alias of :polar_bear:.
+	Arctic = ":polar_bear:"
+	// Arepa is :arepa:, an alias of :flatbread:.
+	Arepa = ":flatbread:"
+	// Aries is :aries:.
+	Aries = ":aries:"
+	// Arm is :arm:, an alias of :mechanical_arm:.
+	Arm = ":mechanical_arm:"
+	// ArmsOpen is :arms_open:, an alias of :hug:.
+	ArmsOpen = ":hug:"
+	// Army is :army:, an alias of :military_helmet:.
+	Army = ":military_helmet:"
+	// Arrival is :arrival:, an alias of :landing:.
+	Arrival = ":landing:"
+	// Art is :art:.
+	Art = ":art:"
+	// Artist is :artist:.
+	Artist = ":artist:"
+	// Ascension is :ascension:, an alias of :phoenix:.
+	Ascension = ":phoenix:"
+	// AskingAQuestion is :asking_a_question:, an alias of :wait_one_second:.
+	AskingAQuestion = ":wait_one_second:"
+	// Ass is :ass:, an alias of :donkey:.
+	Ass = ":donkey:"
+	// Assassin is :assassin:, an alias of :ninja:.
+	Assassin = ":ninja:"
+	// Assistance is :assistance:, an alias of :service_dog:.
+	Assistance = ":service_dog:"
+	// Asterisk is :asterisk:.
+	Asterisk = ":asterisk:"
+	// Astonished is :astonished:.
+	Astonished = ":astonished:"
+	// Astronaut is :astronaut:.
+	Astronaut = ":astronaut:"
+	// At is :at:, an alias of :index_pointing_at_the_viewer:.
+	At = ":index_pointing_at_the_viewer:"
+	// AtWork is :at_work:.
+	AtWork = ":at_work:"
+	// AthleticShoe is :athletic_shoe:.
+	AthleticShoe = ":athletic_shoe:"
+	// Atm is :atm:.
+	Atm = ":atm:"
+	// Atom is :atom:.
+	Atom = ":atom:"
+	// Attachment is :attachment:, an alias of :paperclip:.
+	Attachment = ":paperclip:"
+	// Attraction is :attraction:, an alias of :magnet:.
+	Attraction = ":magnet:"
+	// Auto is :auto:, an alias of :auto_rickshaw:.
+	Auto = ":auto_rickshaw:"
+	// AutoRickshaw is :auto_rickshaw:.
+	AutoRickshaw = ":auto_rickshaw:"
+	// Automobile is :automobile:, an alias of :pickup_truck:.
+	Automobile = ":pickup_truck:"
+	// Autumn is :autumn:, an alias of :moon_cake:.
+	Autumn = ":moon_cake:"
+	// Aviation is :aviation:, an alias of :wing:.
+	Aviation = ":wing:"
+	// Avocado is :avocado:.
+	Avocado = ":avocado:"
+	// Awe is :awe:, an alias of :face_with_open_eyes_and_hand_over_mouth:.
+	Awe = ":face_with_open_eyes_and_hand_over_mouth:"
+	// Aww is :aww:, an alias of :face_holding_back_tears:.
+	Aww = ":face_holding_back_tears:"
+	// Ax is :ax:, an alias of :axe:.
+	Ax = ":axe:"
+	// Axe is :axe:.
+	Axe = ":axe:"
+	// B is :b:.
+	B = ":b:"
+	// Baa is :baa:, an alias of :sheep:.
+	Baa = ":sheep:"
+	// Babooshka is :babooshka:, an alias of :nesting_dolls:.
+	Babooshka = ":nesting_dolls:"
+	// Baboushka is :baboushka:, an alias of :nesting_dolls:.
+	Baboushka = ":nesting_dolls:"
+	// Babushka is :babushka:, an alias of :nesting_dolls:.
+	Babushka = ":nesting_dolls:"
+	// Baby is :baby:.
+	Baby = ":baby:"
+	// BabyBottle is :baby_bottle:.
+	BabyBottle = ":baby_bottle:"
+	// BabyChangeStation is :baby_change_station:.
+	BabyChangeStation = ":baby_change_station:"
+	// BabyChick is :baby_chick:, an alias of :chick:.
+	BabyChick = ":chick:"
+	// Back is :back:.
+	Back = ":back:"
+	// Backpack is :backpack:.
+	Backpack = ":backpack:"
+	// Backpacking is :backpacking:, an alias of :hiking_boot:.
+	Backpacking = ":hiking_boot:"
+	// Bacon is :bacon:.
+	Bacon = ":bacon:"
+	// Badger is :badger:.
+	Badger = ":badger:"
+	// Badminton is :badminton:.
+	Badminton = ":badminton:"
+	// Bag is :bag:, an alias of :luggage:.
+	Bag = ":luggage:"
+	// Bagel is :bagel:.
+	Bagel = ":bagel:"
+	// BaggageClaim is :baggage_claim:.
+	BaggageClaim = ":baggage_claim:"
+	// Bags is :bags:, an alias of :face_with_bags_under_eyes:.
+	Bags = ":face_with_bags_under_eyes:"
+	// Baguette is :baguette:.
+	Baguette = ":baguette:"
+	// Bait is :bait:, an alias of :mouse_trap:.
+	Bait = ":mouse_trap:"
+	// Balance is :balance:, an alias of :justice:.
+	Balance = ":justice:"
+	// Ball is :ball:.
+	Ball = ":ball:"
+	// BalletShoes is :ballet_shoes:.
+	BalletShoes = ":ballet_shoes:"
+	// Balloon is :balloon:.
+	Balloon = ":balloon:"
+	// BallotBox is :ballot_box:.
+	BallotBox = ":ballot_box:"
+	// BallpointPen is :ballpoint_pen:, an alias of :pen:.
+	BallpointPen = ":pen:"
+	// Bam is :bam:, an alias of :anger:.
+	Bam = ":anger:"
+	// Bamboo is :bamboo:.
+	Bamboo = ":bamboo:"
+	// Banana is :banana:.
+	Banana = ":banana:"
+	// Band is :band:, an alias of :flute:.
+	Band = ":flute:"
+	// Bandage is :bandage:, an alias of :adhesive_bandage:.
+	Bandage = ":adhesive_bandage:"
+	// Bandana is :bandana:, an alias of :woman_with_headscarf:.
+	Bandana = ":woman_with_headscarf:"
+	// Bangbang is :bangbang:.
+	Bangbang = ":bangbang:"
+	// Banjo is :banjo:.
+	Banjo = ":banjo:"
+	// Bank is :bank:.
+	Bank = ":bank:"
+	// Bar is :bar:, an alias of :soap:.
+	Bar = ":soap:"
+	// BarChart is :bar_chart:.
+	BarChart = ":bar_chart:"
+	// Barber is :barber:.
+	Barber = ":barber:"
+	// Bare is :bare:, an alias of :leafless_tree:.
+	Bare = ":leafless_tree:"
+	// Barf is :barf:, an alias of :face_vomiting:.
+	Barf = ":face_vomiting:"
+	// Barren is :barren:, an alias of :leafless_tree:.
+	Barren = ":leafless_tree:"
+	// Baseball is :baseball:.
+	Baseball = ":baseball:"
+	// Basket is :basket:.
+	Basket = ":basket:"
+	// Basketball is :basketball:.
+	Basketball = ":basketball:"
+	// Bat is :bat:.
+	Bat = ":bat:"
+	// Bath is :bath:.
+	Bath = ":bath:"
+	// Bathroom is :bathroom:, an alias of :toothbrush:.
+	Bathroom = ":toothbrush:"
+	// Bathtub is :bathtub:.
+	Bathtub = ":bathtub:"
+	// Battery is :battery:.
+	Battery = ":battery:"
+	// Bday is :bday:, an alias of :partying_face:.
+	Bday = ":partying_face:"
+	// Beach is :beach:.
+	Beach = ":beach:"
+	// BeachUmbrella is :beach_umbrella:.
+	BeachUmbrella = ":beach_umbrella:"
+	// Bead is :bead:, an alias of :nazar_amulet:.
+	Bead = ":nazar_amulet:"
+	// Beak is :beak:, an alias of :black_bird:.
+	Beak = ":black_bird:"
+	// Beans is :beans:.
+	Beans = ":beans:"
+	// Beanstalk is :beanstalk:, an alias of :pea_pod:.
+	Beanstalk = ":pea_pod:"
+	// Bear is :bear:.
+	Bear = ":bear:"
+	// Beaver is :beaver:.
+	Beaver = ":beaver:"
+	// Beckon is :beckon:, an alias of :palm_up_hand:.
+	Beckon = ":palm_up_hand:"
+	// Bed is :bed:.
+	Bed = ":bed:"
+	// Bedroom is :bedroom:, an alias of :bed:.
+	Bedroom = ":bed:"
+	// Bedtime is :bedtime:, an alias of :yawning_face:.
+	Bedtime = ":yawning_face:"
+	// Bee is :bee:.
+	Bee = ":bee:"
+	// Beer is :beer:.
+	Beer = ":beer:"
+	// Beers is :beers:.
+	Beers = ":beers:"
+	// Beet is :beet:, an alias of :root_vegetable:.
+	Beet = ":root_vegetable:"
+	// Beetle is :beetle:.
+	Beetle = ":beetle:"
+	// Begging is :begging:, an alias of :pleading_face:.
+	Begging = ":pleading_face:"
+	// Beginner is :beginner:.
+	Beginner = ":beginner:"
+	// Bell is :bell:, an alias of :notifications:.
+	Bell = ":notifications:"
+	// BellPepper is :bell_pepper:.
+	BellPepper = ":bell_pepper:"
+	// BellhopBell is :bellhop_bell:.
+	BellhopBell = ":bellhop_bell:"
+	// Bento is :bento:.
+	Bento = ":bento:"
+	// Berries is :berries:, an alias of :blueberries:.
+	Berries = ":blueberries:"
+	// Berry is :berry:, an alias of :blueberries:.
+	Berry = ":blueberries:"
+	// Betrayed is :betrayed:, an alias of :knife:.
+	Betrayed = ":knife:"
+	// Beverage is :beverage:, an alias of :beverage_box:.
+	Beverage = ":beverage_box:"
+	// BeverageBox is :beverage_box:.
+	BeverageBox = ":beverage_box:"
+	// Bicycle is :bicycle:, an alias of :bike:.
+	Bicycle = ":bike:"
+	// Big is :big:, an alias of :pleading_face:.
+	Big = ":pleading_face:"
+	// BigFrown is :big_frown:, an alias of :sad:.
+	BigFrown = ":sad:"
+	// BigRig is :big_rig:, an alias of :truck:.
+	BigRig = ":truck:"
+	// Bike is :bike:.
+	Bike = ":bike:"
+	// Bikini is :bikini:.
+	Bikini = ":bikini:"
+	// Bilberry is :bilberry:, an alias of :blueberries:.
+	Bilberry = ":blueberries:"
+	// Billed is :billed:, an alias of :billed_cap:.
+	Billed = ":billed_cap:"
+	// BilledCap is :billed_cap:.
+	BilledCap = ":billed_cap:"
+	// Billiards is :billiards:.
+	Billiards = ":billiards:"
+	// Biohazard is :biohazard:.
+	Biohazard = ":biohazard:"
+	// Biology is :biology:, an alias of :petri_dish:.
+	Biology = ":petri_dish:"
+	// Bird is :bird:.
+	Bird = ":bird:"
+	// Birthday is :birthday:.
+	Birthday = ":birthday:"
+	// Bisexual is :bisexual:, an alias of :rainbow_flag:.
+	Bisexual = ":rainbow_flag:"
+	// Bison is :bison:.
+	Bison = ":bison:"
+	// Bisque is :bisque:, an alias of :lobster:.
+	Bisque = ":lobster:"
+	// Bit is :bit:, an alias of :pinching_hand:.
+	Bit = ":pinching_hand:"
+	// Biting is :biting:, an alias of :biting_lip:.
+	Biting = ":biting_lip:"
+	// BitingLip is :biting_lip:.
+	BitingLip = ":biting_lip:"
+	// BlackAndWhiteSquare is :black_and_white_square:.
+	BlackAndWhiteSquare = ":black_and_white_square:"
+	// BlackBelt is :black_belt:.
+	BlackBelt = ":black_belt:"
+	// BlackBird is :black_bird:.
+	BlackBird = ":black_bird:"
+	// BlackCat is :black_cat:.
+	BlackCat = ":black_cat:"
+	// BlackCircle is :black_circle:.
+	BlackCircle = ":black_circle:"
+	// BlackFlag is :black_flag:.
+	BlackFlag = ":black_flag:"
+	// BlackHeart is :black_heart:.
+	BlackHeart = ":black_heart:"
+	// BlackLargeSquare is :black_large_square:.
+	BlackLargeSquare = ":black_large_square:"
+	// BlackMediumSmallSquare is :black_medium_small_square:.
+	BlackMediumSmallSquare = ":black_medium_small_square:"
+	// BlackMediumSquare is :black_medium_square:.
+	BlackMediumSquare = ":black_medium_square:"
+	// BlackNib is :black_nib:.
+	BlackNib = ":black_nib:"
+	// BlackSmallSquare is :black_small_square:.
+	BlackSmallSquare = ":black_small_square:"
+	// Blades is :blades:, an alias of :roller_skate:.
+	Blades = ":roller_skate:"
+	// Blank is :blank:, an alias of :speechless:.
+	Blank = ":speechless:"
+	// Bleed is :bleed:, an alias of :drop_of_blood:.
+	Bleed = ":drop_of_blood:"
+	// Blonde is :blonde:, an alias of :woman_blond_hair:.
+	Blonde = ":woman_blond_hair:"
+	// Bloom is :bloom:, an alias of :hyacinth:.
+	Bloom = ":hyacinth:"
+	// Blossom is :blossom:.
+	Blossom = ":blossom:"
+	// Blow is :blow:, an alias of :face_exhaling:.
+	Blow = ":face_exhaling:"
+	// BlowAKiss is :blow_a_kiss:, an alias of :heart_kiss:.
+	BlowAKiss = ":heart_kiss:"
+	// Blowfish is :blowfish:.
+	Blowfish = ":blowfish:"
+	// Blowing is :blowing:, an alias of :face_exhaling:.
+	Blowing = ":face_exhaling:"
+	// Blown is :blown:, an alias of :exploding_head:.
+	Blown = ":exploding_head:"
+	// BlueBook is :blue_book:.
+	BlueBook = ":blue_book:"
+	// BlueCircle is :blue_circle:.
+	BlueCircle = ":blue_circle:"
+	// BlueFaced is :blue_faced:, an alias of :cold_face:.
+	BlueFaced = ":cold_face:"
+	// BlueHeart is :blue_heart:.
+	BlueHeart = ":blue_heart:"
+	// BlueSquare is :blue_square:.
+	BlueSquare = ":blue_square:"
+	// Blueberries is :blueberries:.
+	Blueberries = ":blueberries:"
+	// Blueberry is :blueberry:, an alias of :blueberries:.
+	Blueberry = ":blueberries:"
+	// Bluebonnet is :bluebonnet:, an alias of :hyacinth:.
+	Bluebonnet = ":hyacinth:"
+	// Blush is :blush:.
+	Blush = ":blush:"
+	// Blushing is :blushing:, an alias of :flushed:.
+	Blushing = ":flushed:"
+	// Boar is :boar:.
+	Boar = ":boar:"
+	// Board is :board:, an alias of :skateboard:.
+	Board = ":skateboard:"
+	// Boat is :boat:.
+	Boat = ":boat:"
+	// Boba is :boba:, an alias of :bubble_tea:.
+	Boba = ":bubble_tea:"
+	// Bok is :bok:, an alias of :leafy_green:.
+	Bok = ":leafy_green:"
+	// Bomb is :bomb:.
+	Bomb = ":bomb:"
+	// Bone is :bone:.
+	Bone = ":bone:"
+	// Boo is :boo:, an alias of :ghost:.
+	Boo = ":ghost:"
+	// Book is :book:.
+	Book = ":book:"
+	// Bookkeeping is :bookkeeping:, an alias of :receipt:.
+	Bookkeeping = ":receipt:"
+	// Bookmark is :bookmark:.
+	Bookmark = ":bookmark:"
+	// Books is :books:.
+	Books = ":books:"
+	// Boom is :boom:.
+	Boom = ":boom:"
+	// Boomerang is :boomerang:.
+	Boomerang = ":boomerang:"
+	// Boot is :boot:.
+	Boot = ":boot:"
+	// Bottle is :bottle:, an alias of :lotion_bottle:.
+	Bottle = ":lotion_bottle:"
+	// Boulder is :boulder:, an alias of :rock:.
+	Boulder = ":rock:"
+	// Bouquet is :bouquet:.
+	Bouquet = ":bouquet:"
+	// Bow is :bow:.
+	Bow = ":bow:"
+	// BowAndArrow is :bow_and_arrow:.
+	BowAndArrow = ":bow_and_arrow:"
+	// Bowl is :bowl:, an alias of :bowl_with_spoon:.
+	Bowl = ":bowl_with_spoon:"
+	// BowlWithSpoon is :bowl_with_spoon:.
+	BowlWithSpoon = ":bowl_with_spoon:"
+	// Bowling is :bowling:, an alias of :strike:.
+	Bowling = ":strike:"
+	// BoxingGlove is :boxing_glove:.
+	BoxingGlove = ":boxing_glove:"
+	// Boy is :boy:.
+	Boy = ":boy:"
+	// Brachiosaurus is :brachiosaurus:, an alias of :sauropod:.
+	Brachiosaurus = ":sauropod:"
+	// Brain is :brain:.
+	Brain = ":brain:"
+	// Branches is :branches:, an alias of :leafless_tree:.
+	Branches = ":leafless_tree:"
+	// Bravery is :bravery:, an alias of :purple_heart:.
+	Bravery = ":purple_heart:"
+	// Bread is :bread:.
+	Bread = ":bread:"
+	// Break is :break:, an alias of :broken_chain:.
+	Break = ":broken_chain:"
+	// Breakfast is :breakfast:, an alias of :pancakes:.
+	Breakfast = ":pancakes:"
+	// Breaking is :breaking:, an alias of :broken_chain:.
+	Breaking = ":broken_chain:"
+	// Breast is :breast:, an alias of :breast_feeding:.
+	Breast = ":breast_feeding:"
+	// BreastFeeding is :breast_feeding:.
+	BreastFeeding = ":breast_feeding:"
+	// Breath is :breath:, an alias of :lungs:.
+	Breath = ":lungs:"
+	// Breathe is :breathe:, an alias of :lungs:.
+	Breathe = ":lungs:"
+	// Brew is :brew:, an alias of :teapot:.
+	Brew = ":teapot:"
+	// Brick is :brick:.
+	Brick = ":brick:"
+	// Bricks is :bricks:, an alias of :brick:.
+	Bricks = ":brick:"
+	// Bride is :bride:.
+	Bride = ":bride:"
+	// Bridge is :bridge:.
+	Bridge = ":bridge:"
+	// Briefcase is :briefcase:.
+	Briefcase = ":briefcase:"
+	// Briefs is :briefs:.
+	Briefs = ":briefs:"
+	// BrightEyed is :bright_eyed:, an alias of :child:.
+	BrightEyed = ":child:"
+	// Brightness is :brightness:.
+	Brightness = ":brightness:"
+	// Broadband is :broadband:, an alias of :wireless:.
+	Broadband = ":wireless:"
+	// Broccoli is :broccoli:.
+	Broccoli = ":broccoli:"
+	// Broken is :broken:, an alias of :broken_chain:.
+	Broken = ":broken_chain:"
+	// BrokenChain is :broken_chain:.
+	BrokenChain = ":broken_chain:"
+	// BrokenHeart is :broken_heart:.
+	BrokenHeart = ":broken_heart:"
+	// Brontosaurus is :brontosaurus:, an alias of :sauropod:.
+	Brontosaurus = ":sauropod:"
+	// Bronze is :bronze:, an alias of :third_place:.
+	Bronze = ":third_place:"
+	// Broom is :broom:.
+	Broom = ":broom:"
+	// BrownCircle is :brown_circle:.
+	BrownCircle = ":brown_circle:"
+	// BrownHeart is :brown_heart:.
+	BrownHeart = ":brown_heart:"
+	// BrownMushroom is :brown_mushroom:.
+	BrownMushroom = ":brown_mushroom:"
+	// BrownSquare is :brown_square:.
+	BrownSquare = ":brown_square:"
+	// Brr is :brr:, an alias of :coat:.
+	Brr = ":coat:"
+	// Brush is :brush:, an alias of :toothbrush:.
+	Brush = ":toothbrush:"
+	// BubbleTea is :bubble_tea:.
+	BubbleTea = ":bubble_tea:"
+	// Bubbles is :bubbles:.
+	Bubbles = ":bubbles:"
+	// Bucket is :bucket:.
+	Bucket = ":bucket:"
+	// Buddhism is :buddhism:, an alias of :wheel_of_dharma:.
+	Buddhism = ":wheel_of_dharma:"
+	// Buffalo is :buffalo:, an alias of :bison:.
+	Buffalo = ":bison:"
+	// Bug is :bug:.
+	Bug = ":bug:"
+	// Bulb is :bulb:, an alias of :light_bulb:.
+	Bulb = ":light_bulb:"
+	// Bull is :bull:, an alias of :ox:.
+	Bull = ":ox:"
+	// BulletTrain is :bullet_train:.
+	BulletTrain = ":bullet_train:"
+	// Bullhorn is :bullhorn:, an alias of :loudspeaker:.
+	Bullhorn = ":loudspeaker:"
+	// BullsEye is :bulls_eye:, an alias of :direct_hit:.
+	BullsEye = ":direct_hit:"
+	// Bunny is :bunny:.
+	Bunny = ":bunny:"
+	// Buoy is :buoy:, an alias of :ring_buoy:.
+	Buoy = ":ring_buoy:"
+	// Burgers is :burgers:, an alias of :leafy_green:.
+	Burgers = ":leafy_green:"
+	// Burial is :burial:, an alias of :coffin:.
+	Burial = ":coffin:"
+	// Burp is :burp:, an alias of :bubbles:.
+	Burp = ":bubbles:"
+	// Burrito is :burrito:.
+	Burrito = ":burrito:"
+	// Burro is :burro:, an alias of :donkey:.
+	Burro = ":donkey:"
+	// Bury is :bury:, an alias of :shovel:.
+	Bury = ":shovel:"
+	// Bus is :bus:.
+	Bus = ":bus:"
+	// BusStop is :bus_stop:.
+	BusStop = ":bus_stop:"
+	// Butter is :butter:.
+	Butter = ":butter:"
+	// Butterfly is :butterfly:.
+	Butterfly = ":butterfly:"
+	// Buzz is :buzz:, an alias of :bee:.
+	Buzz = ":bee:"
+	// C is :c:, an alias of :copyright:.
+	C = ":copyright:"
+	// Cactus is :cactus:.
+	Cactus = ":cactus:"
+	// Cake is :cake:.
+	Cake = ":cake:"
+	// Calculation is :calculation:, an alias of :abacus:.
+	Calculation = ":abacus:"
+	// Calculator is :calculator:, an alias of :abacus:.
+	Calculator = ":abacus:"
+	// Calendar is :calendar:.
+	Calendar = ":calendar:"
+	// Calf is :calf:.
+	Calf = ":calf:"
+	// CallMe is :call_me:.
+	CallMe = ":call_me:"
+	// Calling is :calling:.
+	Calling = ":calling:"
+	// Calm is :calm:, an alias of :lotus:.
+	Calm = ":lotus:"
+	// Camel is :camel:.
+	Camel = ":camel:"
+	// Camera is :camera:.
+	Camera = ":camera:"
+	// Camping is :camping:, an alias of :tent:.
+	Camping = ":tent:"
+	// Campsite is :campsite:.
+	Campsite = ":campsite:"
+	// Can is :can:, an alias of :canned_food:.
+	Can = ":canned_food:"
+	// Cancer is :cancer:.
+	Cancer = ":cancer:"
+	// Candle is :candle:.
+	Candle = ":candle:"
+	// Candy is :candy:.
+	Candy = ":candy:"
+	// Canned is :canned:, an alias of :canned_food:.
+	Canned = ":canned_food:"
+	// CannedFood is :canned_food:.
+	CannedFood = ":canned_food:"
+	// Canoe is :canoe:.
+	Canoe = ":canoe:"
+	// Cap is :cap:, an alias of :billed_cap:.
+	Cap = ":billed_cap:"
+	// CapitalAbcd is :capital_abcd:.
+	CapitalAbcd = ":capital_abcd:"
+	// CapitalLetters is :capital_letters:, an alias of :capital_abcd:.
+	CapitalLetters = ":capital_abcd:"
+	// Capricorn is :capricorn:.
+	Capricorn = ":capricorn:"
+	// Capsicum is :capsicum:, an alias of :bell_pepper:.
+	Capsicum = ":bell_pepper:"
+	// Captivated is :captivated:, an alias of :face_with_peeking_eye:.
+	Captivated = ":face_with_peeking_eye:"
+	// Car is :car:.
+	Car = ":car:"
+	// CardIndex is :card_index:, an alias of :rolodex:.
+	CardIndex = ":rolodex:"
+	// Cardiology is :cardiology:, an alias of :anatomical_heart:.
+	Cardiology = ":anatomical_heart:"
+	// Carousel is :carousel:.
+	Carousel = ":carousel:"
+	// CarpStreamer is :carp_streamer:.
+	CarpStreamer = ":carp_streamer:"
+	// Carpenter is :carpenter:, an alias of :carpentry_saw:.
+	Carpenter = ":carpentry_saw:"
+	// CarpenterSquare is :carpenter_square:.
+	CarpenterSquare = ":carpenter_square:"
+	// Carpentry is :carpentry:, an alias of :carpentry_saw:.
+	Carpentry = ":carpentry_saw:"
+	// CarpentrySaw is :carpentry_saw:.
+	CarpentrySaw = ":carpentry_saw:"
+	// Carrot is :carrot:.
+	Carrot = ":carrot:"
+	// Cartwheel is :cartwheel:.
+	Cartwheel = ":cartwheel:"
+	// Cask is :cask:, an alias of :bucket:.
+	Cask = ":bucket:"
+	// Castle is :castle:.
+	Castle = ":castle:"
+	// Cat is :cat:.
+	Cat = ":cat:"
+	// Caterpillar is :caterpillar:, an alias of :bug:.
+	Caterpillar = ":bug:"
+	// Caution is :caution:, an alias of :warning:.
+	Caution = ":warning:"
+	// Caw is :caw:, an alias of :black_bird:.
+	Caw = ":black_bird:"
+	// Cd is :cd:.
+	Cd = ":cd:"
+	// Celebration is :celebration:, an alias of :balloon:.
+	Celebration = ":balloon:"
+	// CellReception is :cell_reception:.
+	CellReception = ":cell_reception:"
+	// Cemetery is :cemetery:, an alias of :headstone:.
+	Cemetery = ":headstone:"
+	// Censor is :censor:, an alias of :face_with_symbols_on_mouth:.
+	Censor = ":face_with_symbols_on_mouth:"
+	// Cereal is :cereal:, an alias of :bowl_with_spoon:.
+	Cereal = ":bowl_with_spoon:"
+	// Cha is :cha:, an alias of :maracas:.
+	Cha = ":maracas:"
+	// Chain is :chain:, an alias of :broken_chain:.
+	Chain = ":broken_chain:"
+	// Chains is :chains:.
+	Chains = ":chains:"
+	// Chair is :chair:.
+	Chair = ":chair:"
+	// Champagne is :champagne:.
+	Champagne = ":champagne:"
+	// Change is :change:, an alias of :coral:.
+	Change = ":coral:"
+	// Charge is :charge:, an alias of :japanese_service_charge_button:.
+	Charge = ":japanese_service_charge_button:"
+	// Charm is :charm:, an alias of :nazar_amulet:.
+	Charm = ":nazar_amulet:"
+	// Chart is :chart:.
+	Chart = ":chart:"
+	// Check is :check:.
+	Check = ":check:"
+	// CheckMark is :check_mark:.
+	CheckMark = ":check_mark:"
+	// Checkbox is :checkbox:.
+	Checkbox = ":checkbox:"
+	// CheckeredFlag is :checkered_flag:.
+	CheckeredFlag = ":checkered_flag:"
+	// Cheese is :cheese:.
+	Cheese = ":cheese:"
+	// Chemistry is :chemistry:, an alias of :test_tube:.
+	Chemistry = ":test_tube:"
+	// Cherries is :cherries:.
+	Cherries = ":cherries:"
+	// CherryBlossom is :cherry_blossom:.
+	CherryBlossom = ":cherry_blossom:"
+	// Chess is :chess:, an alias of :chess_pawn:.
+	Chess = ":chess_pawn:"
+	// ChessPawn is :chess_pawn:.
+	ChessPawn = ":chess_pawn:"
+	// Chest is :chest:, an alias of :toolbox:.
+	Chest = ":toolbox:"
+	// Chestnut is :chestnut:.
+	Chestnut = ":chestnut:"
+	// Chick is :chick:.
+	Chick = ":chick:"
+	// Chicken is :chicken:.
+	Chicken = ":chicken:"
+	// Chickpea is :chickpea:, an alias of :falafel:.
+	Chickpea = ":falafel:"
+	// Child is :child:.
+	Child = ":child:"
+	// ChildrenCrossing is :children_crossing:.
+	ChildrenCrossing = ":children_crossing:"
+	// ChiliPepper is :chili_pepper:, an alias of :hot_pepper:.
+	ChiliPepper = ":hot_pepper:"
+	// Chipmunk is :chipmunk:.
+	Chipmunk = ":chipmunk:"
+	// Chocolate is :chocolate:.
+	Chocolate = ":chocolate:"
+	// Chopsticks is :chopsticks:.
+	Chopsticks = ":chopsticks:"
+	// Choy is :choy:, an alias of :leafy_green:.
+	Choy = ":leafy_green:"
+	// Christianity is :christianity:, an alias of :cross:.
+	Christianity = ":cross:"
+	// Christmas is :christmas:, an alias of :mx_claus:.
+	Christmas = ":mx_claus:"
+	// Church is :church:.
+	Church = ":church:"
+	// Cinco is :cinco:, an alias of :piñata:.
+	Cinco = ":piñata:"
+	// Cinema is :cinema:.
+	Cinema = ":cinema:"
+	// Circle is :circle:.
+	Circle = ":circle:"
+	// Circus is :circus:.
+	Circus = ":circus:"
+	// Citrus is :citrus:, an alias of :lime:.
+	Citrus = ":lime:"
+	// City is :city:.
+	City = ":city:"
+	// CitySunrise is :city_sunrise:.
+	CitySunrise = ":city_sunrise:"
+	// Cl is :cl:.
+	Cl = ":cl:"
+	// Clack is :clack:, an alias of :folding_hand_fan:.
+	Clack = ":folding_hand_fan:"
+	// Clap is :clap:.
+	Clap = ":clap:"
+	// ClassicalBuilding is :classical_building:.
+	ClassicalBuilding = ":classical_building:"
+	// Classy is :classy:, an alias of :face_with_monocle:.
+	Classy = ":face_with_monocle:"
+	// Claus is :claus:, an alias of :mx_claus:.
+	Claus = ":mx_claus:"
+	// Claws is :claws:, an alias of :lobster:.
+	Claws = ":lobster:"
+	// Clay is :clay:, an alias of :brick:.
+	Clay = ":brick:"
+	// Climate is :climate:, an alias of :coral:.
+	Climate = ":coral:"
+	// Clink is :clink:.
+	Clink = ":clink:"
+	// Clipboard is :clipboard:.
+	Clipboard = ":clipboard:"
+	// Clock is :clock:, an alias of :time:.
+	Clock = ":time:"
+	// Clockwise is :clockwise:.
+	Clockwise = ":clockwise:"
+	// ClosedBook is :closed_book:, an alias of :red_book:.
+	ClosedBook = ":red_book:"
+	// ClosedMailbox is :closed_mailbox:.
+	ClosedMailbox = ":closed_mailbox:"
+	// ClosedUmbrella is :closed_umbrella:.
+	ClosedUmbrella = ":closed_umbrella:"
+	// Clothes is :clothes:, an alias of :lab_coat:.
+	Clothes = ":lab_coat:"
+	// Clothing is :clothing:.
+	Clothing = ":clothing:"
+	// Cloud is :cloud:.
+	Cloud = ":cloud:"
+	// Clouds is :clouds:, an alias of :face_in_clouds:.
+	Clouds = ":face_in_clouds:"
+	// Cloudy is :cloudy:.
+	Cloudy = ":cloudy:"
+	// Clover is :clover:, an alias of :shamrock:.
+	Clover = ":shamrock:"
+	// Clown is :clown:.
+	Clown = ":clown:"
+	// Clubs is :clubs:.
+	Clubs = ":clubs:"
+	// Cluck is :cluck:, an alias of :chicken:.
+	Cluck = ":chicken:"
+	// Clue is :clue:, an alias of :secret:.
+	Clue = ":secret:"
+	// Coat is :coat:.
+	Coat = ":coat:"
+	// CockADoodleDoo is :cock-a-doodle-doo:, an alias of :rooster:.
+	CockADoodleDoo = ":rooster:"
+	// Cockroach is :cockroach:.
+	Cockroach = ":cockroach:"
+	// Cocktail is :cocktail:.
+	Cocktail = ":cocktail:"
+	// Coconut is :coconut:.
+	Coconut = ":coconut:"
+	// Coffee is :coffee:.
+	Coffee = ":coffee:"
+	// Coffin is :coffin:.
+	Coffin = ":coffin:"
+	// Coin is :coin:.
+	Coin = ":coin:"
+	// Colada is :colada:, an alias of :coconut:.
+	Colada = ":coconut:"
+	// ColdFace is :cold_face:.
+	ColdFace = ":cold_face:"
+	// ColdSweat is :cold_sweat:.
+	ColdSweat = ":cold_sweat:"
+	// Collision is :collision:, an alias of :boom:.
+	Collision = ":boom:"
+	// Colorful is :colorful:, an alias of :peacock:.
+	Colorful = ":peacock:"
+	// Comb is :comb:, an alias of :hair_pick:.
+	Comb = ":hair_pick:"
+	// Come is :come:, an alias of :palm_up_hand:.
+	Come = ":palm_up_hand:"
+	// Comet is :comet:.
+	Comet = ":comet:"
+	// Comfort is :comfort:, an alias of :people_hugging:.
+	Comfort = ":people_hugging:"
+	// Comfy is :comfy:, an alias of :flat_shoe:.
+	Comfy = ":flat_shoe:"
+	// Commitment is :commitment:, an alias of :secure:.
+	Commitment = ":secure:"
+	// Commodities is :commodities:, an alias of :oil_drum:.
+	Commodities = ":oil_drum:"
+	// Compass is :compass:.
+	Compass = ":compass:"
+	// CompositionBook is :composition_book:, an alias of :notebook:.
+	CompositionBook = ":notebook:"
+	// Compression is :compression:.
+	Compression = ":compression:"
+	// Computer is :computer:.
+	Computer = ":computer:"
+	// ComputerMouse is :computer_mouse:.
+	ComputerMouse = ":computer_mouse:"
+	// Concerned is :concerned:, an alias of :oh_no:.
+	Concerned = ":oh_no:"
+	// Concertina is :concertina:, an alias of :accordion:.
+	Concertina = ":accordion:"
+	// Conch is :conch:, an alias of :shell:.
+	Conch = ":shell:"
+	// Condemned is :condemned:, an alias of :derelict_house:.
+	Condemned = ":derelict_house:"
+	// Confetti is :confetti:.
+	Confetti = ":confetti:"
+	// Confounded is :confounded:.
+	Confounded = ":confounded:"
+	// Confused is :confused:, an alias of :oh_no:.
+	Confused = ":oh_no:"
+	// Confusion is :confusion:, an alias of :face_with_diagonal_mouth:.
+	Confusion = ":face_with_diagonal_mouth:"
+	// Conga is :conga:, an alias of :long_drum:.
+	Conga = ":long_drum:"
+	// Congee is :congee:, an alias of :bowl_with_spoon:.
+	Congee = ":bowl_with_spoon:"
+	// Congratulations is :congratulations:, an alias of :japanese_congratulations_button:.
+	Congratulations = ":japanese_congratulations_button:"
+	// Connectivity is :connectivity:, an alias of :wireless:.
+	Connectivity = ":wireless:"
+	// Construction is :construction:.
+	Construction = ":construction:"
+	// ConstructionWorker is :construction_worker:.
+	ConstructionWorker = ":construction_worker:"
+	// ConstructionZone is :construction_zone:, an alias of :work_in_progress:.
+	ConstructionZone = ":work_in_progress:"
+	// Contact is :contact:, an alias of :magnifying_glass_tilted_right:.
+	Contact = ":magnifying_glass_tilted_right:"
+	// Container is :container:, an alias of :jar:.
+	Container = ":jar:"
+	// ControlKnobs is :control_knobs:.
+	ControlKnobs = ":control_knobs:"
+	// ConvenienceStore is :convenience_store:.
+	ConvenienceStore = ":convenience_store:"
+	// Convoluted is :convoluted:, an alias of :pretzel:.
+	Convoluted = ":pretzel:"
+	// Cook is :cook:.
+	Cook = ":cook:"
+	// Cookie is :cookie:.
+	Cookie = ":cookie:"
+	// Cooking is :cooking:.
+	Cooking = ":cooking:"
+	// Cool is :cool:.
+	Cool = ":cool:"
+	// Cooling is :cooling:, an alias of :folding_hand_fan:.
+	Cooling = ":folding_hand_fan:"
+	// Cop is :cop:, an alias of :police:.
+	Cop = ":police:"
+	// Copyright is :copyright:.
+	Copyright = ":copyright:"
+	// Coral is :coral:.
+	Coral = ":coral:"
+	// Cord is :cord:, an alias of :knot:.
+	Cord = ":knot:"
+	// Corn is :corn:.
+	Corn = ":corn:"
+	// Corvid is :corvid:, an alias of :black_bird:.
+	Corvid = ":black_bird:"
+	// CouchAndLamp is :couch_and_lamp:, an alias of :living_room:.
+	CouchAndLamp = ":living_room:"
+	// Counterclockwise is :counterclockwise:.
+	Counterclockwise = ":counterclockwise:"
+	// CoupleWithHeart is :couple_with_heart:.
+	CoupleWithHeart = ":couple_with_heart:"
+	// CoupleWithHeartManMan is :couple_with_heart_man_man:.
+	CoupleWithHeartManMan = ":couple_with_heart_man_man:"
+	// CoupleWithHeartWomanMan is :couple_with_heart_woman_man:.
+	CoupleWithHeartWomanMan = ":couple_with_heart_woman_man:"
+	// CoupleWithHeartWomanWoman is :couple_with_heart_woman_woman:.
+	CoupleWithHeartWomanWoman = ":couple_with_heart_woman_woman:"
+	// Cow is :cow:.
+	Cow = ":cow:"
+	// Cowboy is :cowboy:.
+	Cowboy = ":cowboy:"
+	// Crab is :crab:.
+	Crab = ":crab:"
+	// Crash is :crash:, an alias of :boom:.
+	Crash = ":boom:"
+	// Crayon is :crayon:.
+	Crayon = ":crayon:"
+	// Crazy is :crazy:, an alias of :stuck_out_tongue_wink:.
+	Crazy = ":stuck_out_tongue_wink:"
+	// Credentials is :credentials:, an alias of :identification_card:.
+	Credentials = ":identification_card:"
+	// CreditCard is :credit_card:.
+	CreditCard = ":credit_card:"
+	// Cremation is :cremation:, an alias of :funeral_urn:.
+	Cremation = ":funeral_urn:"
+	// Crew is :crew:, an alias of :rowboat:.
+	Crew = ":rowboat:"
+	// Cricket is :cricket:.
+	Cricket = ":cricket:"
+	// CricketGame is :cricket_game:.
+	CricketGame = ":cricket_game:"
+	// Crime is :crime:, an alias of :fingerprint:.
+	Crime = ":fingerprint:"
+	// Crochet is :crochet:, an alias of :yarn:.
+	Crochet = ":yarn:"
+	// Crocodile is :crocodile:.
+	Crocodile = ":crocodile:"
+	// Croissant is :croissant:.
+	Croissant = ":croissant:"
+	// Crook is :crook:, an alias of :hook:.
+	Crook = ":hook:"
+	// Cross is :cross:.
+	Cross = ":cross:"
+	// CrossMark is :cross_mark:.
+	CrossMark = ":cross_mark:"
+	// Crossed is :crossed:, an alias of :hand_with_index_finger_and_thumb_crossed:.
+	Crossed = ":hand_with_index_finger_and_thumb_crossed:"
+	// CrossedFlags is :crossed_flags:.
+	CrossedFlags = ":crossed_flags:"
+	// Crow is :crow:, an alias of :black_bird:.
+	Crow = ":black_bird:"
+	// Crown is :crown:.
+	Crown = ":crown:"
+	// Cruise is :cruise:, an alias of :passenger_ship:.
+	Cruise = ":passenger_ship:"
+	// Crush is :crush:, an alias of :smiling_face_with_hearts:.
+	Crush = ":smiling_face_with_hearts:"
+	// Crushed is :crushed:, an alias of :wilted_flower:.
+	Crushed = ":wilted_flower:"
+	// Crutch is :crutch:.
+	Crutch = ":crutch:"
+	// Cry is :cry:.
+	Cry = ":cry:"
+	// CryingCat is :crying_cat:.
+	CryingCat = ":crying_cat:"
+	// Crystal is :crystal:, an alias of :gem:.
+	Crystal = ":gem:"
+	// CrystalBall is :crystal_ball:.
+	CrystalBall = ":crystal_ball:"
+	// Cube is :cube:, an alias of :ice:.
+	Cube = ":ice:"
+	// Cucumber is :cucumber:.
+	Cucumber = ":cucumber:"
+	// Cuffs is :cuffs:, an alias of :broken_chain:.
+	Cuffs = ":broken_chain:"
+	// Culture is :culture:, an alias of :petri_dish:.
+	Culture = ":petri_dish:"
+	// CupWithStraw is :cup_with_straw:.
+	CupWithStraw = ":cup_with_straw:"
+	// Cupcake is :cupcake:.
+	Cupcake = ":cupcake:"
+	// Cupid is :cupid:.
+	Cupid = ":cupid:"
+	// Cupped is :cupped:, an alias of :palms_up_together:.
+	Cupped = ":palms_up_together:"
+	// Curious is :curious:, an alias of :raccoon:.
+	Curious = ":raccoon:"
+	// Curl is :curl:, an alias of :page_with_curl:.
+	Curl = ":page_with_curl:"
+	// Curling is :curling:, an alias of :curling_stone:.
+	Curling = ":curling_stone:"
+	// CurlingStone is :curling_stone:.
+	CurlingStone = ":curling_stone:"
+	// Curry is :curry:.
+	Curry = ":curry:"
+	// Cursing is :cursing:, an alias of :face_with_symbols_on_mouth:.
+	Cursing = ":face_with_symbols_on_mouth:"
+	// Curve is :curve:, an alias of :hook:.
+	Curve = ":hook:"
+	// Cussing is :cussing:, an alias of :face_with_symbols_on_mouth:.
+	Cussing = ":face_with_symbols_on_mouth:"
+	// Custard is :custard:.
+	Custard = ":custard:"
+	// Customs is :customs:.
+	Customs = ":customs:"
+	// CutOfMeat is :cut_of_meat:.
+	CutOfMeat = ":cut_of_meat:"
+	// Cute is :cute:.
+	Cute = ":cute:"
+	// Cyan is :cyan:, an alias of :light_blue_heart:.
+	Cyan = ":light_blue_heart:"
+	// Cyclist is :cyclist:.
+	Cyclist = ":cyclist:"
+	// Cyclone is :cyclone:.
+	Cyclone = ":cyclone:"
+	// Cygnet is :cygnet:, an alias of :swan:.
+	Cygnet = ":swan:"
+	// Dagger is :dagger:.
+	Dagger = ":dagger:"
+	// Dairy is :dairy:, an alias of :butter:.
+	Dairy = ":butter:"
+	// Dam is :dam:, an alias of :beaver:.
+	Dam = ":beaver:"
+	// Dancer is :dancer:.
+	Dancer = ":dancer:"
+	// Dancers is :dancers:.
+	Dancers = ":dancers:"
+	// Dancing is :dancing:.
+	Dancing = ":dancing:"
+	// Danger is :danger:, an alias of :warning:.
+	Danger = ":warning:"
+	// Dango is :dango:.
+	Dango = ":dango:"
+	// DarkSunglasses is :dark_sunglasses:.
+	DarkSunglasses = ":dark_sunglasses:"
+	// Darts is :darts:, an alias of :direct_hit:.
+	Darts = ":direct_hit:"
+	// Dash is :dash:.
+	Dash = ":dash:"
+	// Date is :date:.
+	Date = ":date:"
+	// Daze is :daze:, an alias of :shaking_face:.
+	Daze = ":shaking_face:"
+	// De is :de:, an alias of :piñata:.
+	De = ":piñata:"
+	// DeafMan is :deaf_man:.
+	DeafMan = ":deaf_man:"
+	// DeafPerson is :deaf_person:.
+	DeafPerson = ":deaf_person:"
+	// DeafWoman is :deaf_woman:.
+	DeafWoman = ":deaf_woman:"
+	// Death is :death:, an alias of :skull_and_crossbones:.
+	Death = ":skull_and_crossbones:"
+	// DebitCard is :debit_card:, an alias of :credit_card:.
+	DebitCard = ":credit_card:"
+	// DeciduousTree is :deciduous_tree:, an alias of :tree:.
+	DeciduousTree = ":tree:"
+	// Decor is :decor:, an alias of :potted_plant:.
+	Decor = ":potted_plant:"
+	// Decoration is :decoration:, an alias of :ribbon:.
+	Decoration = ":ribbon:"
+	// DecorativeNotebook is :decorative_notebook:.
+	DecorativeNotebook = ":decorative_notebook:"
+	// Decrease is :decrease:, an alias of :downvote:.
+	Decrease = ":downvote:"
+	// Decreasing is :decreasing:, an alias of :downwards_trend:.
+	Decreasing = ":downwards_trend:"
+	// Deer is :deer:.
+	Deer = ":deer:"
+	// Deg is :deg:, an alias of :khanda:.
+	Deg = ":khanda:"
+	// Delivery is :delivery:, an alias of :takeout_box:.
+	Delivery = ":takeout_box:"
+	// Demonstration is :demonstration:, an alias of :placard:.
+	Demonstration = ":placard:"
+	// Denim is :denim:, an alias of :jeans:.
+	Denim = ":jeans:"
+	// Dental is :dental:, an alias of :toothbrush:.
+	Dental = ":toothbrush:"
+	// Dentist is :dentist:, an alias of :tooth:.
+	Dentist = ":tooth:"
+	// DepartmentStore is :department_store:.
+	DepartmentStore = ":department_store:"
+	// Departure is :departure:, an alias of :take_off:.
+	Departure = ":take_off:"
+	// Depressed is :depressed:, an alias of :dotted_line_face:.
+	Depressed = ":dotted_line_face:"
+	// DerelictHouse is :derelict_house:.
+	DerelictHouse = ":derelict_house:"
+	// Desert is :desert:.
+	Desert = ":desert:"
+	// DesktopComputer is :desktop_computer:.
+	DesktopComputer = ":desktop_computer:"
+	// Dessert is :dessert:, an alias of :cupcake:.
+	Dessert = ":cupcake:"
+	// Detective is :detective:.
+	Detective = ":detective:"
+	// Devil is :devil:.
+	Devil = ":devil:"
+	// Diagonal is :diagonal:, an alias of :face_with_diagonal_mouth:.
+	Diagonal = ":face_with_diagonal_mouth:"
+	// DiamondWithADot is :diamond_with_a_dot:, an alias of :cute:.
+	DiamondWithADot = ":cute:"
+	// Diamonds is :diamonds:.
+	Diamonds = ":diamonds:"
+	// Diaper is :diaper:, an alias of :safety_pin:.
+	Diaper = ":safety_pin:"
+	// Dice is :dice:.
+	Dice = ":dice:"
+	// Die is :die:, an alias of :dice:.
+	Die = ":dice:"
+	// Dig is :dig:, an alias of :shovel:.
+	Dig = ":shovel:"
+	// DigitalSecurity is :digital_security:, an alias of :privacy:.
+	DigitalSecurity = ":privacy:"
+	// Dim is :dim:, an alias of :low_brightness:.
+	Dim = ":low_brightness:"
+	// Ding is :ding:, an alias of :bellhop_bell:.
+	Ding = ":bellhop_bell:"
+	// Diplodocus is :diplodocus:, an alias of :sauropod:.
+	Diplodocus = ":sauropod:"
+	// DirectHit is :direct_hit:.
+	DirectHit = ":direct_hit:"
+	// Direction is :direction:, an alias of :compass:.
+	Direction = ":compass:"
+	// Disability is :disability:, an alias of :crutch:.
+	Disability = ":crutch:"
+	// Disabled is :disabled:, an alias of :accessible:.
+	Disabled = ":accessible:"
+	// Disappointed is :disappointed:.
+	Disappointed = ":disappointed:"
+	// DisappointedRelieved is :disappointed_relieved:, an alias of :exhausted:.
+	DisappointedRelieved = ":exhausted:"
+	// Disapproval is :disapproval:, an alias of :face_with_raised_eyebrow:.
+	Disapproval = ":face_with_raised_eyebrow:"
+	// Disc is :disc:, an alias of :flying_disc:.
+	Disc = ":flying_disc:"
+	// Disco is :disco:, an alias of :dancing:.
+	Disco = ":dancing:"
+	// Disguise is :disguise:, an alias of :disguised_face:.
+	Disguise = ":disguised_face:"
+	// DisguisedFace is :disguised_face:.
+	DisguisedFace = ":disguised_face:"
+	// Dish is :dish:, an alias of :petri_dish:.
+	Dish = ":petri_dish:"
+	// Dismiss is :dismiss:, an alias of :palm_down_hand:.
+	Dismiss = ":palm_down_hand:"
+	// Dissolve is :dissolve:, an alias of :melting_face:.
+	Dissolve = ":melting_face:"
+	// Distraught is :distraught:, an alias of :weary:.
+	Distraught = ":weary:"
+	// Distrust is :distrust:, an alias of :face_with_raised_eyebrow:.
+	Distrust = ":face_with_raised_eyebrow:"
+	// Dive is :dive:, an alias of :goggles:.
+	Dive = ":goggles:"
+	// Divide is :divide:, an alias of :division:.
+	Divide = ":division:"
+	// DivingMask is :diving_mask:.
+	DivingMask = ":diving_mask:"
+	// Division is :division:.
+	Division = ":division:"
+	// Diya is :diya:, an alias of :diya_lamp:.
+	Diya = ":diya_lamp:"
+	// DiyaLamp is :diya_lamp:.
+	DiyaLamp = ":diya_lamp:"
+	// Dizzy is :dizzy:.
+	Dizzy = ":dizzy:"
+	// Dna is :dna:.
+	Dna = ":dna:"
+	// DoNotLitter is :do_not_litter:.
+	DoNotLitter = ":do_not_litter:"
+	// Document is :document:.
+	Document = ":document:"
+	// Dodo is :dodo:.
+	Dodo = ":dodo:"
+	// Dog is :dog:.
+	Dog = ":dog:"
+	// Dogi is :dogi:, an alias of :black_belt:.
+	Dogi = ":black_belt:"
+	// Doll is :doll:, an alias of :nesting_dolls:.
+	Doll = ":nesting_dolls:"
+	// Dollar is :dollar:, an alias of :coin:.
+	Dollar = ":coin:"
+	// DollarBills is :dollar_bills:.
+	DollarBills = ":dollar_bills:"
+	// Dollars is :dollars:.
+	Dollars = ":dollars:"
+	// Dolls is :dolls:.
+	Dolls = ":dolls:"
+	// Dolphin is :dolphin:.
+	Dolphin = ":dolphin:"
+	// Donation is :donation:, an alias of :drop_of_blood:.
+	Donation = ":drop_of_blood:"
+	// DoneDeal is :done_deal:, an alias of :handshake:.
+	DoneDeal = ":handshake:"
+	// DonerKebab is :doner_kebab:.
+	DonerKebab = ":doner_kebab:"
+	// Donkey is :donkey:.
+	Donkey = ":donkey:"
+	// Donut is :donut:.
+	Donut = ":donut:"
+	// Door is :door:.
+	Door = ":door:"
+	// Dormouse is :dormouse:.
+	Dormouse = ":dormouse:"
+	// Dotted is :dotted:, an alias of :dotted_line_face:.
+	Dotted = ":dotted_line_face:"
+	// DottedLineFace is :dotted_line_face:.
+	DottedLineFace = ":dotted_line_face:"
+	// DottedSixPointedStar is :dotted_six_pointed_star:.
+	DottedSixPointedStar = ":dotted_six_pointed_star:"
+	// DoubleDown is :double_down:.
+	DoubleDown = ":double_down:"
+	// DoubleExclamation is :double_exclamation:, an alias of :bangbang:.
+	DoubleExclamation = ":bangbang:"
+	// DoubleLoop is :double_loop:.
+	DoubleLoop = ":double_loop:"
+	// DoubleUp is :double_up:.
+	DoubleUp = ":double_up:"
+	// Doubtful is :doubtful:, an alias of :face_with_diagonal_mouth:.
+	Doubtful = ":face_with_diagonal_mouth:"
+	// Doughnut is :doughnut:, an alias of :donut:.
+	Doughnut = ":donut:"
+	// Dove is :dove:.
+	Dove = ":dove:"
+	// DoveOfPeace is :dove_of_peace:, an alias of :dove:.
+	DoveOfPeace = ":dove:"
+	// Down is :down:.
+	Down = ":down:"
+	// DownButton is :down_button:, an alias of :downvote:.
+	DownButton = ":downvote:"
+	// Downvote is :downvote:.
+	Downvote = ":downvote:"
+	// DownwardsTrend is :downwards_trend:.
+	DownwardsTrend = ":downwards_trend:"
+	// Dr is :dr:, an alias of :lab_coat:.
+	Dr = ":lab_coat:"
+	// Dracula is :dracula:, an alias of :vampire:.
+	Dracula = ":vampire:"
+	// Dragon is :dragon:.
+	Dragon = ":dragon:"
+	// DragonFace is :dragon_face:.
+	DragonFace = ":dragon_face:"
+	// Drained is :drained:, an alias of :low_battery:.
+	Drained = ":low_battery:"
+	// Drama is :drama:, an alias of :performing_arts:.
+	Drama = ":performing_arts:"
+	// Dream is :dream:, an alias of :thought:.
+	Dream = ":thought:"
+	// Drenched is :drenched:, an alias of :rainy:.
+	Drenched = ":rainy:"
+	// Dress is :dress:.
+	Dress = ":dress:"
+	// DrinkingWater is :drinking_water:, an alias of :potable_water:.
+	DrinkingWater = ":potable_water:"
+	// Drip is :drip:, an alias of :splatter:.
+	Drip = ":splatter:"
+	// DriveWithCare is :drive_with_care:, an alias of :children_crossing:.
+	DriveWithCare = ":children_crossing:"
+	// Drooling is :drooling:.
+	Drooling = ":drooling:"
+	// Drop is :drop:.
+	Drop = ":drop:"
+	// DropOfBlood is :drop_of_blood:.
+	DropOfBlood = ":drop_of_blood:"
+	// Dropped is :dropped:, an alias of :palm_down_hand:.
+	Dropped = ":palm_down_hand:"
+	// Drought is :drought:, an alias of :leafless_tree:.
+	Drought = ":leafless_tree:"
+	// Drum is :drum:.
+	Drum = ":drum:"
+	// Drumstick is :drumstick:.
+	Drumstick = ":drumstick:"
+	// Drunk is :drunk:, an alias of :woozy_face:.
+	Drunk = ":woozy_face:"
+	// Dua is :dua:, an alias of :palms_up_together:.
+	Dua = ":palms_up_together:"
+	// Duck is :duck:.
+	Duck = ":duck:"
+	// Duckling is :duckling:, an alias of :swan:.
+	Duckling = ":swan:"
+	// Duel is :duel:.
+	Duel = ":duel:"
+	// Dumpling is :dumpling:.
+	Dumpling = ":dumpling:"
+	// Dungeon is :dungeon:, an alias of :secret:.
+	Dungeon = ":secret:"
+	// Dupe is :dupe:, an alias of :chess_pawn:.
+	Dupe = ":chess_pawn:"
+	// Dvd is :dvd:.
+	Dvd = ":dvd:"
+	// Dying is :dying:, an alias of :hot_face:.
+	Dying = ":hot_face:"
+	// Dynamite is :dynamite:, an alias of :firecracker:.
+	Dynamite = ":firecracker:"
+	// EMail is :e-mail:.
+	EMail = ":e-mail:"
+	// Eagle is :eagle:.
+	Eagle = ":eagle:"
+	// Ear is :ear:.
+	Ear = ":ear:"
+	// EarOfRice is :ear_of_rice:, an alias of :harvest:.
+	EarOfRice = ":harvest:"
+	// EarWithHearingAid is :ear_with_hearing_aid:.
+	EarWithHearingAid = ":ear_with_hearing_aid:"
+	// EarthAfrica is :earth_africa:.
+	EarthAfrica = ":earth_africa:"
+	// EarthAmericas is :earth_americas:.
+	EarthAmericas = ":earth_americas:"
+	// EarthAsia is :earth_asia:.
+	EarthAsia = ":earth_asia:"
+	// Earthquake is :earthquake:, an alias of :shaking_face:.
+	Earthquake = ":shaking_face:"
+	// Earthworm is :earthworm:, an alias of :worm:.
+	Earthworm = ":worm:"
+	// East is :east:, an alias of :right:.
+	East = ":right:"
+	// EasyComeEasyGo is :easy_come_easy_go:, an alias of :losing_money:.
+	EasyComeEasyGo = ":losing_money:"
+	// EatingUtensils is :eating_utensils:, an alias of :fork_and_knife:.
+	EatingUtensils = ":fork_and_knife:"
+	// Edamame is :edamame:, an alias of :pea_pod:.
+	Edamame = ":pea_pod:"
+	// Egg is :egg:.
+	Egg = ":egg:"
+	// Eggplant is :eggplant:.
+	Eggplant = ":eggplant:"
+	// Eggs is :eggs:, an alias of :nest_with_eggs:.
+	Eggs = ":nest_with_eggs:"
+	// Eight is :eight:.
+	Eight = ":eight:"
+	// EightPointedStar is :eight_pointed_star:.
+	EightPointedStar = ":eight_pointed_star:"
+	// EightSpokedAsterisk is :eight_spoked_asterisk:.
+	EightSpokedAsterisk = ":eight_spoked_asterisk:"
+	// Eject is :eject:, an alias of :eject_button:.
+	Eject = ":eject_button:"
+	// EjectButton is :eject_button:.
+	EjectButton = ":eject_button:"
+	// Elderly is :elderly:, an alias of :older_person:.
+	Elderly = ":older_person:"
+	// ElderlyMan is :elderly_man:, an alias of :older_man:.
+	ElderlyMan = ":older_man:"
+	// ElderlyWoman is :elderly_woman:, an alias of :older_woman:.
+	ElderlyWoman = ":older_woman:"
+	// ElectricPlug is :electric_plug:.
+	ElectricPlug = ":electric_plug:"
+	// Electronic is :electronic:, an alias of :low_battery:.
+	Electronic = ":low_battery:"
+	// Elephant is :elephant:.
+	Elephant = ":elephant:"
+	// ElevatedTrain is :elevated_train:, an alias of :monorail:.
+	ElevatedTrain = ":monorail:"
+	// Elevator is :elevator:.
+	Elevator = ":elevator:"
+	// Elf is :elf:.
+	Elf = ":elf:"
+	// Elk is :elk:, an alias of :moose:.
+	Elk = ":moose:"
+	// Email is :email:.
+	Email = ":email:"
+	// Embarrassed is :embarrassed:, an alias of :flushed:.
+	Embarrassed = ":flushed:"
+	// Embrace is :embrace:, an alias of :people_hugging:.
+	Embrace = ":people_hugging:"
+	// Embroidery is :embroidery:, an alias of :sewing_needle:.
+	Embroidery = ":sewing_needle:"
+	// Emerge is :emerge:, an alias of :phoenix:.
+	Emerge = ":phoenix:"
+	// Emergency is :emergency:, an alias of :safety_vest:.
+	Emergency = ":safety_vest:"
+	// Emoji is :emoji:, an alias of :face_with_raised_eyebrow:.
+	Emoji = ":face_with_raised_eyebrow:"
+	// Empanada is :empanada:, an alias of :dumpling:.
+	Empanada = ":dumpling:"
+	// EmptyMailbox is :empty_mailbox:, an alias of :inbox_zero:.
+	EmptyMailbox = ":inbox_zero:"
+	// EmptyNest is :empty_nest:.
+	EmptyNest = ":empty_nest:"
+	// Encrypted is :encrypted:, an alias of :secret:.
+	Encrypted = ":secret:"
+	// End is :end:.
+	End = ":end:"
+	// Energy is :energy:, an alias of :low_battery:.
+	Energy = ":low_battery:"
+	// Engineer is :engineer:, an alias of :gear:.
+	Engineer = ":gear:"
+	// Ensnare is :ensnare:, an alias of :hook:.
+	Ensnare = ":hook:"
+	// Envelope is :envelope:, an alias of :email:.
+	Envelope = ":email:"
+	// Envy is :envy:, an alias of :green_heart:.
+	Envy = ":green_heart:"
+	// Equal is :equal:, an alias of :heavy_equals_sign:.
+	Equal = ":heavy_equals_sign:"
+	// Equality is :equality:, an alias of :heavy_equals_sign:.
+	Equality = ":heavy_equals_sign:"
+	// Equals is :equals:, an alias of :heavy_equals_sign:.
+	Equals = ":heavy_equals_sign:"
+	// Euro is :euro:, an alias of :coin:.
+	Euro = ":coin:"
+	// EuroBanknotes is :euro_banknotes:.
+	EuroBanknotes = ":euro_banknotes:"
+	// EvergreenTree is :evergreen_tree:.
+	EvergreenTree = ":evergreen_tree:"
+	// Evidence is :evidence:, an alias of :receipt:.
+	Evidence = ":receipt:"
+	// EvilEye is :evil_eye:, an alias of :nazar_amulet:.
+	EvilEye = ":nazar_amulet:"
+	// Evolution is :evolution:, an alias of :dna:.
+	Evolution = ":dna:"
+	// Ew is :ew:, an alias of :face_vomiting:.
+	Ew = ":face_vomiting:"
+	// Exchange is :exchange:.
+	Exchange = ":exchange:"
+	// Exclamation is :exclamation:.
+	Exclamation = ":exclamation:"
+	// Exhalation is :exhalation:, an alias of :lungs:.
+	Exhalation = ":lungs:"
+	// Exhale is :exhale:, an alias of :face_exhaling:.
+	Exhale = ":face_exhaling:"
+	// Exhaling is :exhaling:, an alias of :face_exhaling:.
+	Exhaling = ":face_exhaling:"
+	// Exhausted is :exhausted:.
+	Exhausted = ":exhausted:"
+	// Expecting is :expecting:, an alias of :pregnant:.
+	Expecting = ":pregnant:"
+	// Expendable is :expendable:, an alias of :chess_pawn:.
+	Expendable = ":chess_pawn:"
+	// Expensive is :expensive:, an alias of :hand_with_index_finger_and_thumb_crossed:.
+	Expensive = ":hand_with_index_finger_and_thumb_crossed:"
+	// Explode is :explode:, an alias of :exploding_head:.
+	Explode = ":exploding_head:"
+	// Exploding is :exploding:, an alias of :exploding_head:.
+	Exploding = ":exploding_head:"
+	// ExplodingHead is :exploding_head:.
+	ExplodingHead = ":exploding_head:"
+	// Explosion is :explosion:, an alias of :boom:.
+	Explosion = ":boom:"
+	// Explosive is :explosive:, an alias of :firecracker:.
+	Explosive = ":firecracker:"
+	// Expressionless is :expressionless:.
+	Expressionless = ":expressionless:"
+	// Extinguish is :extinguish:, an alias of :fire_extinguisher:.
+	Extinguish = ":fire_extinguisher:"
+	// Extinguisher is :extinguisher:, an alias of :fire_extinguisher:.
+	Extinguisher = ":fire_extinguisher:"
+	// Extra is :extra:, an alias of :flying_saucer:.
+	Extra = ":flying_saucer:"
+	// Eye is :eye:.
+	Eye = ":eye:"
+	// EyeInSpeechBubble is :eye_in_speech_bubble:.
+	EyeInSpeechBubble = ":eye_in_speech_bubble:"
+	// Eyes is :eyes:.
+	Eyes = ":eyes:"
+	// FaceExhaling is :face_exhaling:.
+	FaceExhaling = ":face_exhaling:"
+	// FaceHoldingBackTears is :face_holding_back_tears:.
+	FaceHoldingBackTears = ":face_holding_back_tears:"
+	// FaceInClouds is :face_in_clouds:.
+	FaceInClouds = ":face_in_clouds:"
+	// FacePalm is :face_palm:.
+	FacePalm = ":face_palm:"
+	// FaceVomiting is :face_vomiting:.
+	FaceVomiting = ":face_vomiting:"
+	// FaceWithBagsUnderEyes is :face_with_bags_under_eyes:.
+	FaceWithBagsUnderEyes = ":face_with_bags_under_eyes:"
+	// FaceWithDiagonalMouth is :face_with_diagonal_mouth:.
+	FaceWithDiagonalMouth = ":face_with_diagonal_mouth:"
+	// FaceWithHandOverMouth is :face_with_hand_over_mouth:.
+	FaceWithHandOverMouth = ":face_with_hand_over_mouth:"
+	// FaceWithMonocle is :face_with_monocle:.
+	FaceWithMonocle = ":face_with_monocle:"
+	// FaceWithOpenEyesAndHandOverMouth is :face_with_open_eyes_and_hand_over_mouth:.
+	FaceWithOpenEyesAndHandOverMouth = ":face_with_open_eyes_and_hand_over_mouth:"
+	// FaceWithPeekingEye is :face_with_peeking_eye:.
+	FaceWithPeekingEye = ":face_with_peeking_eye:"
+	// FaceWithRaisedEyebrow is :face_with_raised_eyebrow:.
+	FaceWithRaisedEyebrow = ":face_with_raised_eyebrow:"
+	// FaceWithSpiralEyes is :face_with_spiral_eyes:.
+	FaceWithSpiralEyes = ":face_with_spiral_eyes:"
+	// FaceWithSymbolsOnMouth is :face_with_symbols_on_mouth:.
+	FaceWithSymbolsOnMouth = ":face_with_symbols_on_mouth:"
+	// FaceWithThermometer is :face_with_thermometer:, an alias of :sick:.
+	FaceWithThermometer = ":sick:"
+	// Factory is :factory:.
+	Factory = ":factory:"
+	// FactoryWorker is :factory_worker:.
+	FactoryWorker = ":factory_worker:"
+	// Fairy is :fairy:.
+	Fairy = ":fairy:"
+	// Falafel is :falafel:.
+	Falafel = ":falafel:"
+	// Fall is :fall:, an alias of :leaves:.
+	Fall = ":leaves:"
+	// FallenLeaf is :fallen_leaf:.
+	FallenLeaf = ":fallen_leaf:"
+	// Family is :family:.
+	Family = ":family:"
+	// FamilyAdultAdultChild is :family_adult_adult_child:.
+	FamilyAdultAdultChild = ":family_adult_adult_child:"
+	// FamilyAdultAdultChildChild is :family_adult_adult_child_child:.
+	FamilyAdultAdultChildChild = ":family_adult_adult_child_child:"
+	// FamilyAdultChild is :family_adult_child:.
+	FamilyAdultChild = ":family_adult_child:"
+	// FamilyAdultChildChild is :family_adult_child_child:.
+	FamilyAdultChildChild = ":family_adult_child_child:"
+	// FamilyManBoy is :family_man_boy:.
+	FamilyManBoy = ":family_man_boy:"
+	// FamilyManBoyBoy is :family_man_boy_boy:.
+	FamilyManBoyBoy = ":family_man_boy_boy:"
+	// FamilyManGirl is :family_man_girl:.
+	FamilyManGirl = ":family_man_girl:"
+	// FamilyManGirlBoy is :family_man_girl_boy:.
+	FamilyManGirlBoy = ":family_man_girl_boy:"
+	// FamilyManGirlGirl is :family_man_girl_girl:.
+	FamilyManGirlGirl = ":family_man_girl_girl:"
+	// FamilyManManBoy is :family_man_man_boy:.
+	FamilyManManBoy = ":family_man_man_boy:"
+	// FamilyManManBoyBoy is :family_man_man_boy_boy:.
+	FamilyManManBoyBoy = ":family_man_man_boy_boy:"
+	// FamilyManManGirl is :family_man_man_girl:.
+	FamilyManManGirl = ":family_man_man_girl:"
+	// FamilyManManGirlBoy is :family_man_man_girl_boy:.
+	FamilyManManGirlBoy = ":family_man_man_girl_boy:"
+	// FamilyManManGirlGirl is :family_man_man_girl_girl:.
+	FamilyManManGirlGirl = ":family_man_man_girl_girl:"
+	// FamilyManWomanBoy is :family_man_woman_boy:.
+	FamilyManWomanBoy = ":family_man_woman_boy:"
+	// FamilyManWomanBoyBoy is :family_man_woman_boy_boy:.
+	FamilyManWomanBoyBoy = ":family_man_woman_boy_boy:"
+	// FamilyManWomanGirl is :family_man_woman_girl:.
+	FamilyManWomanGirl = ":family_man_woman_girl:"
+	// FamilyManWomanGirlBoy is :family_man_woman_girl_boy:.
+	FamilyManWomanGirlBoy = ":family_man_woman_girl_boy:"
+	// FamilyManWomanGirlGirl is :family_man_woman_girl_girl:.
+	FamilyManWomanGirlGirl = ":family_man_woman_girl_girl:"
+	// FamilyWomanBoy is :family_woman_boy:.
+	FamilyWomanBoy = ":family_woman_boy:"
+	// FamilyWomanBoyBoy is :family_woman_boy_boy:.
+	FamilyWomanBoyBoy = ":family_woman_boy_boy:"
+	// FamilyWomanGirl is :family_woman_girl:.
+	FamilyWomanGirl = ":family_woman_girl:"
+	// FamilyWomanGirlBoy is :family_woman_girl_boy:.
+	FamilyWomanGirlBoy = ":family_woman_girl_boy:"
+	// FamilyWomanGirlGirl is :family_woman_girl_girl:.
+	FamilyWomanGirlGirl = ":family_woman_girl_girl:"
+	// FamilyWomanWomanBoy is :family_woman_woman_boy:.
+	FamilyWomanWomanBoy = ":family_woman_woman_boy:"
+	// FamilyWomanWomanBoyBoy is :family_woman_woman_boy_boy:.
+	FamilyWomanWomanBoyBoy = ":family_woman_woman_boy_boy:"
+	// FamilyWomanWomanGirl is :family_woman_woman_girl:.
+	FamilyWomanWomanGirl = ":family_woman_woman_girl:"
+	// FamilyWomanWomanGirlBoy is :family_woman_woman_girl_boy:.
+	FamilyWomanWomanGirlBoy = ":family_woman_woman_girl_boy:"
+	// FamilyWomanWomanGirlGirl is :family_woman_woman_girl_girl:.
+	FamilyWomanWomanGirlGirl = ":family_woman_woman_girl_girl:"
+	// Fan is :fan:, an alias of :folding_hand_fan:.
+	Fan = ":folding_hand_fan:"
+	// Fancy is :fancy:, an alias of :face_with_monocle:.
+	Fancy = ":face_with_monocle:"
+	// Farewell is :farewell:, an alias of :people_hugging:.
+	Farewell = ":people_hugging:"
+	// Farmer is :farmer:.
+	Farmer = ":farmer:"
+	// Farming is :farming:, an alias of :basket:.
+	Farming = ":basket:"
+	// FastDown is :fast_down:, an alias of :double_down:.
+	FastDown = ":double_down:"
+	// FastForward is :fast_forward:.
+	FastForward = ":fast_forward:"
+	// FastReverse is :fast_reverse:, an alias of :rewind:.
+	FastReverse = ":rewind:"
+	// FastUp is :fast_up:, an alias of :double_up:.
+	FastUp = ":double_up:"
+	// Fateh is :fateh:, an alias of :khanda:.
+	Fateh = ":khanda:"
+	// Father is :father:, an alias of :man_feeding_baby:.
+	Father = ":man_feeding_baby:"
+	// Fatigued is :fatigued:, an alias of :face_with_bags_under_eyes:.
+	Fatigued = ":face_with_bags_under_eyes:"
+	// Fatima is :fatima:, an alias of :hamsa:.
+	Fatima = ":hamsa:"
+	// Fax is :fax:.
+	Fax = ":fax:"
+	// Fear is :fear:.
+	Fear = ":fear:"
+	// Feather is :feather:.
+	Feather = ":feather:"
+	// Feelings is :feelings:, an alias of :face_holding_back_tears:.
+	Feelings = ":face_holding_back_tears:"
+	// Feet is :feet:, an alias of :footprints:.
+	Feet = ":footprints:"
+	// Feline is :feline:, an alias of :black_cat:.
+	Feline = ":black_cat:"
+	// Female is :female:, an alias of :female_sign:.
+	Female = ":female_sign:"
+	// FemaleSign is :female_sign:.
+	FemaleSign = ":female_sign:"
+	// Fencing is :fencing:.
+	Fencing = ":fencing:"
+	// FerrisWheel is :ferris_wheel:.
+	FerrisWheel = ":ferris_wheel:"
+	// Ferry is :ferry:.
+	Ferry = ":ferry:"
+	// Festival is :festival:, an alias of :moon_cake:.
+	Festival = ":moon_cake:"
+	// Festive is :festive:, an alias of :piñata:.
+	Festive = ":piñata:"
+	// Fever is :fever:, an alias of :sick:.
+	Fever = ":sick:"
+	// Feverish is :feverish:, an alias of :hot_face:.
+	Feverish = ":hot_face:"
+	// FieldHockey is :field_hockey:.
+	FieldHockey = ":field_hockey:"
+	// Fife is :fife:, an alias of :flute:.
+	Fife = ":flute:"
+	// Fight is :fight:, an alias of :ninja:.
+	Fight = ":ninja:"
+	// Fighter is :fighter:, an alias of :ninja:.
+	Fighter = ":ninja:"
+	// File is :file:, an alias of :document:.
+	File = ":document:"
+	// FileCabinet is :file_cabinet:.
+	FileCabinet = ":file_cabinet:"
+	// FileFolder is :file_folder:, an alias of :organize:.
+	FileFolder = ":organize:"
+	// Filling is :filling:, an alias of :pie:.
+	Filling = ":pie:"
+	// Film is :film:.
+	Film = ":film:"
+	// Find is :find:, an alias of :search:.
+	Find = ":search:"
+	// Fingerprint is :fingerprint:.
+	Fingerprint = ":fingerprint:"
+	// FingersCrossed is :fingers_crossed:.
+	FingersCrossed = ":fingers_crossed:"
+	// Fire is :fire:.
+	Fire = ":fire:"
+	// FireEngine is :fire_engine:, an alias of :fire_truck:.
+	FireEngine = ":fire_truck:"
+	// FireExtinguisher is :fire_extinguisher:.
+	FireExtinguisher = ":fire_extinguisher:"
+	// FireTruck is :fire_truck:.
+	FireTruck = ":fire_truck:"
+	// Firebird is :firebird:, an alias of :phoenix:.
+	Firebird = ":phoenix:"
+	// Firecracker is :firecracker:.
+	Firecracker = ":firecracker:"
+	// Firefighter is :firefighter:.
+	Firefighter = ":firefighter:"
+	// Fireworks is :fireworks:.
+	Fireworks = ":fireworks:"
+	// First is :first:, an alias of :first_quarter_moon:.
+	First = ":first_quarter_moon:"
+	// FirstPlace is :first_place:.
+	FirstPlace = ":first_place:"
+	// FirstQuarterMoon is :first_quarter_moon:.
+	FirstQuarterMoon = ":first_quarter_moon:"
+	// Fish is :fish:.
+	Fish = ":fish:"
+	// Fishing is :fishing:.
+	Fishing = ":fishing:"
+	// Fist is :fist:.
+	Fist = ":fist:"
+	// FistBump is :fist_bump:.
+	FistBump = ":fist_bump:"
+	// Five is :five:.
+	Five = ":five:"
+	// Fixing is :fixing:.
+	Fixing = ":fixing:"
+	// FlagAfghanistan is :flag_afghanistan:.
+	FlagAfghanistan = ":flag_afghanistan:"
+	// FlagAlandIslands is :flag_aland_islands:, an alias of :flag_åland_islands:.
+	FlagAlandIslands = ":flag_åland_islands:"
+	// FlagAlbania is :flag_albania:.
+	FlagAlbania = ":flag_albania:"
+	// FlagAlgeria is :flag_algeria:.
+	FlagAlgeria = ":flag_algeria:"
+	// FlagAmericanSamoa is :flag_american_samoa:.
+	FlagAmericanSamoa = ":flag_american_samoa:"
+	// FlagAndorra is :flag_andorra:.
+	FlagAndorra = ":flag_andorra:"
+	// FlagAngola is :flag_angola:.
+	FlagAngola = ":flag_angola:"
+	// FlagAnguilla is :flag_anguilla:.
+	FlagAnguilla = ":flag_anguilla:"
+	// FlagAntarctica is :flag_antarctica:.
+	FlagAntarctica = ":flag_antarctica:"
+	// FlagAntiguaAndBarbuda is :flag_antigua_and_barbuda:.
+	FlagAntiguaAndBarbuda = ":flag_antigua_and_barbuda:"
+	// FlagArgentina is :flag_argentina:.
+	FlagArgentina = ":flag_argentina:"
+	// FlagArmenia is :flag_armenia:.
+	FlagArmenia = ":flag_armenia:"
+	// FlagAruba is :flag_aruba:.
+	FlagAruba = ":flag_aruba:"
+	// FlagAscensionIsland is :flag_ascension_island:.
+	FlagAscensionIsland = ":flag_ascension_island:"
+	// FlagAustralia is :flag_australia:.
+	FlagAustralia = ":flag_australia:"
+	// FlagAustria is :flag_austria:.
+	FlagAustria = ":flag_austria:"
+	// FlagAzerbaijan is :flag_azerbaijan:.
+	FlagAzerbaijan = ":flag_azerbaijan:"
+	// FlagBahamas is :flag_bahamas:.
+	FlagBahamas = ":flag_bahamas:"
+	// FlagBahrain is :flag_bahrain:.
+	FlagBahrain = ":flag_bahrain:"
+	// FlagBangladesh is :flag_bangladesh:.
+	FlagBangladesh = ":flag_bangladesh:"
+	// FlagBarbados is :flag_barbados:.
+	FlagBarbados = ":flag_barbados:"
+	// FlagBelarus is :flag_belarus:.
+	FlagBelarus = ":flag_belarus:"
+	// FlagBelgium is :flag_belgium:.
+	FlagBelgium = ":flag_belgium:"
+	// FlagBelize is :flag_belize:.
+	FlagBelize = ":flag_belize:"
+	// FlagBenin is :flag_benin:.
+	FlagBenin = ":flag_benin:"
+	// FlagBermuda is :flag_bermuda:.
+	FlagBermuda = ":flag_bermuda:"
+	// FlagBhutan is :flag_bhutan:.
+	FlagBhutan = ":flag_bhutan:"
+	// FlagBolivia is :flag_bolivia:.
+	FlagBolivia = ":flag_bolivia:"
+	// FlagBosniaAndHerzegovina is :flag_bosnia_and_herzegovina:.
+	FlagBosniaAndHerzegovina = ":flag_bosnia_and_herzegovina:"
+	// FlagBotswana is :flag_botswana:.
+	FlagBotswana = ":flag_botswana:"
+	// FlagBouvetIsland is :flag_bouvet_island:.
+	FlagBouvetIsland = ":flag_bouvet_island:"
+	// FlagBrazil is :flag_brazil:.
+	FlagBrazil = ":flag_brazil:"
+	// FlagBritishIndianOceanTerritory is :flag_british_indian_ocean_territory:.
+	FlagBritishIndianOceanTerritory = ":flag_british_indian_ocean_territory:"
+	// FlagBritishVirginIslands is :flag_british_virgin_islands:.
+	FlagBritishVirginIslands = ":flag_british_virgin_islands:"
+	// FlagBrunei is :flag_brunei:.
+	FlagBrunei = ":flag_brunei:"
+	// FlagBulgaria is :flag_bulgaria:.
+	FlagBulgaria = ":flag_bulgaria:"
+	// FlagBurkinaFaso is :flag_burkina_faso:.
+	FlagBurkinaFaso = ":flag_burkina_faso:"
+	// FlagBurundi is :flag_burundi:.
+	FlagBurundi = ":flag_burundi:"
+	// FlagCambodia is :flag_cambodia:.
+	FlagCambodia = ":flag_cambodia:"
+	// FlagCameroon is :flag_cameroon:.
+	FlagCameroon = ":flag_cameroon:"
+	// FlagCanada is :flag_canada:.
+	FlagCanada = ":flag_canada:"
+	// FlagCanaryIslands is :flag_canary_islands:.
+	FlagCanaryIslands = ":flag_canary_islands:"
+	// FlagCapeVerde is :flag_cape_verde:.
+	FlagCapeVerde = ":flag_cape_verde:"
+	// FlagCaribbeanNetherlands is :flag_caribbean_netherlands:.
+	FlagCaribbeanNetherlands = ":flag_caribbean_netherlands:"
+	// FlagCaymanIslands is :flag_cayman_islands:.
+	FlagCaymanIslands = ":flag_cayman_islands:"
+	// FlagCentralAfricanRepublic is :flag_central_african_republic:.
+	FlagCentralAfricanRepublic = ":flag_central_african_republic:"
+	// FlagCeutaAndMelilla is :flag_ceuta_and_melilla:.
+	FlagCeutaAndMelilla = ":flag_ceuta_and_melilla:"
+	// FlagChad is :flag_chad:.
+	FlagChad = ":flag_chad:"
+	// FlagChile is :flag_chile:.
+	FlagChile = ":flag_chile:"
+	// FlagChina is :flag_china:.
+	FlagChina = ":flag_china:"
+	// FlagChristmasIsland is :flag_christmas_island:.
+	FlagChristmasIsland = ":flag_christmas_island:"
+	// FlagClippertonIsland is :flag_clipperton_island:.
+	FlagClippertonIsland = ":flag_clipperton_island:"
+	// FlagCocosKeelingIslands is :flag_cocos_keeling_islands:.
+	FlagCocosKeelingIslands = ":flag_cocos_keeling_islands:"
+	// FlagColombia is :flag_colombia:.
+	FlagColombia = ":flag_colombia:"
+	// FlagComoros is :flag_comoros:.
+	FlagComoros = ":flag_comoros:"
+	// FlagCongoBrazzaville is :flag_congo_brazzaville:.
+	FlagCongoBrazzaville = ":flag_congo_brazzaville:"
+	// FlagCongoKinshasa is :flag_congo_kinshasa:.
+	FlagCongoKinshasa = ":flag_congo_kinshasa:"
+	// FlagCookIslands is :flag_cook_islands:.
+	FlagCookIslands = ":flag_cook_islands:"
+	// FlagCostaRica is :flag_costa_rica:.
+	FlagCostaRica = ":flag_costa_rica:"
+	// FlagCoteDivoire is :flag_cote_divoire:, an alias of :flag_côte_divoire:.
+	FlagCoteDivoire = ":flag_côte_divoire:"
+	// FlagCroatia is :flag_croatia:.
+	FlagCroatia = ":flag_croatia:"
+	// FlagCuba is :flag_cuba:.
+	FlagCuba = ":flag_cuba:"
+	// FlagCuracao is :flag_curacao:, an alias of :flag_curaçao:.
+	FlagCuracao = ":flag_curaçao:"
+	// FlagCuraçao is :flag_curaçao:.
+	FlagCuraçao = ":flag_curaçao:"
+	// FlagCyprus is :flag_cyprus:.
+	FlagCyprus = ":flag_cyprus:"
+	// FlagCzechia is :flag_czechia:.
+	FlagCzechia = ":flag_czechia:"
+	// FlagCôteDivoire is :flag_côte_divoire:.
+	FlagCôteDivoire = ":flag_côte_divoire:"
+	// FlagDenmark is :flag_denmark:.
+	FlagDenmark = ":flag_denmark:"
+	// FlagDiegoGarcia is :flag_diego_garcia:.
+	FlagDiegoGarcia = ":flag_diego_garcia:"
+	// FlagDjibouti is :flag_djibouti:.
+	FlagDjibouti = ":flag_djibouti:"
+	// FlagDominica is :flag_dominica:.
+	FlagDominica = ":flag_dominica:"
+	// FlagDominicanRepublic is :flag_dominican_republic:.
+	FlagDominicanRepublic = ":flag_dominican_republic:"
+	// FlagEcuador is :flag_ecuador:.
+	FlagEcuador = ":flag_ecuador:"
+	// FlagEgypt is :flag_egypt:.
+	FlagEgypt = ":flag_egypt:"
+	// FlagElSalvador is :flag_el_salvador:.
+	FlagElSalvador = ":flag_el_salvador:"
+	// FlagEngland is :flag_england:.
+	FlagEngland = ":flag_england:"
+	// FlagEquatorialGuinea is :flag_equatorial_guinea:.
+	FlagEquatorialGuinea = ":flag_equatorial_guinea:"
+	// FlagEritrea is :flag_eritrea:.
+	FlagEritrea = ":flag_eritrea:"
+	// FlagEstonia is :flag_estonia:.
+	FlagEstonia = ":flag_estonia:"
+	// FlagEswatini is :flag_eswatini:.
+	FlagEswatini = ":flag_eswatini:"
+	// FlagEthiopia is :flag_ethiopia:.
+	FlagEthiopia = ":flag_ethiopia:"
+	// FlagEuropeanUnion is :flag_european_union:.
+	FlagEuropeanUnion = ":flag_european_union:"
+	// FlagFalklandIslands is :flag_falkland_islands:.
+	FlagFalklandIslands = ":flag_falkland_islands:"
+	// FlagFaroeIslands is :flag_faroe_islands:.
+	FlagFaroeIslands = ":flag_faroe_islands:"
+	// FlagFiji is :flag_fiji:.
+	FlagFiji = ":flag_fiji:"
+	// FlagFinland is :flag_finland:.
+	FlagFinland = ":flag_finland:"
+	// FlagFrance is :flag_france:.
+	FlagFrance = ":flag_france:"
+	// FlagFrenchGuiana is :flag_french_guiana:.
+	FlagFrenchGuiana = ":flag_french_guiana:"
+	// FlagFrenchPolynesia is :flag_french_polynesia:.
+	FlagFrenchPolynesia = ":flag_french_polynesia:"
+	// FlagFrenchSouthernTerritories is :flag_french_southern_territories:.
+	FlagFrenchSouthernTerritories = ":flag_french_southern_territories:"
+	// FlagGabon is :flag_gabon:.
+	FlagGabon = ":flag_gabon:"
+	// FlagGambia is :flag_gambia:.
+	FlagGambia = ":flag_gambia:"
+	// FlagGeorgia is :flag_georgia:.
+	FlagGeorgia = ":flag_georgia:"
+	// FlagGermany is :flag_germany:.
+	FlagGermany = ":flag_germany:"
+	// FlagGhana is :flag_ghana:.
+	FlagGhana = ":flag_ghana:"
+	// FlagGibraltar is :flag_gibraltar:.
+	FlagGibraltar = ":flag_gibraltar:"
+	// FlagGreece is :flag_greece:.
+	FlagGreece = ":flag_greece:"
+	// FlagGreenland is :flag_greenland:.
+	FlagGreenland = ":flag_greenland:"
+	// FlagGrenada is :flag_grenada:.
+	FlagGrenada = ":flag_grenada:"
+	// FlagGuadeloupe is :flag_guadeloupe:.
+	FlagGuadeloupe = ":flag_guadeloupe:"
+	// FlagGuam is :flag_guam:.
+	FlagGuam = ":flag_guam:"
+	// FlagGuatemala is :flag_guatemala:.
+	FlagGuatemala = ":flag_guatemala:"
+	// FlagGuernsey is :flag_guernsey:.
+	FlagGuernsey = ":flag_guernsey:"
+	// FlagGuinea is :flag_guinea:.
+	FlagGuinea = ":flag_guinea:"
+	// FlagGuineaBissau is :flag_guinea_bissau:.
+	FlagGuineaBissau = ":flag_guinea_bissau:"
+	// FlagGuyana is :flag_guyana:.
+	FlagGuyana = ":flag_guyana:"
+	// FlagHaiti is :flag_haiti:.
+	FlagHaiti = ":flag_haiti:"
+	// FlagHeardAndMcdonaldIslands is :flag_heard_and_mcdonald_islands:.
+	FlagHeardAndMcdonaldIslands = ":flag_heard_and_mcdonald_islands:"
+	// FlagHonduras is :flag_honduras:.
+	FlagHonduras = ":flag_honduras:"
+	// FlagHongKongSarChina is :flag_hong_kong_sar_china:.
+	FlagHongKongSarChina = ":flag_hong_kong_sar_china:"
+	// FlagHungary is :flag_hungary:.
+	FlagHungary = ":flag_hungary:"
+	// FlagIceland is :flag_iceland:.
+	FlagIceland = ":flag_iceland:"
+	// FlagIndia is :flag_india:.
+	FlagIndia = ":flag_india:"
+	// FlagIndonesia is :flag_indonesia:.
+	FlagIndonesia = ":flag_indonesia:"
+	// FlagIran is :flag_iran:.
+	FlagIran = ":flag_iran:"
+	// FlagIraq is :flag_iraq:.
+	FlagIraq = ":flag_iraq:"
+	// FlagIreland is :flag_ireland:.
+	FlagIreland = ":flag_ireland:"
+	// FlagIsleOfMan is :flag_isle_of_man:.
+	FlagIsleOfMan = ":flag_isle_of_man:"
+	// FlagIsrael is :flag_israel:.
+	FlagIsrael = ":flag_israel:"
+	// FlagItaly is :flag_italy:.
+	FlagItaly = ":flag_italy:"
+	// FlagJamaica is :flag_jamaica:.
+	FlagJamaica = ":flag_jamaica:"
+	// FlagJapan is :flag_japan:.
+	FlagJapan = ":flag_japan:"
+	// FlagJersey is :flag_jersey:.
+	FlagJersey = ":flag_jersey:"
+	// FlagJordan is :flag_jordan:.
+	FlagJordan = ":flag_jordan:"
+	// FlagKazakhstan is :flag_kazakhstan:.
+	FlagKazakhstan = ":flag_kazakhstan:"
+	// FlagKenya is :flag_kenya:.
+	FlagKenya = ":flag_kenya:"
+	// FlagKiribati is :flag_kiribati:.
+	FlagKiribati = ":flag_kiribati:"
+	// FlagKosovo is :flag_kosovo:.
+	FlagKosovo = ":flag_kosovo:"
+	// FlagKuwait is :flag_kuwait:.
+	FlagKuwait = ":flag_kuwait:"
+	// FlagKyrgyzstan is :flag_kyrgyzstan:.
+	FlagKyrgyzstan = ":flag_kyrgyzstan:"
+	// FlagLaos is :flag_laos:.
+	FlagLaos = ":flag_laos:"
+	// FlagLatvia is :flag_latvia:.
+	FlagLatvia = ":flag_latvia:"
+	// FlagLebanon is :flag_lebanon:.
+	FlagLebanon = ":flag_lebanon:"
+	// FlagLesotho is :flag_lesotho:.
+	FlagLesotho = ":flag_lesotho:"
+	// FlagLiberia is :flag_liberia:.
+	FlagLiberia = ":flag_liberia:"
+	// FlagLibya is :flag_libya:.
+	FlagLibya = ":flag_libya:"
+	// FlagLiechtenstein is :flag_liechtenstein:.
+	FlagLiechtenstein = ":flag_liechtenstein:"
+	// FlagLithuania is :flag_lithuania:.
+	FlagLithuania = ":flag_lithuania:"
+	// FlagLuxembourg is :flag_luxembourg:.
+	FlagLuxembourg = ":flag_luxembourg:"
+	// FlagMacaoSarChina is :flag_macao_sar_china:.
+	FlagMacaoSarChina = ":flag_macao_sar_china:"
+	// FlagMadagascar is :flag_madagascar:.
+	FlagMadagascar = ":flag_madagascar:"
+	// FlagMalawi is :flag_malawi:.
+	FlagMalawi = ":flag_malawi:"
+	// FlagMalaysia is :flag_malaysia:.
+	FlagMalaysia = ":flag_malaysia:"
+	// FlagMaldives is :flag_maldives:.
+	FlagMaldives = ":flag_maldives:"
+	// FlagMali is :flag_mali:.
+	FlagMali = ":flag_mali:"
+	// FlagMalta is :flag_malta:.
+	FlagMalta = ":flag_malta:"
+	// FlagMarshallIslands is :flag_marshall_islands:.
+	FlagMarshallIslands = ":flag_marshall_islands:"
+	// FlagMartinique is :flag_martinique:.
+	FlagMartinique = ":flag_martinique:"
+	// FlagMauritania is :flag_mauritania:.
+	FlagMauritania = ":flag_mauritania:"
+	// FlagMauritius is :flag_mauritius:.
+	FlagMauritius = ":flag_mauritius:"
+	// FlagMayotte is :flag_mayotte:.
+	FlagMayotte = ":flag_mayotte:"
+	// FlagMexico is :flag_mexico:.
+	FlagMexico = ":flag_mexico:"
+	// FlagMicronesia is :flag_micronesia:.
+	FlagMicronesia = ":flag_micronesia:"
+	// FlagMoldova is :flag_moldova:.
+	FlagMoldova = ":flag_moldova:"
+	// FlagMonaco is :flag_monaco:.
+	FlagMonaco = ":flag_monaco:"
+	// FlagMongolia is :flag_mongolia:.
+	FlagMongolia = ":flag_mongolia:"
+	// FlagMontenegro is :flag_montenegro:.
+	FlagMontenegro = ":flag_montenegro:"
+	// FlagMontserrat is :flag_montserrat:.
+	FlagMontserrat = ":flag_montserrat:"
+	// FlagMorocco is :flag_morocco:.
+	FlagMorocco = ":flag_morocco:"
+	// FlagMozambique is :flag_mozambique:.
+	FlagMozambique = ":flag_mozambique:"
+	// FlagMyanmarBurma is :flag_myanmar_burma:.
+	FlagMyanmarBurma = ":flag_myanmar_burma:"
+	// FlagNamibia is :flag_namibia:.
+	FlagNamibia = ":flag_namibia:"
+	// FlagNauru is :flag_nauru:.
+	FlagNauru = ":flag_nauru:"
+	// FlagNepal is :flag_nepal:.
+	FlagNepal = ":flag_nepal:"
+	// FlagNetherlands is :flag_netherlands:.
+	FlagNetherlands = ":flag_netherlands:"
+	// FlagNewCaledonia is :flag_new_caledonia:.
+	FlagNewCaledonia = ":flag_new_caledonia:"
+	// FlagNewZealand is :flag_new_zealand:.
+	FlagNewZealand = ":flag_new_zealand:"
+	// FlagNicaragua is :flag_nicaragua:.
+	FlagNicaragua = ":flag_nicaragua:"
+	// FlagNiger is :flag_niger:.
+	FlagNiger = ":flag_niger:"
+	// FlagNigeria is :flag_nigeria:.
+	FlagNigeria = ":flag_nigeria:"
+	// FlagNiue is :flag_niue:.
+	FlagNiue = ":flag_niue:"
+	// FlagNorfolkIsland is :flag_norfolk_island:.
+	FlagNorfolkIsland = ":flag_norfolk_island:"
+	// FlagNorthKorea is :flag_north_korea:.
+	FlagNorthKorea = ":flag_north_korea:"
+	// FlagNorthMacedonia is :flag_north_macedonia:.
+	FlagNorthMacedonia = ":flag_north_macedonia:"
+	// FlagNorthernMarianaIslands is :flag_northern_mariana_islands:.
+	FlagNorthernMarianaIslands = ":flag_northern_mariana_islands:"
+	// FlagNorway is :flag_norway:.
+	FlagNorway = ":flag_norway:"
+	// FlagOman is :flag_oman:.
+	FlagOman = ":flag_oman:"
+	// FlagPakistan is :flag_pakistan:.
+	FlagPakistan = ":flag_pakistan:"
+	// FlagPalau is :flag_palau:.
+	FlagPalau = ":flag_palau:"
+	// FlagPalestinianTerritories is :flag_palestinian_territories:.
+	FlagPalestinianTerritories = ":flag_palestinian_territories:"
+	// FlagPanama is :flag_panama:.
+	FlagPanama = ":flag_panama:"
+	// FlagPapuaNewGuinea is :flag_papua_new_guinea:.
+	FlagPapuaNewGuinea = ":flag_papua_new_guinea:"
+	// FlagParaguay is :flag_paraguay:.
+	FlagParaguay = ":flag_paraguay:"
+	// FlagPeru is :flag_peru:.
+	FlagPeru = ":flag_peru:"
+	// FlagPhilippines is :flag_philippines:.
+	FlagPhilippines = ":flag_philippines:"
+	// FlagPitcairnIslands is :flag_pitcairn_islands:.
+	FlagPitcairnIslands = ":flag_pitcairn_islands:"
+	// FlagPoland is :flag_poland:.
+	FlagPoland = ":flag_poland:"
+	// FlagPortugal is :flag_portugal:.
+	FlagPortugal = ":flag_portugal:"
+	// FlagPuertoRico is :flag_puerto_rico:.
+	FlagPuertoRico = ":flag_puerto_rico:"
+	// FlagQatar is :flag_qatar:.
+	FlagQatar = ":flag_qatar:"
+	// FlagReunion is :flag_reunion:, an alias of :flag_réunion:.
+	FlagReunion = ":flag_réunion:"
+	// FlagRomania is :flag_romania:.
+	FlagRomania = ":flag_romania:"
+	// FlagRussia is :flag_russia:.
+	FlagRussia = ":flag_russia:"
+	// FlagRwanda is :flag_rwanda:.
+	FlagRwanda = ":flag_rwanda:"
+	// FlagRéunion is :flag_réunion:.
+	FlagRéunion = ":flag_réunion:"
+	// FlagSamoa is :flag_samoa:.
+	FlagSamoa = ":flag_samoa:"
+	// FlagSanMarino is :flag_san_marino:.
+	FlagSanMarino = ":flag_san_marino:"
+	// FlagSaoTomeAndPrincipe is :flag_sao_tome_and_principe:, an alias of :flag_são_tomé_and_príncipe:.
+	FlagSaoTomeAndPrincipe = ":flag_são_tomé_and_príncipe:"
+	// FlagSark is :flag_sark:.
+	FlagSark = ":flag_sark:"
+	// FlagSaudiArabia is :flag_saudi_arabia:.
+	FlagSaudiArabia = ":flag_saudi_arabia:"
+	// FlagScotland is :flag_scotland:.
+	FlagScotland = ":flag_scotland:"
+	// FlagSenegal is :flag_senegal:.
+	FlagSenegal = ":flag_senegal:"
+	// FlagSerbia is :flag_serbia:.
+	FlagSerbia = ":flag_serbia:"
+	// FlagSeychelles is :flag_seychelles:.
+	FlagSeychelles = ":flag_seychelles:"
+	// FlagSierraLeone is :flag_sierra_leone:.
+	FlagSierraLeone = ":flag_sierra_leone:"
+	// FlagSingapore is :flag_singapore:.
+	FlagSingapore = ":flag_singapore:"
+	// FlagSintMaarten is :flag_sint_maarten:.
+	FlagSintMaarten = ":flag_sint_maarten:"
+	// FlagSlovakia is :flag_slovakia:.
+	FlagSlovakia = ":flag_slovakia:"
+	// FlagSlovenia is :flag_slovenia:.
+	FlagSlovenia = ":flag_slovenia:"
+	// FlagSolomonIslands is :flag_solomon_islands:.
+	FlagSolomonIslands = ":flag_solomon_islands:"
+	// FlagSomalia is :flag_somalia:.
+	FlagSomalia = ":flag_somalia:"
+	// FlagSouthAfrica is :flag_south_africa:.
+	FlagSouthAfrica = ":flag_south_africa:"
+	// FlagSouthGeorgiaAndSouthSandwichIslands is :flag_south_georgia_and_south_sandwich_islands:.
+	FlagSouthGeorgiaAndSouthSandwichIslands = ":flag_south_georgia_and_south_sandwich_islands:"
+	// FlagSouthKorea is :flag_south_korea:.
+	FlagSouthKorea = ":flag_south_korea:"
+	// FlagSouthSudan is :flag_south_sudan:.
+	FlagSouthSudan = ":flag_south_sudan:"
+	// FlagSpain is :flag_spain:.
+	FlagSpain = ":flag_spain:"
+	// FlagSriLanka is :flag_sri_lanka:.
+	FlagSriLanka = ":flag_sri_lanka:"
+	// FlagStBarthelemy is :flag_st_barthelemy:, an alias of :flag_st_barthélemy:.
+	FlagStBarthelemy = ":flag_st_barthélemy:"
+	// FlagStBarthélemy is :flag_st_barthélemy:.
+	FlagStBarthélemy = ":flag_st_barthélemy:"
+	// FlagStHelena is :flag_st_helena:.
+	FlagStHelena = ":flag_st_helena:"
+	// FlagStKittsAndNevis is :flag_st_kitts_and_nevis:.
+	FlagStKittsAndNevis = ":flag_st_kitts_and_nevis:"
+	// FlagStLucia is :flag_st_lucia:.
+	FlagStLucia = ":flag_st_lucia:"
+	// FlagStMartin is :flag_st_martin:.
+	FlagStMartin = ":flag_st_martin:"
+	// FlagStPierreAndMiquelon is :flag_st_pierre_and_miquelon:.
+	FlagStPierreAndMiquelon = ":flag_st_pierre_and_miquelon:"
+	// FlagStVincentAndGrenadines is :flag_st_vincent_and_grenadines:.
+	FlagStVincentAndGrenadines = ":flag_st_vincent_and_grenadines:"
+	// FlagSudan is :flag_sudan:.
+	FlagSudan = ":flag_sudan:"
+	// FlagSuriname is :flag_suriname:.
+	FlagSuriname = ":flag_suriname:"
+	// FlagSvalbardAndJanMayen is :flag_svalbard_and_jan_mayen:.
+	FlagSvalbardAndJanMayen = ":flag_svalbard_and_jan_mayen:"
+	// FlagSweden is :flag_sweden:.
+	FlagSweden = ":flag_sweden:"
+	// FlagSwitzerland is :flag_switzerland:.
+	FlagSwitzerland = ":flag_switzerland:"
+	// FlagSyria is :flag_syria:.
+	FlagSyria = ":flag_syria:"
+	// FlagSãoToméAndPríncipe is :flag_são_tomé_and_príncipe:.
+	FlagSãoToméAndPríncipe = ":flag_são_tomé_and_príncipe:"
+	// FlagTaiwan is :flag_taiwan:.
+	FlagTaiwan = ":flag_taiwan:"
+	// FlagTajikistan is :flag_tajikistan:.
+	FlagTajikistan = ":flag_tajikistan:"
+	// FlagTanzania is :flag_tanzania:.
+	FlagTanzania = ":flag_tanzania:"
+	// FlagThailand is :flag_thailand:.
+	FlagThailand = ":flag_thailand:"
+	// FlagTimorLeste is :flag_timor_leste:.
+	FlagTimorLeste = ":flag_timor_leste:"
+	// FlagTogo is :flag_togo:.
+	FlagTogo = ":flag_togo:"
+	// FlagTokelau is :flag_tokelau:.
+	FlagTokelau = ":flag_tokelau:"
+	// FlagTonga is :flag_tonga:.
+	FlagTonga = ":flag_tonga:"
+	// FlagTrinidadAndTobago is :flag_trinidad_and_tobago:.
+	FlagTrinidadAndTobago = ":flag_trinidad_and_tobago:"
+	// FlagTristanDaCunha is :flag_tristan_da_cunha:.
+	FlagTristanDaCunha = ":flag_tristan_da_cunha:"
+	// FlagTunisia is :flag_tunisia:.
+	FlagTunisia = ":flag_tunisia:"
+	// FlagTurkiye is :flag_turkiye:, an alias of :flag_türkiye:.
+	FlagTurkiye = ":flag_türkiye:"
+	// FlagTurkmenistan is :flag_turkmenistan:.
+	FlagTurkmenistan = ":flag_turkmenistan:"
+	// FlagTurksAndCaicosIslands is :flag_turks_and_caicos_islands:.
+	FlagTurksAndCaicosIslands = ":flag_turks_and_caicos_islands:"
+	// FlagTuvalu is :flag_tuvalu:.
+	FlagTuvalu = ":flag_tuvalu:"
+	// FlagTürkiye is :flag_türkiye:.
+	FlagTürkiye = ":flag_türkiye:"
+	// FlagUganda is :flag_uganda:.
+	FlagUganda = ":flag_uganda:"
+	// FlagUkraine is :flag_ukraine:.
+	FlagUkraine = ":flag_ukraine:"
+	// FlagUnitedArabEmirates is :flag_united_arab_emirates:.
+	FlagUnitedArabEmirates = ":flag_united_arab_emirates:"
+	// FlagUnitedKingdom is :flag_united_kingdom:.
+	FlagUnitedKingdom = ":flag_united_kingdom:"
+	// FlagUnitedNations is :flag_united_nations:.
+	FlagUnitedNations = ":flag_united_nations:"
+	// FlagUnitedStates is :flag_united_states:.
+	FlagUnitedStates = ":flag_united_states:"
+	// FlagUruguay is :flag_uruguay:.
+	FlagUruguay = ":flag_uruguay:"
+	// FlagUsOutlyingIslands is :flag_us_outlying_islands:.
+	FlagUsOutlyingIslands = ":flag_us_outlying_islands:"
+	// FlagUsVirginIslands is :flag_us_virgin_islands:.
+	FlagUsVirginIslands = ":flag_us_virgin_islands:"
+	// FlagUzbekistan is :flag_uzbekistan:.
+	FlagUzbekistan = ":flag_uzbekistan:"
+	// FlagVanuatu is :flag_vanuatu:.
+	FlagVanuatu = ":flag_vanuatu:"
+	// FlagVaticanCity is :flag_vatican_city:.
+	FlagVaticanCity = ":flag_vatican_city:"
+	// FlagVenezuela is :flag_venezuela:.
+	FlagVenezuela = ":flag_venezuela:"
+	// FlagVietnam is :flag_vietnam:.
+	FlagVietnam = ":flag_vietnam:"
+	// FlagWales is :flag_wales:.
+	FlagWales = ":flag_wales:"
+	// FlagWallisAndFutuna is :flag_wallis_and_futuna:.
+	FlagWallisAndFutuna = ":flag_wallis_and_futuna:"
+	// FlagWesternSahara is :flag_western_sahara:.
+	FlagWesternSahara = ":flag_western_sahara:"
+	// FlagYemen is :flag_yemen:.
+	FlagYemen = ":flag_yemen:"
+	// FlagZambia is :flag_zambia:.
+	FlagZambia = ":flag_zambia:"
+	// FlagZimbabwe is :flag_zimbabwe:.
+	FlagZimbabwe = ":flag_zimbabwe:"
+	// FlagÅlandIslands is :flag_åland_islands:.
+	FlagÅlandIslands = ":flag_åland_islands:"
+	// Flags is :flags:, an alias of :carp_streamer:.
+	Flags = ":carp_streamer:"
+	// Flamboyant is :flamboyant:, an alias of :flamingo:.
+	Flamboyant = ":flamingo:"
+	// Flame is :flame:, an alias of :fire:.
+	Flame = ":fire:"
+	// Flamingo is :flamingo:.
+	Flamingo = ":flamingo:"
+	// Flan is :flan:, an alias of :custard:.
+	Flan = ":custard:"
+	// Flashlight is :flashlight:.
+	Flashlight = ":flashlight:"
+	// Flat is :flat:, an alias of :flat_shoe:.
+	Flat = ":flat_shoe:"
+	// FlatShoe is :flat_shoe:.
+	FlatShoe = ":flat_shoe:"
+	// Flatbed is :flatbed:, an alias of :pickup_truck:.
+	Flatbed = ":pickup_truck:"
+	// Flatbread is :flatbread:.
+	Flatbread = ":flatbread:"
+	// Flathead is :flathead:, an alias of :screwdriver:.
+	Flathead = ":screwdriver:"
+	// Flats is :flats:, an alias of :flat_shoe:.
+	Flats = ":flat_shoe:"
+	// Flautist is :flautist:, an alias of :flute:.
+	Flautist = ":flute:"
+	// Flavor is :flavor:, an alias of :salt:.
+	Flavor = ":salt:"
+	// FleurDeLis is :fleur_de_lis:.
+	FleurDeLis = ":fleur_de_lis:"
+	// Flight is :flight:, an alias of :feather:.
+	Flight = ":feather:"
+	// FlipFlops is :flip_flops:, an alias of :sandal:.
+	FlipFlops = ":sandal:"
+	// Flipper is :flipper:, an alias of :dolphin:.
+	Flipper = ":dolphin:"
+	// Flirting is :flirting:, an alias of :biting_lip:.
+	Flirting = ":biting_lip:"
+	// Float is :float:, an alias of :ring_buoy:.
+	Float = ":ring_buoy:"
+	// Floating is :floating:, an alias of :bubbles:.
+	Floating = ":bubbles:"
+	// Flock is :flock:, an alias of :goose:.
+	Flock = ":goose:"
+	// Flop is :flop:, an alias of :thong_sandal:.
+	Flop = ":thong_sandal:"
+	// FloppyDisk is :floppy_disk:.
+	FloppyDisk = ":floppy_disk:"
+	// Flower is :flower:, an alias of :tulip:.
+	Flower = ":tulip:"
+	// Flu is :flu:, an alias of :sick:.
+	Flu = ":sick:"
+	// Fluctuate is :fluctuate:, an alias of :yo_yo:.
+	Fluctuate = ":yo_yo:"
+	// Flushed is :flushed:.
+	Flushed = ":flushed:"
+	// Flute is :flute:.
+	Flute = ":flute:"
+	// Flutter is :flutter:, an alias of :folding_hand_fan:.
+	Flutter = ":folding_hand_fan:"
+	// Fly is :fly:.
+	Fly = ":fly:"
+	// FlyingDisc is :flying_disc:.
+	FlyingDisc = ":flying_disc:"
+	// FlyingSaucer is :flying_saucer:.
+	FlyingSaucer = ":flying_saucer:"
+	// Fog is :fog:.
+	Fog = ":fog:"
+	// Foggy is :foggy:.
+	Foggy = ":foggy:"
+	// FoldedHands is :folded_hands:.
+	FoldedHands = ":folded_hands:"
+	// Folder is :folder:.
+	Folder = ":folder:"
+	// Folding is :folding:, an alias of :folding_hand_fan:.
+	Folding = ":folding_hand_fan:"
+	// FoldingHandFan is :folding_hand_fan:.
+	FoldingHandFan = ":folding_hand_fan:"
+	// Fondue is :fondue:.
+	Fondue = ":fondue:"
+	// Food is :food:.
+	Food = ":food:"
+	// Foot is :foot:.
+	Foot = ":foot:"
+	// Football is :football:.
+	Football = ":football:"
+	// Footprints is :footprints:.
+	Footprints = ":footprints:"
+	// Force is :force:, an alias of :plunger:.
+	Force = ":plunger:"
+	// Forensics is :forensics:, an alias of :fingerprint:.
+	Forensics = ":fingerprint:"
+	// Forever is :forever:, an alias of :infinity:.
+	Forever = ":infinity:"
+	// ForkAndKnife is :fork_and_knife:.
+	ForkAndKnife = ":fork_and_knife:"
+	// ForkAndKnifeWithPlate is :fork_and_knife_with_plate:, an alias of :hungry:.
+	ForkAndKnifeWithPlate = ":hungry:"
+	// FortuneCookie is :fortune_cookie:.
+	FortuneCookie = ":fortune_cookie:"
+	// FortuneTelling is :fortune_telling:, an alias of :crystal_ball:.
+	FortuneTelling = ":crystal_ball:"
+	// Forward is :forward:.
+	Forward = ":forward:"
+	// Fountain is :fountain:.
+	Fountain = ":fountain:"
+	// FountainPen is :fountain_pen:.
+	FountainPen = ":fountain_pen:"
+	// Four is :four:.
+	Four = ":four:"
+	// FourLeafClover is :four_leaf_clover:, an alias of :lucky:.
+	FourLeafClover = ":lucky:"
+	// Fowl is :fowl:, an alias of :goose:.
+	Fowl = ":goose:"
+	// Fox is :fox:.
+	Fox = ":fox:"
+	// Frame is :frame:, an alias of :window:.
+	Frame = ":window:"
+	// FramedPicture is :framed_picture:, an alias of :picture:.
+	FramedPicture = ":picture:"
+	// Free is :free:.
+	Free = ":free:"
+	// Freedom is :freedom:, an alias of :broken_chain:.
+	Freedom = ":broken_chain:"
+	// Freezing is :freezing:, an alias of :cold_face:.
+	Freezing = ":cold_face:"
+	// Fresh is :fresh:, an alias of :window:.
+	Fresh = ":window:"
+	// Friends is :friends:, an alias of :people_holding_hands:.
+	Friends = ":people_holding_hands:"
+	// Friendship is :friendship:, an alias of :people_hugging:.
+	Friendship = ":people_hugging:"
+	// Fries is :fries:.
+	Fries = ":fries:"
+	// Frog is :frog:.
+	Frog = ":frog:"
+	// Frostbite is :frostbite:, an alias of :cold_face:.
+	Frostbite = ":cold_face:"
+	// Frosty is :frosty:.
+	Frosty = ":frosty:"
+	// Frown is :frown:.
+	Frown = ":frown:"
+	// Frowning is :frowning:.
+	Frowning = ":frowning:"
+	// Frustration is :frustration:, an alias of :face_with_diagonal_mouth:.
+	Frustration = ":face_with_diagonal_mouth:"
+	// FuelPump is :fuel_pump:.
+	FuelPump = ":fuel_pump:"
+	// FullBattery is :full_battery:, an alias of :battery:.
+	FullBattery = ":battery:"
+	// FullMoon is :full_moon:.
+	FullMoon = ":full_moon:"
+	// FuneralUrn is :funeral_urn:.
+	FuneralUrn = ":funeral_urn:"
+	// Fungi is :fungi:, an alias of :brown_mushroom:.
+	Fungi = ":brown_mushroom:"
+	// Fungus is :fungus:, an alias of :brown_mushroom:.
+	Fungus = ":brown_mushroom:"
+	// Furniture is :furniture:, an alias of :living_room:.
+	Furniture = ":living_room:"
+	// Future is :future:, an alias of :crystal_ball:.
+	Future = ":crystal_ball:"
+	// Gaggle is :gaggle:, an alias of :goose:.
+	Gaggle = ":goose:"
+	// Gander is :gander:, an alias of :goose:.
+	Gander = ":goose:"
+	// Garlic is :garlic:.
+	Garlic = ":garlic:"
+	// Garnish is :garnish:, an alias of :lime:.
+	Garnish = ":lime:"
+	// GasPump is :gas_pump:, an alias of :fuel_pump:.
+	GasPump = ":fuel_pump:"
+	// Gay is :gay:, an alias of :rainbow_flag:.
+	Gay = ":rainbow_flag:"
+	// Gear is :gear:.
+	Gear = ":gear:"
+	// Gecko is :gecko:, an alias of :lizard:.
+	Gecko = ":lizard:"
+	// Geek is :geek:, an alias of :nerd:.
+	Geek = ":nerd:"
+	// Geese is :geese:, an alias of :goose:.
+	Geese = ":goose:"
+	// Gelato is :gelato:, an alias of :ice_cream:.
+	Gelato = ":ice_cream:"
+	// Gem is :gem:.
+	Gem = ":gem:"
+	// Gemini is :gemini:.
+	Gemini = ":gemini:"
+	// Genderqueer is :genderqueer:, an alias of :rainbow_flag:.
+	Genderqueer = ":rainbow_flag:"
+	// Gene is :gene:, an alias of :dna:.
+	Gene = ":dna:"
+	// Genetics is :genetics:, an alias of :dna:.
+	Genetics = ":dna:"
+	// Genie is :genie:.
+	Genie = ":genie:"
+	// Ghost is :ghost:.
+	Ghost = ":ghost:"
+	// Gibbous is :gibbous:, an alias of :waning_gibbous_moon:.
+	Gibbous = ":waning_gibbous_moon:"
+	// Gift is :gift:.
+	Gift = ":gift:"
+	// GiftHeart is :gift_heart:.
+	GiftHeart = ":gift_heart:"
+	// Giggle is :giggle:, an alias of :face_with_hand_over_mouth:.
+	Giggle = ":face_with_hand_over_mouth:"
+	// Giggling is :giggling:, an alias of :face_with_hand_over_mouth:.
+	Giggling = ":face_with_hand_over_mouth:"
+	// Ginger is :ginger:, an alias of :ginger_root:.
+	Ginger = ":ginger_root:"
+	// GingerRoot is :ginger_root:.
+	GingerRoot = ":ginger_root:"
+	// Giraffe is :giraffe:.
+	Giraffe = ":giraffe:"
+	// Girl is :girl:.
+	Girl = ":girl:"
+	// Glad is :glad:, an alias of :smiling_face_with_tear:.
+	Glad = ":smiling_face_with_tear:"
+	// Glamour is :glamour:, an alias of :sparkles:.
+	Glamour = ":sparkles:"
+	// GlassOfMilk is :glass_of_milk:, an alias of :milk:.
+	GlassOfMilk = ":milk:"
+	// Glasses is :glasses:.
+	Glasses = ":glasses:"
+	// Glbt is :glbt:, an alias of :rainbow_flag:.
+	Glbt = ":rainbow_flag:"
+	// Glbtq is :glbtq:, an alias of :rainbow_flag:.
+	Glbtq = ":rainbow_flag:"
+	// Glitter is :glitter:, an alias of :mirror_ball:.
+	Glitter = ":mirror_ball:"
+	// Globe is :globe:, an alias of :www:.
+	Globe = ":www:"
+	// Glory is :glory:, an alias of :phoenix:.
+	Glory = ":phoenix:"
+	// Glove is :glove:, an alias of :softball:.
+	Glove = ":softball:"
+	// Gloves is :gloves:.
+	Gloves = ":gloves:"
+	// GlowingStar is :glowing_star:.
+	GlowingStar = ":glowing_star:"
+	// Go is :go:, an alias of :checkered_flag:.
+	Go = ":checkered_flag:"
+	// Goal is :goal:, an alias of :gooooooooal:.
+	Goal = ":gooooooooal:"
+	// Goat is :goat:.
+	Goat = ":goat:"
+	// Goblin is :goblin:.
+	Goblin = ":goblin:"
+	// Goggles is :goggles:.
+	Goggles = ":goggles:"
+	// Gold is :gold:, an alias of :first_place:.
+	Gold = ":first_place:"
+	// GoldRecord is :gold_record:.
+	GoldRecord = ":gold_record:"
+	// Golf is :golf:.
+	Golf = ":golf:"
+	// Gondola is :gondola:.
+	Gondola = ":gondola:"
+	// Goodbye is :goodbye:, an alias of :people_hugging:.
+	Goodbye = ":people_hugging:"
+	// Goodnight is :goodnight:.
+	Goodnight = ":goodnight:"
+	// Goofy is :goofy:, an alias of :zany_face:.
+	Goofy = ":zany_face:"
+	// Gooooooooal is :gooooooooal:.
+	Gooooooooal = ":gooooooooal:"
+	// Goose is :goose:.
+	Goose = ":goose:"
+	// Gordita is :gordita:, an alias of :flatbread:.
+	Gordita = ":flatbread:"
+	// Gorilla is :gorilla:.
+	Gorilla = ":gorilla:"
+	// GotIt is :got_it:, an alias of :ok:.
+	GotIt = ":ok:"
+	// Graduate is :graduate:.
+	Graduate = ":graduate:"
+	// Grandchild is :grandchild:, an alias of :child:.
+	Grandchild = ":child:"
+	// Grandparent is :grandparent:, an alias of :older_person:.
+	Grandparent = ":older_person:"
+	// Grapes is :grapes:.
+	Grapes = ":grapes:"
+	// Grasshopper is :grasshopper:, an alias of :cricket:.
+	Grasshopper = ":cricket:"
+	// Gratitude is :gratitude:, an alias of :face_holding_back_tears:.
+	Gratitude = ":face_holding_back_tears:"
+	// Grave is :grave:, an alias of :coffin:.
+	Grave = ":coffin:"
+	// Graveyard is :graveyard:, an alias of :headstone:.
+	Graveyard = ":headstone:"
+	// Gray is :gray:, an alias of :grey_heart:.
+	Gray = ":grey_heart:"
+	// GreenApple is :green_apple:.
+	GreenApple = ":green_apple:"
+	// GreenBook is :green_book:.
+	GreenBook = ":green_book:"
+	// GreenCircle is :green_circle:.
+	GreenCircle = ":green_circle:"
+	// GreenHeart is :green_heart:.
+	GreenHeart = ":green_heart:"
+	// GreenLargeSquare is :green_large_square:.
+	GreenLargeSquare = ":green_large_square:"
+	// Grey is :grey:, an alias of :grey_heart:.
+	Grey = ":grey_heart:"
+	// GreyExclamation is :grey_exclamation:.
+	GreyExclamation = ":grey_exclamation:"
+	// GreyHeart is :grey_heart:.
+	GreyHeart = ":grey_heart:"
+	// GreyQuestion is :grey_question:.
+	GreyQuestion = ":grey_question:"
+	// Grimacing is :grimacing:.
+	Grimacing = ":grimacing:"
+	// Grinning is :grinning:.
+	Grinning = ":grinning:"
+	// GrinningFaceWithSmilingEyes is :grinning_face_with_smiling_eyes:.
+	GrinningFaceWithSmilingEyes = ":grinning_face_with_smiling_eyes:"
+	// Groan is :groan:, an alias of :face_exhaling:.
+	Groan = ":face_exhaling:"
+	// Gross is :gross:, an alias of :face_vomiting:.
+	Gross = ":face_vomiting:"
+	// Grow is :grow:, an alias of :potted_plant:.
+	Grow = ":potted_plant:"
+	// Growing is :growing:, an alias of :chart:.
+	Growing = ":chart:"
+	// GrowingHeart is :growing_heart:, an alias of :heart_pulse:.
+	GrowingHeart = ":heart_pulse:"
+	// Grumpy is :grumpy:, an alias of :rage:.
+	Grumpy = ":rage:"
+	// GuaPiMao is :gua_pi_mao:.
+	GuaPiMao = ":gua_pi_mao:"
+	// Guanaco is :guanaco:, an alias of :llama:.
+	Guanaco = ":llama:"
+	// Guard is :guard:.
+	Guard = ":guard:"
+	// Guestrooms is :guestrooms:, an alias of :in_bed:.
+	Guestrooms = ":in_bed:"
+	// GuideDog is :guide_dog:.
+	GuideDog = ":guide_dog:"
+	// Guitar is :guitar:.
+	Guitar = ":guitar:"
+	// Gun is :gun:.
+	Gun = ":gun:"
+	// Gym is :gym:, an alias of :lift:.
+	Gym = ":lift:"
+	// Gymnastics is :gymnastics:, an alias of :cartwheel:.
+	Gymnastics = ":cartwheel:"
+	// Gyoza is :gyoza:, an alias of :dumpling:.
+	Gyoza = ":dumpling:"
+	// Gyōza is :gyōza:, an alias of :dumpling:.
+	Gyōza = ":dumpling:"
+	// Haha is :haha:, an alias of :melting_face:.
+	Haha = ":melting_face:"
+	// HairPick is :hair_pick:.
+	HairPick = ":hair_pick:"
+	// Haircut is :haircut:.
+	Haircut = ":haircut:"
+	// HalfFrown is :half_frown:, an alias of :oh_no:.
+	HalfFrown = ":oh_no:"
+	// Halo is :halo:, an alias of :innocent:.
+	Halo = ":innocent:"
+	// Hamburger is :hamburger:.
+	Hamburger = ":hamburger:"
+	// Hammer is :hammer:.
+	Hammer = ":hammer:"
+	// HammerAndPick is :hammer_and_pick:, an alias of :at_work:.
+	HammerAndPick = ":at_work:"
+	// HammerAndWrench is :hammer_and_wrench:, an alias of :working_on_it:.
+	HammerAndWrench = ":working_on_it:"
+	// Hamsa is :hamsa:.
+	Hamsa = ":hamsa:"
+	// Hamster is :hamster:.
+	Hamster = ":hamster:"
+	// Hand is :hand:.
+	Hand = ":hand:"
+	// HandWithIndexFingerAndThumbCrossed is :hand_with_index_finger_and_thumb_crossed:.
+	HandWithIndexFingerAndThumbCrossed = ":hand_with_index_finger_and_thumb_crossed:"
+	// Handbag is :handbag:.
+	Handbag = ":handbag:"
+	// Handball is :handball:.
+	Handball = ":handball:"
+	// Handshake is :handshake:.
+	Handshake = ":handshake:"
+	// Handy is :handy:, an alias of :screwdriver:.
+	Handy = ":screwdriver:"
+	// Handyman is :handyman:, an alias of :hammer:.
+	Handyman = ":hammer:"
+	// Handywoman is :handywoman:, an alias of :hammer:.
+	Handywoman = ":hammer:"
+	// HangGlide is :hang_glide:, an alias of :parachute:.
+	HangGlide = ":parachute:"
+	// Happy is :happy:, an alias of :grinning:.
+	Happy = ":grinning:"
+	// Hard is :hard:, an alias of :ear_with_hearing_aid:.
+	Hard = ":ear_with_hearing_aid:"
+	// HardHat is :hard_hat:, an alias of :helmet:.
+	HardHat = ":helmet:"
+	// Harp is :harp:.
+	Harp = ":harp:"
+	// Harvest is :harvest:.
+	Harvest = ":harvest:"
+	// Hash is :hash:.
+	Hash = ":hash:"
+	// Hashi is :hashi:, an alias of :chopsticks:.
+	Hashi = ":chopsticks:"
+	// Hat is :hat:.
+	Hat = ":hat:"
+	// Hatchet is :hatchet:, an alias of :axe:.
+	Hatchet = ":axe:"
+	// Hatching is :hatching:.
+	Hatching = ":hatching:"
+	// HatchingChick is :hatching_chick:, an alias of :hatching:.
+	HatchingChick = ":hatching:"
+	// Haunted is :haunted:, an alias of :ghost:.
+	Haunted = ":ghost:"
+	// Hazard is :hazard:, an alias of :skull_and_crossbones:.
+	Hazard = ":skull_and_crossbones:"
+	// Hazy is :hazy:, an alias of :fog:.
+	Hazy = ":fog:"
+	// HeadBandage is :head_bandage:, an alias of :hurt:.
+	HeadBandage = ":hurt:"
+	// HeadShakingHorizontally is :head_shaking_horizontally:.
+	HeadShakingHorizontally = ":head_shaking_horizontally:"
+	// HeadShakingVertically is :head_shaking_vertically:.
+	HeadShakingVertically = ":head_shaking_vertically:"
+	// HeadingDown is :heading_down:.
+	HeadingDown = ":heading_down:"
+	// HeadingUp is :heading_up:.
+	HeadingUp = ":heading_up:"
+	// Headlines is :headlines:.
+	Headlines = ":headlines:"
+	// Headphones is :headphones:.
+	Headphones = ":headphones:"
+	// Headscarf is :headscarf:, an alias of :woman_with_headscarf:.
+	Headscarf = ":woman_with_headscarf:"
+	// Headstone is :headstone:.
+	Headstone = ":headstone:"
+	// HealthWorker is :health_worker:.
+	HealthWorker = ":health_worker:"
+	// Healthier is :healthier:, an alias of :mending_heart:.
+	Healthier = ":mending_heart:"
+	// HearNoEvil is :hear_no_evil:.
+	HearNoEvil = ":hear_no_evil:"
+	// Hearing is :hearing:, an alias of :ear_with_hearing_aid:.
+	Hearing = ":ear_with_hearing_aid:"
+	// Heart is :heart:.
+	Heart = ":heart:"
+	// HeartArrow is :heart_arrow:, an alias of :cupid:.
+	HeartArrow = ":cupid:"
+	// HeartBox is :heart_box:.
+	HeartBox = ":heart_box:"
+	// HeartExclamation is :heart_exclamation:.
+	HeartExclamation = ":heart_exclamation:"
+	// HeartEyes is :heart_eyes:.
+	HeartEyes = ":heart_eyes:"
+	// HeartEyesCat is :heart_eyes_cat:.
+	HeartEyesCat = ":heart_eyes_cat:"
+	// HeartHands is :heart_hands:.
+	HeartHands = ":heart_hands:"
+	// HeartKiss is :heart_kiss:.
+	HeartKiss = ":heart_kiss:"
+	// HeartOfGold is :heart_of_gold:, an alias of :yellow_heart:.
+	HeartOfGold = ":yellow_heart:"
+	// HeartOnFire is :heart_on_fire:.
+	HeartOnFire = ":heart_on_fire:"
+	// HeartPulse is :heart_pulse:.
+	HeartPulse = ":heart_pulse:"
+	// Heartache is :heartache:, an alias of :broken_heart:.
+	Heartache = ":broken_heart:"
+	// Heartbeat is :heartbeat:.
+	Heartbeat = ":heartbeat:"
+	// Hearts is :hearts:.
+	Hearts = ":hearts:"
+	// Heavenly is :heavenly:, an alias of :wing:.
+	Heavenly = ":wing:"
+	// HeavyEqualsSign is :heavy_equals_sign:.
+	HeavyEqualsSign = ":heavy_equals_sign:"
+	// Hedgehog is :hedgehog:.
+	Hedgehog = ":hedgehog:"
+	// Helicopter is :helicopter:.
+	Helicopter = ":helicopter:"
+	// Hello is :hello:, an alias of :wave:.
+	Hello = ":wave:"
+	// Helmet is :helmet:.
+	Helmet = ":helmet:"
+	// Help is :help:, an alias of :crutch:.
+	Help = ":crutch:"
+	// Helpless is :helpless:, an alias of :persevere:.
+	Helpless = ":persevere:"
+	// Herb is :herb:.
+	Herb = ":herb:"
+	// Herd is :herd:, an alias of :bison:.
+	Herd = ":bison:"
+	// Heroine is :heroine:, an alias of :woman_superhero:.
+	Heroine = ":woman_superhero:"
+	// Hi is :hi:, an alias of :wave:.
+	Hi = ":wave:"
+	// Hibiscus is :hibiscus:.
+	Hibiscus = ":hibiscus:"
+	// Hiding is :hiding:, an alias of :face_with_peeking_eye:.
+	Hiding = ":face_with_peeking_eye:"
+	// HighBrightness is :high_brightness:, an alias of :brightness:.
+	HighBrightness = ":brightness:"
+	// HighFive is :high_five:.
+	HighFive = ":high_five:"
+	// HighHeels is :high_heels:.
+	HighHeels = ":high_heels:"
+	// HighSpeedTrain is :high_speed_train:.
+	HighSpeedTrain = ":high_speed_train:"
+	// HighVoltage is :high_voltage:.
+	HighVoltage = ":high_voltage:"
+	// Hijab is :hijab:, an alias of :woman_with_headscarf:.
+	Hijab = ":woman_with_headscarf:"
+	// Hiking is :hiking:, an alias of :hiking_boot:.
+	Hiking = ":hiking_boot:"
+	// HikingBoot is :hiking_boot:.
+	HikingBoot = ":hiking_boot:"
+	// Hindu is :hindu:, an alias of :hindu_temple:.
+	Hindu = ":hindu_temple:"
+	// HinduTemple is :hindu_temple:.
+	HinduTemple = ":hindu_temple:"
+	// Hinduism is :hinduism:, an alias of :om:.
+	Hinduism = ":om:"
+	// Hinny is :hinny:, an alias of :donkey:.
+	Hinny = ":donkey:"
+	// Hint is :hint:, an alias of :secret:.
+	Hint = ":secret:"
+	// Hippo is :hippo:, an alias of :hippopotamus:.
+	Hippo = ":hippopotamus:"
+	// Hippopotamus is :hippopotamus:.
+	Hippopotamus = ":hippopotamus:"
+	// Hiss is :hiss:, an alias of :snake:.
+	Hiss = ":snake:"
+	// Hmm is :hmm:, an alias of :face_with_raised_eyebrow:.
+	Hmm = ":face_with_raised_eyebrow:"
+	// Hocho is :hocho:, an alias of :knife:.
+	Hocho = ":knife:"
+	// Hoist is :hoist:, an alias of :elevator:.
+	Hoist = ":elevator:"
+	// Holding is :holding:, an alias of :face_holding_back_tears:.
+	Holding = ":face_holding_back_tears:"
+	// Hole is :hole:.
+	Hole = ":hole:"
+	// HoleInOne is :hole_in_one:.
+	HoleInOne = ":hole_in_one:"
+	// Holi is :holi:, an alias of :splatter:.
+	Holi = ":splatter:"
+	// Holiday is :holiday:, an alias of :mx_claus:.
+	Holiday = ":mx_claus:"
+	// HolidayTree is :holiday_tree:.
+	HolidayTree = ":holiday_tree:"
+	// HomePhone is :home_phone:, an alias of :landline:.
+	HomePhone = ":landline:"
+	// Honey is :honey:.
+	Honey = ":honey:"
+	// Honeybee is :honeybee:, an alias of :bee:.
+	Honeybee = ":bee:"
+	// Hongbao is :hongbao:, an alias of :red_envelope:.
+	Hongbao = ":red_envelope:"
+	// Honk is :honk:, an alias of :goose:.
+	Honk = ":goose:"
+	// Hook is :hook:.
+	Hook = ":hook:"
+	// Hooray is :hooray:, an alias of :partying_face:.
+	Hooray = ":partying_face:"
+	// HorizontalTrafficLight is :horizontal_traffic_light:.
+	HorizontalTrafficLight = ":horizontal_traffic_light:"
+	// Horizontally is :horizontally:, an alias of :head_shaking_horizontally:.
+	Horizontally = ":head_shaking_horizontally:"
+	// Horn is :horn:.
+	Horn = ":horn:"
+	// Horse is :horse:.
+	Horse = ":horse:"
+	// HorseRacing is :horse_racing:.
+	HorseRacing = ":horse_racing:"
+	// HorseRiding is :horse_riding:, an alias of :horse_racing:.
+	HorseRiding = ":horse_racing:"
+	// Horseshoe is :horseshoe:, an alias of :magnet:.
+	Horseshoe = ":magnet:"
+	// Hospital is :hospital:.
+	Hospital = ":hospital:"
+	// Hot is :hot:, an alias of :fire:.
+	Hot = ":fire:"
+	// HotFace is :hot_face:.
+	HotFace = ":hot_face:"
+	// HotPepper is :hot_pepper:.
+	HotPepper = ":hot_pepper:"
+	// HotSprings is :hot_springs:.
+	HotSprings = ":hot_springs:"
+	// Hotdog is :hotdog:.
+	Hotdog = ":hotdog:"
+	// Hotel is :hotel:.
+	Hotel = ":hotel:"
+	// Hotspot is :hotspot:, an alias of :wireless:.
+	Hotspot = ":wireless:"
+	// Hourglass is :hourglass:, an alias of :time_ticking:.
+	Hourglass = ":time_ticking:"
+	// HourglassDone is :hourglass_done:, an alias of :times_up:.
+	HourglassDone = ":times_up:"
+	// House is :house:.
+	House = ":house:"
+	// Houses is :houses:.
+	Houses = ":houses:"
+	// Hover is :hover:, an alias of :levitating:.
+	Hover = ":levitating:"
+	// Hug is :hug:.
+	Hug = ":hug:"
+	// Hugging is :hugging:, an alias of :people_hugging:.
+	Hugging = ":people_hugging:"
+	// Huh is :huh:, an alias of :pinched_fingers:.
+	Huh = ":pinched_fingers:"
+	// Human is :human:, an alias of :person_blond_hair:.
+	Human = ":person_blond_hair:"
+	// HumpbackWhale is :humpback_whale:.
+	HumpbackWhale = ":humpback_whale:"
+	// Hundred is :hundred:, an alias of :100:.
+	Hundred = ":100:"
+	// Hungry is :hungry:.
+	Hungry = ":hungry:"
+	// Hurricane is :hurricane:, an alias of :cyclone:.
+	Hurricane = ":cyclone:"
+	// Hurt is :hurt:.
+	Hurt = ":hurt:"
+	// Hush is :hush:, an alias of :silence:.
+	Hush = ":silence:"
+	// Hushed is :hushed:.
+	Hushed = ":hushed:"
+	// Hut is :hut:.
+	Hut = ":hut:"
+	// Hyacinth is :hyacinth:.
+	Hyacinth = ":hyacinth:"
+	// Hygiene is :hygiene:, an alias of :toothbrush:.
+	Hygiene = ":toothbrush:"
+	// Hypnotized is :hypnotized:, an alias of :face_with_spiral_eyes:.
+	Hypnotized = ":face_with_spiral_eyes:"
+	// Hóngbāo is :hóngbāo:, an alias of :red_envelope:.
+	Hóngbāo = ":red_envelope:"
+	// Ice is :ice:.
+	Ice = ":ice:"
+	// IceCream is :ice_cream:.
+	IceCream = ":ice_cream:"
+	// IceHockey is :ice_hockey:.
+	IceHockey = ":ice_hockey:"
+	// IceSkate is :ice_skate:.
+	IceSkate = ":ice_skate:"
+	// Iceberg is :iceberg:, an alias of :ice:.
+	Iceberg = ":ice:"
+	// Icicles is :icicles:, an alias of :cold_face:.
+	Icicles = ":cold_face:"
+	// Id is :id:.
+	Id = ":id:"
+	// Idea is :idea:, an alias of :light_bulb:.
+	Idea = ":light_bulb:"
+	// Identification is :identification:, an alias of :identification_card:.
+	Identification = ":identification_card:"
+	// IdentificationCard is :identification_card:.
+	IdentificationCard = ":identification_card:"
+	// Identity is :identity:, an alias of :fingerprint:.
+	Identity = ":fingerprint:"
+	// IdeographAdvantage is :ideograph_advantage:, an alias of :japanese_bargain_button:.
+	IdeographAdvantage = ":japanese_bargain_button:"
+	// Ill is :ill:, an alias of :sick:.
+	Ill = ":sick:"
+	// Immigration is :immigration:, an alias of :passport_control:.
+	Immigration = ":passport_control:"
+	// Immortal is :immortal:, an alias of :phoenix:.
+	Immortal = ":phoenix:"
+	// Imp is :imp:, an alias of :devil:.
+	Imp = ":devil:"
+	// Improving is :improving:, an alias of :mending_heart:.
+	Improving = ":mending_heart:"
+	// InBed is :in_bed:.
+	InBed = ":in_bed:"
+	// InLove is :in_love:, an alias of :heart_eyes:.
+	InLove = ":heart_eyes:"
+	// Inbox is :inbox:.
+	Inbox = ":inbox:"
+	// InboxZero is :inbox_zero:.
+	InboxZero = ":inbox_zero:"
+	// Incognito is :incognito:, an alias of :disguised_face:.
+	Incognito = ":disguised_face:"
+	// Incorrect is :incorrect:, an alias of :cross_mark:.
+	Incorrect = ":cross_mark:"
+	// Increase is :increase:, an alias of :upvote:.
+	Increase = ":upvote:"
+	// Increasing is :increasing:, an alias of :chart:.
+	Increasing = ":chart:"
+	// Indecisive is :indecisive:, an alias of :waffle:.
+	Indecisive = ":waffle:"
+	// IndexPointingAtTheViewer is :index_pointing_at_the_viewer:.
+	IndexPointingAtTheViewer = ":index_pointing_at_the_viewer:"
+	// Indigo is :indigo:, an alias of :hyacinth:.
+	Indigo = ":hyacinth:"
+	// Infinity is :infinity:.
+	Infinity = ":infinity:"
+	// Info is :info:.
+	Info = ":info:"
+	// InformationDeskPerson is :information_desk_person:.
+	InformationDeskPerson = ":information_desk_person:"
+	// Inhalation is :inhalation:, an alias of :lungs:.
+	Inhalation = ":lungs:"
+	// Injection is :injection:.
+	Injection = ":injection:"
+	// Injured is :injured:, an alias of :hurt:.
+	Injured = ":hurt:"
+	// Injury is :injury:, an alias of :drop_of_blood:.
+	Injury = ":drop_of_blood:"
+	// Ink is :ink:, an alias of :splatter:.
+	Ink = ":splatter:"
+	// Innocent is :innocent:.
+	Innocent = ":innocent:"
+	// Intelligent is :intelligent:, an alias of :brain:.
+	Intelligent = ":brain:"
+	// Interlocking is :interlocking:, an alias of :puzzle_piece:.
+	Interlocking = ":puzzle_piece:"
+	// Internet is :internet:, an alias of :wireless:.
+	Internet = ":wireless:"
+	// Interrobang is :interrobang:.
+	Interrobang = ":interrobang:"
+	// Interrogation is :interrogation:, an alias of :pinched_fingers:.
+	Interrogation = ":pinched_fingers:"
+	// Intoxicated is :intoxicated:, an alias of :woozy_face:.
+	Intoxicated = ":woozy_face:"
+	// Introvert is :introvert:, an alias of :dotted_line_face:.
+	Introvert = ":dotted_line_face:"
+	// Invertebrate is :invertebrate:, an alias of :jellyfish:.
+	Invertebrate = ":jellyfish:"
+	// Invincible is :invincible:, an alias of :helmet:.
+	Invincible = ":helmet:"
+	// Invisible is :invisible:, an alias of :dotted_line_face:.
+	Invisible = ":dotted_line_face:"
+	// Invoice is :invoice:, an alias of :receipt:.
+	Invoice = ":receipt:"
+	// Iphone is :iphone:, an alias of :mobile_phone:.
+	Iphone = ":mobile_phone:"
+	// Iron is :iron:, an alias of :waffle:.
+	Iron = ":waffle:"
+	// Islam is :islam:, an alias of :star_and_crescent:.
+	Islam = ":star_and_crescent:"
+	// Island is :island:.
+	Island = ":island:"
+	// IzakayaLantern is :izakaya_lantern:, an alias of :lantern:.
+	IzakayaLantern = ":lantern:"
+	// JackOLantern is :jack-o-lantern:.
+	JackOLantern = ":jack-o-lantern:"
+	// Japan is :japan:.
+	Japan = ":japan:"
+	// JapanPost is :japan_post:.
+	JapanPost = ":japan_post:"
+	// JapaneseAcceptableButton is :japanese_acceptable_button:.
+	JapaneseAcceptableButton = ":japanese_acceptable_button:"
+	// JapaneseApplicationButton is :japanese_application_button:.
+	JapaneseApplicationButton = ":japanese_application_button:"
+	// JapaneseBargainButton is :japanese_bargain_button:.
+	JapaneseBargainButton = ":japanese_bargain_button:"
+	// JapaneseCongratulationsButton is :japanese_congratulations_button:.
+	JapaneseCongratulationsButton = ":japanese_congratulations_button:"
+	// JapaneseDiscountButton is :japanese_discount_button:.
+	JapaneseDiscountButton = ":japanese_discount_button:"
+	// JapaneseFreeOfChargeButton is :japanese_free_of_charge_button:.
+	JapaneseFreeOfChargeButton = ":japanese_free_of_charge_button:"
+	// JapaneseHereButton is :japanese_here_button:.
+	JapaneseHereButton = ":japanese_here_button:"
+	// JapaneseMonthlyAmountButton is :japanese_monthly_amount_button:.
+	JapaneseMonthlyAmountButton = ":japanese_monthly_amount_button:"
+	// JapaneseNoVacancyButton is :japanese_no_vacancy_button:.
+	JapaneseNoVacancyButton = ":japanese_no_vacancy_button:"
+	// JapaneseNotFreeOfChargeButton is :japanese_not_free_of_charge_button:.
+	JapaneseNotFreeOfChargeButton = ":japanese_not_free_of_charge_button:"
+	// JapaneseOpenForBusinessButton is :japanese_open_for_business_button:.
+	JapaneseOpenForBusinessButton = ":japanese_open_for_business_button:"
+	// JapanesePassingGradeButton is :japanese_passing_grade_button:.
+	JapanesePassingGradeButton = ":japanese_passing_grade_button:"
+	// JapaneseProhibitedButton is :japanese_prohibited_button:.
+	JapaneseProhibitedButton = ":japanese_prohibited_button:"
+	// JapaneseReservedButton is :japanese_reserved_button:.
+	JapaneseReservedButton = ":japanese_reserved_button:"
+	// JapaneseSecretButton is :japanese_secret_button:.
+	JapaneseSecretButton = ":japanese_secret_button:"
+	// JapaneseServiceChargeButton is :japanese_service_charge_button:.
+	JapaneseServiceChargeButton = ":japanese_service_charge_button:"
+	// JapaneseVacancyButton is :japanese_vacancy_button:.
+	JapaneseVacancyButton = ":japanese_vacancy_button:"
+	// Jar is :jar:.
+	Jar = ":jar:"
+	// Jeans is :jeans:.
+	Jeans = ":jeans:"
+	// Jeep is :jeep:, an alias of :recreational_vehicle:.
+	Jeep = ":recreational_vehicle:"
+	// Jelly is :jelly:, an alias of :jellyfish:.
+	Jelly = ":jellyfish:"
+	// Jellyfish is :jellyfish:.
+	Jellyfish = ":jellyfish:"
+	// Jeotgarak is :jeotgarak:, an alias of :chopsticks:.
+	Jeotgarak = ":chopsticks:"
+	// Jewish is :jewish:, an alias of :star_of_david:.
+	Jewish = ":star_of_david:"
+	// Jiaozi is :jiaozi:, an alias of :dumpling:.
+	Jiaozi = ":dumpling:"
+	// Jigsaw is :jigsaw:, an alias of :puzzle_piece:.
+	Jigsaw = ":puzzle_piece:"
+	// Joey is :joey:, an alias of :kangaroo:.
+	Joey = ":kangaroo:"
+	// Joker is :joker:.
+	Joker = ":joker:"
+	// Joking is :joking:, an alias of :stuck_out_tongue_wink:.
+	Joking = ":stuck_out_tongue_wink:"
+	// Jolly is :jolly:, an alias of :pirate_flag:.
+	Jolly = ":pirate_flag:"
+	// Joy is :joy:.
+	Joy = ":joy:"
+	// JoyCat is :joy_cat:.
+	JoyCat = ":joy_cat:"
+	// Joystick is :joystick:.
+	Joystick = ":joystick:"
+	// Judaism is :judaism:, an alias of :star_of_david:.
+	Judaism = ":star_of_david:"
+	// Judge is :judge:.
+	Judge = ":judge:"
+	// Juggling is :juggling:.
+	Juggling = ":juggling:"
+	// Jump is :jump:, an alias of :kangaroo:.
+	Jump = ":kangaroo:"
+	// Justice is :justice:.
+	Justice = ":justice:"
+	// Kaaba is :kaaba:.
+	Kaaba = ":kaaba:"
+	// Kaching is :kaching:, an alias of :money_face:.
+	Kaching = ":money_face:"
+	// Kale is :kale:, an alias of :leafy_green:.
+	Kale = ":leafy_green:"
+	// Kangaroo is :kangaroo:.
+	Kangaroo = ":kangaroo:"
+	// Kawaii is :kawaii:, an alias of :cute:.
+	Kawaii = ":cute:"
+	// Keikogi is :keikogi:, an alias of :black_belt:.
+	Keikogi = ":black_belt:"
+	// Kerchief is :kerchief:, an alias of :woman_with_headscarf:.
+	Kerchief = ":woman_with_headscarf:"
+	// Key is :key:.
+	Key = ":key:"
+	// KeySigning is :key_signing:, an alias of :privacy:.
+	KeySigning = ":privacy:"
+	// Keyboard is :keyboard:.
+	Keyboard = ":keyboard:"
+	// Khalsa is :khalsa:, an alias of :khanda:.
+	Khalsa = ":khanda:"
+	// Khanda is :khanda:.
+	Khanda = ":khanda:"
+	// KickScooter is :kick_scooter:.
+	KickScooter = ":kick_scooter:"
+	// Kid is :kid:, an alias of :child:.
+	Kid = ":child:"
+	// Kidney is :kidney:, an alias of :beans:.
+	Kidney = ":beans:"
+	// Kimono is :kimono:.
+	Kimono = ":kimono:"
+	// King is :king:, an alias of :crown:.
+	King = ":crown:"
+	// Kiss is :kiss:.
+	Kiss = ":kiss:"
+	// KissManMan is :kiss_man_man:.
+	KissManMan = ":kiss_man_man:"
+	// KissSmilingEyes is :kiss_smiling_eyes:.
+	KissSmilingEyes = ":kiss_smiling_eyes:"
+	// KissWithBlush is :kiss_with_blush:.
+	KissWithBlush = ":kiss_with_blush:"
+	// KissWomanMan is :kiss_woman_man:.
+	KissWomanMan = ":kiss_woman_man:"
+	// KissWomanWoman is :kiss_woman_woman:.
+	KissWomanWoman = ":kiss_woman_woman:"
+	// KissingCat is :kissing_cat:.
+	KissingCat = ":kissing_cat:"
+	// KissingFace is :kissing_face:.
+	KissingFace = ":kissing_face:"
+	// Kite is :kite:.
+	Kite = ":kite:"
+	// Kitten is :kitten:.
+	Kitten = ":kitten:"
+	// Kiwi is :kiwi:.
+	Kiwi = ":kiwi:"
+	// Knee is :knee:, an alias of :leg:.
+	Knee = ":leg:"
+	// Knife is :knife:.
+	Knife = ":knife:"
+	// Knit is :knit:, an alias of :yarn:.
+	Knit = ":yarn:"
+	// Knot is :knot:.
+	Knot = ":knot:"
+	// Koala is :koala:.
+	Koala = ":koala:"
+	// Kuaizi is :kuaizi:, an alias of :chopsticks:.
+	Kuaizi = ":chopsticks:"
+	// LabCoat is :lab_coat:.
+	LabCoat = ":lab_coat:"
+	// Label is :label:.
+	Label = ":label:"
+	// Lacrosse is :lacrosse:.
+	Lacrosse = ":lacrosse:"
+	// Ladder is :ladder:.
+	Ladder = ":ladder:"
+	// LadyBeetle is :lady_beetle:.
+	LadyBeetle = ":lady_beetle:"
+	// Ladybird is :ladybird:, an alias of :lady_beetle:.
+	Ladybird = ":lady_beetle:"
+	// Ladybug is :ladybug:, an alias of :lady_beetle:.
+	Ladybug = ":lady_beetle:"
+	// Lai is :lai:, an alias of :red_envelope:.
+	Lai = ":red_envelope:"
+	// Lambchop is :lambchop:, an alias of :cut_of_meat:.
+	Lambchop = ":cut_of_meat:"
+	// Landing is :landing:.
+	Landing = ":landing:"
+	// Landline is :landline:.
+	Landline = ":landline:"
+	// Lantern is :lantern:.
+	Lantern = ":lantern:"
+	// Laptop is :laptop:, an alias of :computer:.
+	Laptop = ":computer:"
+	// LargeBlueDiamond is :large_blue_diamond:.
+	LargeBlueDiamond = ":large_blue_diamond:"
+	// LargeOrangeDiamond is :large_orange_diamond:.
+	LargeOrangeDiamond = ":large_orange_diamond:"
+	// LastQuarterMoon is :last_quarter_moon:.
+	LastQuarterMoon = ":last_quarter_moon:"
+	// LastQuarterMoonFace is :last_quarter_moon_face:.
+	LastQuarterMoonFace = ":last_quarter_moon_face:"
+	// Late is :late:, an alias of :face_with_bags_under_eyes:.
+	Late = ":face_with_bags_under_eyes:"
+	// Lather is :lather:, an alias of :soap:.
+	Lather = ":soap:"
+	// Laughing is :laughing:.
+	Laughing = ":laughing:"
+	// LaughterTears is :laughter_tears:, an alias of :joy:.
+	LaughterTears = ":joy:"
+	// Laundry is :laundry:, an alias of :basket:.
+	Laundry = ":basket:"
+	// Lavash is :lavash:, an alias of :flatbread:.
+	Lavash = ":flatbread:"
+	// Lavender is :lavender:, an alias of :hyacinth:.
+	Lavender = ":hyacinth:"
+	// Lazy is :lazy:, an alias of :sloth:.
+	Lazy = ":sloth:"
+	// Leafless is :leafless:, an alias of :leafless_tree:.
+	Leafless = ":leafless_tree:"
+	// LeaflessTree is :leafless_tree:.
+	LeaflessTree = ":leafless_tree:"
+	// Leafy is :leafy:, an alias of :leafy_green:.
+	Leafy = ":leafy_green:"
+	// LeafyGreen is :leafy_green:.
+	LeafyGreen = ":leafy_green:"
+	// Leaves is :leaves:.
+	Leaves = ":leaves:"
+	// Ledger is :ledger:.
+	Ledger = ":ledger:"
+	// Left is :left:.
+	Left = ":left:"
+	// LeftFist is :left_fist:.
+	LeftFist = ":left_fist:"
+	// LeftHook is :left_hook:, an alias of :reply:.
+	LeftHook = ":reply:"
+	// LeftRight is :left_right:.
+	LeftRight = ":left_right:"
+	// LeftwardsHand is :leftwards_hand:.
+	LeftwardsHand = ":leftwards_hand:"
+	// LeftwardsPushingHand is :leftwards_pushing_hand:.
+	LeftwardsPushingHand = ":leftwards_pushing_hand:"
+	// Leg is :leg:.
+	Leg = ":leg:"
+	// Lemon is :lemon:.
+	Lemon = ":lemon:"
+	// Leo is :leo:.
+	Leo = ":leo:"
+	// Leopard is :leopard:.
+	Leopard = ":leopard:"
+	// Lesbian is :lesbian:, an alias of :rainbow_flag:.
+	Lesbian = ":rainbow_flag:"
+	// LetsEat is :lets_eat:, an alias of :hungry:.
+	LetsEat = ":hungry:"
+	// Lettuce is :lettuce:, an alias of :leafy_green:.
+	Lettuce = ":leafy_green:"
+	// LevelSlider is :level_slider:, an alias of :volume:.
+	LevelSlider = ":volume:"
+	// Levitating is :levitating:.
+	Levitating = ":levitating:"
+	// Lgbt is :lgbt:, an alias of :rainbow_flag:.
+	Lgbt = ":rainbow_flag:"
+	// Lgbtq is :lgbtq:, an alias of :rainbow:.
+	Lgbtq = ":rainbow:"
+	// Lgbtqia is :lgbtqia:, an alias of :rainbow_flag:.
+	Lgbtqia = ":rainbow_flag:"
+	// Libra is :libra:.
+	Libra = ":libra:"
+	// License is :license:, an alias of :identification_card:.
+	License = ":identification_card:"
+	// Lifesaver is :lifesaver:, an alias of :ring_buoy:.
+	Lifesaver = ":ring_buoy:"
+	// Lifestyles is :lifestyles:, an alias of :living_room:.
+	Lifestyles = ":living_room:"
+	// Lift is :lift:.
+	Lift = ":lift:"
+	// LightBlueHeart is :light_blue_heart:.
+	LightBlueHeart = ":light_blue_heart:"
+	// LightBulb is :light_bulb:.
+	LightBulb = ":light_bulb:"
+	// LightRail is :light_rail:.
+	LightRail = ":light_rail:"
+	// Lightning is :lightning:.
+	Lightning = ":lightning:"
+	// LightningStorm is :lightning_storm:, an alias of :lightning:.
+	LightningStorm = ":lightning:"
+	// Like is :like:, an alias of :+1:.
+	Like = ":+1:"
+	// Lilac is :lilac:, an alias of :hyacinth:.
+	Lilac = ":hyacinth:"
+	// Limb is :limb:, an alias of :leg:.
+	Limb = ":leg:"
+	// Lime is :lime:.
+	Lime = ":lime:"
+	// Line is :line:, an alias of :dotted_line_face:.
+	Line = ":dotted_line_face:"
+	// Link is :link:.
+	Link = ":link:"
+	// Linked is :linked:, an alias of :office_supplies:.
+	Linked = ":office_supplies:"
+	// Lion is :lion:.
+	Lion = ":lion:"
+	// Lip is :lip:, an alias of :biting_lip:.
+	Lip = ":biting_lip:"
+	// Lips is :lips:.
+	Lips = ":lips:"
+	// LipsAreSealed is :lips_are_sealed:, an alias of :silence:.
+	LipsAreSealed = ":silence:"
+	// Lipstick is :lipstick:.
+	Lipstick = ":lipstick:"
+	// LipstickKiss is :lipstick_kiss:.
+	LipstickKiss = ":lipstick_kiss:"
+	// Lit is :lit:, an alias of :fire:.
+	Lit = ":fire:"
+	// Little is :little:, an alias of :pinching_hand:.
+	Little = ":pinching_hand:"
+	// LiveLongAndProsper is :live_long_and_prosper:, an alias of :spock:.
+	LiveLongAndProsper = ":spock:"
+	// LivingRoom is :living_room:.
+	LivingRoom = ":living_room:"
+	// Lizard is :lizard:.
+	Lizard = ":lizard:"
+	// Llama is :llama:.
+	Llama = ":llama:"
+	// Lobster is :lobster:.
+	Lobster = ":lobster:"
+	// LockWithKey is :lock_with_key:, an alias of :secure:.
+	LockWithKey = ":secure:"
+	// Locked is :locked:.
+	Locked = ":locked:"
+	// LockedBag is :locked_bag:, an alias of :locker:.
+	LockedBag = ":locker:"
+	// Locker is :locker:.
+	Locker = ":locker:"
+	// Log is :log:, an alias of :wood:.
+	Log = ":wood:"
+	// Lol is :lol:, an alias of :laughing:.
+	Lol = ":laughing:"
+	// Lollipop is :lollipop:.
+	Lollipop = ":lollipop:"
+	// Long is :long:, an alias of :long_drum:.
+	Long = ":long_drum:"
+	// LongDrum is :long_drum:.
+	LongDrum = ":long_drum:"
+	// Looking is :looking:, an alias of :eyes:.
+	Looking = ":eyes:"
+	// Loop is :loop:.
+	Loop = ":loop:"
+	// LosingMoney is :losing_money:.
+	LosingMoney = ":losing_money:"
+	// Lotion is :lotion:, an alias of :lotion_bottle:.
+	Lotion = ":lotion_bottle:"
+	// LotionBottle is :lotion_bottle:.
+	LotionBottle = ":lotion_bottle:"
+	// Lotus is :lotus:.
+	Lotus = ":lotus:"
+	// Louder is :louder:.
+	Louder = ":louder:"
+	// Loudspeaker is :loudspeaker:.
+	Loudspeaker = ":loudspeaker:"
+	// Love is :love:, an alias of :heart:.
+	Love = ":heart:"
+	// LoveHotel is :love_hotel:.
+	LoveHotel = ":love_hotel:"
+	// LoveLetter is :love_letter:.
+	LoveLetter = ":love_letter:"
+	// LoveYou is :love_you:, an alias of :heart:.
+	LoveYou = ":heart:"
+	// LoveYouGesture is :love_you_gesture:.
+	LoveYouGesture = ":love_you_gesture:"
+	// Low is :low:, an alias of :low_battery:.
+	Low = ":low_battery:"
+	// LowBattery is :low_battery:.
+	LowBattery = ":low_battery:"
+	// LowBrightness is :low_brightness:.
+	LowBrightness = ":low_brightness:"
+	// LowerLeft is :lower_left:.
+	LowerLeft = ":lower_left:"
+	// LowerRight is :lower_right:.
+	LowerRight = ":lower_right:"
+	// Loyalty is :loyalty:, an alias of :secure:.
+	Loyalty = ":secure:"
+	// Lucky is :lucky:.
+	Lucky = ":lucky:"
+	// Luge is :luge:, an alias of :sled:.
+	Luge = ":sled:"
+	// Luggage is :luggage:.
+	Luggage = ":luggage:"
+	// Lung is :lung:, an alias of :lungs:.
+	Lung = ":lungs:"
+	// Lungs is :lungs:.
+	Lungs = ":lungs:"
+	// Lupine is :lupine:, an alias of :hyacinth:.
+	Lupine = ":hyacinth:"
+	// Lure is :lure:, an alias of :mouse_trap:.
+	Lure = ":mouse_trap:"
+	// Lust is :lust:, an alias of :heart_on_fire:.
+	Lust = ":heart_on_fire:"
+	// Lying is :lying:.
+	Lying = ":lying:"
+	// M is :m:, an alias of :metro:.
+	M = ":metro:"
+	// Maam is :maam:, an alias of :saluting_face:.
+	Maam = ":saluting_face:"
+	// Mad is :mad:, an alias of :rage:.
+	Mad = ":rage:"
+	// Mage is :mage:.
+	Mage = ":mage:"
+	// Maggot is :maggot:, an alias of :fly:.
+	Maggot = ":fly:"
+	// MagicWand is :magic_wand:.
+	MagicWand = ":magic_wand:"
+	// Magician is :magician:, an alias of :magic_wand:.
+	Magician = ":magic_wand:"
+	// Magnet is :magnet:.
+	Magnet = ":magnet:"
+	// Magnifying is :magnifying:, an alias of :magnifying_glass_tilted_right:.
+	Magnifying = ":magnifying_glass_tilted_right:"
+	// MagnifyingGlass is :magnifying_glass:, an alias of :search:.
+	MagnifyingGlass = ":search:"
+	// MagnifyingGlassTiltedRight is :magnifying_glass_tilted_right:.
+	MagnifyingGlassTiltedRight = ":magnifying_glass_tilted_right:"
+	// Mahjong is :mahjong:.
+	Mahjong = ":mahjong:"
+	// Mail is :mail:, an alias of :email:.
+	Mail = ":email:"
+	// MailDropoff is :mail_dropoff:.
+	MailDropoff = ":mail_dropoff:"
+	// MailReceived is :mail_received:.
+	MailReceived = ":mail_received:"
+	// MailSent is :mail_sent:.
+	MailSent = ":mail_sent:"
+	// Mailbox is :mailbox:.
+	Mailbox = ":mailbox:"
+	// Maintenance is :maintenance:, an alias of :hammer:.
+	Maintenance = ":hammer:"
+	// Maize is :maize:, an alias of :corn:.
+	Maize = ":corn:"
+	// Makeup is :makeup:, an alias of :mirror:.
+	Makeup = ":mirror:"
+	// Malaria is :malaria:, an alias of :mosquito:.
+	Malaria = ":mosquito:"
+	// Male is :male:, an alias of :male_sign:.
+	Male = ":male_sign:"
+	// MaleSign is :male_sign:.
+	MaleSign = ":male_sign:"
+	// Malt is :malt:, an alias of :cup_with_straw:.
+	Malt = ":cup_with_straw:"
+	// Mammoth is :mammoth:.
+	Mammoth = ":mammoth:"
+	// Man is :man:.
+	Man = ":man:"
+	// ManAndWomanCouple is :man_and_woman_couple:, an alias of :man_and_woman_holding_hands:.
+	ManAndWomanCouple = ":man_and_woman_holding_hands:"
+	// ManAndWomanHoldingHands is :man_and_woman_holding_hands:.
+	ManAndWomanHoldingHands = ":man_and_woman_holding_hands:"
+	// ManArtist is :man_artist:.
+	ManArtist = ":man_artist:"
+	// ManAstronaut is :man_astronaut:.
+	ManAstronaut = ":man_astronaut:"
+	// ManBald is :man_bald:.
+	ManBald = ":man_bald:"
+	// ManBeard is :man_beard:.
+	ManBeard = ":man_beard:"
+	// ManBiking is :man_biking:.
+	ManBiking = ":man_biking:"
+	// ManBlondHair is :man_blond_hair:.
+	ManBlondHair = ":man_blond_hair:"
+	// ManBouncingBall is :man_bouncing_ball:.
+	ManBouncingBall = ":man_bouncing_ball:"
+	// ManBowing is :man_bowing:.
+	ManBowing = ":man_bowing:"
+	// ManCartwheeling is :man_cartwheeling:.
+	ManCartwheeling = ":man_cartwheeling:"
+	// ManClimbing is :man_climbing:.
+	ManClimbing = ":man_climbing:"
+	// ManConstructionWorker is :man_construction_worker:.
+	ManConstructionWorker = ":man_construction_worker:"
+	// ManCook is :man_cook:.
+	ManCook = ":man_cook:"
+	// ManCurlyHair is :man_curly_hair:.
+	ManCurlyHair = ":man_curly_hair:"
+	// ManDetective is :man_detective:.
+	ManDetective = ":man_detective:"
+	// ManElf is :man_elf:.
+	ManElf = ":man_elf:"
+	// ManFacepalming is :man_facepalming:.
+	ManFacepalming = ":man_facepalming:"
+	// ManFactoryWorker is :man_factory_worker:.
+	ManFactoryWorker = ":man_factory_worker:"
+	// ManFairy is :man_fairy:.
+	ManFairy = ":man_fairy:"
+	// ManFarmer is :man_farmer:.
+	ManFarmer = ":man_farmer:"
+	// ManFeedingBaby is :man_feeding_baby:.
+	ManFeedingBaby = ":man_feeding_baby:"
+	// ManFirefighter is :man_firefighter:.
+	ManFirefighter = ":man_firefighter:"
+	// ManFrowning is :man_frowning:.
+	ManFrowning = ":man_frowning:"
+	// ManGenie is :man_genie:.
+	ManGenie = ":man_genie:"
+	// ManGesturingNo is :man_gesturing_no:.
+	ManGesturingNo = ":man_gesturing_no:"
+	// ManGesturingOk is :man_gesturing_ok:.
+	ManGesturingOk = ":man_gesturing_ok:"
+	// ManGettingHaircut is :man_getting_haircut:.
+	ManGettingHaircut = ":man_getting_haircut:"
+	// ManGettingMassage is :man_getting_massage:.
+	ManGettingMassage = ":man_getting_massage:"
+	// ManGolfing is :man_golfing:.
+	ManGolfing = ":man_golfing:"
+	// ManGuard is :man_guard:.
+	ManGuard = ":man_guard:"
+	// ManHealthWorker is :man_health_worker:.
+	ManHealthWorker = ":man_health_worker:"
+	// ManInLotusPosition is :man_in_lotus_position:.
+	ManInLotusPosition = ":man_in_lotus_position:"
+	// ManInManualWheelchair is :man_in_manual_wheelchair:.
+	ManInManualWheelchair = ":man_in_manual_wheelchair:"
+	// ManInManualWheelchairFacingRight is :man_in_manual_wheelchair_facing_right:.
+	ManInManualWheelchairFacingRight = ":man_in_manual_wheelchair_facing_right:"
+	// ManInMotorizedWheelchair is :man_in_motorized_wheelchair:.
+	ManInMotorizedWheelchair = ":man_in_motorized_wheelchair:"
+	// ManInMotorizedWheelchairFacingRight is :man_in_motorized_wheelchair_facing_right:.
+	ManInMotorizedWheelchairFacingRight = ":man_in_motorized_wheelchair_facing_right:"
+	// ManInSteamyRoom is :man_in_steamy_room:.
+	ManInSteamyRoom = ":man_in_steamy_room:"
+	// ManInTuxedo is :man_in_tuxedo:.
+	ManInTuxedo = ":man_in_tuxedo:"
+	// ManJudge is :man_judge:.
+	ManJudge = ":man_judge:"
+	// ManJuggling is :man_juggling:.
+	ManJuggling = ":man_juggling:"
+	// ManKneeling is :man_kneeling:.
+	ManKneeling = ":man_kneeling:"
+	// ManKneelingFacingRight is :man_kneeling_facing_right:.
+	ManKneelingFacingRight = ":man_kneeling_facing_right:"
+	// ManLiftingWeights is :man_lifting_weights:.
+	ManLiftingWeights = ":man_lifting_weights:"
+	// ManMage is :man_mage:.
+	ManMage = ":man_mage:"
+	// ManMechanic is :man_mechanic:.
+	ManMechanic = ":man_mechanic:"
+	// ManMountainBiking is :man_mountain_biking:.
+	ManMountainBiking = ":man_mountain_biking:"
+	// ManOfficeWorker is :man_office_worker:.
+	ManOfficeWorker = ":man_office_worker:"
+	// ManPilot is :man_pilot:.
+	ManPilot = ":man_pilot:"
+	// ManPlayingHandball is :man_playing_handball:.
+	ManPlayingHandball = ":man_playing_handball:"
+	// ManPlayingWaterPolo is :man_playing_water_polo:.
+	ManPlayingWaterPolo = ":man_playing_water_polo:"
+	// ManPoliceOfficer is :man_police_officer:.
+	ManPoliceOfficer = ":man_police_officer:"
+	// ManPouting is :man_pouting:.
+	ManPouting = ":man_pouting:"
+	// ManRaisingHand is :man_raising_hand:.
+	ManRaisingHand = ":man_raising_hand:"
+	// ManRedHair is :man_red_hair:.
+	ManRedHair = ":man_red_hair:"
+	// ManRowingBoat is :man_rowing_boat:.
+	ManRowingBoat = ":man_rowing_boat:"
+	// ManRunning is :man_running:.
+	ManRunning = ":man_running:"
+	// ManRunningFacingRight is :man_running_facing_right:.
+	ManRunningFacingRight = ":man_running_facing_right:"
+	// ManScientist is :man_scientist:.
+	ManScientist = ":man_scientist:"
+	// ManShrugging is :man_shrugging:.
+	ManShrugging = ":man_shrugging:"
+	// ManSinger is :man_singer:.
+	ManSinger = ":man_singer:"
+	// ManStanding is :man_standing:.
+	ManStanding = ":man_standing:"
+	// ManStudent is :man_student:.
+	ManStudent = ":man_student:"
+	// ManSuperhero is :man_superhero:.
+	ManSuperhero = ":man_superhero:"
+	// ManSupervillain is :man_supervillain:.
+	ManSupervillain = ":man_supervillain:"
+	// ManSurfing is :man_surfing:.
+	ManSurfing = ":man_surfing:"
+	// ManSwimming is :man_swimming:.
+	ManSwimming = ":man_swimming:"
+	// ManTeacher is :man_teacher:.
+	ManTeacher = ":man_teacher:"
+	// ManTechnologist is :man_technologist:.
+	ManTechnologist = ":man_technologist:"
+	// ManTippingHand is :man_tipping_hand:.
+	ManTippingHand = ":man_tipping_hand:"
+	// ManVampire is :man_vampire:.
+	ManVampire = ":man_vampire:"
+	// ManWalking is :man_walking:.
+	ManWalking = ":man_walking:"
+	// ManWalkingFacingRight is :man_walking_facing_right:.
+	ManWalkingFacingRight = ":man_walking_facing_right:"
+	// ManWearingTurban is :man_wearing_turban:.
+	ManWearingTurban = ":man_wearing_turban:"
+	// ManWhiteHair is :man_white_hair:.
+	ManWhiteHair = ":man_white_hair:"
+	// ManWithVeil is :man_with_veil:.
+	ManWithVeil = ":man_with_veil:"
+	// ManWithWhiteCane is :man_with_white_cane:.
+	ManWithWhiteCane = ":man_with_white_cane:"
+	// ManWithWhiteCaneFacingRight is :man_with_white_cane_facing_right:.
+	ManWithWhiteCaneFacingRight = ":man_with_white_cane_facing_right:"
+	// ManZombie is :man_zombie:.
+	ManZombie = ":man_zombie:"
+	// Mandarin is :mandarin:, an alias of :orange:.
+	Mandarin = ":orange:"
+	// Mango is :mango:.
+	Mango = ":mango:"
+	// MantelpieceClock is :mantelpiece_clock:.
+	MantelpieceClock = ":mantelpiece_clock:"
+	// Mantilla is :mantilla:, an alias of :woman_with_headscarf:.
+	Mantilla = ":woman_with_headscarf:"
+	// ManualWheelchair is :manual_wheelchair:.
+	ManualWheelchair = ":manual_wheelchair:"
+	// Map is :map:.
+	Map = ":map:"
+	// MapleLeaf is :maple_leaf:.
+	MapleLeaf = ":maple_leaf:"
+	// Maracas is :maracas:.
+	Maracas = ":maracas:"
+	// Marching is :marching:, an alias of :flute:.
+	Marching = ":flute:"
+	// Margarita is :margarita:, an alias of :lime:.
+	Margarita = ":lime:"
+	// Marine is :marine:, an alias of :jellyfish:.
+	Marine = ":jellyfish:"
+	// Marsupial is :marsupial:, an alias of :kangaroo:.
+	Marsupial = ":kangaroo:"
+	// MartialArts is :martial_arts:, an alias of :black_belt:.
+	MartialArts = ":black_belt:"
+	// Mary is :mary:, an alias of :hamsa:.
+	Mary = ":hamsa:"
+	// Mask is :mask:.
+	Mask = ":mask:"
+	// Massage is :massage:.
+	Massage = ":massage:"
+	// Mate is :mate:.
+	Mate = ":mate:"
+	// Math is :math:, an alias of :heavy_equals_sign:.
+	Math = ":heavy_equals_sign:"
+	// Matryoshka is :matryoshka:, an alias of :nesting_dolls:.
+	Matryoshka = ":nesting_dolls:"
+	// Mayo is :mayo:, an alias of :piñata:.
+	Mayo = ":piñata:"
+	// Meal is :meal:, an alias of :hungry:.
+	Meal = ":hungry:"
+	// Meat is :meat:.
+	Meat = ":meat:"
+	// Meatball is :meatball:, an alias of :falafel:.
+	Meatball = ":falafel:"
+	// Mechanic is :mechanic:.
+	Mechanic = ":mechanic:"
+	// Mechanical is :mechanical:, an alias of :gear:.
+	Mechanical = ":gear:"
+	// MechanicalArm is :mechanical_arm:.
+	MechanicalArm = ":mechanical_arm:"
+	// MechanicalLeg is :mechanical_leg:.
+	MechanicalLeg = ":mechanical_leg:"
+	// Medal is :medal:.
+	Medal = ":medal:"
+	// MedicalSymbol is :medical_symbol:.
+	MedicalSymbol = ":medical_symbol:"
+	// Medicine is :medicine:.
+	Medicine = ":medicine:"
+	// Megaphone is :megaphone:.
+	Megaphone = ":megaphone:"
+	// Melon is :melon:.
+	Melon = ":melon:"
+	// Melt is :melt:, an alias of :melting_face:.
+	Melt = ":melting_face:"
+	// Melted is :melted:, an alias of :fondue:.
+	Melted = ":fondue:"
+	// Melting is :melting:, an alias of :melting_face:.
+	Melting = ":melting_face:"
+	// MeltingFace is :melting_face:.
+	MeltingFace = ":melting_face:"
+	// Memo is :memo:.
+	Memo = ":memo:"
+	// Memorial is :memorial:, an alias of :headstone:.
+	Memorial = ":headstone:"
+	// MenCouple is :men_couple:, an alias of :two_men_holding_hands:.
+	MenCouple = ":two_men_holding_hands:"
+	// MenWithBunnyEars is :men_with_bunny_ears:.
+	MenWithBunnyEars = ":men_with_bunny_ears:"
+	// MenWrestling is :men_wrestling:.
+	MenWrestling = ":men_wrestling:"
+	// Mending is :mending:, an alias of :mending_heart:.
+	Mending = ":mending_heart:"
+	// MendingHeart is :mending_heart:.
+	MendingHeart = ":mending_heart:"
+	// Menorah is :menorah:.
+	Menorah = ":menorah:"
+	// Mens is :mens:.
+	Mens = ":mens:"
+	// Menstruation is :menstruation:, an alias of :drop_of_blood:.
+	Menstruation = ":drop_of_blood:"
+	// Meow is :meow:, an alias of :cat:.
+	Meow = ":cat:"
+	// Mercy is :mercy:, an alias of :pleading_face:.
+	Mercy = ":pleading_face:"
+	// Mermaid is :mermaid:.
+	Mermaid = ":mermaid:"
+	// Merman is :merman:.
+	Merman = ":merman:"
+	// Merperson is :merperson:.
+	Merperson = ":merperson:"
+	// Merry is :merry:, an alias of :mx_claus:.
+	Merry = ":mx_claus:"
+	// MerryGoRound is :merry_go_round:, an alias of :carousel:.
+	MerryGoRound = ":carousel:"
+	// Merwoman is :merwoman:, an alias of :mermaid:.
+	Merwoman = ":mermaid:"
+	// Mess is :mess:, an alias of :splatter:.
+	Mess = ":splatter:"
+	// Metal is :metal:, an alias of :coin:.
+	Metal = ":coin:"
+	// Meteor is :meteor:, an alias of :comet:.
+	Meteor = ":comet:"
+	// Metro is :metro:.
+	Metro = ":metro:"
+	// Mexican is :mexican:, an alias of :tamale:.
+	Mexican = ":tamale:"
+	// Mic is :mic:, an alias of :microphone:.
+	Mic = ":microphone:"
+	// Microbe is :microbe:.
+	Microbe = ":microbe:"
+	// Microphone is :microphone:.
+	Microphone = ":microphone:"
+	// Microscope is :microscope:, an alias of :science:.
+	Microscope = ":science:"
+	// MiddleFinger is :middle_finger:.
+	MiddleFinger = ":middle_finger:"
+	// Mike is :mike:, an alias of :microphone:.
+	Mike = ":microphone:"
+	// Mild is :mild:, an alias of :face_with_raised_eyebrow:.
+	Mild = ":face_with_raised_eyebrow:"
+	// Military is :military:, an alias of :military_helmet:.
+	Military = ":military_helmet:"
+	// MilitaryHelmet is :military_helmet:.
+	MilitaryHelmet = ":military_helmet:"
+	// MilitaryMedal is :military_medal:.
+	MilitaryMedal = ":military_medal:"
+	// Milk is :milk:.
+	Milk = ":milk:"
+	// MilkyWay is :milky_way:.
+	MilkyWay = ":milky_way:"
+	// Mind is :mind:, an alias of :exploding_head:.
+	Mind = ":exploding_head:"
+	// Mindblown is :mindblown:, an alias of :exploding_head:.
+	Mindblown = ":exploding_head:"
+	// Mine is :mine:.
+	Mine = ":mine:"
+	// Minibus is :minibus:.
+	Minibus = ":minibus:"
+	// Minidisc is :minidisc:, an alias of :gold_record:.
+	Minidisc = ":gold_record:"
+	// Minus is :minus:.
+	Minus = ":minus:"
+	// Miriam is :miriam:, an alias of :hamsa:.
+	Miriam = ":hamsa:"
+	// Mirror is :mirror:.
+	Mirror = ":mirror:"
+	// MirrorBall is :mirror_ball:.
+	MirrorBall = ":mirror_ball:"
+	// Mischievous is :mischievous:, an alias of :stuck_out_tongue:.
+	Mischievous = ":stuck_out_tongue:"
+	// MobilePhone is :mobile_phone:.
+	MobilePhone = ":mobile_phone:"
+	// Mobility is :mobility:, an alias of :crutch:.
+	Mobility = ":crutch:"
+	// Moisturizer is :moisturizer:, an alias of :lotion_bottle:.
+	Moisturizer = ":lotion_bottle:"
+	// Mojito is :mojito:, an alias of :lime:.
+	Mojito = ":lime:"
+	// Monarch is :monarch:, an alias of :person_with_crown:.
+	Monarch = ":person_with_crown:"
+	// Money is :money:.
+	Money = ":money:"
+	// MoneyFace is :money_face:.
+	MoneyFace = ":money_face:"
+	// MoneyWithWings is :money_with_wings:, an alias of :losing_money:.
+	MoneyWithWings = ":losing_money:"
+	// Monkey is :monkey:.
+	Monkey = ":monkey:"
+	// MonkeyFace is :monkey_face:.
+	MonkeyFace = ":monkey_face:"
+	// Monocle is :monocle:, an alias of :face_with_monocle:.
+	Monocle = ":face_with_monocle:"
+	// Monorail is :monorail:.
+	Monorail = ":monorail:"
+	// Monster is :monster:, an alias of :troll:.
+	Monster = ":troll:"
+	// Moon is :moon:.
+	Moon = ":moon:"
+	// MoonCake is :moon_cake:.
+	MoonCake = ":moon_cake:"
+	// MoonCeremony is :moon_ceremony:.
+	MoonCeremony = ":moon_ceremony:"
+	// MoonFace is :moon_face:.
+	MoonFace = ":moon_face:"
+	// Moose is :moose:.
+	Moose = ":moose:"
+	// Mortar is :mortar:, an alias of :brick:.
+	Mortar = ":brick:"
+	// MortarBoard is :mortar_board:, an alias of :graduate:.
+	MortarBoard = ":graduate:"
+	// Mosque is :mosque:.
+	Mosque = ":mosque:"
+	// Mosquito is :mosquito:.
+	Mosquito = ":mosquito:"
+	// MostlySunny is :mostly_sunny:.
+	MostlySunny = ":mostly_sunny:"
+	// MotherChristmas is :mother_christmas:.
+	MotherChristmas = ":mother_christmas:"
+	// MotherNature is :mother_nature:, an alias of :windy:.
+	MotherNature = ":windy:"
+	// MotorBike is :motor_bike:, an alias of :scooter:.
+	MotorBike = ":scooter:"
+	// MotorBoat is :motor_boat:.
+	MotorBoat = ":motor_boat:"
+	// Motorcycle is :motorcycle:.
+	Motorcycle = ":motorcycle:"
+	// MotorizedWheelchair is :motorized_wheelchair:.
+	MotorizedWheelchair = ":motorized_wheelchair:"
+	// Motorway is :motorway:, an alias of :road:.
+	Motorway = ":road:"
+	// MountFuji is :mount_fuji:.
+	MountFuji = ":mount_fuji:"
+	// Mountain is :mountain:.
+	Mountain = ":mountain:"
+	// MountainBiker is :mountain_biker:.
+	MountainBiker = ":mountain_biker:"
+	// MountainCableway is :mountain_cableway:, an alias of :gondola:.
+	MountainCableway = ":gondola:"
+	// MountainRailway is :mountain_railway:.
+	MountainRailway = ":mountain_railway:"
+	// MountainSunrise is :mountain_sunrise:.
+	MountainSunrise = ":mountain_sunrise:"
+	// Mouse is :mouse:.
+	Mouse = ":mouse:"
+	// MouseTrap is :mouse_trap:.
+	MouseTrap = ":mouse_trap:"
+	// Mousetrap is :mousetrap:, an alias of :mouse_trap:.
+	Mousetrap = ":mouse_trap:"
+	// Moustache is :moustache:, an alias of :disguised_face:.
+	Moustache = ":disguised_face:"
+	// Mouth is :mouth:, an alias of :lips:.
+	Mouth = ":lips:"
+	// Movie is :movie:, an alias of :projector:.
+	Movie = ":projector:"
+	// MovieCamera is :movie_camera:.
+	MovieCamera = ":movie_camera:"
+	// MovieTheater is :movie_theater:, an alias of :cinema:.
+	MovieTheater = ":cinema:"
+	// MovingTruck is :moving_truck:.
+	MovingTruck = ":moving_truck:"
+	// Moyai is :moyai:, an alias of :rock_carving:.
+	Moyai = ":rock_carving:"
+	// MrsClaus is :mrs_claus:, an alias of :mother_christmas:.
+	MrsClaus = ":mother_christmas:"
+	// Mule is :mule:, an alias of :donkey:.
+	Mule = ":donkey:"
+	// Multiplication is :multiplication:.
+	Multiplication = ":multiplication:"
+	// Multiply is :multiply:, an alias of :multiplication:.
+	Multiply = ":multiplication:"
+	// Muscle is :muscle:.
+	Muscle = ":muscle:"
+	// Mushroom is :mushroom:.
+	Mushroom = ":mushroom:"
+	// Music is :music:.
+	Music = ":music:"
+	// MusicalKeyboard is :musical_keyboard:, an alias of :piano:.
+	MusicalKeyboard = ":piano:"
+	// MusicalNotes is :musical_notes:.
+	MusicalNotes = ":musical_notes:"
+	// MusicalScore is :musical_score:.
+	MusicalScore = ":musical_score:"
+	// Mustache is :mustache:, an alias of :disguised_face:.
+	Mustache = ":disguised_face:"
+	// Mute is :mute:.
+	Mute = ":mute:"
+	// MuteNotifications is :mute_notifications:.
+	MuteNotifications = ":mute_notifications:"
+	// Mx is :mx:, an alias of :mx_claus:.
+	Mx = ":mx_claus:"
+	// MxClaus is :mx_claus:.
+	MxClaus = ":mx_claus:"
+	// Mystery is :mystery:, an alias of :fingerprint:.
+	Mystery = ":fingerprint:"
+	// Mythology is :mythology:, an alias of :wing:.
+	Mythology = ":wing:"
+	// Naan is :naan:, an alias of :flatbread:.
+	Naan = ":flatbread:"
+	// NailCare is :nail_care:, an alias of :nail_polish:.
+	NailCare = ":nail_polish:"
+	// NailPolish is :nail_polish:.
+	NailPolish = ":nail_polish:"
+	// Namaste is :namaste:, an alias of :folded_hands:.
+	Namaste = ":folded_hands:"
+	// NameBadge is :name_badge:.
+	NameBadge = ":name_badge:"
+	// Nap is :nap:, an alias of :yawning_face:.
+	Nap = ":yawning_face:"
+	// Naruto is :naruto:.
+	Naruto = ":naruto:"
+	// NationalPark is :national_park:.
+	NationalPark = ":national_park:"
+	// Natural is :natural:, an alias of :ginger_root:.
+	Natural = ":ginger_root:"
+	// Nauseated is :nauseated:.
+	Nauseated = ":nauseated:"
+	// Navigation is :navigation:, an alias of :compass:.
+	Navigation = ":compass:"
+	// Nazar is :nazar:, an alias of :nazar_amulet:.
+	Nazar = ":nazar_amulet:"
+	// NazarAmulet is :nazar_amulet:.
+	NazarAmulet = ":nazar_amulet:"
+	// Nc17 is :nc17:, an alias of :underage:.
+	Nc17 = ":underage:"
+	// Neck is :neck:, an alias of :scarf:.
+	Neck = ":scarf:"
+	// Negative is :negative:, an alias of :magnet:.
+	Negative = ":magnet:"
+	// Neptune is :neptune:, an alias of :merman:.
+	Neptune = ":merman:"
+	// Nerd is :nerd:.
+	Nerd = ":nerd:"
+	// Nervous is :nervous:, an alias of :grimacing:.
+	Nervous = ":grimacing:"
+	// NestWithEggs is :nest_with_eggs:.
+	NestWithEggs = ":nest_with_eggs:"
+	// NestingDolls is :nesting_dolls:.
+	NestingDolls = ":nesting_dolls:"
+	// Network is :network:, an alias of :wireless:.
+	Network = ":wireless:"
+	// Neutral is :neutral:.
+	Neutral = ":neutral:"
+	// New is :new:.
+	New = ":new:"
+	// NewBaby is :new_baby:.
+	NewBaby = ":new_baby:"
+	// NewMoon is :new_moon:.
+	NewMoon = ":new_moon:"
+	// NewMoonFace is :new_moon_face:.
+	NewMoonFace = ":new_moon_face:"
+	// NewYork is :new_york:, an alias of :statue:.
+	NewYork = ":statue:"
+	// Newspaper is :newspaper:.
+	Newspaper = ":newspaper:"
+	// NextTrack is :next_track:.
+	NextTrack = ":next_track:"
+	// Ng is :ng:.
+	Ng = ":ng:"
+	// Nib is :nib:, an alias of :black_nib:.
+	Nib = ":black_nib:"
+	// Night is :night:.
+	Night = ":night:"
+	// NightSky is :night_sky:, an alias of :milky_way:.
+	NightSky = ":milky_way:"
+	// Nine is :nine:.
+	Nine = ":nine:"
+	// Ninja is :ninja:.
+	Ninja = ":ninja:"
+	// NoBicycles is :no_bicycles:.
+	NoBicycles = ":no_bicycles:"
+	// NoEntry is :no_entry:.
+	NoEntry = ":no_entry:"
+	// NoMail is :no_mail:, an alias of :inbox_zero:.
+	NoMail = ":inbox_zero:"
+	// NoMouth is :no_mouth:, an alias of :speechless:.
+	NoMouth = ":speechless:"
+	// NoPedestrians is :no_pedestrians:.
+	NoPedestrians = ":no_pedestrians:"
+	// NoPhones is :no_phones:.
+	NoPhones = ":no_phones:"
+	// NoSignal is :no_signal:.
+	NoSignal = ":no_signal:"
+	// NoSmoking is :no_smoking:.
+	NoSmoking = ":no_smoking:"
+	// NoSound is :no_sound:, an alias of :mute:.
+	NoSound = ":mute:"
+	// Noble is :noble:, an alias of :person_with_crown:.
+	Noble = ":person_with_crown:"
+	// Nod is :nod:, an alias of :head_shaking_vertically:.
+	Nod = ":head_shaking_vertically:"
+	// NonPotableWater is :non-potable_water:.
+	NonPotableWater = ":non-potable_water:"
+	// Noodles is :noodles:, an alias of :ramen:.
+	Noodles = ":ramen:"
+	// Nope is :nope:, an alias of :no_signal:.
+	Nope = ":no_signal:"
+	// North is :north:, an alias of :up:.
+	North = ":up:"
+	// NorthEast is :north_east:, an alias of :upper_right:.
+	NorthEast = ":upper_right:"
+	// NorthWest is :north_west:, an alias of :upper_left:.
+	NorthWest = ":upper_left:"
+	// Nose is :nose:.
+	Nose = ":nose:"
+	// NotAllowed is :not_allowed:, an alias of :prohibited:.
+	NotAllowed = ":prohibited:"
+	// Note is :note:, an alias of :memo:.
+	Note = ":memo:"
+	// Notebook is :notebook:.
+	Notebook = ":notebook:"
+	// Nothing is :nothing:, an alias of :jar:.
+	Nothing = ":jar:"
+	// Notice is :notice:, an alias of :placard:.
+	Notice = ":placard:"
+	// Notifications is :notifications:.
+	Notifications = ":notifications:"
+	// Nuclear is :nuclear:, an alias of :radioactive:.
+	Nuclear = ":radioactive:"
+	// NumberOne is :number_one:, an alias of :first_place:.
+	NumberOne = ":first_place:"
+	// Numbers is :numbers:, an alias of :1234:.
+	Numbers = ":1234:"
+	// Nursery is :nursery:, an alias of :baby_change_station:.
+	Nursery = ":baby_change_station:"
+	// Nurturing is :nurturing:, an alias of :potted_plant:.
+	Nurturing = ":potted_plant:"
+	// NutAndBolt is :nut_and_bolt:.
+	NutAndBolt = ":nut_and_bolt:"
+	// O is :o:.
+	O = ":o:"
+	// Oatmeal is :oatmeal:, an alias of :bowl_with_spoon:.
+	Oatmeal = ":bowl_with_spoon:"
+	// Oberon is :oberon:, an alias of :man_fairy:.
+	Oberon = ":man_fairy:"
+	// Ocean is :ocean:.
+	Ocean = ":ocean:"
+	// OceanSunrise is :ocean_sunrise:, an alias of :sunrise:.
+	OceanSunrise = ":sunrise:"
+	// OctagonalSign is :octagonal_sign:, an alias of :stop_sign:.
+	OctagonalSign = ":stop_sign:"
+	// Octopus is :octopus:.
+	Octopus = ":octopus:"
+	// Oden is :oden:.
+	Oden = ":oden:"
+	// Offer is :offer:, an alias of :palm_up_hand:.
+	Offer = ":palm_up_hand:"
+	// Office is :office:.
+	Office = ":office:"
+	// OfficeSupplies is :office_supplies:.
+	OfficeSupplies = ":office_supplies:"
+	// OfficeWorker is :office_worker:.
+	OfficeWorker = ":office_worker:"
+	// Ogre is :ogre:.
+	Ogre = ":ogre:"
+	// OhNo is :oh_no:.
+	OhNo = ":oh_no:"
+	// Oil is :oil:, an alias of :diya_lamp:.
+	Oil = ":diya_lamp:"
+	// OilDrum is :oil_drum:.
+	OilDrum = ":oil_drum:"
+	// Oink is :oink:, an alias of :pig:.
+	Oink = ":pig:"
+	// Ok is :ok:.
+	Ok = ":ok:"
+	// OkSignal is :ok_signal:.
+	OkSignal = ":ok_signal:"
+	// Old is :old:, an alias of :older_person:.
+	Old = ":older_person:"
+	// OldKey is :old_key:, an alias of :secret:.
+	OldKey = ":secret:"
+	// OlderMan is :older_man:.
+	OlderMan = ":older_man:"
+	// OlderPerson is :older_person:.
+	OlderPerson = ":older_person:"
+	// OlderWoman is :older_woman:.
+	OlderWoman = ":older_woman:"
+	// Olive is :olive:.
+	Olive = ":olive:"
+	// Om is :om:.
+	Om = ":om:"
+	// On is :on:.
+	On = ":on:"
+	// OncomingAutomobile is :oncoming_automobile:, an alias of :oncoming_car:.
+	OncomingAutomobile = ":oncoming_car:"
+	// OncomingBus is :oncoming_bus:.
+	OncomingBus = ":oncoming_bus:"
+	// OncomingCar is :oncoming_car:.
+	OncomingCar = ":oncoming_car:"
+	// OncomingPoliceCar is :oncoming_police_car:.
+	OncomingPoliceCar = ":oncoming_police_car:"
+	// OncomingStreetcar is :oncoming_streetcar:, an alias of :oncoming_tram:.
+	OncomingStreetcar = ":oncoming_tram:"
+	// OncomingTaxi is :oncoming_taxi:.
+	OncomingTaxi = ":oncoming_taxi:"
+	// OncomingTrain is :oncoming_train:.
+	OncomingTrain = ":oncoming_train:"
+	// OncomingTram is :oncoming_tram:.
+	OncomingTram = ":oncoming_tram:"
+	// OncomingTrolley is :oncoming_trolley:, an alias of :oncoming_tram:.
+	OncomingTrolley = ":oncoming_tram:"
+	// One is :one:.
+	One = ":one:"
+	// OnePieceSwimsuit is :one_piece_swimsuit:.
+	OnePieceSwimsuit = ":one_piece_swimsuit:"
+	// Onigiri is :onigiri:.
+	Onigiri = ":onigiri:"
+	// Onion is :onion:.
+	Onion = ":onion:"
+	// Oops is :oops:, an alias of :upside_down:.
+	Oops = ":upside_down:"
+	// Open is :open:, an alias of :face_with_open_eyes_and_hand_over_mouth:.
+	Open = ":face_with_open_eyes_and_hand_over_mouth:"
+	// OpenBook is :open_book:, an alias of :book:.
+	OpenBook = ":book:"
+	// OpenHands is :open_hands:.
+	OpenHands = ":open_hands:"
+	// OpenMouth is :open_mouth:.
+	OpenMouth = ":open_mouth:"
+	// Opening is :opening:, an alias of :window:.
+	Opening = ":window:"
+	// Ophiuchus is :ophiuchus:.
+	Ophiuchus = ":ophiuchus:"
+	// Oracle is :oracle:, an alias of :crystal_ball:.
+	Oracle = ":crystal_ball:"
+	// Orange is :orange:.
+	Orange = ":orange:"
+	// OrangeBook is :orange_book:.
+	OrangeBook = ":orange_book:"
+	// OrangeCircle is :orange_circle:.
+	OrangeCircle = ":orange_circle:"
+	// OrangeHeart is :orange_heart:.
+	OrangeHeart = ":orange_heart:"
+	// OrangeSquare is :orange_square:.
+	OrangeSquare = ":orange_square:"
+	// Orangutan is :orangutan:.
+	Orangutan = ":orangutan:"
+	// Organize is :organize:.
+	Organize = ":organize:"
+	// Orienteering is :orienteering:, an alias of :compass:.
+	Orienteering = ":compass:"
+	// OrthodoxCross is :orthodox_cross:.
+	OrthodoxCross = ":orthodox_cross:"
+	// Orthoptera is :orthoptera:, an alias of :cricket:.
+	Orthoptera = ":cricket:"
+	// Ostentatious is :ostentatious:, an alias of :peacock:.
+	Ostentatious = ":peacock:"
+	// Otter is :otter:.
+	Otter = ":otter:"
+	// Ouch is :ouch:, an alias of :jellyfish:.
+	Ouch = ":jellyfish:"
+	// Outbox is :outbox:.
+	Outbox = ":outbox:"
+	// Outdoors is :outdoors:, an alias of :hiking_boot:.
+	Outdoors = ":hiking_boot:"
+	// Overcast is :overcast:, an alias of :cloud:.
+	Overcast = ":cloud:"
+	// Owl is :owl:.
+	Owl = ":owl:"
+	// Ox is :ox:.
+	Ox = ":ox:"
+	// Oyster is :oyster:.
+	Oyster = ":oyster:"
+	// P is :p:, an alias of :parking:.
+	P = ":parking:"
+	// Package is :package:.
+	Package = ":package:"
+	// Packing is :packing:, an alias of :luggage:.
+	Packing = ":luggage:"
+	// Pad is :pad:, an alias of :spiral_calendar:.
+	Pad = ":spiral_calendar:"
+	// Paella is :paella:.
+	Paella = ":paella:"
+	// Page is :page:, an alias of :document:.
+	Page = ":document:"
+	// PageWithCurl is :page_with_curl:.
+	PageWithCurl = ":page_with_curl:"
+	// Pager is :pager:.
+	Pager = ":pager:"
+	// Pain is :pain:, an alias of :smiling_face_with_tear:.
+	Pain = ":smiling_face_with_tear:"
+	// Pained is :pained:, an alias of :anguished:.
+	Pained = ":anguished:"
+	// Paint is :paint:, an alias of :splatter:.
+	Paint = ":splatter:"
+	// Paintbrush is :paintbrush:.
+	Paintbrush = ":paintbrush:"
+	// Painting is :painting:, an alias of :art:.
+	Painting = ":art:"
+	// Palette is :palette:, an alias of :art:.
+	Palette = ":art:"
+	// Palm is :palm:, an alias of :high_five:.
+	Palm = ":high_five:"
+	// PalmDownHand is :palm_down_hand:.
+	PalmDownHand = ":palm_down_hand:"
+	// PalmTree is :palm_tree:.
+	PalmTree = ":palm_tree:"
+	// PalmUpHand is :palm_up_hand:.
+	PalmUpHand = ":palm_up_hand:"
+	// Palms is :palms:, an alias of :palms_up_together:.
+	Palms = ":palms_up_together:"
+	// PalmsUpTogether is :palms_up_together:.
+	PalmsUpTogether = ":palms_up_together:"
+	// Pamonha is :pamonha:, an alias of :tamale:.
+	Pamonha = ":tamale:"
+	// Pancakes is :pancakes:.
+	Pancakes = ":pancakes:"
+	// Panda is :panda:.
+	Panda = ":panda:"
+	// Panic is :panic:, an alias of :shaking_face:.
+	Panic = ":shaking_face:"
+	// Panting is :panting:, an alias of :hot_face:.
+	Panting = ":hot_face:"
+	// Pants is :pants:, an alias of :shorts:.
+	Pants = ":shorts:"
+	// Paper is :paper:, an alias of :document:.
+	Paper = ":document:"
+	// Paperclip is :paperclip:.
+	Paperclip = ":paperclip:"
+	// PaperclipChain is :paperclip_chain:, an alias of :office_supplies:.
+	PaperclipChain = ":office_supplies:"
+	// Parachute is :parachute:.
+	Parachute = ":parachute:"
+	// Parasail is :parasail:, an alias of :parachute:.
+	Parasail = ":parachute:"
+	// Parasite is :parasite:, an alias of :worm:.
+	Parasite = ":worm:"
+	// Parent is :parent:, an alias of :person_feeding_baby:.
+	Parent = ":person_feeding_baby:"
+	// Park is :park:, an alias of :playground_slide:.
+	Park = ":playground_slide:"
+	// Parking is :parking:.
+	Parking = ":parking:"
+	// Parrot is :parrot:.
+	Parrot = ":parrot:"
+	// PartAlternation is :part_alternation:.
+	PartAlternation = ":part_alternation:"
+	// PartlyCloudy is :partly_cloudy:, an alias of :partly_sunny:.
+	PartlyCloudy = ":partly_sunny:"
+	// PartlySunny is :partly_sunny:.
+	PartlySunny = ":partly_sunny:"
+	// PartlySunnyWithRain is :partly_sunny_with_rain:, an alias of :sunshowers:.
+	PartlySunnyWithRain = ":sunshowers:"
+	// PartyBall is :party_ball:, an alias of :confetti:.
+	PartyBall = ":confetti:"
+	// PartyingFace is :partying_face:.
+	PartyingFace = ":partying_face:"
+	// Pass is :pass:.
+	Pass = ":pass:"
+	// PassengerShip is :passenger_ship:.
+	PassengerShip = ":passenger_ship:"
+	// PassportControl is :passport_control:.
+	PassportControl = ":passport_control:"
+	// Pastry is :pastry:, an alias of :pie:.
+	Pastry = ":pie:"
+	// Patience is :patience:, an alias of :pinched_fingers:.
+	Patience = ":pinched_fingers:"
+	// Pause is :pause:.
+	Pause = ":pause:"
+	// PawPrints is :paw_prints:.
+	PawPrints = ":paw_prints:"
+	// Pawn is :pawn:, an alias of :chess_pawn:.
+	Pawn = ":chess_pawn:"
+	// Paws is :paws:, an alias of :paw_prints:.
+	Paws = ":paw_prints:"
+	// Pea is :pea:, an alias of :pea_pod:.
+	Pea = ":pea_pod:"
+	// PeaPod is :pea_pod:.
+	PeaPod = ":pea_pod:"
+	// Peace is :peace:.
+	Peace = ":peace:"
+	// PeaceSign is :peace_sign:.
+	PeaceSign = ":peace_sign:"
+	// Peach is :peach:.
+	Peach = ":peach:"
+	// Peacock is :peacock:.
+	Peacock = ":peacock:"
+	// Peahen is :peahen:, an alias of :peacock:.
+	Peahen = ":peacock:"
+	// Peanuts is :peanuts:.
+	Peanuts = ":peanuts:"
+	// Pear is :pear:.
+	Pear = ":pear:"
+	// Pearly is :pearly:, an alias of :tooth:.
+	Pearly = ":tooth:"
+	// Pedestrian is :pedestrian:, an alias of :walking:.
+	Pedestrian = ":walking:"
+	// Peek is :peek:, an alias of :face_with_peeking_eye:.
+	Peek = ":face_with_peeking_eye:"
+	// Peeking is :peeking:, an alias of :face_with_peeking_eye:.
+	Peeking = ":face_with_peeking_eye:"
+	// Peep is :peep:, an alias of :face_with_peeking_eye:.
+	Peep = ":face_with_peeking_eye:"
+	// Pen is :pen:.
+	Pen = ":pen:"
+	// Penalty is :penalty:, an alias of :red_square:.
+	Penalty = ":red_square:"
+	// Pencil is :pencil:.
+	Pencil = ":pencil:"
+	// Penguin is :penguin:.
+	Penguin = ":penguin:"
+	// Pensive is :pensive:.
+	Pensive = ":pensive:"
+	// PeopleHoldingHands is :people_holding_hands:.
+	PeopleHoldingHands = ":people_holding_hands:"
+	// PeopleHugging is :people_hugging:.
+	PeopleHugging = ":people_hugging:"
+	// Pepper is :pepper:, an alias of :bell_pepper:.
+	Pepper = ":bell_pepper:"
+	// Percussion is :percussion:, an alias of :maracas:.
+	Percussion = ":maracas:"
+	// PerformingArts is :performing_arts:.
+	PerformingArts = ":performing_arts:"
+	// Persevere is :persevere:.
+	Persevere = ":persevere:"
+	// Person is :person:.
+	Person = ":person:"
+	// PersonBald is :person_bald:.
+	PersonBald = ":person_bald:"
+	// PersonBeard is :person_beard:.
+	PersonBeard = ":person_beard:"
+	// PersonBlondHair is :person_blond_hair:.
+	PersonBlondHair = ":person_blond_hair:"
+	// PersonClimbing is :person_climbing:.
+	PersonClimbing = ":person_climbing:"
+	// PersonCurlyHair is :person_curly_hair:.
+	PersonCurlyHair = ":person_curly_hair:"
+	// PersonFeedingBaby is :person_feeding_baby:.
+	PersonFeedingBaby = ":person_feeding_baby:"
+	// PersonFrowning is :person_frowning:.
+	PersonFrowning = ":person_frowning:"
+	// PersonInLotusPosition is :person_in_lotus_position:.
+	PersonInLotusPosition = ":person_in_lotus_position:"
+	// PersonInManualWheelchair is :person_in_manual_wheelchair:.
+	PersonInManualWheelchair = ":person_in_manual_wheelchair:"
+	// PersonInManualWheelchairFacingRight is :person_in_manual_wheelchair_facing_right:.
+	PersonInManualWheelchairFacingRight = ":person_in_manual_wheelchair_facing_right:"
+	// PersonInMotorizedWheelchair is :person_in_motorized_wheelchair:.
+	PersonInMotorizedWheelchair = ":person_in_motorized_wheelchair:"
+	// PersonInMotorizedWheelchairFacingRight is :person_in_motorized_wheelchair_facing_right:.
+	PersonInMotorizedWheelchairFacingRight = ":person_in_motorized_wheelchair_facing_right:"
+	// PersonInSteamyRoom is :person_in_steamy_room:.
+	PersonInSteamyRoom = ":person_in_steamy_room:"
+	// PersonKneeling is :person_kneeling:.
+	PersonKneeling = ":person_kneeling:"
+	// PersonKneelingFacingRight is :person_kneeling_facing_right:.
+	PersonKneelingFacingRight = ":person_kneeling_facing_right:"
+	// PersonPouting is :person_pouting:.
+	PersonPouting = ":person_pouting:"
+	// PersonRedHair is :person_red_hair:.
+	PersonRedHair = ":person_red_hair:"
+	// PersonRunningFacingRight is :person_running_facing_right:.
+	PersonRunningFacingRight = ":person_running_facing_right:"
+	// PersonStanding is :person_standing:.
+	PersonStanding = ":person_standing:"
+	// PersonTippingHand is :person_tipping_hand:, an alias of :information_desk_person:.
+	PersonTippingHand = ":information_desk_person:"
+	// PersonWalkingFacingRight is :person_walking_facing_right:.
+	PersonWalkingFacingRight = ":person_walking_facing_right:"
+	// PersonWhiteHair is :person_white_hair:.
+	PersonWhiteHair = ":person_white_hair:"
+	// PersonWithCrown is :person_with_crown:.
+	PersonWithCrown = ":person_with_crown:"
+	// PersonWithWhiteCane is :person_with_white_cane:.
+	PersonWithWhiteCane = ":person_with_white_cane:"
+	// PersonWithWhiteCaneFacingRight is :person_with_white_cane_facing_right:.
+	PersonWithWhiteCaneFacingRight = ":person_with_white_cane_facing_right:"
+	// Pester is :pester:, an alias of :badger:.
+	Pester = ":badger:"
+	// Petri is :petri:, an alias of :petri_dish:.
+	Petri = ":petri_dish:"
+	// PetriDish is :petri_dish:.
+	PetriDish = ":petri_dish:"
+	// PetrolPump is :petrol_pump:, an alias of :fuel_pump:.
+	PetrolPump = ":fuel_pump:"
+	// Phoenix is :phoenix:.
+	Phoenix = ":phoenix:"
+	// Phone is :phone:.
+	Phone = ":phone:"
+	// PhoneOff is :phone_off:.
+	PhoneOff = ":phone_off:"
+	// Physics is :physics:, an alias of :atom:.
+	Physics = ":atom:"
+	// Piano is :piano:.
+	Piano = ":piano:"
+	// Piccolo is :piccolo:, an alias of :flute:.
+	Piccolo = ":flute:"
+	// Pick is :pick:, an alias of :mine:.
+	Pick = ":mine:"
+	// PickMe is :pick_me:, an alias of :raising_hand:.
+	PickMe = ":raising_hand:"
+	// PickUp is :pick_up:, an alias of :pickup_truck:.
+	PickUp = ":pickup_truck:"
+	// Picket is :picket:, an alias of :placard:.
+	Picket = ":placard:"
+	// Pickup is :pickup:, an alias of :pickup_truck:.
+	Pickup = ":pickup_truck:"
+	// PickupTruck is :pickup_truck:.
+	PickupTruck = ":pickup_truck:"
+	// Picnic is :picnic:, an alias of :basket:.
+	Picnic = ":basket:"
+	// Picture is :picture:.
+	Picture = ":picture:"
+	// Pie is :pie:.
+	Pie = ":pie:"
+	// Piece is :piece:, an alias of :puzzle_piece:.
+	Piece = ":puzzle_piece:"
+	// Pierogi is :pierogi:, an alias of :dumpling:.
+	Pierogi = ":dumpling:"
+	// Pig is :pig:.
+	Pig = ":pig:"
+	// PigNose is :pig_nose:.
+	PigNose = ":pig_nose:"
+	// Piglet is :piglet:.
+	Piglet = ":piglet:"
+	// PileOfPoo is :pile_of_poo:, an alias of :poop:.
+	PileOfPoo = ":poop:"
+	// Pill is :pill:, an alias of :medicine:.
+	Pill = ":medicine:"
+	// Pilot is :pilot:.
+	Pilot = ":pilot:"
+	// Pin is :pin:.
+	Pin = ":pin:"
+	// Pina is :pina:, an alias of :coconut:.
+	Pina = ":coconut:"
+	// Pinada is :pinada:, an alias of :piñata:.
+	Pinada = ":piñata:"
+	// Pinata is :pinata:, an alias of :piñata:.
+	Pinata = ":piñata:"
+	// Pinched is :pinched:, an alias of :pinched_fingers:.
+	Pinched = ":pinched_fingers:"
+	// PinchedFingers is :pinched_fingers:.
+	PinchedFingers = ":pinched_fingers:"
+	// Pinching is :pinching:, an alias of :pinching_hand:.
+	Pinching = ":pinching_hand:"
+	// PinchingHand is :pinching_hand:.
+	PinchingHand = ":pinching_hand:"
+	// Pineapple is :pineapple:.
+	Pineapple = ":pineapple:"
+	// PingPong is :ping_pong:.
+	PingPong = ":ping_pong:"
+	// PinkHeart is :pink_heart:.
+	PinkHeart = ":pink_heart:"
+	// Pipe is :pipe:, an alias of :flute:.
+	Pipe = ":flute:"
+	// Pirate is :pirate:, an alias of :skull_and_crossbones:.
+	Pirate = ":skull_and_crossbones:"
+	// PirateFlag is :pirate_flag:.
+	PirateFlag = ":pirate_flag:"
+	// Pisces is :pisces:.
+	Pisces = ":pisces:"
+	// Pissed is :pissed:, an alias of :face_with_symbols_on_mouth:.
+	Pissed = ":face_with_symbols_on_mouth:"
+	// Pita is :pita:, an alias of :flatbread:.
+	Pita = ":flatbread:"
+	// Pizza is :pizza:.
+	Pizza = ":pizza:"
+	// Piña is :piña:, an alias of :coconut:.
+	Piña = ":coconut:"
+	// Piñata is :piñata:.
+	Piñata = ":piñata:"
+	// Placard is :placard:.
+	Placard = ":placard:"
+	// PlaceHolder is :place_holder:.
+	PlaceHolder = ":place_holder:"
+	// PlaceOfWorship is :place_of_worship:.
+	PlaceOfWorship = ":place_of_worship:"
+	// Planet is :planet:, an alias of :ringed_planet:.
+	Planet = ":ringed_planet:"
+	// Plankton is :plankton:, an alias of :jellyfish:.
+	Plankton = ":jellyfish:"
+	// Plant is :plant:, an alias of :herb:.
+	Plant = ":herb:"
+	// Plaque is :plaque:, an alias of :placard:.
+	Plaque = ":placard:"
+	// Play is :play:.
+	Play = ":play:"
+	// PlayPause is :play_pause:.
+	PlayPause = ":play_pause:"
+	// PlayReverse is :play_reverse:.
+	PlayReverse = ":play_reverse:"
+	// Playful is :playful:, an alias of :otter:.
+	Playful = ":otter:"
+	// Playground is :playground:, an alias of :playground_slide:.
+	Playground = ":playground_slide:"
+	// PlaygroundSlide is :playground_slide:.
+	PlaygroundSlide = ":playground_slide:"
+	// PlayingCards is :playing_cards:.
+	PlayingCards = ":playing_cards:"
+	// Plaything is :plaything:, an alias of :teddy_bear:.
+	Plaything = ":teddy_bear:"
+	// Pleading is :pleading:, an alias of :pleading_face:.
+	Pleading = ":pleading_face:"
+	// PleadingFace is :pleading_face:.
+	PleadingFace = ":pleading_face:"
+	// Plumage is :plumage:, an alias of :feather:.
+	Plumage = ":feather:"
+	// Plunder is :plunder:, an alias of :pirate_flag:.
+	Plunder = ":pirate_flag:"
+	// Plunger is :plunger:.
+	Plunger = ":plunger:"
+	// Plus is :plus:.
+	Plus = ":plus:"
+	// Plush is :plush:, an alias of :teddy_bear:.
+	Plush = ":teddy_bear:"
+	// Pod is :pod:, an alias of :pea_pod:.
+	Pod = ":pea_pod:"
+	// Point is :point:, an alias of :hook:.
+	Point = ":hook:"
+	// PointDown is :point_down:.
+	PointDown = ":point_down:"
+	// PointLeft is :point_left:.
+	PointLeft = ":point_left:"
+	// PointOfInformation is :point_of_information:, an alias of :wait_one_second:.
+	PointOfInformation = ":wait_one_second:"
+	// PointRight is :point_right:.
+	PointRight = ":point_right:"
+	// PointUp is :point_up:.
+	PointUp = ":point_up:"
+	// Pointing is :pointing:, an alias of :index_pointing_at_the_viewer:.
+	Pointing = ":index_pointing_at_the_viewer:"
+	// Poison is :poison:, an alias of :skull_and_crossbones:.
+	Poison = ":skull_and_crossbones:"
+	// Poke is :poke:, an alias of :index_pointing_at_the_viewer:.
+	Poke = ":index_pointing_at_the_viewer:"
+	// PokerFace is :poker_face:, an alias of :speechless:.
+	PokerFace = ":speechless:"
+	// Polar is :polar:, an alias of :polar_bear:.
+	Polar = ":polar_bear:"
+	// PolarBear is :polar_bear:.
+	PolarBear = ":polar_bear:"
+	// Police is :police:.
+	Police = ":police:"
+	// PoliceCar is :police_car:.
+	PoliceCar = ":police_car:"
+	// Pony is :pony:.
+	Pony = ":pony:"
+	// Poodle is :poodle:.
+	Poodle = ":poodle:"
+	// Pool is :pool:, an alias of :billiards:.
+	Pool = ":billiards:"
+	// Poop is :poop:.
+	Poop = ":poop:"
+	// Pop is :pop:, an alias of :firecracker:.
+	Pop = ":firecracker:"
+	// Popcorn is :popcorn:.
+	Popcorn = ":popcorn:"
+	// Popping is :popping:, an alias of :firecracker:.
+	Popping = ":firecracker:"
+	// Porkchop is :porkchop:, an alias of :cut_of_meat:.
+	Porkchop = ":cut_of_meat:"
+	// Porous is :porous:, an alias of :sponge:.
+	Porous = ":sponge:"
+	// Porridge is :porridge:, an alias of :bowl_with_spoon:.
+	Porridge = ":bowl_with_spoon:"
+	// Portobello is :portobello:, an alias of :brown_mushroom:.
+	Portobello = ":brown_mushroom:"
+	// Poseidon is :poseidon:, an alias of :merman:.
+	Poseidon = ":merman:"
+	// Positive is :positive:, an alias of :magnet:.
+	Positive = ":magnet:"
+	// PostOffice is :post_office:.
+	PostOffice = ":post_office:"
+	// PotableWater is :potable_water:.
+	PotableWater = ":potable_water:"
+	// Potato is :potato:.
+	Potato = ":potato:"
+	// Potsticker is :potsticker:, an alias of :dumpling:.
+	Potsticker = ":dumpling:"
+	// Potted is :potted:, an alias of :potted_plant:.
+	Potted = ":potted_plant:"
+	// PottedPlant is :potted_plant:.
+	PottedPlant = ":potted_plant:"
+	// Pouch is :pouch:.
+	Pouch = ":pouch:"
+	// Poultry is :poultry:, an alias of :drumstick:.
+	Poultry = ":drumstick:"
+	// PoundNotes is :pound_notes:.
+	PoundNotes = ":pound_notes:"
+	// Pour is :pour:, an alias of :pouring_liquid:.
+	Pour = ":pouring_liquid:"
+	// Pouring is :pouring:, an alias of :pouring_liquid:.
+	Pouring = ":pouring_liquid:"
+	// PouringLiquid is :pouring_liquid:.
+	PouringLiquid = ":pouring_liquid:"
+	// PoutingCat is :pouting_cat:, an alias of :angry_cat:.
+	PoutingCat = ":angry_cat:"
+	// Pow is :pow:, an alias of :anger:.
+	Pow = ":anger:"
+	// Power is :power:, an alias of :fist:.
+	Power = ":fist:"
+	// Praise is :praise:, an alias of :raised_hands:.
+	Praise = ":raised_hands:"
+	// Pray is :pray:, an alias of :folded_hands:.
+	Pray = ":folded_hands:"
+	// Prayer is :prayer:, an alias of :palms_up_together:.
+	Prayer = ":palms_up_together:"
+	// PrayerBeads is :prayer_beads:.
+	PrayerBeads = ":prayer_beads:"
+	// Pregnant is :pregnant:.
+	Pregnant = ":pregnant:"
+	// PregnantMan is :pregnant_man:.
+	PregnantMan = ":pregnant_man:"
+	// PregnantPerson is :pregnant_person:.
+	PregnantPerson = ":pregnant_person:"
+	// Present is :present:, an alias of :gift:.
+	Present = ":gift:"
+	// Preserver is :preserver:, an alias of :ring_buoy:.
+	Preserver = ":ring_buoy:"
+	// Pretzel is :pretzel:.
+	Pretzel = ":pretzel:"
+	// PreviousTrack is :previous_track:.
+	PreviousTrack = ":previous_track:"
+	// PriceTag is :price_tag:, an alias of :label:.
+	PriceTag = ":label:"
+	// Pride is :pride:, an alias of :rainbow:.
+	Pride = ":rainbow:"
+	// Prince is :prince:.
+	Prince = ":prince:"
+	// Princess is :princess:.
+	Princess = ":princess:"
+	// Print is :print:, an alias of :fingerprint:.
+	Print = ":fingerprint:"
+	// Printer is :printer:.
+	Printer = ":printer:"
+	// Privacy is :privacy:.
+	Privacy = ":privacy:"
+	// Prohibited is :prohibited:.
+	Prohibited = ":prohibited:"
+	// Projector is :projector:.
+	Projector = ":projector:"
+	// Proof is :proof:, an alias of :receipt:.
+	Proof = ":receipt:"
+	// Prophecy is :prophecy:, an alias of :fortune_cookie:.
+	Prophecy = ":fortune_cookie:"
+	// Protect is :protect:, an alias of :hamsa:.
+	Protect = ":hamsa:"
+	// Protected is :protected:, an alias of :privacy:.
+	Protected = ":privacy:"
+	// Protest is :protest:, an alias of :placard:.
+	Protest = ":placard:"
+	// Puck is :puck:, an alias of :man_fairy:.
+	Puck = ":man_fairy:"
+	// Puke is :puke:, an alias of :face_vomiting:.
+	Puke = ":face_vomiting:"
+	// Pulse is :pulse:, an alias of :anatomical_heart:.
+	Pulse = ":anatomical_heart:"
+	// Pumpkin is :pumpkin:, an alias of :jack-o-lantern:.
+	Pumpkin = ":jack-o-lantern:"
+	// Punch is :punch:, an alias of :fist_bump:.
+	Punch = ":fist_bump:"
+	// Punk is :punk:, an alias of :safety_pin:.
+	Punk = ":safety_pin:"
+	// Puppy is :puppy:.
+	Puppy = ":puppy:"
+	// Purity is :purity:, an alias of :lotus:.
+	Purity = ":lotus:"
+	// PurpleCircle is :purple_circle:.
+	PurpleCircle = ":purple_circle:"
+	// PurpleHeart is :purple_heart:.
+	PurpleHeart = ":purple_heart:"
+	// PurpleSquare is :purple_square:.
+	PurpleSquare = ":purple_square:"
+	// Purse is :purse:.
+	Purse = ":purse:"
+	// PushPin is :push_pin:.
+	PushPin = ":push_pin:"
+	// PutLitterInItsPlace is :put_litter_in_its_place:.
+	PutLitterInItsPlace = ":put_litter_in_its_place:"
+	// Puzzle is :puzzle:, an alias of :puzzle_piece:.
+	Puzzle = ":puzzle_piece:"
+	// PuzzlePiece is :puzzle_piece:.
+	PuzzlePiece = ":puzzle_piece:"
+	// Queasy is :queasy:, an alias of :nauseated:.
+	Queasy = ":nauseated:"
+	// Queen is :queen:, an alias of :crown:.
+	Queen = ":crown:"
+	// Queer is :queer:, an alias of :rainbow_flag:.
+	Queer = ":rainbow_flag:"
+	// Quench is :quench:, an alias of :fire_extinguisher:.
+	Quench = ":fire_extinguisher:"
+	// Question is :question:.
+	Question = ":question:"
+	// Quiet is :quiet:, an alias of :silence:.
+	Quiet = ":silence:"
+	// R is :r:, an alias of :registered:.
+	R = ":registered:"
+	// Rabbit is :rabbit:.
+	Rabbit = ":rabbit:"
+	// Raccoon is :raccoon:.
+	Raccoon = ":raccoon:"
+	// Race is :race:, an alias of :checkered_flag:.
+	Race = ":checkered_flag:"
+	// Racecar is :racecar:.
+	Racecar = ":racecar:"
+	// Radio is :radio:.
+	Radio = ":radio:"
+	// RadioButton is :radio_button:.
+	RadioButton = ":radio_button:"
+	// Radioactive is :radioactive:.
+	Radioactive = ":radioactive:"
+	// Radish is :radish:, an alias of :root_vegetable:.
+	Radish = ":root_vegetable:"
+	// Rage is :rage:.
+	Rage = ":rage:"
+	// RailwayCar is :railway_car:.
+	RailwayCar = ":railway_car:"
+	// RailwayTrack is :railway_track:.
+	RailwayTrack = ":railway_track:"
+	// Rainbow is :rainbow:.
+	Rainbow = ":rainbow:"
+	// RainbowFlag is :rainbow_flag:.
+	RainbowFlag = ":rainbow_flag:"
+	// Rainy is :rainy:.
+	Rainy = ":rainy:"
+	// Raised is :raised:, an alias of :face_with_raised_eyebrow:.
+	Raised = ":face_with_raised_eyebrow:"
+	// RaisedHand is :raised_hand:, an alias of :hand:.
+	RaisedHand = ":hand:"
+	// RaisedHands is :raised_hands:.
+	RaisedHands = ":raised_hands:"
+	// RaisingHand is :raising_hand:.
+	RaisingHand = ":raising_hand:"
+	// Ram is :ram:.
+	Ram = ":ram:"
+	// Ramen is :ramen:.
+	Ramen = ":ramen:"
+	// Rat is :rat:.
+	Rat = ":rat:"
+	// RatedForViolence is :rated_for_violence:, an alias of :dagger:.
+	RatedForViolence = ":dagger:"
+	// Rattle is :rattle:, an alias of :maracas:.
+	Rattle = ":maracas:"
+	// Raven is :raven:, an alias of :black_bird:.
+	Raven = ":black_bird:"
+	// Razor is :razor:.
+	Razor = ":razor:"
+	// Real is :real:, an alias of :anatomical_heart:.
+	Real = ":anatomical_heart:"
+	// Realization is :realization:, an alias of :face_with_hand_over_mouth:.
+	Realization = ":face_with_hand_over_mouth:"
+	// Rebirth is :rebirth:, an alias of :phoenix:.
+	Rebirth = ":phoenix:"
+	// Rebound is :rebound:, an alias of :boomerang:.
+	Rebound = ":boomerang:"
+	// Receipt is :receipt:.
+	Receipt = ":receipt:"
+	// Reception is :reception:, an alias of :bellhop_bell:.
+	Reception = ":bellhop_bell:"
+	// Record is :record:.
+	Record = ":record:"
+	// Recorder is :recorder:, an alias of :flute:.
+	Recorder = ":flute:"
+	// Recovering is :recovering:, an alias of :mending_heart:.
+	Recovering = ":mending_heart:"
+	// RecreationalVehicle is :recreational_vehicle:.
+	RecreationalVehicle = ":recreational_vehicle:"
+	// Recuperating is :recuperating:, an alias of :mending_heart:.
+	Recuperating = ":mending_heart:"
+	// Recycle is :recycle:.
+	Recycle = ":recycle:"
+	// RedBook is :red_book:.
+	RedBook = ":red_book:"
+	// RedCircle is :red_circle:.
+	RedCircle = ":red_circle:"
+	// RedEnvelope is :red_envelope:.
+	RedEnvelope = ":red_envelope:"
+	// RedFaced is :red_faced:, an alias of :hot_face:.
+	RedFaced = ":hot_face:"
+	// RedSquare is :red_square:.
+	RedSquare = ":red_square:"
+	// RedTriangleDown is :red_triangle_down:.
+	RedTriangleDown = ":red_triangle_down:"
+	// RedTriangleUp is :red_triangle_up:.
+	RedTriangleUp = ":red_triangle_up:"
+	// Reef is :reef:, an alias of :coral:.
+	Reef = ":coral:"
+	// Reflection is :reflection:, an alias of :mirror:.
+	Reflection = ":mirror:"
+	// Reflector is :reflector:, an alias of :mirror:.
+	Reflector = ":mirror:"
+	// Refreshing is :refreshing:, an alias of :lime:.
+	Refreshing = ":lime:"
+	// Regal is :regal:, an alias of :person_with_crown:.
+	Regal = ":person_with_crown:"
+	// Registered is :registered:.
+	Registered = ":registered:"
+	// Reincarnation is :reincarnation:, an alias of :phoenix:.
+	Reincarnation = ":phoenix:"
+	// Reinvent is :reinvent:, an alias of :phoenix:.
+	Reinvent = ":phoenix:"
+	// Relaxed is :relaxed:, an alias of :smiling_face:.
+	Relaxed = ":smiling_face:"
+	// Relief is :relief:, an alias of :face_exhaling:.
+	Relief = ":face_exhaling:"
+	// Relieved is :relieved:.
+	Relieved = ":relieved:"
+	// Religion is :religion:, an alias of :khanda:.
+	Religion = ":khanda:"
+	// ReminderRibbon is :reminder_ribbon:.
+	ReminderRibbon = ":reminder_ribbon:"
+	// Renewal is :renewal:, an alias of :phoenix:.
+	Renewal = ":phoenix:"
+	// Repeat is :repeat:.
+	Repeat = ":repeat:"
+	// RepeatOne is :repeat_one:.
+	RepeatOne = ":repeat_one:"
+	// Repercussion is :repercussion:, an alias of :boomerang:.
+	Repercussion = ":boomerang:"
+	// Reply is :reply:.
+	Reply = ":reply:"
+	// Rescue is :rescue:, an alias of :ring_buoy:.
+	Rescue = ":ring_buoy:"
+	// RescueWorker is :rescue_worker:, an alias of :helmet:.
+	RescueWorker = ":helmet:"
+	// Reserved is :reserved:, an alias of :japanese_reserved_button:.
+	Reserved = ":japanese_reserved_button:"
+	// Resist is :resist:, an alias of :face_holding_back_tears:.
+	Resist = ":face_holding_back_tears:"
+	// Respect is :respect:, an alias of :saluting_face:.
+	Respect = ":saluting_face:"
+	// Respiration is :respiration:, an alias of :lungs:.
+	Respiration = ":lungs:"
+	// Restroom is :restroom:.
+	Restroom = ":restroom:"
+	// Return is :return:, an alias of :counterclockwise:.
+	Return = ":counterclockwise:"
+	// Revival is :revival:, an alias of :phoenix:.
+	Revival = ":phoenix:"
+	// Revive is :revive:, an alias of :phoenix:.
+	Revive = ":phoenix:"
+	// RevolvingHearts is :revolving_hearts:.
+	RevolvingHearts = ":revolving_hearts:"
+	// Rewind is :rewind:.
+	Rewind = ":rewind:"
+	// Rex is :rex:, an alias of :t_rex:.
+	Rex = ":t_rex:"
+	// Rhinoceros is :rhinoceros:.
+	Rhinoceros = ":rhinoceros:"
+	// Rhythm is :rhythm:, an alias of :long_drum:.
+	Rhythm = ":long_drum:"
+	// Ribbon is :ribbon:.
+	Ribbon = ":ribbon:"
+	// Rice is :rice:.
+	Rice = ":rice:"
+	// RiceCracker is :rice_cracker:, an alias of :senbei:.
+	RiceCracker = ":senbei:"
+	// Rickshaw is :rickshaw:, an alias of :auto_rickshaw:.
+	Rickshaw = ":auto_rickshaw:"
+	// Rideshare is :rideshare:, an alias of :taxi:.
+	Rideshare = ":taxi:"
+	// Right is :right:.
+	Right = ":right:"
+	// RightFist is :right_fist:.
+	RightFist = ":right_fist:"
+	// RightHook is :right_hook:, an alias of :forward:.
+	RightHook = ":forward:"
+	// RightPointing is :right_pointing:, an alias of :magnifying_glass_tilted_right:.
+	RightPointing = ":magnifying_glass_tilted_right:"
+	// RightwardsHand is :rightwards_hand:.
+	RightwardsHand = ":rightwards_hand:"
+	// RightwardsPushingHand is :rightwards_pushing_hand:.
+	RightwardsPushingHand = ":rightwards_pushing_hand:"
+	// Ring is :ring:.
+	Ring = ":ring:"
+	// RingBuoy is :ring_buoy:.
+	RingBuoy = ":ring_buoy:"
+	// Ringed is :ringed:, an alias of :ringed_planet:.
+	Ringed = ":ringed_planet:"
+	// RingedPlanet is :ringed_planet:.
+	RingedPlanet = ":ringed_planet:"
+	// Rip is :rip:, an alias of :headstone:.
+	Rip = ":headstone:"
+	// Rise is :rise:, an alias of :phoenix:.
+	Rise = ":phoenix:"
+	// Roach is :roach:, an alias of :cockroach:.
+	Roach = ":cockroach:"
+	// Road is :road:.
+	Road = ":road:"
+	// RoadTrip is :road_trip:, an alias of :map:.
+	RoadTrip = ":map:"
+	// Robot is :robot:.
+	Robot = ":robot:"
+	// Rock is :rock:.
+	Rock = ":rock:"
+	// RockCarving is :rock_carving:.
+	RockCarving = ":rock_carving:"
+	// RockOn is :rock_on:.
+	RockOn = ":rock_on:"
+	// Rocket is :rocket:.
+	Rocket = ":rocket:"
+	// Rofl is :rofl:, an alias of :rolling_on_the_floor_laughing:.
+	Rofl = ":rolling_on_the_floor_laughing:"
+	// Roger is :roger:, an alias of :pirate_flag:.
+	Roger = ":pirate_flag:"
+	// Roll is :roll:, an alias of :roll_of_paper:.
+	Roll = ":roll_of_paper:"
+	// RollOfPaper is :roll_of_paper:.
+	RollOfPaper = ":roll_of_paper:"
+	// RollerCoaster is :roller_coaster:.
+	RollerCoaster = ":roller_coaster:"
+	// RollerSkate is :roller_skate:.
+	RollerSkate = ":roller_skate:"
+	// RollingEyes is :rolling_eyes:.
+	RollingEyes = ":rolling_eyes:"
+	// RollingOnTheFloorLaughing is :rolling_on_the_floor_laughing:.
+	RollingOnTheFloorLaughing = ":rolling_on_the_floor_laughing:"
+	// Rolodex is :rolodex:.
+	Rolodex = ":rolodex:"
+	// Rook is :rook:, an alias of :black_bird:.
+	Rook = ":black_bird:"
+	// Rooster is :rooster:.
+	Rooster = ":rooster:"
+	// RootVegetable is :root_vegetable:.
+	RootVegetable = ":root_vegetable:"
+	// Rope is :rope:, an alias of :knot:.
+	Rope = ":knot:"
+	// Rose is :rose:.
+	Rose = ":rose:"
+	// Rosette is :rosette:.
+	Rosette = ":rosette:"
+	// RotatingLight is :rotating_light:, an alias of :siren:.
+	RotatingLight = ":siren:"
+	// Rotting is :rotting:, an alias of :fly:.
+	Rotting = ":fly:"
+	// Roundhouse is :roundhouse:, an alias of :hut:.
+	Roundhouse = ":hut:"
+	// Router is :router:, an alias of :wireless:.
+	Router = ":wireless:"
+	// Rowboat is :rowboat:.
+	Rowboat = ":rowboat:"
+	// Rowing is :rowing:, an alias of :rowboat:.
+	Rowing = ":rowboat:"
+	// Royal is :royal:, an alias of :person_with_crown:.
+	Royal = ":person_with_crown:"
+	// Royalty is :royalty:, an alias of :person_with_crown:.
+	Royalty = ":person_with_crown:"
+	// Rugby is :rugby:.
+	Rugby = ":rugby:"
+	// Ruler is :ruler:.
+	Ruler = ":ruler:"
+	// Rung is :rung:, an alias of :ladder:.
+	Rung = ":ladder:"
+	// Runner is :runner:, an alias of :running:.
+	Runner = ":running:"
+	// Running is :running:.
+	Running = ":running:"
+	// RunningShirt is :running_shirt:.
+	RunningShirt = ":running_shirt:"
+	// RunningShoe is :running_shoe:, an alias of :athletic_shoe:.
+	RunningShoe = ":athletic_shoe:"
+	// Russia is :russia:, an alias of :nesting_dolls:.
+	Russia = ":nesting_dolls:"
+	// Sacred is :sacred:, an alias of :heart_on_fire:.
+	Sacred = ":heart_on_fire:"
+	// Sad is :sad:.
+	Sad = ":sad:"
+	// Safe is :safe:, an alias of :secure:.
+	Safe = ":secure:"
+	// SafetyFirst is :safety_first:, an alias of :helmet:.
+	SafetyFirst = ":helmet:"
+	// SafetyPin is :safety_pin:.
+	SafetyPin = ":safety_pin:"
+	// SafetyVest is :safety_vest:.
+	SafetyVest = ":safety_vest:"
+	// Sagittarius is :sagittarius:.
+	Sagittarius = ":sagittarius:"
+	// Sailboat is :sailboat:, an alias of :boat:.
+	Sailboat = ":boat:"
+	// Sake is :sake:.
+	Sake = ":sake:"
+	// Salad is :salad:.
+	Salad = ":salad:"
+	// Salsa is :salsa:, an alias of :lime:.
+	Salsa = ":lime:"
+	// Salt is :salt:.
+	Salt = ":salt:"
+	// Salty is :salty:, an alias of :salt:.
+	Salty = ":salt:"
+	// Salute is :salute:, an alias of :saluting_face:.
+	Salute = ":saluting_face:"
+	// Saluting is :saluting:, an alias of :saluting_face:.
+	Saluting = ":saluting_face:"
+	// SalutingFace is :saluting_face:.
+	SalutingFace = ":saluting_face:"
+	// Sandal is :sandal:.
+	Sandal = ":sandal:"
+	// Sandals is :sandals:, an alias of :thong_sandal:.
+	Sandals = ":thong_sandal:"
+	// Sandwich is :sandwich:.
+	Sandwich = ":sandwich:"
+	// Santa is :santa:.
+	Santa = ":santa:"
+	// Sari is :sari:.
+	Sari = ":sari:"
+	// Satchel is :satchel:, an alias of :backpack:.
+	Satchel = ":backpack:"
+	// Satellite is :satellite:.
+	Satellite = ":satellite:"
+	// SatelliteAntenna is :satellite_antenna:.
+	SatelliteAntenna = ":satellite_antenna:"
+	// Saturn is :saturn:, an alias of :ringed_planet:.
+	Saturn = ":ringed_planet:"
+	// Saturnine is :saturnine:, an alias of :ringed_planet:.
+	Saturnine = ":ringed_planet:"
+	// Sauce is :sauce:, an alias of :jar:.
+	Sauce = ":jar:"
+	// Saucer is :saucer:, an alias of :flying_saucer:.
+	Saucer = ":flying_saucer:"
+	// Sauropod is :sauropod:.
+	Sauropod = ":sauropod:"
+	// Save is :save:, an alias of :ring_buoy:.
+	Save = ":ring_buoy:"
+	// Saver is :saver:, an alias of :ring_buoy:.
+	Saver = ":ring_buoy:"
+	// Saw is :saw:, an alias of :carpentry_saw:.
+	Saw = ":carpentry_saw:"
+	// Saxophone is :saxophone:.
+	Saxophone = ":saxophone:"
+	// SayCheese is :say_cheese:, an alias of :taking_a_picture:.
+	SayCheese = ":taking_a_picture:"
+	// Scales is :scales:, an alias of :justice:.
+	Scales = ":justice:"
+	// Scared is :scared:, an alias of :fear:.
+	Scared = ":fear:"
+	// Scarf is :scarf:.
+	Scarf = ":scarf:"
+	// Schmear is :schmear:, an alias of :bagel:.
+	Schmear = ":bagel:"
+	// School is :school:.
+	School = ":school:"
+	// SchoolBus is :school_bus:, an alias of :bus:.
+	SchoolBus = ":bus:"
+	// SchoolCrossing is :school_crossing:, an alias of :children_crossing:.
+	SchoolCrossing = ":children_crossing:"
+	// Science is :science:.
+	Science = ":science:"
+	// Scientist is :scientist:.
+	Scientist = ":scientist:"
+	// Scissors is :scissors:.
+	Scissors = ":scissors:"
+	// Scoop is :scoop:, an alias of :shovel:.
+	Scoop = ":shovel:"
+	// Scooter is :scooter:.
+	Scooter = ":scooter:"
+	// Scorpion is :scorpion:.
+	Scorpion = ":scorpion:"
+	// Scorpius is :scorpius:.
+	Scorpius = ":scorpius:"
+	// Scream is :scream:.
+	Scream = ":scream:"
+	// ScreamCat is :scream_cat:.
+	ScreamCat = ":scream_cat:"
+	// Screw is :screw:, an alias of :nut_and_bolt:.
+	Screw = ":nut_and_bolt:"
+	// Screwdriver is :screwdriver:.
+	Screwdriver = ":screwdriver:"
+	// Scroll is :scroll:.
+	Scroll = ":scroll:"
+	// Sculling is :sculling:, an alias of :rowboat:.
+	Sculling = ":rowboat:"
+	// Seafood is :seafood:, an alias of :lobster:.
+	Seafood = ":lobster:"
+	// Seal is :seal:.
+	Seal = ":seal:"
+	// Sealed is :sealed:, an alias of :mail_sent:.
+	Sealed = ":mail_sent:"
+	// Search is :search:.
+	Search = ":search:"
+	// Seashell is :seashell:, an alias of :shell:.
+	Seashell = ":shell:"
+	// Seat is :seat:.
+	Seat = ":seat:"
+	// SecondPlace is :second_place:.
+	SecondPlace = ":second_place:"
+	// Secret is :secret:.
+	Secret = ":secret:"
+	// Secure is :secure:.
+	Secure = ":secure:"
+	// Security is :security:, an alias of :identification_card:.
+	Security = ":identification_card:"
+	// See is :see:, an alias of :red_envelope:.
+	See = ":red_envelope:"
+	// SeeNoEvil is :see_no_evil:.
+	SeeNoEvil = ":see_no_evil:"
+	// Seedling is :seedling:.
+	Seedling = ":seedling:"
+	// SeeingStars is :seeing_stars:.
+	SeeingStars = ":seeing_stars:"
+	// Selfie is :selfie:.
+	Selfie = ":selfie:"
+	// Selling is :selling:, an alias of :hook:.
+	Selling = ":hook:"
+	// SemiTruck is :semi_truck:, an alias of :truck:.
+	SemiTruck = ":truck:"
+	// Senbei is :senbei:.
+	Senbei = ":senbei:"
+	// ServiceDog is :service_dog:.
+	ServiceDog = ":service_dog:"
+	// Services is :services:, an alias of :bellhop_bell:.
+	Services = ":bellhop_bell:"
+	// Settings is :settings:, an alias of :gear:.
+	Settings = ":gear:"
+	// Seven is :seven:.
+	Seven = ":seven:"
+	// Sew is :sew:, an alias of :sewing_needle:.
+	Sew = ":sewing_needle:"
+	// SewingNeedle is :sewing_needle:.
+	SewingNeedle = ":sewing_needle:"
+	// SewingPin is :sewing_pin:, an alias of :pin:.
+	SewingPin = ":pin:"
+	// Sexy is :sexy:, an alias of :biting_lip:.
+	Sexy = ":biting_lip:"
+	// Shadow is :shadow:, an alias of :silhouette:.
+	Shadow = ":silhouette:"
+	// Shadows is :shadows:, an alias of :silhouettes:.
+	Shadows = ":silhouettes:"
+	// ShakingFace is :shaking_face:.
+	ShakingFace = ":shaking_face:"
+	// Shampoo is :shampoo:, an alias of :lotion_bottle:.
+	Shampoo = ":lotion_bottle:"
+	// Shamrock is :shamrock:.
+	Shamrock = ":shamrock:"
+	// Shape is :shape:, an alias of :magnet:.
+	Shape = ":magnet:"
+	// Shark is :shark:.
+	Shark = ":shark:"
+	// Sharp is :sharp:, an alias of :razor:.
+	Sharp = ":razor:"
+	// Shave is :shave:, an alias of :razor:.
+	Shave = ":razor:"
+	// ShavedIce is :shaved_ice:.
+	ShavedIce = ":shaved_ice:"
+	// Shawarma is :shawarma:, an alias of :doner_kebab:.
+	Shawarma = ":doner_kebab:"
+	// Sheep is :sheep:.
+	Sheep = ":sheep:"
+	// Shell is :shell:.
+	Shell = ":shell:"
+	// Shelter is :shelter:, an alias of :hut:.
+	Shelter = ":hut:"
+	// Shh is :shh:, an alias of :shushing_face:.
+	Shh = ":shushing_face:"
+	// Shield is :shield:.
+	Shield = ":shield:"
+	// Shiitake is :shiitake:, an alias of :brown_mushroom:.
+	Shiitake = ":brown_mushroom:"
+	// ShintoShrine is :shinto_shrine:.
+	ShintoShrine = ":shinto_shrine:"
+	// Ship is :ship:.
+	Ship = ":ship:"
+	// Shiro is :shiro:.
+	Shiro = ":shiro:"
+	// Shirt is :shirt:.
+	Shirt = ":shirt:"
+	// Shock is :shock:, an alias of :fear:.
+	Shock = ":fear:"
+	// Shocked is :shocked:, an alias of :exploding_head:.
+	Shocked = ":exploding_head:"
+	// Shoe is :shoe:.
+	Shoe = ":shoe:"
+	// Shoes is :shoes:, an alias of :ballet_shoes:.
+	Shoes = ":ballet_shoes:"
+	// Shoo is :shoo:, an alias of :palm_down_hand:.
+	Shoo = ":palm_down_hand:"
+	// ShootingStar is :shooting_star:.
+	ShootingStar = ":shooting_star:"
+	// ShoppingBags is :shopping_bags:.
+	ShoppingBags = ":shopping_bags:"
+	// ShoppingCart is :shopping_cart:.
+	ShoppingCart = ":shopping_cart:"
+	// ShoppingTrolley is :shopping_trolley:, an alias of :shopping_cart:.
+	ShoppingTrolley = ":shopping_cart:"
+	// Shorts is :shorts:.
+	Shorts = ":shorts:"
+	// Shout is :shout:, an alias of :megaphone:.
+	Shout = ":megaphone:"
+	// Shovel is :shovel:.
+	Shovel = ":shovel:"
+	// Shower is :shower:.
+	Shower = ":shower:"
+	// Shrimp is :shrimp:.
+	Shrimp = ":shrimp:"
+	// Shrinking is :shrinking:, an alias of :downwards_trend:.
+	Shrinking = ":downwards_trend:"
+	// Shroom is :shroom:, an alias of :brown_mushroom:.
+	Shroom = ":brown_mushroom:"
+	// Shrub is :shrub:, an alias of :hyacinth:.
+	Shrub = ":hyacinth:"
+	// Shrug is :shrug:.
+	Shrug = ":shrug:"
+	// Shuffle is :shuffle:.
+	Shuffle = ":shuffle:"
+	// Shush is :shush:, an alias of :shushing_face:.
+	Shush = ":shushing_face:"
+	// Shushing is :shushing:, an alias of :shushing_face:.
+	Shushing = ":shushing_face:"
+	// ShushingFace is :shushing_face:.
+	ShushingFace = ":shushing_face:"
+	// Sick is :sick:.
+	Sick = ":sick:"
+	// Sigh is :sigh:, an alias of :face_exhaling:.
+	Sigh = ":face_exhaling:"
+	// SignOfTheHorns is :sign_of_the_horns:, an alias of :rock_on:.
+	SignOfTheHorns = ":rock_on:"
+	// SignalBars is :signal_bars:, an alias of :cell_reception:.
+	SignalBars = ":cell_reception:"
+	// SignalStrength is :signal_strength:, an alias of :cell_reception:.
+	SignalStrength = ":cell_reception:"
+	// Sikh is :sikh:, an alias of :khanda:.
+	Sikh = ":khanda:"
+	// Sikhism is :sikhism:, an alias of :khanda:.
+	Sikhism = ":khanda:"
+	// Silence is :silence:.
+	Silence = ":silence:"
+	// Silhouette is :silhouette:.
+	Silhouette = ":silhouette:"
+	// Silhouettes is :silhouettes:.
+	Silhouettes = ":silhouettes:"
+	// Silly is :silly:, an alias of :goose:.
+	Silly = ":goose:"
+	// Silver is :silver:, an alias of :second_place:.
+	Silver = ":second_place:"
+	// Singer is :singer:.
+	Singer = ":singer:"
+	// Sir is :sir:, an alias of :saluting_face:.
+	Sir = ":saluting_face:"
+	// Siren is :siren:.
+	Siren = ":siren:"
+	// Sit is :sit:, an alias of :chair:.
+	Sit = ":chair:"
+	// Six is :six:.
+	Six = ":six:"
+	// SixPointed is :six_pointed:, an alias of :dotted_six_pointed_star:.
+	SixPointed = ":dotted_six_pointed_star:"
+	// Skateboard is :skateboard:.
+	Skateboard = ":skateboard:"
+	// Skater is :skater:, an alias of :skateboard:.
+	Skater = ":skateboard:"
+	// Skates is :skates:, an alias of :roller_skate:.
+	Skates = ":roller_skate:"
+	// Skeptic is :skeptic:, an alias of :face_with_raised_eyebrow:.
+	Skeptic = ":face_with_raised_eyebrow:"
+	// Skepticism is :skepticism:, an alias of :face_with_raised_eyebrow:.
+	Skepticism = ":face_with_raised_eyebrow:"
+	// Ski is :ski:.
+	Ski = ":ski:"
+	// SkiLift is :ski_lift:, an alias of :aerial_tramway:.
+	SkiLift = ":aerial_tramway:"
+	// Skier is :skier:.
+	Skier = ":skier:"
+	// Skills is :skills:, an alias of :ninja:.
+	Skills = ":ninja:"
+	// SkipBack is :skip_back:, an alias of :previous_track:.
+	SkipBack = ":previous_track:"
+	// SkipForward is :skip_forward:, an alias of :next_track:.
+	SkipForward = ":next_track:"
+	// Skull is :skull:.
+	Skull = ":skull:"
+	// SkullAndCrossbones is :skull_and_crossbones:.
+	SkullAndCrossbones = ":skull_and_crossbones:"
+	// Skunk is :skunk:.
+	Skunk = ":skunk:"
+	// Sky is :sky:, an alias of :light_blue_heart:.
+	Sky = ":light_blue_heart:"
+	// Skydive is :skydive:, an alias of :parachute:.
+	Skydive = ":parachute:"
+	// Skyline is :skyline:, an alias of :city:.
+	Skyline = ":city:"
+	// Slate is :slate:, an alias of :grey_heart:.
+	Slate = ":grey_heart:"
+	// Sled is :sled:.
+	Sled = ":sled:"
+	// Sledge is :sledge:, an alias of :sled:.
+	Sledge = ":sled:"
+	// Sleep is :sleep:, an alias of :yawning_face:.
+	Sleep = ":yawning_face:"
+	// Sleeping is :sleeping:.
+	Sleeping = ":sleeping:"
+	// Sleepy is :sleepy:.
+	Sleepy = ":sleepy:"
+	// Sleigh is :sleigh:, an alias of :sled:.
+	Sleigh = ":sled:"
+	// Sleuth is :sleuth:, an alias of :detective:.
+	Sleuth = ":detective:"
+	// Slice is :slice:, an alias of :pie:.
+	Slice = ":pie:"
+	// Slide is :slide:, an alias of :playground_slide:.
+	Slide = ":playground_slide:"
+	// Sliding is :sliding:, an alias of :playground_slide:.
+	Sliding = ":playground_slide:"
+	// SlightFrown is :slight_frown:, an alias of :frown:.
+	SlightFrown = ":frown:"
+	// SlightSmile is :slight_smile:.
+	SlightSmile = ":slight_smile:"
+	// SlipOn is :slip_on:, an alias of :flat_shoe:.
+	SlipOn = ":flat_shoe:"
+	// Slipper is :slipper:, an alias of :flat_shoe:.
+	Slipper = ":flat_shoe:"
+	// SlotMachine is :slot_machine:.
+	SlotMachine = ":slot_machine:"
+	// Sloth is :sloth:.
+	Sloth = ":sloth:"
+	// Slow is :slow:, an alias of :sloth:.
+	Slow = ":sloth:"
+	// SmallAirplane is :small_airplane:.
+	SmallAirplane = ":small_airplane:"
+	// SmallBlueDiamond is :small_blue_diamond:.
+	SmallBlueDiamond = ":small_blue_diamond:"
+	// SmallGlass is :small_glass:.
+	SmallGlass = ":small_glass:"
+	// SmallOrangeDiamond is :small_orange_diamond:.
+	SmallOrangeDiamond = ":small_orange_diamond:"
+	// Smart is :smart:, an alias of :brain:.
+	Smart = ":brain:"
+	// Smartphone is :smartphone:, an alias of :mobile_phone:.
+	Smartphone = ":mobile_phone:"
+	// Smile is :smile:.
+	Smile = ":smile:"
+	// SmileCat is :smile_cat:.
+	SmileCat = ":smile_cat:"
+	// Smiley is :smiley:.
+	Smiley = ":smiley:"
+	// SmileyCat is :smiley_cat:.
+	SmileyCat = ":smiley_cat:"
+	// SmilingDevil is :smiling_devil:.
+	SmilingDevil = ":smiling_devil:"
+	// SmilingFace is :smiling_face:.
+	SmilingFace = ":smiling_face:"
+	// SmilingFaceWithHearts is :smiling_face_with_hearts:.
+	SmilingFaceWithHearts = ":smiling_face_with_hearts:"
+	// SmilingFaceWithHorns is :smiling_face_with_horns:, an alias of :smiling_devil:.
+	SmilingFaceWithHorns = ":smiling_devil:"
+	// SmilingFaceWithTear is :smiling_face_with_tear:.
+	SmilingFaceWithTear = ":smiling_face_with_tear:"
+	// SmilingImp is :smiling_imp:, an alias of :smiling_devil:.
+	SmilingImp = ":smiling_devil:"
+	// Smirk is :smirk:.
+	Smirk = ":smirk:"
+	// SmirkCat is :smirk_cat:.
+	SmirkCat = ":smirk_cat:"
+	// Smitten is :smitten:, an alias of :cupid:.
+	Smitten = ":cupid:"
+	// Smoke is :smoke:, an alias of :face_exhaling:.
+	Smoke = ":face_exhaling:"
+	// Smoking is :smoking:.
+	Smoking = ":smoking:"
+	// Smooch is :smooch:, an alias of :kissing_face:.
+	Smooch = ":kissing_face:"
+	// Smooches is :smooches:, an alias of :kissing_face:.
+	Smooches = ":kissing_face:"
+	// Smug is :smug:, an alias of :smirk:.
+	Smug = ":smirk:"
+	// SmugCat is :smug_cat:, an alias of :smirk_cat:.
+	SmugCat = ":smirk_cat:"
+	// Snail is :snail:.
+	Snail = ":snail:"
+	// Snake is :snake:.
+	Snake = ":snake:"
+	// Snap is :snap:, an alias of :hand_with_index_finger_and_thumb_crossed:.
+	Snap = ":hand_with_index_finger_and_thumb_crossed:"
+	// Snapdragon is :snapdragon:, an alias of :hyacinth:.
+	Snapdragon = ":hyacinth:"
+	// Snare is :snare:, an alias of :mouse_trap:.
+	Snare = ":mouse_trap:"
+	// Sneaker is :sneaker:, an alias of :athletic_shoe:.
+	Sneaker = ":athletic_shoe:"
+	// Sneaky is :sneaky:, an alias of :detective:.
+	Sneaky = ":detective:"
+	// Sneezing is :sneezing:.
+	Sneezing = ":sneezing:"
+	// Snorkeling is :snorkeling:, an alias of :diving_mask:.
+	Snorkeling = ":diving_mask:"
+	// Snowboarder is :snowboarder:.
+	Snowboarder = ":snowboarder:"
+	// Snowflake is :snowflake:.
+	Snowflake = ":snowflake:"
+	// Snowman is :snowman:.
+	Snowman = ":snowman:"
+	// Snowstorm is :snowstorm:, an alias of :snowy:.
+	Snowstorm = ":snowy:"
+	// Snowy is :snowy:.
+	Snowy = ":snowy:"
+	// SnowyMountain is :snowy_mountain:.
+	SnowyMountain = ":snowy_mountain:"
+	// Soak is :soak:, an alias of :sponge:.
+	Soak = ":sponge:"
+	// Soaked is :soaked:, an alias of :rainy:.
+	Soaked = ":rainy:"
+	// Soap is :soap:.
+	Soap = ":soap:"
+	// Soapdish is :soapdish:, an alias of :soap:.
+	Soapdish = ":soap:"
+	// Sob is :sob:.
+	Sob = ":sob:"
+	// Soccer is :soccer:, an alias of :football:.
+	Soccer = ":football:"
+	// Socks is :socks:.
+	Socks = ":socks:"
+	// Soda is :soda:, an alias of :cup_with_straw:.
+	Soda = ":cup_with_straw:"
+	// Soft is :soft:, an alias of :cup_with_straw:.
+	Soft = ":cup_with_straw:"
+	// SoftIceCream is :soft_ice_cream:, an alias of :soft_serve:.
+	SoftIceCream = ":soft_serve:"
+	// SoftServe is :soft_serve:.
+	SoftServe = ":soft_serve:"
+	// Softball is :softball:.
+	Softball = ":softball:"
+	// Softer is :softer:.
+	Softer = ":softer:"
+	// Solid is :solid:, an alias of :rock:.
+	Solid = ":rock:"
+	// Solidarity is :solidarity:, an alias of :crossed_flags:.
+	Solidarity = ":crossed_flags:"
+	// Soon is :soon:.
+	Soon = ":soon:"
+	// Sort is :sort:.
+	Sort = ":sort:"
+	// Sos is :sos:.
+	Sos = ":sos:"
+	// Sound is :sound:, an alias of :louder:.
+	Sound = ":louder:"
+	// Soup is :soup:, an alias of :food:.
+	Soup = ":food:"
+	// Sour is :sour:, an alias of :lime:.
+	Sour = ":lime:"
+	// South is :south:, an alias of :down:.
+	South = ":down:"
+	// SouthEast is :south_east:, an alias of :lower_right:.
+	SouthEast = ":lower_right:"
+	// SouthWest is :south_west:, an alias of :lower_left:.
+	SouthWest = ":lower_left:"
+	// Souvlaki is :souvlaki:, an alias of :doner_kebab:.
+	Souvlaki = ":doner_kebab:"
+	// Soybean is :soybean:, an alias of :pea_pod:.
+	Soybean = ":pea_pod:"
+	// SpaceInvader is :space_invader:.
+	SpaceInvader = ":space_invader:"
+	// Spade is :spade:, an alias of :shovel:.
+	Spade = ":shovel:"
+	// Spades is :spades:.
+	Spades = ":spades:"
+	// Spaghetti is :spaghetti:.
+	Spaghetti = ":spaghetti:"
+	// Spark is :spark:, an alias of :firecracker:.
+	Spark = ":firecracker:"
+	// Sparkle is :sparkle:.
+	Sparkle = ":sparkle:"
+	// Sparkler is :sparkler:.
+	Sparkler = ":sparkler:"
+	// Sparkles is :sparkles:.
+	Sparkles = ":sparkles:"
+	// SparklingHeart is :sparkling_heart:.
+	SparklingHeart = ":sparkling_heart:"
+	// SpeakNoEvil is :speak_no_evil:.
+	SpeakNoEvil = ":speak_no_evil:"
+	// Speaker is :speaker:.
+	Speaker = ":speaker:"
+	// SpeakingHead is :speaking_head:.
+	SpeakingHead = ":speaking_head:"
+	// Spectacles is :spectacles:, an alias of :glasses:.
+	Spectacles = ":glasses:"
+	// Speculum is :speculum:, an alias of :mirror:.
+	Speculum = ":mirror:"
+	// Speech is :speech:, an alias of :eye_in_speech_bubble:.
+	Speech = ":eye_in_speech_bubble:"
+	// SpeechBalloon is :speech_balloon:, an alias of :umm:.
+	SpeechBalloon = ":umm:"
+	// SpeechBubble is :speech_bubble:.
+	SpeechBubble = ":speech_bubble:"
+	// Speechless is :speechless:.
+	Speechless = ":speechless:"
+	// Speedboat is :speedboat:.
+	Speedboat = ":speedboat:"
+	// Spew is :spew:, an alias of :face_vomiting:.
+	Spew = ":face_vomiting:"
+	// Spice is :spice:, an alias of :ginger_root:.
+	Spice = ":ginger_root:"
+	// Spider is :spider:.
+	Spider = ":spider:"
+	// SpiderWeb is :spider_web:, an alias of :web:.
+	SpiderWeb = ":web:"
+	// Spiny is :spiny:, an alias of :hedgehog:.
+	Spiny = ":hedgehog:"
+	// SpiralCalendar is :spiral_calendar:.
+	SpiralCalendar = ":spiral_calendar:"
+	// SpiralNotebook is :spiral_notebook:, an alias of :ledger:.
+	SpiralNotebook = ":ledger:"
+	// SpiralNotepad is :spiral_notepad:.
+	SpiralNotepad = ":spiral_notepad:"
+	// SpiralShell is :spiral_shell:, an alias of :shell:.
+	SpiralShell = ":shell:"
+	// Splatter is :splatter:.
+	Splatter = ":splatter:"
+	// Split is :split:, an alias of :axe:.
+	Split = ":axe:"
+	// Spock is :spock:.
+	Spock = ":spock:"
+	// Sponge is :sponge:.
+	Sponge = ":sponge:"
+	// Spooky is :spooky:, an alias of :ghost:.
+	Spooky = ":ghost:"
+	// Spool is :spool:, an alias of :thread:.
+	Spool = ":thread:"
+	// Spoon is :spoon:.
+	Spoon = ":spoon:"
+	// Spore is :spore:, an alias of :brown_mushroom:.
+	Spore = ":brown_mushroom:"
+	// Sports is :sports:, an alias of :ball:.
+	Sports = ":ball:"
+	// Spots is :spots:, an alias of :giraffe:.
+	Spots = ":giraffe:"
+	// Spring is :spring:, an alias of :hyacinth:.
+	Spring = ":hyacinth:"
+	// Sprinkles is :sprinkles:, an alias of :cupcake:.
+	Sprinkles = ":cupcake:"
+	// Sprout is :sprout:, an alias of :seedling:.
+	Sprout = ":seedling:"
+	// Spy is :spy:, an alias of :detective:.
+	Spy = ":detective:"
+	// SquaredOk is :squared_ok:.
+	SquaredOk = ":squared_ok:"
+	// SquaredUp is :squared_up:.
+	SquaredUp = ":squared_up:"
+	// Squeeze is :squeeze:, an alias of :accordion:.
+	Squeeze = ":accordion:"
+	// Squeezebox is :squeezebox:, an alias of :accordion:.
+	Squeezebox = ":accordion:"
+	// Squid is :squid:.
+	Squid = ":squid:"
+	// Stadium is :stadium:.
+	Stadium = ":stadium:"
+	// Staff is :staff:, an alias of :medical_symbol:.
+	Staff = ":medical_symbol:"
+	// Stain is :stain:, an alias of :splatter:.
+	Stain = ":splatter:"
+	// Star is :star:.
+	Star = ":star:"
+	// StarAndCrescent is :star_and_crescent:.
+	StarAndCrescent = ":star_and_crescent:"
+	// StarOfDavid is :star_of_david:.
+	StarOfDavid = ":star_of_david:"
+	// StarStruck is :star_struck:.
+	StarStruck = ":star_struck:"
+	// Stare is :stare:, an alias of :face_with_peeking_eye:.
+	Stare = ":face_with_peeking_eye:"
+	// StarryEyed is :starry_eyed:, an alias of :star_struck:.
+	StarryEyed = ":star_struck:"
+	// Start is :start:, an alias of :checkered_flag:.
+	Start = ":checkered_flag:"
+	// Station is :station:.
+	Station = ":station:"
+	// Statue is :statue:.
+	Statue = ":statue:"
+	// StatueOfLiberty is :statue_of_liberty:, an alias of :statue:.
+	StatueOfLiberty = ":statue:"
+	// Steak is :steak:, an alias of :cut_of_meat:.
+	Steak = ":cut_of_meat:"
+	// Stealth is :stealth:, an alias of :ninja:.
+	Stealth = ":ninja:"
+	// SteamLocomotive is :steam_locomotive:, an alias of :train:.
+	SteamLocomotive = ":train:"
+	// Step is :step:, an alias of :ladder:.
+	Step = ":ladder:"
+	// Stethoscope is :stethoscope:.
+	Stethoscope = ":stethoscope:"
+	// Stew is :stew:, an alias of :food:.
+	Stew = ":food:"
+	// Sting is :sting:, an alias of :jellyfish:.
+	Sting = ":jellyfish:"
+	// Stinger is :stinger:, an alias of :jellyfish:.
+	Stinger = ":jellyfish:"
+	// Stink is :stink:, an alias of :skunk:.
+	Stink = ":skunk:"
+	// Stitches is :stitches:, an alias of :sewing_needle:.
+	Stitches = ":sewing_needle:"
+	// StockMarket is :stock_market:.
+	StockMarket = ":stock_market:"
+	// Stocking is :stocking:, an alias of :socks:.
+	Stocking = ":socks:"
+	// Stomp is :stomp:, an alias of :foot:.
+	Stomp = ":foot:"
+	// Stop is :stop:.
+	Stop = ":stop:"
+	// StopButton is :stop_button:.
+	StopButton = ":stop_button:"
+	// StopSign is :stop_sign:.
+	StopSign = ":stop_sign:"
+	// Stopwatch is :stopwatch:.
+	Stopwatch = ":stopwatch:"
+	// Store is :store:, an alias of :jar:.
+	Store = ":jar:"
+	// Straightedge is :straightedge:, an alias of :ruler:.
+	Straightedge = ":ruler:"
+	// Strawberry is :strawberry:.
+	Strawberry = ":strawberry:"
+	// Streetcar is :streetcar:, an alias of :tram:.
+	Streetcar = ":tram:"
+	// Stressed is :stressed:, an alias of :exhausted:.
+	Stressed = ":exhausted:"
+	// Strike is :strike:.
+	Strike = ":strike:"
+	// String is :string:, an alias of :thread:.
+	String = ":thread:"
+	// Stringed is :stringed:, an alias of :banjo:.
+	Stringed = ":banjo:"
+	// Stripe is :stripe:, an alias of :zebra:.
+	Stripe = ":zebra:"
+	// StripedPole is :striped_pole:, an alias of :barber:.
+	StripedPole = ":barber:"
+	// Stroke is :stroke:, an alias of :hot_face:.
+	Stroke = ":hot_face:"
+	// Stubborn is :stubborn:, an alias of :donkey:.
+	Stubborn = ":donkey:"
+	// StuckOutTongue is :stuck_out_tongue:.
+	StuckOutTongue = ":stuck_out_tongue:"
+	// StuckOutTongueClosedEyes is :stuck_out_tongue_closed_eyes:.
+	StuckOutTongueClosedEyes = ":stuck_out_tongue_closed_eyes:"
+	// StuckOutTongueWink is :stuck_out_tongue_wink:.
+	StuckOutTongueWink = ":stuck_out_tongue_wink:"
+	// Student is :student:.
+	Student = ":student:"
+	// StudioMicrophone is :studio_microphone:.
+	StudioMicrophone = ":studio_microphone:"
+	// StuffedFlatbread is :stuffed_flatbread:, an alias of :doner_kebab:.
+	StuffedFlatbread = ":doner_kebab:"
+	// Stuffy is :stuffy:, an alias of :face_with_monocle:.
+	Stuffy = ":face_with_monocle:"
+	// Subtract is :subtract:, an alias of :minus:.
+	Subtract = ":minus:"
+	// Suburb is :suburb:.
+	Suburb = ":suburb:"
+	// Subway is :subway:.
+	Subway = ":subway:"
+	// Subzero is :subzero:, an alias of :cold_face:.
+	Subzero = ":cold_face:"
+	// Suction is :suction:, an alias of :plunger:.
+	Suction = ":plunger:"
+	// Sudden is :sudden:, an alias of :face_with_hand_over_mouth:.
+	Sudden = ":face_with_hand_over_mouth:"
+	// Sugar is :sugar:, an alias of :cupcake:.
+	Sugar = ":cupcake:"
+	// Suitcase is :suitcase:, an alias of :luggage:.
+	Suitcase = ":luggage:"
+	// SunAndRain is :sun_and_rain:, an alias of :sunshowers:.
+	SunAndRain = ":sunshowers:"
+	// SunFace is :sun_face:.
+	SunFace = ":sun_face:"
+	// Sunflower is :sunflower:.
+	Sunflower = ":sunflower:"
+	// Sunglasses is :sunglasses:.
+	Sunglasses = ":sunglasses:"
+	// Sunny is :sunny:.
+	Sunny = ":sunny:"
+	// Sunrise is :sunrise:.
+	Sunrise = ":sunrise:"
+	// Sunscreen is :sunscreen:, an alias of :lotion_bottle:.
+	Sunscreen = ":lotion_bottle:"
+	// Sunset is :sunset:.
+	Sunset = ":sunset:"
+	// Sunshowers is :sunshowers:.
+	Sunshowers = ":sunshowers:"
+	// Superhero is :superhero:.
+	Superhero = ":superhero:"
+	// Supervillain is :supervillain:.
+	Supervillain = ":supervillain:"
+	// Surf is :surf:.
+	Surf = ":surf:"
+	// Surprise is :surprise:, an alias of :open_mouth:.
+	Surprise = ":open_mouth:"
+	// Surrender is :surrender:, an alias of :white_flag:.
+	Surrender = ":white_flag:"
+	// Sushi is :sushi:.
+	Sushi = ":sushi:"
+	// SuspensionRailway is :suspension_railway:.
+	SuspensionRailway = ":suspension_railway:"
+	// Sutures is :sutures:, an alias of :sewing_needle:.
+	Sutures = ":sewing_needle:"
+	// Swan is :swan:.
+	Swan = ":swan:"
+	// Swap is :swap:, an alias of :left_right:.
+	Swap = ":left_right:"
+	// Swat is :swat:, an alias of :newspaper:.
+	Swat = ":newspaper:"
+	// Swearing is :swearing:, an alias of :face_with_symbols_on_mouth:.
+	Swearing = ":face_with_symbols_on_mouth:"
+	// Sweat is :sweat:.
+	Sweat = ":sweat:"
+	// SweatDrops is :sweat_drops:.
+	SweatDrops = ":sweat_drops:"
+	// SweatSmile is :sweat_smile:.
+	SweatSmile = ":sweat_smile:"
+	// Sweating is :sweating:, an alias of :hot_face:.
+	Sweating = ":hot_face:"
+	// Sweeping is :sweeping:, an alias of :broom:.
+	Sweeping = ":broom:"
+	// SweetPotato is :sweet_potato:, an alias of :yam:.
+	SweetPotato = ":yam:"
+	// Swim is :swim:.
+	Swim = ":swim:"
+	// Swords is :swords:, an alias of :duel:.
+	Swords = ":duel:"
+	// Symbols is :symbols:.
+	Symbols = ":symbols:"
+	// Synagogue is :synagogue:.
+	Synagogue = ":synagogue:"
+	// Syringe is :syringe:, an alias of :injection:.
+	Syringe = ":injection:"
+	// T is :t:, an alias of :t_rex:.
+	T = ":t_rex:"
+	// TRex is :t_rex:.
+	TRex = ":t_rex:"
+	// TableSetting is :table_setting:, an alias of :hungry:.
+	TableSetting = ":hungry:"
+	// TableTennis is :table_tennis:, an alias of :ping_pong:.
+	TableTennis = ":ping_pong:"
+	// Tache is :tache:, an alias of :disguised_face:.
+	Tache = ":disguised_face:"
+	// Taco is :taco:.
+	Taco = ":taco:"
+	// Tada is :tada:.
+	Tada = ":tada:"
+	// Tag is :tag:, an alias of :label:.
+	Tag = ":label:"
+	// Tailoring is :tailoring:, an alias of :sewing_needle:.
+	Tailoring = ":sewing_needle:"
+	// TakeOff is :take_off:.
+	TakeOff = ":take_off:"
+	// Takeout is :takeout:, an alias of :takeout_box:.
+	Takeout = ":takeout_box:"
+	// TakeoutBox is :takeout_box:.
+	TakeoutBox = ":takeout_box:"
+	// TakingAPicture is :taking_a_picture:.
+	TakingAPicture = ":taking_a_picture:"
+	// Talisman is :talisman:, an alias of :nazar_amulet:.
+	Talisman = ":nazar_amulet:"
+	// Talk is :talk:, an alias of :parrot:.
+	Talk = ":parrot:"
+	// Tamale is :tamale:.
+	Tamale = ":tamale:"
+	// TanabataTree is :tanabata_tree:, an alias of :wish_tree:.
+	TanabataTree = ":wish_tree:"
+	// Tangerine is :tangerine:, an alias of :orange:.
+	Tangerine = ":orange:"
+	// Tangled is :tangled:, an alias of :knot:.
+	Tangled = ":knot:"
+	// Tangy is :tangy:, an alias of :lime:.
+	Tangy = ":lime:"
+	// TapWater is :tap_water:, an alias of :potable_water:.
+	TapWater = ":potable_water:"
+	// Tash is :tash:, an alias of :disguised_face:.
+	Tash = ":disguised_face:"
+	// Taste is :taste:, an alias of :salt:.
+	Taste = ":salt:"
+	// Taurus is :taurus:.
+	Taurus = ":taurus:"
+	// Taxi is :taxi:.
+	Taxi = ":taxi:"
+	// Tea is :tea:.
+	Tea = ":tea:"
+	// Teacher is :teacher:.
+	Teacher = ":teacher:"
+	// Teal is :teal:, an alias of :light_blue_heart:.
+	Teal = ":light_blue_heart:"
+	// Teapot is :teapot:.
+	Teapot = ":teapot:"
+	// Tear is :tear:, an alias of :smiling_face_with_tear:.
+	Tear = ":smiling_face_with_tear:"
+	// Tears is :tears:, an alias of :joy:.
+	Tears = ":joy:"
+	// Technologist is :technologist:.
+	Technologist = ":technologist:"
+	// Teddy is :teddy:, an alias of :teddy_bear:.
+	Teddy = ":teddy_bear:"
+	// TeddyBear is :teddy_bear:.
+	TeddyBear = ":teddy_bear:"
+	// Tegh is :tegh:, an alias of :khanda:.
+	Tegh = ":khanda:"
+	// Telephone is :telephone:, an alias of :phone:.
+	Telephone = ":phone:"
+	// Telescope is :telescope:.
+	Telescope = ":telescope:"
+	// Television is :television:, an alias of :tv:.
+	Television = ":tv:"
+	// Tell is :tell:, an alias of :palm_up_hand:.
+	Tell = ":palm_up_hand:"
+	// Temperature is :temperature:.
+	Temperature = ":temperature:"
+	// Temple is :temple:, an alias of :hindu_temple:.
+	Temple = ":hindu_temple:"
+	// Tempura is :tempura:.
+	Tempura = ":tempura:"
+	// Ten is :ten:.
+	Ten = ":ten:"
+	// Tennis is :tennis:.
+	Tennis = ":tennis:"
+	// Tent is :tent:.
+	Tent = ":tent:"
+	// Tentacles is :tentacles:, an alias of :jellyfish:.
+	Tentacles = ":jellyfish:"
+	// Tequila is :tequila:, an alias of :lime:.
+	Tequila = ":lime:"
+	// Terrestrial is :terrestrial:, an alias of :flying_saucer:.
+	Terrestrial = ":flying_saucer:"
+	// Test is :test:, an alias of :test_tube:.
+	Test = ":test_tube:"
+	// TestTube is :test_tube:.
+	TestTube = ":test_tube:"
+	// ThankYou is :thank_you:, an alias of :folded_hands:.
+	ThankYou = ":folded_hands:"
+	// Thanks is :thanks:, an alias of :people_hugging:.
+	Thanks = ":people_hugging:"
+	// Theater is :theater:, an alias of :performing_arts:.
+	Theater = ":performing_arts:"
+	// Theme is :theme:, an alias of :playground_slide:.
+	Theme = ":playground_slide:"
+	// Thermometer is :thermometer:, an alias of :temperature:.
+	Thermometer = ":temperature:"
+	// Thinking is :thinking:.
+	Thinking = ":thinking:"
+	// ThirdPlace is :third_place:.
+	ThirdPlace = ":third_place:"
+	// This is :this:, an alias of :point_up:.
+	This = ":point_up:"
+	// Thong is :thong:, an alias of :thong_sandal:.
+	Thong = ":thong_sandal:"
+	// ThongSandal is :thong_sandal:.
+	ThongSandal = ":thong_sandal:"
+	// Thongs is :thongs:, an alias of :thong_sandal:.
+	Thongs = ":thong_sandal:"
+	// Thought is :thought:.
+	Thought = ":thought:"
+	// Thread is :thread:.
+	Thread = ":thread:"
+	// Three is :three:.
+	Three = ":three:"
+	// Thumb is :thumb:, an alias of :hand_with_index_finger_and_thumb_crossed:.
+	Thumb = ":hand_with_index_finger_and_thumb_crossed:"
+	// ThumbTack is :thumb_tack:, an alias of :push_pin:.
+	ThumbTack = ":push_pin:"
+	// ThumbsDown is :thumbs_down:, an alias of :-1:.
+	ThumbsDown = ":-1:"
+	// ThumbsUp is :thumbs_up:, an alias of :+1:.
+	ThumbsUp = ":+1:"
+	// ThunderAndRain is :thunder_and_rain:, an alias of :thunderstorm:.
+	ThunderAndRain = ":thunderstorm:"
+	// Thunderstorm is :thunderstorm:.
+	Thunderstorm = ":thunderstorm:"
+	// Tichel is :tichel:, an alias of :woman_with_headscarf:.
+	Tichel = ":woman_with_headscarf:"
+	// Ticket is :ticket:.
+	Ticket = ":ticket:"
+	// Tie is :tie:.
+	Tie = ":tie:"
+	// Tiger is :tiger:.
+	Tiger = ":tiger:"
+	// TigerCub is :tiger_cub:.
+	TigerCub = ":tiger_cub:"
+	// Tilted is :tilted:, an alias of :magnifying_glass_tilted_right:.
+	Tilted = ":magnifying_glass_tilted_right:"
+	// Timber is :timber:, an alias of :wood:.
+	Timber = ":wood:"
+	// Time is :time:.
+	Time = ":time:"
+	// TimeTicking is :time_ticking:.
+	TimeTicking = ":time_ticking:"
+	// Timer is :timer:.
+	Timer = ":timer:"
+	// TimesUp is :times_up:.
+	TimesUp = ":times_up:"
+	// Tipsy is :tipsy:, an alias of :woozy_face:.
+	Tipsy = ":woozy_face:"
+	// Tire is :tire:, an alias of :wheel:.
+	Tire = ":wheel:"
+	// Tired is :tired:, an alias of :pensive:.
+	Tired = ":pensive:"
+	// Titania is :titania:, an alias of :woman_fairy:.
+	Titania = ":woman_fairy:"
+	// Tm is :tm:.
+	Tm = ":tm:"
+	// Toast is :toast:, an alias of :clink:.
+	Toast = ":clink:"
+	// Toboggan is :toboggan:, an alias of :sled:.
+	Toboggan = ":sled:"
+	// Toilet is :toilet:.
+	Toilet = ":toilet:"
+	// Toiletry is :toiletry:, an alias of :toothbrush:.
+	Toiletry = ":toothbrush:"
+	// TokyoTower is :tokyo_tower:, an alias of :tower:.
+	TokyoTower = ":tower:"
+	// Tomato is :tomato:.
+	Tomato = ":tomato:"
+	// Tomb is :tomb:, an alias of :headstone:.
+	Tomb = ":headstone:"
+	// Tombstone is :tombstone:, an alias of :headstone:.
+	Tombstone = ":headstone:"
+	// Tongue is :tongue:.
+	Tongue = ":tongue:"
+	// Toolbox is :toolbox:.
+	Toolbox = ":toolbox:"
+	// Tools is :tools:, an alias of :working_on_it:.
+	Tools = ":working_on_it:"
+	// Tooth is :tooth:.
+	Tooth = ":tooth:"
+	// Toothbrush is :toothbrush:.
+	Toothbrush = ":toothbrush:"
+	// Top is :top:.
+	Top = ":top:"
+	// TopHat is :top_hat:.
+	TopHat = ":top_hat:"
+	// Toppings is :toppings:, an alias of :brown_mushroom:.
+	Toppings = ":brown_mushroom:"
+	// Tornado is :tornado:.
+	Tornado = ":tornado:"
+	// Tortoise is :tortoise:, an alias of :turtle:.
+	Tortoise = ":turtle:"
+	// Touched is :touched:, an alias of :smiling_face_with_tear:.
+	Touched = ":smiling_face_with_tear:"
+	// Tough is :tough:, an alias of :rock:.
+	Tough = ":rock:"
+	// Towels is :towels:, an alias of :roll_of_paper:.
+	Towels = ":roll_of_paper:"
+	// Tower is :tower:.
+	Tower = ":tower:"
+	// Toxic is :toxic:, an alias of :skull_and_crossbones:.
+	Toxic = ":skull_and_crossbones:"
+	// Trace is :trace:, an alias of :fingerprint:.
+	Trace = ":fingerprint:"
+	// Trackball is :trackball:.
+	Trackball = ":trackball:"
+	// Tractor is :tractor:.
+	Tractor = ":tractor:"
+	// TractorTrailer is :tractor-trailer:, an alias of :truck:.
+	TractorTrailer = ":truck:"
+	// Trademark is :trademark:, an alias of :tm:.
+	Trademark = ":tm:"
+	// TrafficLight is :traffic_light:.
+	TrafficLight = ":traffic_light:"
+	// Train is :train:.
+	Train = ":train:"
+	// TrainCar is :train_car:, an alias of :railway_car:.
+	TrainCar = ":railway_car:"
+	// TrainTracks is :train_tracks:, an alias of :railway_track:.
+	TrainTracks = ":railway_track:"
+	// Tram is :tram:.
+	Tram = ":tram:"
+	// Trans is :trans:, an alias of :rainbow_flag:.
+	Trans = ":rainbow_flag:"
+	// Transform is :transform:, an alias of :phoenix:.
+	Transform = ":phoenix:"
+	// TransgenderFlag is :transgender_flag:.
+	TransgenderFlag = ":transgender_flag:"
+	// TransgenderSymbol is :transgender_symbol:.
+	TransgenderSymbol = ":transgender_symbol:"
+	// Transparent is :transparent:, an alias of :window:.
+	Transparent = ":window:"
+	// TransportTruck is :transport_truck:, an alias of :truck:.
+	TransportTruck = ":truck:"
+	// Transportation is :transportation:, an alias of :pickup_truck:.
+	Transportation = ":pickup_truck:"
+	// Trap is :trap:, an alias of :mouse_trap:.
+	Trap = ":mouse_trap:"
+	// TrashCan is :trash_can:, an alias of :wastebasket:.
+	TrashCan = ":wastebasket:"
+	// Travel is :travel:, an alias of :luggage:.
+	Travel = ":luggage:"
+	// Treat is :treat:, an alias of :cupcake:.
+	Treat = ":cupcake:"
+	// Tree is :tree:.
+	Tree = ":tree:"
+	// TriangularFlag is :triangular_flag:.
+	TriangularFlag = ":triangular_flag:"
+	// TriangularRuler is :triangular_ruler:, an alias of :carpenter_square:.
+	TriangularRuler = ":carpenter_square:"
+	// Trident is :trident:.
+	Trident = ":trident:"
+	// Trim is :trim:, an alias of :carpentry_saw:.
+	Trim = ":carpentry_saw:"
+	// Triton is :triton:, an alias of :merman:.
+	Triton = ":merman:"
+	// Triumph is :triumph:.
+	Triumph = ":triumph:"
+	// Troll is :troll:.
+	Troll = ":troll:"
+	// Trolley is :trolley:.
+	Trolley = ":trolley:"
+	// Trolling is :trolling:, an alias of :troll:.
+	Trolling = ":troll:"
+	// Troops is :troops:, an alias of :saluting_face:.
+	Troops = ":saluting_face:"
+	// Trophy is :trophy:.
+	Trophy = ":trophy:"
+	// TropicalDrink is :tropical_drink:.
+	TropicalDrink = ":tropical_drink:"
+	// TropicalFish is :tropical_fish:.
+	TropicalFish = ":tropical_fish:"
+	// Trouble is :trouble:, an alias of :face_with_spiral_eyes:.
+	Trouble = ":face_with_spiral_eyes:"
+	// Truck is :truck:.
+	Truck = ":truck:"
+	// Truffle is :truffle:, an alias of :brown_mushroom:.
+	Truffle = ":brown_mushroom:"
+	// Trumpet is :trumpet:.
+	Trumpet = ":trumpet:"
+	// Trunk is :trunk:, an alias of :leafless_tree:.
+	Trunk = ":leafless_tree:"
+	// Tshirt is :tshirt:, an alias of :shirt:.
+	Tshirt = ":shirt:"
+	// Tube is :tube:, an alias of :test_tube:.
+	Tube = ":test_tube:"
+	// Tuk is :tuk:, an alias of :auto_rickshaw:.
+	Tuk = ":auto_rickshaw:"
+	// Tulip is :tulip:.
+	Tulip = ":tulip:"
+	// Tumbling is :tumbling:, an alias of :cartwheel:.
+	Tumbling = ":cartwheel:"
+	// Turban is :turban:.
+	Turban = ":turban:"
+	// Turkey is :turkey:.
+	Turkey = ":turkey:"
+	// Turn is :turn:, an alias of :wheel:.
+	Turn = ":wheel:"
+	// Turnip is :turnip:, an alias of :root_vegetable:.
+	Turnip = ":root_vegetable:"
+	// Turtle is :turtle:.
+	Turtle = ":turtle:"
+	// Tusk is :tusk:, an alias of :mammoth:.
+	Tusk = ":mammoth:"
+	// Tuxedo is :tuxedo:.
+	Tuxedo = ":tuxedo:"
+	// Tv is :tv:.
+	Tv = ":tv:"
+	// Twine is :twine:, an alias of :knot:.
+	Twine = ":knot:"
+	// Twins is :twins:, an alias of :people_holding_hands:.
+	Twins = ":people_holding_hands:"
+	// Twist is :twist:, an alias of :knot:.
+	Twist = ":knot:"
+	// Twisted is :twisted:, an alias of :pretzel:.
+	Twisted = ":pretzel:"
+	// Two is :two:.
+	Two = ":two:"
+	// TwoHearts is :two_hearts:.
+	TwoHearts = ":two_hearts:"
+	// TwoMenHoldingHands is :two_men_holding_hands:.
+	TwoMenHoldingHands = ":two_men_holding_hands:"
+	// TwoWomenHoldingHands is :two_women_holding_hands:.
+	TwoWomenHoldingHands = ":two_women_holding_hands:"
+	// Typhoon is :typhoon:, an alias of :cyclone:.
+	Typhoon = ":cyclone:"
+	// Tyrannosaurus is :tyrannosaurus:, an alias of :t_rex:.
+	Tyrannosaurus = ":t_rex:"
+	// U is :u:, an alias of :magnet:.
+	U = ":magnet:"
+	// U5272 is :u5272:, an alias of :japanese_discount_button:.
+	U5272 = ":japanese_discount_button:"
+	// U5408 is :u5408:, an alias of :japanese_passing_grade_button:.
+	U5408 = ":japanese_passing_grade_button:"
+	// U55b6 is :u55b6:, an alias of :japanese_open_for_business_button:.
+	U55b6 = ":japanese_open_for_business_button:"
+	// U6708 is :u6708:, an alias of :japanese_monthly_amount_button:.
+	U6708 = ":japanese_monthly_amount_button:"
+	// U6709 is :u6709:, an alias of :japanese_not_free_of_charge_button:.
+	U6709 = ":japanese_not_free_of_charge_button:"
+	// U6e80 is :u6e80:, an alias of :japanese_no_vacancy_button:.
+	U6e80 = ":japanese_no_vacancy_button:"
+	// U7121 is :u7121:, an alias of :japanese_free_of_charge_button:.
+	U7121 = ":japanese_free_of_charge_button:"
+	// U7533 is :u7533:, an alias of :japanese_application_button:.
+	U7533 = ":japanese_application_button:"
+	// U7981 is :u7981:, an alias of :japanese_prohibited_button:.
+	U7981 = ":japanese_prohibited_button:"
+	// Ufo is :ufo:, an alias of :alien:.
+	Ufo = ":alien:"
+	// Ugh is :ugh:, an alias of :pinched_fingers:.
+	Ugh = ":pinched_fingers:"
+	// Ugly is :ugly:, an alias of :swan:.
+	Ugly = ":swan:"
+	// Ultimate is :ultimate:, an alias of :flying_disc:.
+	Ultimate = ":flying_disc:"
+	// Umbrella is :umbrella:.
+	Umbrella = ":umbrella:"
+	// UmbrellaWithRain is :umbrella_with_rain:.
+	UmbrellaWithRain = ":umbrella_with_rain:"
+	// Umm is :umm:.
+	Umm = ":umm:"
+	// Unamused is :unamused:.
+	Unamused = ":unamused:"
+	// Unbounded is :unbounded:, an alias of :infinity:.
+	Unbounded = ":infinity:"
+	// Uncomfortable is :uncomfortable:, an alias of :biting_lip:.
+	Uncomfortable = ":biting_lip:"
+	// Underage is :underage:.
+	Underage = ":underage:"
+	// Underarm is :underarm:, an alias of :softball:.
+	Underarm = ":softball:"
+	// Underwater is :underwater:, an alias of :bubbles:.
+	Underwater = ":bubbles:"
+	// Uneven is :uneven:, an alias of :woozy_face:.
+	Uneven = ":woozy_face:"
+	// Unicorn is :unicorn:.
+	Unicorn = ":unicorn:"
+	// Universal is :universal:, an alias of :infinity:.
+	Universal = ":infinity:"
+	// Unlocked is :unlocked:.
+	Unlocked = ":unlocked:"
+	// Unlucky is :unlucky:, an alias of :black_cat:.
+	Unlucky = ":black_cat:"
+	// UnreadMail is :unread_mail:.
+	UnreadMail = ":unread_mail:"
+	// Unsure is :unsure:, an alias of :face_with_diagonal_mouth:.
+	Unsure = ":face_with_diagonal_mouth:"
+	// Up is :up:.
+	Up = ":up:"
+	// UpButton is :up_button:, an alias of :upvote:.
+	UpButton = ":upvote:"
+	// UpDown is :up_down:.
+	UpDown = ":up_down:"
+	// UpperLeft is :upper_left:.
+	UpperLeft = ":upper_left:"
+	// UpperRight is :upper_right:.
+	UpperRight = ":upper_right:"
+	// UpsideDown is :upside_down:.
+	UpsideDown = ":upside_down:"
+	// Upvote is :upvote:.
+	Upvote = ":upvote:"
+	// UpwardsTrend is :upwards_trend:, an alias of :chart:.
+	UpwardsTrend = ":chart:"
+	// Vacancy is :vacancy:, an alias of :japanese_vacancy_button:.
+	Vacancy = ":japanese_vacancy_button:"
+	// Vampire is :vampire:.
+	Vampire = ":vampire:"
+	// Vase is :vase:.
+	Vase = ":vase:"
+	// Vat is :vat:, an alias of :bucket:.
+	Vat = ":bucket:"
+	// Vehicle is :vehicle:, an alias of :wheel:.
+	Vehicle = ":wheel:"
+	// VerticalTrafficLight is :vertical_traffic_light:, an alias of :traffic_light:.
+	VerticalTrafficLight = ":traffic_light:"
+	// Vertically is :vertically:, an alias of :head_shaking_vertically:.
+	Vertically = ":head_shaking_vertically:"
+	// VeryAngry is :very_angry:, an alias of :rage:.
+	VeryAngry = ":rage:"
+	// Vest is :vest:, an alias of :safety_vest:.
+	Vest = ":safety_vest:"
+	// Vhs is :vhs:.
+	Vhs = ":vhs:"
+	// Vibrate is :vibrate:, an alias of :shaking_face:.
+	Vibrate = ":shaking_face:"
+	// VibrationMode is :vibration_mode:.
+	VibrationMode = ":vibration_mode:"
+	// Victory is :victory:, an alias of :peace_sign:.
+	Victory = ":peace_sign:"
+	// Vicuna is :vicuna:, an alias of :llama:.
+	Vicuna = ":llama:"
+	// Vicuña is :vicuña:, an alias of :llama:.
+	Vicuña = ":llama:"
+	// VideoCamera is :video_camera:.
+	VideoCamera = ":video_camera:"
+	// VideoGame is :video_game:.
+	VideoGame = ":video_game:"
+	// VideoRecorder is :video_recorder:, an alias of :video_camera:.
+	VideoRecorder = ":video_camera:"
+	// Videocassette is :videocassette:, an alias of :vhs:.
+	Videocassette = ":vhs:"
+	// View is :view:, an alias of :window:.
+	View = ":window:"
+	// Viewer is :viewer:, an alias of :index_pointing_at_the_viewer:.
+	Viewer = ":index_pointing_at_the_viewer:"
+	// Violet is :violet:, an alias of :hyacinth:.
+	Violet = ":hyacinth:"
+	// Violin is :violin:.
+	Violin = ":violin:"
+	// Virgo is :virgo:.
+	Virgo = ":virgo:"
+	// Vise is :vise:, an alias of :compression:.
+	Vise = ":compression:"
+	// Voicemail is :voicemail:, an alias of :double_loop:.
+	Voicemail = ":double_loop:"
+	// Volcano is :volcano:.
+	Volcano = ":volcano:"
+	// Volleyball is :volleyball:.
+	Volleyball = ":volleyball:"
+	// Volume is :volume:.
+	Volume = ":volume:"
+	// Vomit is :vomit:, an alias of :face_vomiting:.
+	Vomit = ":face_vomiting:"
+	// Vomiting is :vomiting:, an alias of :face_vomiting:.
+	Vomiting = ":face_vomiting:"
+	// Vs is :vs:.
+	Vs = ":vs:"
+	// Waffle is :waffle:.
+	Waffle = ":waffle:"
+	// WaitOneSecond is :wait_one_second:.
+	WaitOneSecond = ":wait_one_second:"
+	// Walking is :walking:.
+	Walking = ":walking:"
+	// Wall is :wall:, an alias of :brick:.
+	Wall = ":brick:"
+	// Wand is :wand:, an alias of :magic_wand:.
+	Wand = ":magic_wand:"
+	// WaningCrescentMoon is :waning_crescent_moon:.
+	WaningCrescentMoon = ":waning_crescent_moon:"
+	// WaningGibbousMoon is :waning_gibbous_moon:.
+	WaningGibbousMoon = ":waning_gibbous_moon:"
+	// Warm is :warm:, an alias of :temperature:.
+	Warm = ":temperature:"
+	// Warning is :warning:.
+	Warning = ":warning:"
+	// Warrior is :warrior:, an alias of :military_helmet:.
+	Warrior = ":military_helmet:"
+	// Wastebasket is :wastebasket:.
+	Wastebasket = ":wastebasket:"
+	// Watch is :watch:.
+	Watch = ":watch:"
+	// WaterBuffalo is :water_buffalo:.
+	WaterBuffalo = ":water_buffalo:"
+	// WaterCloset is :water_closet:, an alias of :wc:.
+	WaterCloset = ":wc:"
+	// WaterDrop is :water_drop:, an alias of :drop:.
+	WaterDrop = ":drop:"
+	// WaterPolo is :water_polo:.
+	WaterPolo = ":water_polo:"
+	// Watermelon is :watermelon:.
+	Watermelon = ":watermelon:"
+	// Wave is :wave:.
+	Wave = ":wave:"
+	// Wavy is :wavy:, an alias of :woozy_face:.
+	Wavy = ":woozy_face:"
+	// WavyDash is :wavy_dash:.
+	WavyDash = ":wavy_dash:"
+	// Waxing is :waxing:, an alias of :waxing_crescent_moon:.
+	Waxing = ":waxing_crescent_moon:"
+	// WaxingCrescentMoon is :waxing_crescent_moon:.
+	WaxingCrescentMoon = ":waxing_crescent_moon:"
+	// WaxingMoon is :waxing_moon:.
+	WaxingMoon = ":waxing_moon:"
+	// Way is :way:, an alias of :exploding_head:.
+	Way = ":exploding_head:"
+	// Wc is :wc:.
+	Wc = ":wc:"
+	// Wealthy is :wealthy:, an alias of :face_with_monocle:.
+	Wealthy = ":face_with_monocle:"
+	// Weapon is :weapon:, an alias of :boomerang:.
+	Weapon = ":boomerang:"
+	// Weary is :weary:.
+	Weary = ":weary:"
+	// WearyCat is :weary_cat:, an alias of :scream_cat:.
+	WearyCat = ":scream_cat:"
+	// Web is :web:.
+	Web = ":web:"
+	// Wedding is :wedding:.
+	Wedding = ":wedding:"
+	// WeightLift is :weight_lift:, an alias of :lift:.
+	WeightLift = ":lift:"
+	// Welcome is :welcome:, an alias of :folded_hands:.
+	Welcome = ":folded_hands:"
+	// Welding is :welding:, an alias of :goggles:.
+	Welding = ":goggles:"
+	// Well is :well:, an alias of :mending_heart:.
+	Well = ":mending_heart:"
+	// West is :west:, an alias of :left:.
+	West = ":left:"
+	// Whale is :whale:.
+	Whale = ":whale:"
+	// Wheel is :wheel:.
+	Wheel = ":wheel:"
+	// WheelOfDharma is :wheel_of_dharma:.
+	WheelOfDharma = ":wheel_of_dharma:"
+	// Wheelchair is :wheelchair:, an alias of :accessible:.
+	Wheelchair = ":accessible:"
+	// Wheels is :wheels:, an alias of :skateboard:.
+	Wheels = ":skateboard:"
+	// Whisper is :whisper:, an alias of :face_exhaling:.
+	Whisper = ":face_exhaling:"
+	// Whistle is :whistle:, an alias of :face_exhaling:.
+	Whistle = ":face_exhaling:"
+	// WhiteAndBlackSquare is :white_and_black_square:.
+	WhiteAndBlackSquare = ":white_and_black_square:"
+	// WhiteCane is :white_cane:.
+	WhiteCane = ":white_cane:"
+	// WhiteCircle is :white_circle:.
+	WhiteCircle = ":white_circle:"
+	// WhiteFlag is :white_flag:.
+	WhiteFlag = ":white_flag:"
+	// WhiteFlower is :white_flower:.
+	WhiteFlower = ":white_flower:"
+	// WhiteHeart is :white_heart:.
+	WhiteHeart = ":white_heart:"
+	// WhiteLargeSquare is :white_large_square:.
+	WhiteLargeSquare = ":white_large_square:"
+	// WhiteMediumSmallSquare is :white_medium_small_square:.
+	WhiteMediumSmallSquare = ":white_medium_small_square:"
+	// WhiteMediumSquare is :white_medium_square:.
+	WhiteMediumSquare = ":white_medium_square:"
+	// WhiteSmallSquare is :white_small_square:.
+	WhiteSmallSquare = ":white_small_square:"
+	// Whoops is :whoops:, an alias of :face_with_hand_over_mouth:.
+	Whoops = ":face_with_hand_over_mouth:"
+	// Why is :why:, an alias of :pleading_face:.
+	Why = ":pleading_face:"
+	// WiFi is :wi_fi:, an alias of :wireless:.
+	WiFi = ":wireless:"
+	// Wifi is :wifi:, an alias of :wireless:.
+	Wifi = ":wireless:"
+	// Wild is :wild:, an alias of :broccoli:.
+	Wild = ":broccoli:"
+	// WiltedFlower is :wilted_flower:.
+	WiltedFlower = ":wilted_flower:"
+	// Wind is :wind:, an alias of :leaves:.
+	Wind = ":leaves:"
+	// WindChime is :wind_chime:.
+	WindChime = ":wind_chime:"
+	// Window is :window:.
+	Window = ":window:"
+	// Windy is :windy:.
+	Windy = ":windy:"
+	// Wine is :wine:.
+	Wine = ":wine:"
+	// Wing is :wing:.
+	Wing = ":wing:"
+	// Wink is :wink:.
+	Wink = ":wink:"
+	// Winner is :winner:, an alias of :trophy:.
+	Winner = ":trophy:"
+	// Winter is :winter:, an alias of :leafless_tree:.
+	Winter = ":leafless_tree:"
+	// Wireless is :wireless:.
+	Wireless = ":wireless:"
+	// Wise is :wise:, an alias of :older_person:.
+	Wise = ":older_person:"
+	// Wisent is :wisent:, an alias of :bison:.
+	Wisent = ":bison:"
+	// Wish is :wish:, an alias of :shooting_star:.
+	Wish = ":shooting_star:"
+	// WishTree is :wish_tree:.
+	WishTree = ":wish_tree:"
+	// Wishbone is :wishbone:, an alias of :bone:.
+	Wishbone = ":bone:"
+	// Witness is :witness:, an alias of :eye_in_speech_bubble:.
+	Witness = ":eye_in_speech_bubble:"
+	// Wlan is :wlan:, an alias of :wireless:.
+	Wlan = ":wireless:"
+	// Woah is :woah:, an alias of :face_with_spiral_eyes:.
+	Woah = ":face_with_spiral_eyes:"
+	// Wolf is :wolf:.
+	Wolf = ":wolf:"
+	// Woman is :woman:.
+	Woman = ":woman:"
+	// WomanArtist is :woman_artist:.
+	WomanArtist = ":woman_artist:"
+	// WomanAstronaut is :woman_astronaut:.
+	WomanAstronaut = ":woman_astronaut:"
+	// WomanBald is :woman_bald:.
+	WomanBald = ":woman_bald:"
+	// WomanBeard is :woman_beard:.
+	WomanBeard = ":woman_beard:"
+	// WomanBiking is :woman_biking:.
+	WomanBiking = ":woman_biking:"
+	// WomanBlondHair is :woman_blond_hair:.
+	WomanBlondHair = ":woman_blond_hair:"
+	// WomanBouncingBall is :woman_bouncing_ball:.
+	WomanBouncingBall = ":woman_bouncing_ball:"
+	// WomanBowing is :woman_bowing:.
+	WomanBowing = ":woman_bowing:"
+	// WomanCartwheeling is :woman_cartwheeling:.
+	WomanCartwheeling = ":woman_cartwheeling:"
+	// WomanClimbing is :woman_climbing:.
+	WomanClimbing = ":woman_climbing:"
+	// WomanConstructionWorker is :woman_construction_worker:.
+	WomanConstructionWorker = ":woman_construction_worker:"
+	// WomanCook is :woman_cook:.
+	WomanCook = ":woman_cook:"
+	// WomanCurlyHair is :woman_curly_hair:.
+	WomanCurlyHair = ":woman_curly_hair:"
+	// WomanDetective is :woman_detective:.
+	WomanDetective = ":woman_detective:"
+	// WomanElf is :woman_elf:.
+	WomanElf = ":woman_elf:"
+	// WomanFacepalming is :woman_facepalming:.
+	WomanFacepalming = ":woman_facepalming:"
+	// WomanFactoryWorker is :woman_factory_worker:.
+	WomanFactoryWorker = ":woman_factory_worker:"
+	// WomanFairy is :woman_fairy:.
+	WomanFairy = ":woman_fairy:"
+	// WomanFarmer is :woman_farmer:.
+	WomanFarmer = ":woman_farmer:"
+	// WomanFeedingBaby is :woman_feeding_baby:.
+	WomanFeedingBaby = ":woman_feeding_baby:"
+	// WomanFirefighter is :woman_firefighter:.
+	WomanFirefighter = ":woman_firefighter:"
+	// WomanFrowning is :woman_frowning:.
+	WomanFrowning = ":woman_frowning:"
+	// WomanGenie is :woman_genie:.
+	WomanGenie = ":woman_genie:"
+	// WomanGesturingNo is :woman_gesturing_no:.
+	WomanGesturingNo = ":woman_gesturing_no:"
+	// WomanGesturingOk is :woman_gesturing_ok:.
+	WomanGesturingOk = ":woman_gesturing_ok:"
+	// WomanGettingHaircut is :woman_getting_haircut:.
+	WomanGettingHaircut = ":woman_getting_haircut:"
+	// WomanGettingMassage is :woman_getting_massage:.
+	WomanGettingMassage = ":woman_getting_massage:"
+	// WomanGolfing is :woman_golfing:.
+	WomanGolfing = ":woman_golfing:"
+	// WomanGuard is :woman_guard:.
+	WomanGuard = ":woman_guard:"
+	// WomanHealthWorker is :woman_health_worker:.
+	WomanHealthWorker = ":woman_health_worker:"
+	// WomanInLotusPosition is :woman_in_lotus_position:.
+	WomanInLotusPosition = ":woman_in_lotus_position:"
+	// WomanInManualWheelchair is :woman_in_manual_wheelchair:.
+	WomanInManualWheelchair = ":woman_in_manual_wheelchair:"
+	// WomanInManualWheelchairFacingRight is :woman_in_manual_wheelchair_facing_right:.
+	WomanInManualWheelchairFacingRight = ":woman_in_manual_wheelchair_facing_right:"
+	// WomanInMotorizedWheelchair is :woman_in_motorized_wheelchair:.
+	WomanInMotorizedWheelchair = ":woman_in_motorized_wheelchair:"
+	// WomanInMotorizedWheelchairFacingRight is :woman_in_motorized_wheelchair_facing_right:.
+	WomanInMotorizedWheelchairFacingRight = ":woman_in_motorized_wheelchair_facing_right:"
+	// WomanInSteamyRoom is :woman_in_steamy_room:.
+	WomanInSteamyRoom = ":woman_in_steamy_room:"
+	// WomanInTuxedo is :woman_in_tuxedo:.
+	WomanInTuxedo = ":woman_in_tuxedo:"
+	// WomanJudge is :woman_judge:.
+	WomanJudge = ":woman_judge:"
+	// WomanJuggling is :woman_juggling:.
+	WomanJuggling = ":woman_juggling:"
+	// WomanKneeling is :woman_kneeling:.
+	WomanKneeling = ":woman_kneeling:"
+	// WomanKneelingFacingRight is :woman_kneeling_facing_right:.
+	WomanKneelingFacingRight = ":woman_kneeling_facing_right:"
+	// WomanLiftingWeights is :woman_lifting_weights:.
+	WomanLiftingWeights = ":woman_lifting_weights:"
+	// WomanMage is :woman_mage:.
+	WomanMage = ":woman_mage:"
+	// WomanMechanic is :woman_mechanic:.
+	WomanMechanic = ":woman_mechanic:"
+	// WomanMountainBiking is :woman_mountain_biking:.
+	WomanMountainBiking = ":woman_mountain_biking:"
+	// WomanOfficeWorker is :woman_office_worker:.
+	WomanOfficeWorker = ":woman_office_worker:"
+	// WomanPilot is :woman_pilot:.
+	WomanPilot = ":woman_pilot:"
+	// WomanPlayingHandball is :woman_playing_handball:.
+	WomanPlayingHandball = ":woman_playing_handball:"
+	// WomanPlayingWaterPolo is :woman_playing_water_polo:.
+	WomanPlayingWaterPolo = ":woman_playing_water_polo:"
+	// WomanPoliceOfficer is :woman_police_officer:.
+	WomanPoliceOfficer = ":woman_police_officer:"
+	// WomanPouting is :woman_pouting:.
+	WomanPouting = ":woman_pouting:"
+	// WomanRaisingHand is :woman_raising_hand:.
+	WomanRaisingHand = ":woman_raising_hand:"
+	// WomanRedHair is :woman_red_hair:.
+	WomanRedHair = ":woman_red_hair:"
+	// WomanRowingBoat is :woman_rowing_boat:.
+	WomanRowingBoat = ":woman_rowing_boat:"
+	// WomanRunning is :woman_running:.
+	WomanRunning = ":woman_running:"
+	// WomanRunningFacingRight is :woman_running_facing_right:.
+	WomanRunningFacingRight = ":woman_running_facing_right:"
+	// WomanScientist is :woman_scientist:.
+	WomanScientist = ":woman_scientist:"
+	// WomanShrugging is :woman_shrugging:.
+	WomanShrugging = ":woman_shrugging:"
+	// WomanSinger is :woman_singer:.
+	WomanSinger = ":woman_singer:"
+	// WomanStanding is :woman_standing:.
+	WomanStanding = ":woman_standing:"
+	// WomanStudent is :woman_student:.
+	WomanStudent = ":woman_student:"
+	// WomanSuperhero is :woman_superhero:.
+	WomanSuperhero = ":woman_superhero:"
+	// WomanSupervillain is :woman_supervillain:.
+	WomanSupervillain = ":woman_supervillain:"
+	// WomanSurfing is :woman_surfing:.
+	WomanSurfing = ":woman_surfing:"
+	// WomanSwimming is :woman_swimming:.
+	WomanSwimming = ":woman_swimming:"
+	// WomanTeacher is :woman_teacher:.
+	WomanTeacher = ":woman_teacher:"
+	// WomanTechnologist is :woman_technologist:.
+	WomanTechnologist = ":woman_technologist:"
+	// WomanTippingHand is :woman_tipping_hand:.
+	WomanTippingHand = ":woman_tipping_hand:"
+	// WomanVampire is :woman_vampire:.
+	WomanVampire = ":woman_vampire:"
+	// WomanWalking is :woman_walking:.
+	WomanWalking = ":woman_walking:"
+	// WomanWalkingFacingRight is :woman_walking_facing_right:.
+	WomanWalkingFacingRight = ":woman_walking_facing_right:"
+	// WomanWearingTurban is :woman_wearing_turban:.
+	WomanWearingTurban = ":woman_wearing_turban:"
+	// WomanWhiteHair is :woman_white_hair:.
+	WomanWhiteHair = ":woman_white_hair:"
+	// WomanWithHeadscarf is :woman_with_headscarf:.
+	WomanWithHeadscarf = ":woman_with_headscarf:"
+	// WomanWithVeil is :woman_with_veil:.
+	WomanWithVeil = ":woman_with_veil:"
+	// WomanWithWhiteCane is :woman_with_white_cane:.
+	WomanWithWhiteCane = ":woman_with_white_cane:"
+	// WomanWithWhiteCaneFacingRight is :woman_with_white_cane_facing_right:.
+	WomanWithWhiteCaneFacingRight = ":woman_with_white_cane_facing_right:"
+	// WomanZombie is :woman_zombie:.
+	WomanZombie = ":woman_zombie:"
+	// WomenCouple is :women_couple:, an alias of :two_women_holding_hands:.
+	WomenCouple = ":two_women_holding_hands:"
+	// WomenWithBunnyEars is :women_with_bunny_ears:.
+	WomenWithBunnyEars = ":women_with_bunny_ears:"
+	// WomenWrestling is :women_wrestling:.
+	WomenWrestling = ":women_wrestling:"
+	// Womens is :womens:.
+	Womens = ":womens:"
+	// Wood is :wood:.
+	Wood = ":wood:"
+	// Woodwind is :woodwind:, an alias of :flute:.
+	Woodwind = ":flute:"
+	// Woof is :woof:, an alias of :dog:.
+	Woof = ":dog:"
+	// Wool is :wool:, an alias of :llama:.
+	Wool = ":llama:"
+	// Wooly is :wooly:, an alias of :mammoth:.
+	Wooly = ":mammoth:"
+	// WoozyFace is :woozy_face:.
+	WoozyFace = ":woozy_face:"
+	// WorkInProgress is :work_in_progress:.
+	WorkInProgress = ":work_in_progress:"
+	// WorkOut is :work_out:, an alias of :lift:.
+	WorkOut = ":lift:"
+	// WorkingOnIt is :working_on_it:.
+	WorkingOnIt = ":working_on_it:"
+	// WorldMap is :world_map:, an alias of :map:.
+	WorldMap = ":map:"
+	// Worm is :worm:.
+	Worm = ":worm:"
+	// Worried is :worried:.
+	Worried = ":worried:"
+	// Worry is :worry:, an alias of :biting_lip:.
+	Worry = ":biting_lip:"
+	// Wrapped is :wrapped:, an alias of :tamale:.
+	Wrapped = ":tamale:"
+	// Wrench is :wrench:, an alias of :fixing:.
+	Wrench = ":fixing:"
+	// Wrestling is :wrestling:.
+	Wrestling = ":wrestling:"
+	// Writing is :writing:.
+	Writing = ":writing:"
+	// Wrong is :wrong:, an alias of :cross_mark:.
+	Wrong = ":cross_mark:"
+	// WrongWay is :wrong_way:, an alias of :no_entry:.
+	WrongWay = ":no_entry:"
+	// Www is :www:.
+	Www = ":www:"
+	// X is :x:.
+	X = ":x:"
+	// XRay is :x_ray:.
+	XRay = ":x_ray:"
+	// Xmas is :xmas:, an alias of :mx_claus:.
+	Xmas = ":mx_claus:"
+	// Xray is :xray:, an alias of :x_ray:.
+	Xray = ":x_ray:"
+	// Yacht is :yacht:, an alias of :passenger_ship:.
+	Yacht = ":passenger_ship:"
+	// Yam is :yam:.
+	Yam = ":yam:"
+	// Yarn is :yarn:.
+	Yarn = ":yarn:"
+	// Yawn is :yawn:, an alias of :yawning_face:.
+	Yawn = ":yawning_face:"
+	// Yawning is :yawning:, an alias of :yawning_face:.
+	Yawning = ":yawning_face:"
+	// YawningFace is :yawning_face:.
+	YawningFace = ":yawning_face:"
+	// Yellow is :yellow:, an alias of :yellow_circle:.
+	Yellow = ":yellow_circle:"
+	// YellowCircle is :yellow_circle:.
+	YellowCircle = ":yellow_circle:"
+	// YellowHeart is :yellow_heart:.
+	YellowHeart = ":yellow_heart:"
+	// YellowLargeSquare is :yellow_large_square:.
+	YellowLargeSquare = ":yellow_large_square:"
+	// YenBanknotes is :yen_banknotes:.
+	YenBanknotes = ":yen_banknotes:"
+	// YinYang is :yin_yang:.
+	YinYang = ":yin_yang:"
+	// YoYo is :yo_yo:.
+	YoYo = ":yo_yo:"
+	// Young is :young:, an alias of :child:.
+	Young = ":child:"
+	// Younger is :younger:, an alias of :child:.
+	Younger = ":child:"
+	// Yuebing is :yuebing:, an alias of :moon_cake:.
+	Yuebing = ":moon_cake:"
+	// Yum is :yum:.
+	Yum = ":yum:"
+	// Yurt is :yurt:, an alias of :hut:.
+	Yurt = ":hut:"
+	// Yuèbǐng is :yuèbǐng:, an alias of :moon_cake:.
+	Yuèbǐng = ":moon_cake:"
+	// Zany is :zany:, an alias of :zany_face:.
+	Zany = ":zany_face:"
+	// ZanyFace is :zany_face:.
+	ZanyFace = ":zany_face:"
+	// Zap is :zap:, an alias of :high_voltage:.
+	Zap = ":high_voltage:"
+	// Zebra is :zebra:.
+	Zebra = ":zebra:"
+	// Zero is :zero:.
+	Zero = ":zero:"
+	// Zest is :zest:, an alias of :lime:.
+	Zest = ":lime:"
+	// Zip is :zip:, an alias of :pinched_fingers:.
+	Zip = ":pinched_fingers:"
+	// ZipIt is :zip_it:, an alias of :silence:.
+	ZipIt = ":silence:"
+	// Zombie is :zombie:.
+	Zombie = ":zombie:"
+	// Zori is :zori:, an alias of :thong_sandal:.
+	Zori = ":thong_sandal:"
+	// Zzz is :zzz:.
+	Zzz = ":zzz:"
+	// Zōri is :zōri:, an alias of :thong_sandal:.
+	Zōri = ":thong_sandal:"
+)
+
+// codepoints maps each emoji name, aliases included, to its code points.
+var codepoints = map[string]string{
+	"+1":                            "1f44d",
+	"-1":                            "1f44e",
+	"100":                           "1f4af",
+	"1234":                          "1f522",
+	"3":                             "1f970",
+	"8_ball":                        "1f3b1",
+	"a":                             "1f170",
+	"ab":                            "1f18e",
+	"abacus":                        "1f9ee",
+	"abc":                           "1f524",
+	"abcd":                          "1f521",
+	"absentminded":                  "1f636-200d-1f32b",
+	"absorbing":                     "1f9fd",
+	"accept":                        "1f251",
+	"accessible":                    "267f",
+	"accident":                      "1fad7",
+	"accommodations":                "1f6cc",
+	"accordion":                     "1fa97",
+	"accounting":                    "1f9fe",
+	"acidity":                       "1f34b-200d-1f7e9",
+	"acrobatics":                    "1f938",
+	"action":                        "1f3ac",
+	"add":                           "2795",
+	"adhesive":                      "1fa79",
+	"adhesive_bandage":              "1fa79",
+	"admiration":                    "1f979",
+	"adorable":                      "1fa77",
+	"adore":                         "1f970",
+	"aerial_tramway":                "1f6a1",
+	"aesculapius":                   "2695",
+	"afro":                          "1faae",
+	"agent":                         "1f575",
+	"agony":                         "1f616",
+	"air":                           "1fa9f",
+	"airplane":                      "2708",
+	"airplane_arrival":              "1f6ec",
+	"airplane_departure":            "1f6eb",
+	"alarm":                         "1f413",
+	"alarm_clock":                   "23f0",
+	"alces":                         "1face",
+	"alchemy":                       "2697",
+	"alembic":                       "2697",
+	"alert":                         "1f6a8",
+	"alien":                         "1f47d",
+	"aliens":                        "1f6f8",
+	"all_good":                      "2705",
+	"alpaca":                        "1f999",
+	"alphabet":                      "1f521",
+	"amazement":                     "1fae2",
+	"ambulance":                     "1f691",
+	"american_football":             "1f3c8",
+	"amoeba":                        "1f9a0",
+	"amount":                        "1f90f",
+	"amphora":                       "1f3fa",
+	"amusement":                     "1f6dd",
+	"anatomical":                    "1fac0",
+	"anatomical_heart":              "1fac0",
+	"anchor":                        "2693",
+	"android":                       "1f4f1",
+	"angel":                         "1f47c",
+	"angelic":                       "1fabd",
+	"anger":                         "1f4a2",
+	"anger_bubble":                  "1f5ef",
+	"angry":                         "1f620",
+	"angry_cat":                     "1f63e",
+	"angry_devil":                   "1f47f",
+	"anguish":                       "1f62b",
+	"anguished":                     "1f627",
+	"ankle":                         "1f9b6",
+	"annelid":                       "1fab1",
+	"answer":                        "1f7f0",
+	"ant":                           "1f41c",
+	"antlers":                       "1face",
+	"anxious":                       "1f62c",
+	"ape":                           "1f9a7",
+	"applause":                      "1f44f",
+	"apple":                         "1f34e",
+	"approved":                      "2705",
+	"aquarium":                      "1fabc",
+	"aquarius":                      "2652",
+	"arabian_camel":                 "1f42a",
+	"arcade":                        "1f579",
+	"archery":                       "1f3f9",
+	"archive":                       "1f5c3",
+	"arctic":                        "1f43b-200d-2744",
+	"arepa":                         "1fad3",
+	"aries":                         "2648",
+	"arm":                           "1f9be",
+	"arms_open":                     "1f917",
+	"army":                          "1fa96",
+	"arrival":                       "1f6ec",
+	"art":                           "1f3a8",
+	"artist":                        "1f9d1-200d-1f3a8",
+	"ascension":                     "1f426-200d-1f525",
+	"asking_a_question":             "261d",
+	"ass":                           "1facf",
+	"assassin":                      "1f977",
+	"assistance":                    "1f415-200d-1f9ba",
+	"asterisk":                      "002a-20e3",
+	"astonished":                    "1f632",
+	"astronaut":                     "1f9d1-200d-1f680",
+	"at":                            "1faf5",
+	"at_work":                       "2692",
+	"athletic_shoe":                 "1f45f",
+	"atm":                           "1f3e7",
+	"atom":                          "269b",
+	"attachment":                    "1f4ce",
+	"attraction":                    "1f9f2",
+	"auto":                          "1f6fa",
+	"auto_rickshaw":                 "1f6fa",
+	"automobile":                    "1f6fb",
+	"autumn":                        "1f96e",
+	"aviation":                      "1fabd",
+	"avocado":                       "1f951",
+	"awe":                           "1fae2",
+	"aww":                           "1f979",
+	"ax":                            "1fa93",
+	"axe":                           "1fa93",
+	"b":                             "1f171",
+	"baa":                           "1f411",
+	"babooshka":                     "1fa86",
+	"baboushka":                     "1fa86",
+	"babushka":                      "1fa86",
+	"baby":                          "1f476",
+	"baby_bottle":                   "1f37c",
+	"baby_change_station":           "1f6bc",
+	"baby_chick":                    "1f424",
+	"back":                          "1f519",
+	"backpack":                      "1f392",
+	"backpacking":                   "1f97e",
+	"bacon":                         "1f953",
+	"badger":                        "1f9a1",
+	"badminton":                     "1f3f8",
+	"bag":                           "1f9f3",
+	"bagel":                         "1f96f",
+	"baggage_claim":                 "1f6c4",
+	"bags":                          "1fae9",
+	"baguette":                      "1f956",
+	"bait":                          "1faa4",
+	"balance":                       "2696",
+	"ball":                          "26f9",
+	"ballet_shoes":                  "1fa70",
+	"balloon":                       "1f388",
+	"ballot_box":                    "1f5f3",
+	"ballpoint_pen":                 "1f58a",
+	"bam":                           "1f4a2",
+	"bamboo":                        "1f38d",
+	"banana":                        "1f34c",
+	"band":                          "1fa88",
+	"bandage":                       "1fa79",
+	"bandana":                       "1f9d5",
+	"bangbang":                      "203c",
+	"banjo":                         "1fa95",
+	"bank":                          "1f3e6",
+	"bar":                           "1f9fc",
+	"bar_chart":                     "1f4ca",
+	"barber":                        "1f488",
+	"bare":                          "1fabe",
+	"barf":                          "1f92e",
+	"barren":                        "1fabe",
+	"baseball":                      "26be",
+	"basket":                        "1f9fa",
+	"basketball":                    "1f3c0",
+	"bat":                           "1f987",
+	"bath":                          "1f6c0",
+	"bathroom":                      "1faa5",
+	"bathtub":                       "1f6c1",
+	"battery":                       "1f50b",
+	"bday":                          "1f973",
+	"beach":                         "1f3d6",
+	"beach_umbrella":                "26f1",
+	"bead":                          "1f9ff",
+	"beak":                          "1f426-200d-2b1b",
+	"beans":                         "1fad8",
+	"beanstalk":                     "1fadb",
+	"bear":                          "1f43b",
+	"beaver":                        "1f9ab",
+	"beckon":                        "1faf4",
+	"bed":                           "1f6cf",
+	"bedroom":                       "1f6cf",
+	"bedtime":                       "1f971",
+	"bee":                           "1f41d",
+	"beer":                          "1f37a",
+	"beers":                         "1f37b",
+	"beet":                          "1fadc",
+	"beetle":                        "1fab2",
+	"begging":                       "1f97a",
+	"beginner":                      "1f530",
+	"bell":                          "1f514",
+	"bell_pepper":                   "1fad1",
+	"bellhop_bell":                  "1f6ce",
+	"bento":                         "1f371",
+	"berries":                       "1fad0",
+	"berry":                         "1fad0",
+	"betrayed":                      "1f52a",
+	"beverage":                      "1f9c3",
+	"beverage_box":                  "1f9c3",
+	"bicycle":                       "1f6b2",
+	"big":                           "1f97a",
+	"big_frown":                     "2639",
+	"big_rig":                       "1f69b",
+	"bike":                          "1f6b2",
+	"bikini":                        "1f459",
+	"bilberry":                      "1fad0",
+	"billed":                        "1f9e2",
+	"billed_cap":                    "1f9e2",
+	"billiards":                     "1f3b1",
+	"biohazard":                     "2623",
+	"biology":                       "1f9eb",
+	"bird":                          "1f426",
+	"birthday":                      "1f382",
+	"bisexual":                      "1f3f3-200d-1f308",
+	"bison":                         "1f9ac",
+	"bisque":                        "1f99e",
+	"bit":                           "1f90f",
+	"biting":                        "1fae6",
+	"biting_lip":                    "1fae6",
+	"black_and_white_square":        "1f533",
+	"black_belt":                    "1f94b",
+	"black_bird":                    "1f426-200d-2b1b",
+	"black_cat":                     "1f408-200d-2b1b",
+	"black_circle":                  "26ab",
+	"black_flag":                    "1f3f4",
+	"black_heart":                   "1f5a4",
+	"black_large_square":            "2b1b",
+	"black_medium_small_square":     "25fe",
+	"black_medium_square":           "25fc",
+	"black_nib":                     "2712",
+	"black_small_square":            "25aa",
+	"blades":                        "1f6fc",
+	"blank":                         "1f636",
+	"bleed":                         "1fa78",
+	"blonde":                        "1f471-200d-2640",
+	"bloom":                         "1fabb",
+	"blossom":                       "1f33c",
+	"blow":                          "1f62e-200d-1f4a8",
+	"blow_a_kiss":                   "1f618",
+	"blowfish":                      "1f421",
+	"blowing":                       "1f62e-200d-1f4a8",
+	"blown":                         "1f92f",
+	"blue_book":                     "1f4d8",
+	"blue_circle":                   "1f535",
+	"blue_faced":                    "1f976",
+	"blue_heart":                    "1f499",
+	"blue_square":                   "1f7e6",
+	"blueberries":                   "1fad0",
+	"blueberry":                     "1fad0",
+	"bluebonnet":                    "1fabb",
+	"blush":                         "1f60a",
+	"blushing":                      "1f633",
+	"boar":                          "1f417",
+	"board":                         "1f6f9",
+	"boat":                          "26f5",
+	"boba":                          "1f9cb",
+	"bok":                           "1f96c",
+	"bomb":                          "1f4a3",
+	"bone":                          "1f9b4",
+	"boo":                           "1f47b",
+	"book":                          "1f4d6",
+	"bookkeeping":                   "1f9fe",
+	"bookmark":                      "1f516",
+	"books":                         "1f4da",
+	"boom":                          "1f4a5",
+	"boomerang":                     "1fa83",
+	"boot":                          "1f462",
+	"bottle":                        "1f9f4",
+	"boulder":                       "1faa8",
+	"bouquet":                       "1f490",
+	"bow":                           "1f647",
+	"bow_and_arrow":                 "1f3f9",
+	"bowl":                          "1f963",
+	"bowl_with_spoon":               "1f963",
+	"bowling":                       "1f3b3",
+	"boxing_glove":                  "1f94a",
+	"boy":                           "1f466",
+	"brachiosaurus":                 "1f995",
+	"brain":                         "1f9e0",
+	"branches":                      "1fabe",
+	"bravery":                       "1f49c",
+	"bread":                         "1f35e",
+	"break":                         "26d3-200d-1f4a5",
+	"breakfast":                     "1f95e",
+	"breaking":                      "26d3-200d-1f4a5",
+	"breast":                        "1f931",
+	"breast_feeding":                "1f931",
+	"breath":                        "1fac1",
+	"breathe":                       "1fac1",
+	"brew":                          "1fad6",
+	"brick":                         "1f9f1",
+	"bricks":                        "1f9f1",
+	"bride":                         "1f470",
+	"bridge":                        "1f309",
+	"briefcase":                     "1f4bc",
+	"briefs":                        "1fa72",
+	"bright_eyed":                   "1f9d2",
+	"brightness":                    "1f506",
+	"broadband":                     "1f6dc",
+	"broccoli":                      "1f966",
+	"broken":                        "26d3-200d-1f4a5",
+	"broken_chain":                  "26d3-200d-1f4a5",
+	"broken_heart":                  "1f494",
+	"brontosaurus":                  "1f995",
+	"bronze":                        "1f949",
+	"broom":                         "1f9f9",
+	"brown_circle":                  "1f7e4",
+	"brown_heart":                   "1f90e",
+	"brown_mushroom":                "1f344-200d-1f7eb",
+	"brown_square":                  "1f7eb",
+	"brr":                           "1f9e5",
+	"brush":                         "1faa5",
+	"bubble_tea":                    "1f9cb",
+	"bubbles":                       "1fae7",
+	"bucket":                        "1faa3",
+	"buddhism":                      "2638",
+	"buffalo":                       "1f9ac",
+	"bug":                           "1f41b",
+	"bulb":                          "1f4a1",
+	"bull":                          "1f402",
+	"bullet_train":                  "1f685",
+	"bullhorn":                      "1f4e2",
+	"bulls_eye":                     "1f3af",
+	"bunny":                         "1f430",
+	"buoy":                          "1f6df",
+	"burgers":                       "1f96c",
+	"burial":                        "26b0",
+	"burp":                          "1fae7",
+	"burrito":                       "1f32f",
+	"burro":                         "1facf",
+	"bury":                          "1fa8f",
+	"bus":                           "1f68c",
+	"bus_stop":                      "1f68f",
+	"butter":                        "1f9c8",
+	"butterfly":                     "1f98b",
+	"buzz":                          "1f41d",
+	"c":                             "00a9",
+	"cactus":                        "1f335",
+	"cake":                          "1f370",
+	"calculation":                   "1f9ee",
+	"calculator":                    "1f9ee",
+	"calendar":                      "1f4c5",
+	"calf":                          "1f42e",
+	"call_me":                       "1f919",
+	"calling":                       "1f4f2",
+	"calm":                          "1fab7",
+	"camel":                         "1f42b",
+	"camera":                        "1f4f7",
+	"camping":                       "26fa",
+	"campsite":                      "1f3d5",
+	"can":                           "1f96b",
+	"cancer":                        "264b",
+	"candle":                        "1f56f",
+	"candy":                         "1f36c",
+	"canned":                        "1f96b",
+	"canned_food":                   "1f96b",
+	"canoe":                         "1f6f6",
+	"cap":                           "1f9e2",
+	"capital_abcd":                  "1f520",
+	"capital_letters":               "1f520",
+	"capricorn":                     "2651",
+	"capsicum":                      "1fad1",
+	"captivated":                    "1fae3",
+	"car":                           "1f697",
+	"card_index":                    "1f4c7",
+	"cardiology":                    "1fac0",
+	"carousel":                      "1f3a0",
+	"carp_streamer":                 "1f38f",
+	"carpenter":                     "1fa9a",
+	"carpenter_square":              "1f4d0",
+	"carpentry":                     "1fa9a",
+	"carpentry_saw":                 "1fa9a",
+	"carrot":                        "1f955",
+	"cartwheel":                     "1f938",
+	"cask":                          "1faa3",
+	"castle":                        "1f3f0",
+	"cat":                           "1f408",
+	"caterpillar":                   "1f41b",
+	"caution":                       "26a0",
+	"caw":                           "1f426-200d-2b1b",
+	"cd":                            "1f4bf",
+	"celebration":                   "1f388",
+	"cell_reception":                "1f4f6",
+	"cemetery":                      "1faa6",
+	"censor":                        "1f92c",
+	"cereal":                        "1f963",
+	"cha":                           "1fa87",
+	"chain":                         "26d3-200d-1f4a5",
+	"chains":                        "26d3",
+	"chair":                         "1fa91",
+	"champagne":                     "1f37e",
+	"change":                        "1fab8",
+	"charge":                        "1f202",
+	"charm":                         "1f9ff",
+	"chart":                         "1f4c8",
+	"check":                         "2705",
+	"check_mark":                    "2714",
+	"checkbox":                      "2611",
+	"checkered_flag":                "1f3c1",
+	"cheese":                        "1f9c0",
+	"chemistry":                     "1f9ea",
+	"cherries":                      "1f352",
+	"cherry_blossom":                "1f338",
+	"chess":                         "265f",
+	"chess_pawn":                    "265f",
+	"chest":                         "1f9f0",
+	"chestnut":                      "1f330",
+	"chick":                         "1f424",
+	"chicken":                       "1f414",
+	"chickpea":                      "1f9c6",
+	"child":                         "1f9d2",
+	"children_crossing":             "1f6b8",
+	"chili_pepper":                  "1f336",
+	"chipmunk":                      "1f43f",
+	"chocolate":                     "1f36b",
+	"chopsticks":                    "1f962",
+	"choy":                          "1f96c",
+	"christianity":                  "271d",
+	"christmas":                     "1f9d1-200d-1f384",
+	"church":                        "26ea",
+	"cinco":                         "1fa85",
+	"cinema":                        "1f3a6",
+	"circle":                        "2b55",
+	"circus":                        "1f3aa",
+	"citrus":                        "1f34b-200d-1f7e9",
+	"city":                          "1f3d9",
+	"city_sunrise":                  "1f307",
+	"cl":                            "1f191",
+	"clack":                         "1faad",
+	"clap":                          "1f44f",
+	"classical_building":            "1f3db",
+	"classy":                        "1f9d0",
+	"claus":                         "1f9d1-200d-1f384",
+	"claws":                         "1f99e",
+	"clay":                          "1f9f1",
+	"climate":                       "1fab8",
+	"clink":                         "1f942",
+	"clipboard":                     "1f4cb",
+	"clock":                         "1f557",
+	"clockwise":                     "1f503",
+	"closed_book":                   "1f4d5",
+	"closed_mailbox":                "1f4ea",
+	"closed_umbrella":               "1f302",
+	"clothes":                       "1f97c",
+	"clothing":                      "1f45a",
+	"cloud":                         "2601",
+	"clouds":                        "1f636-200d-1f32b",
+	"cloudy":                        "1f325",
+	"clover":                        "2618",
+	"clown":                         "1f921",
+	"clubs":                         "2663",
+	"cluck":                         "1f414",
+	"clue":                          "1f5dd",
+	"coat":                          "1f9e5",
+	"cock-a-doodle-doo":             "1f413",
+	"cockroach":                     "1fab3",
+	"cocktail":                      "1f378",
+	"coconut":                       "1f965",
+	"coffee":                        "2615",
+	"coffin":                        "26b0",
+	"coin":                          "1fa99",
+	"colada":                        "1f965",
+	"cold_face":                     "1f976",
+	"cold_sweat":                    "1f630",
+	"collision":                     "1f4a5",
+	"colorful":                      "1f99a",
+	"comb":                          "1faae",
+	"come":                          "1faf4",
+	"comet":                         "2604",
+	"comfort":                       "1fac2",
+	"comfy":                         "1f97f",
+	"commitment":                    "1f510",
+	"commodities":                   "1f6e2",
+	"compass":                       "1f9ed",
+	"composition_book":              "1f4d3",
+	"compression":                   "1f5dc",
+	"computer":                      "1f4bb",
+	"computer_mouse":                "1f5b1",
+	"concerned":                     "1f615",
+	"concertina":                    "1fa97",
+	"conch":                         "1f41a",
+	"condemned":                     "1f3da",
+	"confetti":                      "1f38a",
+	"confounded":                    "1f616",
+	"confused":                      "1f615",
+	"confusion":                     "1fae4",
+	"conga":                         "1fa98",
+	"congee":                        "1f963",
+	"congratulations":               "3297",
+	"connectivity":                  "1f6dc",
+	"construction":                  "1f3d7",
+	"construction_worker":           "1f477",
+	"construction_zone":             "1f6a7",
+	"contact":                       "1f50e",
+	"container":                     "1fad9",
+	"control_knobs":                 "1f39b",
+	"convenience_store":             "1f3ea",
+	"convoluted":                    "1f968",
+	"cook":                          "1f9d1-200d-1f373",
+	"cookie":                        "1f36a",
+	"cooking":                       "1f373",
+	"cool":                          "1f192",
+	"cooling":                       "1faad",
+	"cop":                           "1f46e",
+	"copyright":                     "00a9",
+	"coral":                         "1fab8",
+	"cord":                          "1faa2",
+	"corn":                          "1f33d",
+	"corvid":                        "1f426-200d-2b1b",
+	"couch_and_lamp":                "1f6cb",
+	"counterclockwise":              "1f504",
+	"couple_with_heart":             "1f491",
+	"couple_with_heart_man_man":     "1f468-200d-2764-200d-1f468",
+	"couple_with_heart_woman_man":   "1f469-200d-2764-200d-1f468",
+	"couple_with_heart_woman_woman": "1f469-200d-2764-200d-1f469",
+	"cow":                           "1f404",
+	"cowboy":                        "1f920",
+	"crab":                          "1f980",
+	"crash":                         "1f4a5",
+	"crayon":                        "1f58d",
+	"crazy":                         "1f61c",
+	"credentials":                   "1faaa",
+	"credit_card":                   "1f4b3",
+	"cremation":                     "26b1",
+	"crew":                          "1f6a3",
+	"cricket":                       "1f997",
+	"cricket_game":                  "1f3cf",
+	"crime":                         "1fac6",
+	"crochet":                       "1f9f6",
+	"crocodile":                     "1f40a",
+	"croissant":                     "1f950",
+	"crook":                         "1fa9d",
+	"cross":                         "271d",
+	"cross_mark":                    "274c",
+	"crossed":                       "1faf0",
+	"crossed_flags":                 "1f38c",
+	"crow":                          "1f426-200d-2b1b",
+	"crown":                         "1f451",
+	"cruise":                        "1f6f3",
+	"crush":                         "1f970",
+	"crushed":                       "1f940",
+	"crutch":                        "1fa7c",
+	"cry":                           "1f622",
+	"crying_cat":                    "1f63f",
+	"crystal":                       "1f48e",
+	"crystal_ball":                  "1f52e",
+	"cube":                          "1f9ca",
+	"cucumber":                      "1f952",
+	"cuffs":                         "26d3-200d-1f4a5",
+	"culture":                       "1f9eb",
+	"cup_with_straw":                "1f964",
+	"cupcake":                       "1f9c1",
+	"cupid":                         "1f498",
+	"cupped":                        "1f932",
+	"curious":                       "1f99d",
+	"curl":                          "1f4c3",
+	"curling":                       "1f94c",
+	"curling_stone":                 "1f94c",
+	"curry":                         "1f35b",
+	"cursing":                       "1f92c",
+	"curve":                         "1fa9d",
+	"cussing":                       "1f92c",
+	"custard":                       "1f36e",
+	"customs":                       "1f6c3",
+	"cut_of_meat":                   "1f969",
+	"cute":                          "1f4a0",
+	"cyan":                          "1fa75",
+	"cyclist":                       "1f6b4",
+	"cyclone":                       "1f300",
+	"cygnet":                        "1f9a2",
+	"dagger":                        "1f5e1",
+	"dairy":                         "1f9c8",
+	"dam":                           "1f9ab",
+	"dancer":                        "1f483",
+	"dancers":                       "1f46f",
+	"dancing":                       "1f57a",
+	"danger":                        "26a0",
+	"dango":                         "1f361",
+	"dark_sunglasses":               "1f576",
+	"darts":                         "1f3af",
+	"dash":                          "1f4a8",
+	"date":                          "1f4c6",
+	"daze":                          "1fae8",
+	"de":                            "1fa85",
+	"deaf_man":                      "1f9cf-200d-2642",
+	"deaf_person":                   "1f9cf",
+	"deaf_woman":                    "1f9cf-200d-2640",
+	"death":                         "2620",
+	"debit_card":                    "1f4b3",
+	"deciduous_tree":                "1f333",
+	"decor":                         "1fab4",
+	"decoration":                    "1f380",
+	"decorative_notebook":           "1f4d4",
+	"decrease":                      "1f53d",
+	"decreasing":                    "1f4c9",
+	"deer":                          "1f98c",
+	"deg":                           "1faaf",
+	"delivery":                      "1f961",
+	"demonstration":                 "1faa7",
+	"denim":                         "1f456",
+	"dental":                        "1faa5",
+	"dentist":                       "1f9b7",
+	"department_store":              "1f3ec",
+	"departure":                     "1f6eb",
+	"depressed":                     "1fae5",
+	"derelict_house":                "1f3da",
+	"desert":                        "1f3dc",
+	"desktop_computer":              "1f5a5",
+	"dessert":                       "1f9c1",
+	"detective":                     "1f575",
+	"devil":                         "1f47f",
+	"diagonal":                      "1fae4",
+	"diamond_with_a_dot":            "1f4a0",
+	"diamonds":                      "2666",
+	"diaper":                        "1f9f7",
+	"dice":                          "1f3b2",
+	"die":                           "1f3b2",
+	"dig":                           "1fa8f",
+	"digital_security":              "1f50f",
+	"dim":                           "1f505",
+	"ding":                          "1f6ce",
+	"diplodocus":                    "1f995",
+	"direct_hit":                    "1f3af",
+	"direction":                     "1f9ed",
+	"disability":                    "1fa7c",
+	"disabled":                      "267f",
+	"disappointed":                  "1f61e",
+	"disappointed_relieved":         "1f625",
+	"disapproval":                   "1f928",
+	"disc":                          "1f94f",
+	"disco":                         "1f57a",
+	"disguise":                      "1f978",
+	"disguised_face":                "1f978",
+	"dish":                          "1f9eb",
+	"dismiss":                       "1faf3",
+	"dissolve":                      "1fae0",
+	"distraught":                    "1f629",
+	"distrust":                      "1f928",
+	"dive":                          "1f97d",
+	"divide":                        "2797",
+	"diving_mask":                   "1f93f",
+	"division":                      "2797",
+	"diya":                          "1fa94",
+	"diya_lamp":                     "1fa94",
+	"dizzy":                         "1f635",
+	"dna":                           "1f9ec",
+	"do_not_litter":                 "1f6af",
+	"document":                      "1f4c4",
+	"dodo":                          "1f9a4",
+	"dog":                           "1f415",
+	"dogi":                          "1f94b",
+	"doll":                          "1fa86",
+	"dollar":                        "1fa99",
+	"dollar_bills":                  "1f4b5",
+	"dollars":                       "1f4b2",
+	"dolls":                         "1f38e",
+	"dolphin":                       "1f42c",
+	"donation":                      "1fa78",
+	"done_deal":                     "1f91d",
+	"doner_kebab":                   "1f959",
+	"donkey":                        "1facf",
+	"donut":                         "1f369",
+	"door":                          "1f6aa",
+	"dormouse":                      "1f42d",
+	"dotted":                        "1fae5",
+	"dotted_line_face":              "1fae5",
+	"dotted_six_pointed_star":       "1f52f",
+	"double_down":                   "23ec",
+	"double_exclamation":            "203c",
+	"double_loop":                   "27bf",
+	"double_up":                     "23eb",
+	"doubtful":                      "1fae4",
+	"doughnut":                      "1f369",
+	"dove":                          "1f54a",
+	"dove_of_peace":                 "1f54a",
+	"down":                          "2b07",
+	"down_button":                   "1f53d",
+	"downvote":                      "1f53d",
+	"downwards_trend":               "1f4c9",
+	"dr":                            "1f97c",
+	"dracula":                       "1f9db",
+	"dragon":                        "1f409",
+	"dragon_face":                   "1f432",
+	"drained":                       "1faab",
+	"drama":                         "1f3ad",
+	"dream":                         "1f4ad",
+	"drenched":                      "1f327",
+	"dress":                         "1f457",
+	"drinking_water":                "1f6b0",
+	"drip":                          "1fadf",
+	"drive_with_care":               "1f6b8",
+	"drooling":                      "1f924",
+	"drop":                          "1f4a7",
+	"drop_of_blood":                 "1fa78",
+	"dropped":                       "1faf3",
+	"drought":                       "1fabe",
+	"drum":                          "1f941",
+	"drumstick":                     "1f357",
+	"drunk":                         "1f974",
+	"dua":                           "1f932",
+	"duck":                          "1f986",
+	"duckling":                      "1f9a2",
+	"duel":                          "2694",
+	"dumpling":                      "1f95f",
+	"dungeon":                       "1f5dd",
+	"dupe":                          "265f",
+	"dvd":                           "1f4c0",
+	"dying":                         "1f975",
+	"dynamite":                      "1f9e8",
+	"e-mail":                        "1f4e7",
+	"eagle":                         "1f985",
+	"ear":                           "1f442",
+	"ear_of_rice":                   "1f33e",
+	"ear_with_hearing_aid":          "1f9bb",
+	"earth_africa":                  "1f30d",
+	"earth_americas":                "1f30e",
+	"earth_asia":                    "1f30f",
+	"earthquake":                    "1fae8",
+	"earthworm":                     "1fab1",
+	"east":                          "27a1",
+	"easy_come_easy_go":             "1f4b8",
+	"eating_utensils":               "1f374",
+	"edamame":                       "1fadb",
+	"egg":                           "1f95a",
+	"eggplant":                      "1f346",
+	"eggs":                          "1faba",
+	"eight":                         "0038-20e3",
+	"eight_pointed_star":            "2734",
+	"eight_spoked_asterisk":         "2733",
+	"eject":                         "23cf",
+	"eject_button":                  "23cf",
+	"elderly":                       "1f9d3",
+	"elderly_man":                   "1f474",
+	"elderly_woman":                 "1f475",
+	"electric_plug":                 "1f50c",
+	"electronic":                    "1faab",
+	"elephant":                      "1f418",
+	"elevated_train":                "1f69d",
+	"elevator":                      "1f6d7",
+	"elf":                           "1f9dd",
+	"elk":                           "1face",
+	"email":                         "2709",
+	"embarrassed":                   "1f633",
+	"embrace":                       "1fac2",
+	"embroidery":                    "1faa1",
+	"emerge":                        "1f426-200d-1f525",
+	"emergency":                     "1f9ba",
+	"emoji":                         "1f928",
+	"empanada":                      "1f95f",
+	"empty_mailbox":                 "1f4ed",
+	"empty_nest":                    "1fab9",
+	"encrypted":                     "1f5dd",
+	"end":                           "1f51a",
+	"energy":                        "1faab",
+	"engineer":                      "2699",
+	"ensnare":                       "1fa9d",
+	"envelope":                      "2709",
+	"envy":                          "1f49a",
+	"equal":                         "1f7f0",
+	"equality":                      "1f7f0",
+	"equals":                        "1f7f0",
+	"euro":                          "1fa99",
+	"euro_banknotes":                "1f4b6",
+	"evergreen_tree":                "1f332",
+	"evidence":                      "1f9fe",
+	"evil_eye":                      "1f9ff",
+	"evolution":                     "1f9ec",
+	"ew":                            "1f92e",
+	"exchange":                      "1f4b1",
+	"exclamation":                   "2757",
+	"exhalation":                    "1fac1",
+	"exhale":                        "1f62e-200d-1f4a8",
+	"exhaling":                      "1f62e-200d-1f4a8",
+	"exhausted":                     "1f625",
+	"expecting":                     "1f930",
+	"expendable":                    "265f",
+	"expensive":                     "1faf0",
+	"explode":                       "1f92f",
+	"exploding":                     "1f92f",
+	"exploding_head":                "1f92f",
+	"explosion":                     "1f4a5",
+	"explosive":                     "1f9e8",
+	"expressionless":                "1f611",
+	"extinguish":                    "1f9ef",
+	"extinguisher":                  "1f9ef",
+	"extra":                         "1f6f8",
+	"eye":                           "1f441",
+	"eye_in_speech_bubble":          "1f441-200d-1f5e8",
+	"eyes":                          "1f440",
+	"face_exhaling":                 "1f62e-200d-1f4a8",
+	"face_holding_back_tears":       "1f979",
+	"face_in_clouds":                "1f636-200d-1f32b",
+	"face_palm":                     "1f926",
+	"face_vomiting":                 "1f92e",
+	"face_with_bags_under_eyes":     "1fae9",
+	"face_with_diagonal_mouth":      "1fae4",
+	"face_with_hand_over_mouth":     "1f92d",
+	"face_with_monocle":             "1f9d0",
+	"face_with_open_eyes_and_hand_over_mouth": "1fae2",
+	"face_with_peeking_eye":                   "1fae3",
+	"face_with_raised_eyebrow":                "1f928",
+	"face_with_spiral_eyes":                   "1f635-200d-1f4ab",
+	"face_with_symbols_on_mouth":              "1f92c",
+	"face_with_thermometer":                   "1f912",
+	"factory":                                 "1f3ed",
+	"factory_worker":                          "1f9d1-200d-1f3ed",
+	"fairy":                                   "1f9da",
+	"falafel":                                 "1f9c6",
+	"fall":                                    "1f343",
+	"fallen_leaf":                             "1f342",
+	"family":                                  "1f46a",
+	"family_adult_adult_child":                "1f9d1-200d-1f9d1-200d-1f9d2",
+	"family_adult_adult_child_child":          "1f9d1-200d-1f9d1-200d-1f9d2-200d-1f9d2",
+	"family_adult_child":                      "1f9d1-200d-1f9d2",
+	"family_adult_child_child":                "1f9d1-200d-1f9d2-200d-1f9d2",
+	"family_man_boy":                          "1f468-200d-1f466",
+	"family_man_boy_boy":                      "1f468-200d-1f466-200d-1f466",
+	"family_man_girl":                         "1f468-200d-1f467",
+	"family_man_girl_boy":                     "1f468-200d-1f467-200d-1f466",
+	"family_man_girl_girl":                    "1f468-200d-1f467-200d-1f467",
+	"family_man_man_boy":                      "1f468-200d-1f468-200d-1f466",
+	"family_man_man_boy_boy":                  "1f468-200d-1f468-200d-1f466-200d-1f466",
+	"family_man_man_girl":                     "1f468-200d-1f468-200d-1f467",
+	"family_man_man_girl_boy":                 "1f468-200d-1f468-200d-1f467-200d-1f466",
+	"family_man_man_girl_girl":                "1f468-200d-1f468-200d-1f467-200d-1f467",
+	"family_man_woman_boy":                    "1f468-200d-1f469-200d-1f466",
+	"family_man_woman_boy_boy":                "1f468-200d-1f469-200d-1f466-200d-1f466",
+	"family_man_woman_girl":                   "1f468-200d-1f469-200d-1f467",
+	"family_man_woman_girl_boy":               "1f468-200d-1f469-200d-1f467-200d-1f466",
+	"family_man_woman_girl_girl":              "1f468-200d-1f469-200d-1f467-200d-1f467",
+	"family_woman_boy":                        "1f469-200d-1f466",
+	"family_woman_boy_boy":                    "1f469-200d-1f466-200d-1f466",
+	"family_woman_girl":                       "1f469-200d-1f467",
+	"family_woman_girl_boy":                   "1f469-200d-1f467-200d-1f466",
+	"family_woman_girl_girl":                  "1f469-200d-1f467-200d-1f467",
+	"family_woman_woman_boy":                  "1f469-200d-1f469-200d-1f466",
+	"family_woman_woman_boy_boy":              "1f469-200d-1f469-200d-1f466-200d-1f466",
+	"family_woman_woman_girl":                 "1f469-200d-1f469-200d-1f467",
+	"family_woman_woman_girl_boy":             "1f469-200d-1f469-200d-1f467-200d-1f466",
+	"family_woman_woman_girl_girl":            "1f469-200d-1f469-200d-1f467-200d-1f467",
+	"fan":                                     "1faad",
+	"fancy":                                   "1f9d0",
+	"farewell":                                "1fac2",
+	"farmer":                                  "1f9d1-200d-1f33e",
+	"farming":                                 "1f9fa",
+	"fast_down":                               "23ec",
+	"fast_forward":                            "23e9",
+	"fast_reverse":                            "23ea",
+	"fast_up":                                 "23eb",
+	"fateh":                                   "1faaf",
+	"father":                                  "1f468-200d-1f37c",
+	"fatigued":                                "1fae9",
+	"fatima":                                  "1faac",
+	"fax":                                     "1f4e0",
+	"fear":                                    "1f628",
+	"feather":                                 "1fab6",
+	"feelings":                                "1f979",
+	"feet":                                    "1f463",
+	"feline":                                  "1f408-200d-2b1b",
+	"female":                                  "2640",
+	"female_sign":                             "2640",
+	"fencing":                                 "1f93a",
+	"ferris_wheel":                            "1f3a1",
+	"ferry":                                   "26f4",
+	"festival":                                "1f96e",
+	"festive":                                 "1fa85",
+	"fever":                                   "1f912",
+	"feverish":                                "1f975",
+	"field_hockey":                            "1f3d1",
+	"fife":                                    "1fa88",
+	"fight":                                   "1f977",
+	"fighter":                                 "1f977",
+	"file":                                    "1f4c4",
+	"file_cabinet":                            "1f5c4",
+	"file_folder":                             "1f4c1",
+	"filling":                                 "1f967",
+	"film":                                    "1f39e",
+	"find":                                    "1f50d",
+	"fingerprint":                             "1fac6",
+	"fingers_crossed":                         "1f91e",
+	"fire":                                    "1f525",
+	"fire_engine":                             "1f692",
+	"fire_extinguisher":                       "1f9ef",
+	"fire_truck":                              "1f692",
+	"firebird":                                "1f426-200d-1f525",
+	"firecracker":                             "1f9e8",
+	"firefighter":                             "1f9d1-200d-1f692",
+	"fireworks":                               "1f386",
+	"first":                                   "1f313",
+	"first_place":                             "1f947",
+	"first_quarter_moon":                      "1f313",
+	"fish":                                    "1f41f",
+	"fishing":                                 "1f3a3",
+	"fist":                                    "270a",
+	"fist_bump":                               "1f44a",
+	"five":                                    "0035-20e3",
+	"fixing":                                  "1f527",
+	"flag_afghanistan":                        "1f1e6-1f1eb",
+	"flag_aland_islands":                      "1f1e6-1f1fd",
+	"flag_albania":                            "1f1e6-1f1f1",
+	"flag_algeria":                            "1f1e9-1f1ff",
+	"flag_american_samoa":                     "1f1e6-1f1f8",
+	"flag_andorra":                            "1f1e6-1f1e9",
+	"flag_angola":                             "1f1e6-1f1f4",
+	"flag_anguilla":                           "1f1e6-1f1ee",
+	"flag_antarctica":                         "1f1e6-1f1f6",
+	"flag_antigua_and_barbuda":                "1f1e6-1f1ec",
+	"flag_argentina":                          "1f1e6-1f1f7",
+	"flag_armenia":                            "1f1e6-1f1f2",
+	"flag_aruba":                              "1f1e6-1f1fc",
+	"flag_ascension_island":                   "1f1e6-1f1e8",
+	"flag_australia":                          "1f1e6-1f1fa",
+	"flag_austria":                            "1f1e6-1f1f9",
+	"flag_azerbaijan":                         "1f1e6-1f1ff",
+	"flag_bahamas":                            "1f1e7-1f1f8",
+	"flag_bahrain":                            "1f1e7-1f1ed",
+	"flag_bangladesh":                         "1f1e7-1f1e9",
+	"flag_barbados":                           "1f1e7-1f1e7",
+	"flag_belarus":                            "1f1e7-1f1fe",
+	"flag_belgium":                            "1f1e7-1f1ea",
+	"flag_belize":                             "1f1e7-1f1ff",
+	"flag_benin":                              "1f1e7-1f1ef",
+	"flag_bermuda":                            "1f1e7-1f1f2",
+	"flag_bhutan":                             "1f1e7-1f1f9",
+	"flag_bolivia":                            "1f1e7-1f1f4",
+	"flag_bosnia_and_herzegovina":             "1f1e7-1f1e6",
+	"flag_botswana":                           "1f1e7-1f1fc",
+	"flag_bouvet_island":                      "1f1e7-1f1fb",
+	"flag_brazil":                             "1f1e7-1f1f7",
+	"flag_british_indian_ocean_territory":     "1f1ee-1f1f4",
+	"flag_british_virgin_islands":             "1f1fb-1f1ec",
+	"flag_brunei":                             "1f1e7-1f1f3",
+	"flag_bulgaria":                           "1f1e7-1f1ec",
+	"flag_burkina_faso":                       "1f1e7-1f1eb",
+	"flag_burundi":                            "1f1e7-1f1ee",
+	"flag_cambodia":                           "1f1f0-1f1ed",
+	"flag_cameroon":                           "1f1e8-1f1f2",
+	"flag_canada":                             "1f1e8-1f1e6",
+	"flag_canary_islands":                     "1f1ee-1f1e8",
+	"flag_cape_verde":                         "1f1e8-1f1fb",
+	"flag_caribbean_netherlands":              "1f1e7-1f1f6",
+	"flag_cayman_islands":                     "1f1f0-1f1fe",
+	"flag_central_african_republic":           "1f1e8-1f1eb",
+	"flag_ceuta_and_melilla":                  "1f1ea-1f1e6",
+	"flag_chad":                               "1f1f9-1f1e9",
+	"flag_chile":                              "1f1e8-1f1f1",
+	"flag_china":                              "1f1e8-1f1f3",
+	"flag_christmas_island":                   "1f1e8-1f1fd",
+	"flag_clipperton_island":                  "1f1e8-1f1f5",
+	"flag_cocos_keeling_islands":              "1f1e8-1f1e8",
+	"flag_colombia":                           "1f1e8-1f1f4",
+	"flag_comoros":                            "1f1f0-1f1f2",
+	"flag_congo_brazzaville":                  "1f1e8-1f1ec",
+	"flag_congo_kinshasa":                     "1f1e8-1f1e9",
+	"flag_cook_islands":                       "1f1e8-1f1f0",
+	"flag_costa_rica":                         "1f1e8-1f1f7",
+	"flag_cote_divoire":                       "1f1e8-1f1ee",
+	"flag_croatia":                            "1f1ed-1f1f7",
+	"flag_cuba":                               "1f1e8-1f1fa",
+	"flag_curacao":                            "1f1e8-1f1fc",
+	"flag_curaçao":                            "1f1e8-1f1fc",
+	"flag_cyprus":                             "1f1e8-1f1fe",
+	"flag_czechia":                            "1f1e8-1f1ff",
+	"flag_côte_divoire":                       "1f1e8-1f1ee",
+	"flag_denmark":                            "1f1e9-1f1f0",
+	"flag_diego_garcia":                       "1f1e9-1f1ec",
+	"flag_djibouti":                           "1f1e9-1f1ef",
+	"flag_dominica":                           "1f1e9-1f1f2",
+	"flag_dominican_republic":                 "1f1e9-1f1f4",
+	"flag_ecuador":                            "1f1ea-1f1e8",
+	"flag_egypt":                              "1f1ea-1f1ec",
+	"flag_el_salvador":                        "1f1f8-1f1fb",
+	"flag_england":                            "1f3f4-e0067-e0062-e0065-e006e-e0067-e007f",
+	"flag_equatorial_guinea":                  "1f1ec-1f1f6",
+	"flag_eritrea":                            "1f1ea-1f1f7",
+	"flag_estonia":                            "1f1ea-1f1ea",
+	"flag_eswatini":                           "1f1f8-1f1ff",
+	"flag_ethiopia":                           "1f1ea-1f1f9",
+	"flag_european_union":                     "1f1ea-1f1fa",
+	"flag_falkland_islands":                   "1f1eb-1f1f0",
+	"flag_faroe_islands":                      "1f1eb-1f1f4",
+	"flag_fiji":                               "1f1eb-1f1ef",
+	"flag_finland":                            "1f1eb-1f1ee",
+	"flag_france":                             "1f1eb-1f1f7",
+	"flag_french_guiana":                      "1f1ec-1f1eb",
+	"flag_french_polynesia":                   "1f1f5-1f1eb",
+	"flag_french_southern_territories":        "1f1f9-1f1eb",
+	"flag_gabon":                              "1f1ec-1f1e6",
+	"flag_gambia":                             "1f1ec-1f1f2",
+	"flag_georgia":                            "1f1ec-1f1ea",
+	"flag_germany":                            "1f1e9-1f1ea",
+	"flag_ghana":                              "1f1ec-1f1ed",
+	"flag_gibraltar":                          "1f1ec-1f1ee",
+	"flag_greece":                             "1f1ec-1f1f7",
+	"flag_greenland":                          "1f1ec-1f1f1",
+	"flag_grenada":                            "1f1ec-1f1e9",
+	"flag_guadeloupe":                         "1f1ec-1f1f5",
+	"flag_guam":                               "1f1ec-1f1fa",
+	"flag_guatemala":                          "1f1ec-1f1f9",
+	"flag_guernsey":                           "1f1ec-1f1ec",
+	"flag_guinea":                             "1f1ec-1f1f3",
+	"flag_guinea_bissau":                      "1f1ec-1f1fc",
+	"flag_guyana":                             "1f1ec-1f1fe",
+	"flag_haiti":                              "1f1ed-1f1f9",
+	"flag_heard_and_mcdonald_islands":         "1f1ed-1f1f2",
+	"flag_honduras":                           "1f1ed-1f1f3",
+	"flag_hong_kong_sar_china":                "1f1ed-1f1f0",
+	"flag_hungary":                            "1f1ed-1f1fa",
+	"flag_iceland":                            "1f1ee-1f1f8",
+	"flag_india":                              "1f1ee-1f1f3",
+	"flag_indonesia":                          "1f1ee-1f1e9",
+	"flag_iran":                               "1f1ee-1f1f7",
+	"flag_iraq":                               "1f1ee-1f1f6",
+	"flag_ireland":                            "1f1ee-1f1ea",
+	"flag_isle_of_man":                        "1f1ee-1f1f2",
+	"flag_israel":                             "1f1ee-1f1f1",
+	"flag_italy":                              "1f1ee-1f1f9",
+	"flag_jamaica":                            "1f1ef-1f1f2",
+	"flag_japan":                              "1f1ef-1f1f5",
+	"flag_jersey":                             "1f1ef-1f1ea",
+	"flag_jordan":                             "1f1ef-1f1f4",
+	"flag_kazakhstan":                         "1f1f0-1f1ff",
+	"flag_kenya":                              "1f1f0-1f1ea",
+	"flag_kiribati":                           "1f1f0-1f1ee",
+	"flag_kosovo":                             "1f1fd-1f1f0",
+	"flag_kuwait":                             "1f1f0-1f1fc",
+	"flag_kyrgyzstan":                         "1f1f0-1f1ec",
+	"flag_laos":                               "1f1f1-1f1e6",
+	"flag_latvia":                             "1f1f1-1f1fb",
+	"flag_lebanon":                            "1f1f1-1f1e7",
+	"flag_lesotho":                            "1f1f1-1f1f8",
+	"flag_liberia":                            "1f1f1-1f1f7",
+	"flag_libya":                              "1f1f1-1f1fe",
+	"flag_liechtenstein":                      "1f1f1-1f1ee",
+	"flag_lithuania":                          "1f1f1-1f1f9",
+	"flag_luxembourg":                         "1f1f1-1f1fa",
+	"flag_macao_sar_china":                    "1f1f2-1f1f4",
+	"flag_madagascar":                         "1f1f2-1f1ec",
+	"flag_malawi":                             "1f1f2-1f1fc",
+	"flag_malaysia":                           "1f1f2-1f1fe",
+	"flag_maldives":                           "1f1f2-1f1fb",
+	"flag_mali":                               "1f1f2-1f1f1",
+	"flag_malta":                              "1f1f2-1f1f9",
+	"flag_marshall_islands":                   "1f1f2-1f1ed",
+	"flag_martinique":                         "1f1f2-1f1f6",
+	"flag_mauritania":                         "1f1f2-1f1f7",
+	"flag_mauritius":                          "1f1f2-1f1fa",
+	"flag_mayotte":                            "1f1fe-1f1f9",
+	"flag_mexico":                             "1f1f2-1f1fd",
+	"flag_micronesia":                         "1f1eb-1f1f2",
+	"flag_moldova":                            "1f1f2-1f1e9",
+	"flag_monaco":                             "1f1f2-1f1e8",
+	"flag_mongolia":                           "1f1f2-1f1f3",
+	"flag_montenegro":                         "1f1f2-1f1ea",
+	"flag_montserrat":                         "1f1f2-1f1f8",
+	"flag_morocco":                            "1f1f2-1f1e6",
+	"flag_mozambique":                         "1f1f2-1f1ff",
+	"flag_myanmar_burma":                      "1f1f2-1f1f2",
+	"flag_namibia":                            "1f1f3-1f1e6",
+	"flag_nauru":                              "1f1f3-1f1f7",
+	"flag_nepal":                              "1f1f3-1f1f5",
+	"flag_netherlands":                        "1f1f3-1f1f1",
+	"flag_new_caledonia":                      "1f1f3-1f1e8",
+	"flag_new_zealand":                        "1f1f3-1f1ff",
+	"flag_nicaragua":                          "1f1f3-1f1ee",
+	"flag_niger":                              "1f1f3-1f1ea",
+	"flag_nigeria":                            "1f1f3-1f1ec",
+	"flag_niue":                               "1f1f3-1f1fa",
+	"flag_norfolk_island":                     "1f1f3-1f1eb",
+	"flag_north_korea":                        "1f1f0-1f1f5",
+	"flag_north_macedonia":                    "1f1f2-1f1f0",
+	"flag_northern_mariana_islands":           "1f1f2-1f1f5",
+	"flag_norway":                             "1f1f3-1f1f4",
+	"flag_oman":                               "1f1f4-1f1f2",
+	"flag_pakistan":                           "1f1f5-1f1f0",
+	"flag_palau":                              "1f1f5-1f1fc",
+	"flag_palestinian_territories":            "1f1f5-1f1f8",
+	"flag_panama":                             "1f1f5-1f1e6",
+	"flag_papua_new_guinea":                   "1f1f5-1f1ec",
+	"flag_paraguay":                           "1f1f5-1f1fe",
+	"flag_peru":                               "1f1f5-1f1ea",
+	"flag_philippines":                        "1f1f5-1f1ed",
+	"flag_pitcairn_islands":                   "1f1f5-1f1f3",
+	"flag_poland":                             "1f1f5-1f1f1",
+	"flag_portugal":                           "1f1f5-1f1f9",
+	"flag_puerto_rico":                        "1f1f5-1f1f7",
+	"flag_qatar":                              "1f1f6-1f1e6",
+	"flag_reunion":                            "1f1f7-1f1ea",
+	"flag_romania":                            "1f1f7-1f1f4",
+	"flag_russia":                             "1f1f7-1f1fa",
+	"flag_rwanda":                             "1f1f7-1f1fc",
+	"flag_réunion":                            "1f1f7-1f1ea",
+	"flag_samoa":                              "1f1fc-1f1f8",
+	"flag_san_marino":                         "1f1f8-1f1f2",
+	"flag_sao_tome_and_principe":              "1f1f8-1f1f9",
+	"flag_sark":                               "1f1e8-1f1f6",
+	"flag_saudi_arabia":                       "1f1f8-1f1e6",
+	"flag_scotland":                           "1f3f4-e0067-e0062-e0073-e0063-e0074-e007f",
+	"flag_senegal":                            "1f1f8-1f1f3",
+	"flag_serbia":                             "1f1f7-1f1f8",
+	"flag_seychelles":                         "1f1f8-1f1e8",
+	"flag_sierra_leone":                       "1f1f8-1f1f1",
+	"flag_singapore":                          "1f1f8-1f1ec",
+	"flag_sint_maarten":                       "1f1f8-1f1fd",
+	"flag_slovakia":                           "1f1f8-1f1f0",
+	"flag_slovenia":                           "1f1f8-1f1ee",
+	"flag_solomon_islands":                    "1f1f8-1f1e7",
+	"flag_somalia":                            "1f1f8-1f1f4",
+	"flag_south_africa":                       "1f1ff-1f1e6",
+	"flag_south_georgia_and_south_sandwich_islands": "1f1ec-1f1f8",
+	"flag_south_korea":                "1f1f0-1f1f7",
+	"flag_south_sudan":                "1f1f8-1f1f8",
+	"flag_spain":                      "1f1ea-1f1f8",
+	"flag_sri_lanka":                  "1f1f1-1f1f0",
+	"flag_st_barthelemy":              "1f1e7-1f1f1",
+	"flag_st_barthélemy":              "1f1e7-1f1f1",
+	"flag_st_helena":                  "1f1f8-1f1ed",
+	"flag_st_kitts_and_nevis":         "1f1f0-1f1f3",
+	"flag_st_lucia":                   "1f1f1-1f1e8",
+	"flag_st_martin":                  "1f1f2-1f1eb",
+	"flag_st_pierre_and_miquelon":     "1f1f5-1f1f2",
+	"flag_st_vincent_and_grenadines":  "1f1fb-1f1e8",
+	"flag_sudan":                      "1f1f8-1f1e9",
+	"flag_suriname":                   "1f1f8-1f1f7",
+	"flag_svalbard_and_jan_mayen":     "1f1f8-1f1ef",
+	"flag_sweden":                     "1f1f8-1f1ea",
+	"flag_switzerland":                "1f1e8-1f1ed",
+	"flag_syria":                      "1f1f8-1f1fe",
+	"flag_são_tomé_and_príncipe":      "1f1f8-1f1f9",
+	"flag_taiwan":                     "1f1f9-1f1fc",
+	"flag_tajikistan":                 "1f1f9-1f1ef",
+	"flag_tanzania":                   "1f1f9-1f1ff",
+	"flag_thailand":                   "1f1f9-1f1ed",
+	"flag_timor_leste":                "1f1f9-1f1f1",
+	"flag_togo":                       "1f1f9-1f1ec",
+	"flag_tokelau":                    "1f1f9-1f1f0",
+	"flag_tonga":                      "1f1f9-1f1f4",
+	"flag_trinidad_and_tobago":        "1f1f9-1f1f9",
+	"flag_tristan_da_cunha":           "1f1f9-1f1e6",
+	"flag_tunisia":                    "1f1f9-1f1f3",
+	"flag_turkiye":                    "1f1f9-1f1f7",
+	"flag_turkmenistan":               "1f1f9-1f1f2",
+	"flag_turks_and_caicos_islands":   "1f1f9-1f1e8",
+	"flag_tuvalu":                     "1f1f9-1f1fb",
+	"flag_türkiye":                    "1f1f9-1f1f7",
+	"flag_uganda":                     "1f1fa-1f1ec",
+	"flag_ukraine":                    "1f1fa-1f1e6",
+	"flag_united_arab_emirates":       "1f1e6-1f1ea",
+	"flag_united_kingdom":             "1f1ec-1f1e7",
+	"flag_united_nations":             "1f1fa-1f1f3",
+	"flag_united_states":              "1f1fa-1f1f8",
+	"flag_uruguay":                    "1f1fa-1f1fe",
+	"flag_us_outlying_islands":        "1f1fa-1f1f2",
+	"flag_us_virgin_islands":          "1f1fb-1f1ee",
+	"flag_uzbekistan":                 "1f1fa-1f1ff",
+	"flag_vanuatu":                    "1f1fb-1f1fa",
+	"flag_vatican_city":               "1f1fb-1f1e6",
+	"flag_venezuela":                  "1f1fb-1f1ea",
+	"flag_vietnam":                    "1f1fb-1f1f3",
+	"flag_wales":                      "1f3f4-e0067-e0062-e0077-e006c-e0073-e007f",
+	"flag_wallis_and_futuna":          "1f1fc-1f1eb",
+	"flag_western_sahara":             "1f1ea-1f1ed",
+	"flag_yemen":                      "1f1fe-1f1ea",
+	"flag_zambia":                     "1f1ff-1f1f2",
+	"flag_zimbabwe":                   "1f1ff-1f1fc",
+	"flag_åland_islands":              "1f1e6-1f1fd",
+	"flags":                           "1f38f",
+	"flamboyant":                      "1f9a9",
+	"flame":                           "1f525",
+	"flamingo":                        "1f9a9",
+	"flan":                            "1f36e",
+	"flashlight":                      "1f526",
+	"flat":                            "1f97f",
+	"flat_shoe":                       "1f97f",
+	"flatbed":                         "1f6fb",
+	"flatbread":                       "1fad3",
+	"flathead":                        "1fa9b",
+	"flats":                           "1f97f",
+	"flautist":                        "1fa88",
+	"flavor":                          "1f9c2",
+	"fleur_de_lis":                    "269c",
+	"flight":                          "1fab6",
+	"flip_flops":                      "1f461",
+	"flipper":                         "1f42c",
+	"flirting":                        "1fae6",
+	"float":                           "1f6df",
+	"floating":                        "1fae7",
+	"flock":                           "1fabf",
+	"flop":                            "1fa74",
+	"floppy_disk":                     "1f4be",
+	"flower":                          "1f337",
+	"flu":                             "1f912",
+	"fluctuate":                       "1fa80",
+	"flushed":                         "1f633",
+	"flute":                           "1fa88",
+	"flutter":                         "1faad",
+	"fly":                             "1fab0",
+	"flying_disc":                     "1f94f",
+	"flying_saucer":                   "1f6f8",
+	"fog":                             "1f32b",
+	"foggy":                           "1f301",
+	"folded_hands":                    "1f64f",
+	"folder":                          "1f4c2",
+	"folding":                         "1faad",
+	"folding_hand_fan":                "1faad",
+	"fondue":                          "1fad5",
+	"food":                            "1f372",
+	"foot":                            "1f9b6",
+	"football":                        "26bd",
+	"footprints":                      "1f463",
+	"force":                           "1faa0",
+	"forensics":                       "1fac6",
+	"forever":                         "267e",
+	"fork_and_knife":                  "1f374",
+	"fork_and_knife_with_plate":       "1f37d",
+	"fortune_cookie":                  "1f960",
+	"fortune_telling":                 "1f52e",
+	"forward":                         "21aa",
+	"fountain":                        "26f2",
+	"fountain_pen":                    "1f58b",
+	"four":                            "0034-20e3",
+	"four_leaf_clover":                "1f340",
+	"fowl":                            "1fabf",
+	"fox":                             "1f98a",
+	"frame":                           "1fa9f",
+	"framed_picture":                  "1f5bc",
+	"free":                            "1f193",
+	"freedom":                         "26d3-200d-1f4a5",
+	"freezing":                        "1f976",
+	"fresh":                           "1fa9f",
+	"friends":                         "1f9d1-200d-1f91d-200d-1f9d1",
+	"friendship":                      "1fac2",
+	"fries":                           "1f35f",
+	"frog":                            "1f438",
+	"frostbite":                       "1f976",
+	"frosty":                          "26c4",
+	"frown":                           "1f641",
+	"frowning":                        "1f626",
+	"frustration":                     "1fae4",
+	"fuel_pump":                       "26fd",
+	"full_battery":                    "1f50b",
+	"full_moon":                       "1f315",
+	"funeral_urn":                     "26b1",
+	"fungi":                           "1f344-200d-1f7eb",
+	"fungus":                          "1f344-200d-1f7eb",
+	"furniture":                       "1f6cb",
+	"future":                          "1f52e",
+	"gaggle":                          "1fabf",
+	"gander":                          "1fabf",
+	"garlic":                          "1f9c4",
+	"garnish":                         "1f34b-200d-1f7e9",
+	"gas_pump":                        "26fd",
+	"gay":                             "1f3f3-200d-1f308",
+	"gear":                            "2699",
+	"gecko":                           "1f98e",
+	"geek":                            "1f913",
+	"geese":                           "1fabf",
+	"gelato":                          "1f368",
+	"gem":                             "1f48e",
+	"gemini":                          "264a",
+	"genderqueer":                     "1f3f3-200d-1f308",
+	"gene":                            "1f9ec",
+	"genetics":                        "1f9ec",
+	"genie":                           "1f9de",
+	"ghost":                           "1f47b",
+	"gibbous":                         "1f316",
+	"gift":                            "1f381",
+	"gift_heart":                      "1f49d",
+	"giggle":                          "1f92d",
+	"giggling":                        "1f92d",
+	"ginger":                          "1fada",
+	"ginger_root":                     "1fada",
+	"giraffe":                         "1f992",
+	"girl":                            "1f467",
+	"glad":                            "1f972",
+	"glamour":                         "2728",
+	"glass_of_milk":                   "1f95b",
+	"glasses":                         "1f453",
+	"glbt":                            "1f3f3-200d-1f308",
+	"glbtq":                           "1f3f3-200d-1f308",
+	"glitter":                         "1faa9",
+	"globe":                           "1f310",
+	"glory":                           "1f426-200d-1f525",
+	"glove":                           "1f94e",
+	"gloves":                          "1f9e4",
+	"glowing_star":                    "1f31f",
+	"go":                              "1f3c1",
+	"goal":                            "1f945",
+	"goat":                            "1f410",
+	"goblin":                          "1f47a",
+	"goggles":                         "1f97d",
+	"gold":                            "1f947",
+	"gold_record":                     "1f4bd",
+	"golf":                            "1f3cc",
+	"gondola":                         "1f6a0",
+	"goodbye":                         "1fac2",
+	"goodnight":                       "1f31b",
+	"goofy":                           "1f92a",
+	"gooooooooal":                     "1f945",
+	"goose":                           "1fabf",
+	"gordita":                         "1fad3",
+	"gorilla":                         "1f98d",
+	"got_it":                          "1f44c",
+	"graduate":                        "1f393",
+	"grandchild":                      "1f9d2",
+	"grandparent":                     "1f9d3",
+	"grapes":                          "1f347",
+	"grasshopper":                     "1f997",
+	"gratitude":                       "1f979",
+	"grave":                           "26b0",
+	"graveyard":                       "1faa6",
+	"gray":                            "1fa76",
+	"green_apple":                     "1f34f",
+	"green_book":                      "1f4d7",
+	"green_circle":                    "1f7e2",
+	"green_heart":                     "1f49a",
+	"green_large_square":              "1f7e9",
+	"grey":                            "1fa76",
+	"grey_exclamation":                "2755",
+	"grey_heart":                      "1fa76",
+	"grey_question":                   "2754",
+	"grimacing":                       "1f62c",
+	"grinning":                        "1f600",
+	"grinning_face_with_smiling_eyes": "1f601",
+	"groan":                           "1f62e-200d-1f4a8",
+	"gross":                           "1f92e",
+	"grow":                            "1fab4",
+	"growing":                         "1f4c8",
+	"growing_heart":                   "1f497",
+	"grumpy":                          "1f621",
+	"gua_pi_mao":                      "1f472",
+	"guanaco":                         "1f999",
+	"guard":                           "1f482",
+	"guestrooms":                      "1f6cc",
+	"guide_dog":                       "1f9ae",
+	"guitar":                          "1f3b8",
+	"gun":                             "1f52b",
+	"gym":                             "1f3cb",
+	"gymnastics":                      "1f938",
+	"gyoza":                           "1f95f",
+	"gyōza":                           "1f95f",
+	"haha":                            "1fae0",
+	"hair_pick":                       "1faae",
+	"haircut":                         "1f487",
+	"half_frown":                      "1f615",
+	"halo":                            "1f607",
+	"hamburger":                       "1f354",
+	"hammer":                          "1f528",
+	"hammer_and_pick":                 "2692",
+	"hammer_and_wrench":               "1f6e0",
+	"hamsa":                           "1faac",
+	"hamster":                         "1f439",
+	"hand":                            "270b",
+	"hand_with_index_finger_and_thumb_crossed": "1faf0",
+	"handbag":                               "1f45c",
+	"handball":                              "1f93e",
+	"handshake":                             "1f91d",
+	"handy":                                 "1fa9b",
+	"handyman":                              "1f528",
+	"handywoman":                            "1f528",
+	"hang_glide":                            "1fa82",
+	"happy":                                 "1f600",
+	"hard":                                  "1f9bb",
+	"hard_hat":                              "26d1",
+	"harp":                                  "1fa89",
+	"harvest":                               "1f33e",
+	"hash":                                  "0023-20e3",
+	"hashi":                                 "1f962",
+	"hat":                                   "1f452",
+	"hatchet":                               "1fa93",
+	"hatching":                              "1f423",
+	"hatching_chick":                        "1f423",
+	"haunted":                               "1f47b",
+	"hazard":                                "2620",
+	"hazy":                                  "1f32b",
+	"head_bandage":                          "1f915",
+	"head_shaking_horizontally":             "1f642-200d-2194",
+	"head_shaking_vertically":               "1f642-200d-2195",
+	"heading_down":                          "2935",
+	"heading_up":                            "2934",
+	"headlines":                             "1f4f0",
+	"headphones":                            "1f3a7",
+	"headscarf":                             "1f9d5",
+	"headstone":                             "1faa6",
+	"health_worker":                         "1f9d1-200d-2695",
+	"healthier":                             "2764-200d-1fa79",
+	"hear_no_evil":                          "1f649",
+	"hearing":                               "1f9bb",
+	"heart":                                 "2764",
+	"heart_arrow":                           "1f498",
+	"heart_box":                             "1f49f",
+	"heart_exclamation":                     "2763",
+	"heart_eyes":                            "1f60d",
+	"heart_eyes_cat":                        "1f63b",
+	"heart_hands":                           "1faf6",
+	"heart_kiss":                            "1f618",
+	"heart_of_gold":                         "1f49b",
+	"heart_on_fire":                         "2764-200d-1f525",
+	"heart_pulse":                           "1f497",
+	"heartache":                             "1f494",
+	"heartbeat":                             "1f493",
+	"hearts":                                "2665",
+	"heavenly":                              "1fabd",
+	"heavy_equals_sign":                     "1f7f0",
+	"hedgehog":                              "1f994",
+	"helicopter":                            "1f681",
+	"hello":                                 "1f44b",
+	"helmet":                                "26d1",
+	"help":                                  "1fa7c",
+	"helpless":                              "1f623",
+	"herb":                                  "1f33f",
+	"herd":                                  "1f9ac",
+	"heroine":                               "1f9b8-200d-2640",
+	"hi":                                    "1f44b",
+	"hibiscus":                              "1f33a",
+	"hiding":                                "1fae3",
+	"high_brightness":                       "1f506",
+	"high_five":                             "1f590",
+	"high_heels":                            "1f460",
+	"high_speed_train":                      "1f684",
+	"high_voltage":                          "26a1",
+	"hijab":                                 "1f9d5",
+	"hiking":                                "1f97e",
+	"hiking_boot":                           "1f97e",
+	"hindu":                                 "1f6d5",
+	"hindu_temple":                          "1f6d5",
+	"hinduism":                              "1f549",
+	"hinny":                                 "1facf",
+	"hint":                                  "1f5dd",
+	"hippo":                                 "1f99b",
+	"hippopotamus":                          "1f99b",
+	"hiss":                                  "1f40d",
+	"hmm":                                   "1f928",
+	"hocho":                                 "1f52a",
+	"hoist":                                 "1f6d7",
+	"holding":                               "1f979",
+	"hole":                                  "1f573",
+	"hole_in_one":                           "26f3",
+	"holi":                                  "1fadf",
+	"holiday":                               "1f9d1-200d-1f384",
+	"holiday_tree":                          "1f384",
+	"home_phone":                            "1f4de",
+	"honey":                                 "1f36f",
+	"honeybee":                              "1f41d",
+	"hongbao":                               "1f9e7",
+	"honk":                                  "1fabf",
+	"hook":                                  "1fa9d",
+	"hooray":                                "1f973",
+	"horizontal_traffic_light":              "1f6a5",
+	"horizontally":                          "1f642-200d-2194",
+	"horn":                                  "1f4ef",
+	"horse":                                 "1f40e",
+	"horse_racing":                          "1f3c7",
+	"horse_riding":                          "1f3c7",
+	"horseshoe":                             "1f9f2",
+	"hospital":                              "1f3e5",
+	"hot":                                   "1f525",
+	"hot_face":                              "1f975",
+	"hot_pepper":                            "1f336",
+	"hot_springs":                           "2668",
+	"hotdog":                                "1f32d",
+	"hotel":                                 "1f3e8",
+	"hotspot":                               "1f6dc",
+	"hourglass":                             "23f3",
+	"hourglass_done":                        "231b",
+	"house":                                 "1f3e0",
+	"houses":                                "1f3d8",
+	"hover":                                 "1f574",
+	"hug":                                   "1f917",
+	"hugging":                               "1fac2",
+	"huh":                                   "1f90c",
+	"human":                                 "1f471",
+	"humpback_whale":                        "1f40b",
+	"hundred":                               "1f4af",
+	"hungry":                                "1f37d",
+	"hurricane":                             "1f300",
+	"hurt":                                  "1f915",
+	"hush":                                  "1f910",
+	"hushed":                                "1f62f",
+	"hut":                                   "1f6d6",
+	"hyacinth":                              "1fabb",
+	"hygiene":                               "1faa5",
+	"hypnotized":                            "1f635-200d-1f4ab",
+	"hóngbāo":                               "1f9e7",
+	"ice":                                   "1f9ca",
+	"ice_cream":                             "1f368",
+	"ice_hockey":                            "1f3d2",
+	"ice_skate":                             "26f8",
+	"iceberg":                               "1f9ca",
+	"icicles":                               "1f976",
+	"id":                                    "1f194",
+	"idea":                                  "1f4a1",
+	"identification":                        "1faaa",
+	"identification_card":                   "1faaa",
+	"identity":                              "1fac6",
+	"ideograph_advantage":                   "1f250",
+	"ill":                                   "1f912",
+	"immigration":                           "1f6c2",
+	"immortal":                              "1f426-200d-1f525",
+	"imp":                                   "1f47f",
+	"improving":                             "2764-200d-1fa79",
+	"in_bed":                                "1f6cc",
+	"in_love":                               "1f60d",
+	"inbox":                                 "1f4e5",
+	"inbox_zero":                            "1f4ed",
+	"incognito":                             "1f978",
+	"incorrect":                             "274c",
+	"increase":                              "1f53c",
+	"increasing":                            "1f4c8",
+	"indecisive":                            "1f9c7",
+	"index_pointing_at_the_viewer":          "1faf5",
+	"indigo":                                "1fabb",
+	"infinity":                              "267e",
+	"info":                                  "2139",
+	"information_desk_person":               "1f481",
+	"inhalation":                            "1fac1",
+	"injection":                             "1f489",
+	"injured":                               "1f915",
+	"injury":                                "1fa78",
+	"ink":                                   "1fadf",
+	"innocent":                              "1f607",
+	"intelligent":                           "1f9e0",
+	"interlocking":                          "1f9e9",
+	"internet":                              "1f6dc",
+	"interrobang":                           "2049",
+	"interrogation":                         "1f90c",
+	"intoxicated":                           "1f974",
+	"introvert":                             "1fae5",
+	"invertebrate":                          "1fabc",
+	"invincible":                            "26d1",
+	"invisible":                             "1fae5",
+	"invoice":                               "1f9fe",
+	"iphone":                                "1f4f1",
+	"iron":                                  "1f9c7",
+	"islam":                                 "262a",
+	"island":                                "1f3dd",
+	"izakaya_lantern":                       "1f3ee",
+	"jack-o-lantern":                        "1f383",
+	"japan":                                 "1f5fe",
+	"japan_post":                            "1f3e3",
+	"japanese_acceptable_button":            "1f251",
+	"japanese_application_button":           "1f238",
+	"japanese_bargain_button":               "1f250",
+	"japanese_congratulations_button":       "3297",
+	"japanese_discount_button":              "1f239",
+	"japanese_free_of_charge_button":        "1f21a",
+	"japanese_here_button":                  "1f201",
+	"japanese_monthly_amount_button":        "1f237",
+	"japanese_no_vacancy_button":            "1f235",
+	"japanese_not_free_of_charge_button":    "1f236",
+	"japanese_open_for_business_button":     "1f23a",
+	"japanese_passing_grade_button":         "1f234",
+	"japanese_prohibited_button":            "1f232",
+	"japanese_reserved_button":              "1f22f",
+	"japanese_secret_button":                "3299",
+	"japanese_service_charge_button":        "1f202",
+	"japanese_vacancy_button":               "1f233",
+	"jar":                                   "1fad9",
+	"jeans":                                 "1f456",
+	"jeep":                                  "1f699",
+	"jelly":                                 "1fabc",
+	"jellyfish":                             "1fabc",
+	"jeotgarak":                             "1f962",
+	"jewish":                                "2721",
+	"jiaozi":                                "1f95f",
+	"jigsaw":                                "1f9e9",
+	"joey":                                  "1f998",
+	"joker":                                 "1f0cf",
+	"joking":                                "1f61c",
+	"jolly":                                 "1f3f4-200d-2620",
+	"joy":                                   "1f602",
+	"joy_cat":                               "1f639",
+	"joystick":                              "1f579",
+	"judaism":                               "2721",
+	"judge":                                 "1f9d1-200d-2696",
+	"juggling":                              "1f939",
+	"jump":                                  "1f998",
+	"justice":                               "2696",
+	"kaaba":                                 "1f54b",
+	"kaching":                               "1f911",
+	"kale":                                  "1f96c",
+	"kangaroo":                              "1f998",
+	"kawaii":                                "1f4a0",
+	"keikogi":                               "1f94b",
+	"kerchief":                              "1f9d5",
+	"key":                                   "1f511",
+	"key_signing":                           "1f50f",
+	"keyboard":                              "2328",
+	"khalsa":                                "1faaf",
+	"khanda":                                "1faaf",
+	"kick_scooter":                          "1f6f4",
+	"kid":                                   "1f9d2",
+	"kidney":                                "1fad8",
+	"kimono":                                "1f458",
+	"king":                                  "1f451",
+	"kiss":                                  "1f48f",
+	"kiss_man_man":                          "1f468-200d-2764-200d-1f48b-200d-1f468",
+	"kiss_smiling_eyes":                     "1f619",
+	"kiss_with_blush":                       "1f61a",
+	"kiss_woman_man":                        "1f469-200d-2764-200d-1f48b-200d-1f468",
+	"kiss_woman_woman":                      "1f469-200d-2764-200d-1f48b-200d-1f469",
+	"kissing_cat":                           "1f63d",
+	"kissing_face":                          "1f617",
+	"kite":                                  "1fa81",
+	"kitten":                                "1f431",
+	"kiwi":                                  "1f95d",
+	"knee":                                  "1f9b5",
+	"knife":                                 "1f52a",
+	"knit":                                  "1f9f6",
+	"knot":                                  "1faa2",
+	"koala":                                 "1f428",
+	"kuaizi":                                "1f962",
+	"lab_coat":                              "1f97c",
+	"label":                                 "1f3f7",
+	"lacrosse":                              "1f94d",
+	"ladder":                                "1fa9c",
+	"lady_beetle":                           "1f41e",
+	"ladybird":                              "1f41e",
+	"ladybug":                               "1f41e",
+	"lai":                                   "1f9e7",
+	"lambchop":                              "1f969",
+	"landing":                               "1f6ec",
+	"landline":                              "1f4de",
+	"lantern":                               "1f3ee",
+	"laptop":                                "1f4bb",
+	"large_blue_diamond":                    "1f537",
+	"large_orange_diamond":                  "1f536",
+	"last_quarter_moon":                     "1f317",
+	"last_quarter_moon_face":                "1f31c",
+	"late":                                  "1fae9",
+	"lather":                                "1f9fc",
+	"laughing":                              "1f606",
+	"laughter_tears":                        "1f602",
+	"laundry":                               "1f9fa",
+	"lavash":                                "1fad3",
+	"lavender":                              "1fabb",
+	"lazy":                                  "1f9a5",
+	"leafless":                              "1fabe",
+	"leafless_tree":                         "1fabe",
+	"leafy":                                 "1f96c",
+	"leafy_green":                           "1f96c",
+	"leaves":                                "1f343",
+	"ledger":                                "1f4d2",
+	"left":                                  "2b05",
+	"left_fist":                             "1f91b",
+	"left_hook":                             "21a9",
+	"left_right":                            "2194",
+	"leftwards_hand":                        "1faf2",
+	"leftwards_pushing_hand":                "1faf7",
+	"leg":                                   "1f9b5",
+	"lemon":                                 "1f34b",
+	"leo":                                   "264c",
+	"leopard":                               "1f406",
+	"lesbian":                               "1f3f3-200d-1f308",
+	"lets_eat":                              "1f37d",
+	"lettuce":                               "1f96c",
+	"level_slider":                          "1f39a",
+	"levitating":                            "1f574",
+	"lgbt":                                  "1f3f3-200d-1f308",
+	"lgbtq":                                 "1f308",
+	"lgbtqia":                               "1f3f3-200d-1f308",
+	"libra":                                 "264e",
+	"license":                               "1faaa",
+	"lifesaver":                             "1f6df",
+	"lifestyles":                            "1f6cb",
+	"lift":                                  "1f3cb",
+	"light_blue_heart":                      "1fa75",
+	"light_bulb":                            "1f4a1",
+	"light_rail":                            "1f688",
+	"lightning":                             "1f329",
+	"lightning_storm":                       "1f329",
+	"like":                                  "1f44d",
+	"lilac":                                 "1fabb",
+	"limb":                                  "1f9b5",
+	"lime":                                  "1f34b-200d-1f7e9",
+	"line":                                  "1fae5",
+	"link":                                  "1f517",
+	"linked":                                "1f587",
+	"lion":                                  "1f981",
+	"lip":                                   "1fae6",
+	"lips":                                  "1f444",
+	"lips_are_sealed":                       "1f910",
+	"lipstick":                              "1f484",
+	"lipstick_kiss":                         "1f48b",
+	"lit":                                   "1f525",
+	"little":                                "1f90f",
+	"live_long_and_prosper":                 "1f596",
+	"living_room":                           "1f6cb",
+	"lizard":                                "1f98e",
+	"llama":                                 "1f999",
+	"lobster":                               "1f99e",
+	"lock_with_key":                         "1f510",
+	"locked":                                "1f512",
+	"locked_bag":                            "1f6c5",
+	"locker":                                "1f6c5",
+	"log":                                   "1fab5",
+	"lol":                                   "1f606",
+	"lollipop":                              "1f36d",
+	"long":                                  "1fa98",
+	"long_drum":                             "1fa98",
+	"looking":                               "1f440",
+	"loop":                                  "27b0",
+	"losing_money":                          "1f4b8",
+	"lotion":                                "1f9f4",
+	"lotion_bottle":                         "1f9f4",
+	"lotus":                                 "1fab7",
+	"louder":                                "1f50a",
+	"loudspeaker":                           "1f4e2",
+	"love":                                  "2764",
+	"love_hotel":                            "1f3e9",
+	"love_letter":                           "1f48c",
+	"love_you":                              "2764",
+	"love_you_gesture":                      "1f91f",
+	"low":                                   "1faab",
+	"low_battery":                           "1faab",
+	"low_brightness":                        "1f505",
+	"lower_left":                            "2199",
+	"lower_right":                           "2198",
+	"loyalty":                               "1f510",
+	"lucky":                                 "1f340",
+	"luge":                                  "1f6f7",
+	"luggage":                               "1f9f3",
+	"lung":                                  "1fac1",
+	"lungs":                                 "1fac1",
+	"lupine":                                "1fabb",
+	"lure":                                  "1faa4",
+	"lust":                                  "2764-200d-1f525",
+	"lying":                                 "1f925",
+	"m":                                     "24c2",
+	"maam":                                  "1fae1",
+	"mad":                                   "1f621",
+	"mage":                                  "1f9d9",
+	"maggot":                                "1fab0",
+	"magic_wand":                            "1fa84",
+	"magician":                              "1fa84",
+	"magnet":                                "1f9f2",
+	"magnifying":                            "1f50e",
+	"magnifying_glass":                      "1f50d",
+	"magnifying_glass_tilted_right":         "1f50e",
+	"mahjong":                               "1f004",
+	"mail":                                  "2709",
+	"mail_dropoff":                          "1f4ee",
+	"mail_received":                         "1f4e8",
+	"mail_sent":                             "1f4e9",
+	"mailbox":                               "1f4eb",
+	"maintenance":                           "1f528",
+	"maize":                                 "1f33d",
+	"makeup":                                "1fa9e",
+	"malaria":                               "1f99f",
+	"male":                                  "2642",
+	"male_sign":                             "2642",
+	"malt":                                  "1f964",
+	"mammoth":                               "1f9a3",
+	"man":                                   "1f468",
+	"man_and_woman_couple":                  "1f46b",
+	"man_and_woman_holding_hands":           "1f46b",
+	"man_artist":                            "1f468-200d-1f3a8",
+	"man_astronaut":                         "1f468-200d-1f680",
+	"man_bald":                              "1f468-200d-1f9b2",
+	"man_beard":                             "1f9d4-200d-2642",
+	"man_biking":                            "1f6b4-200d-2642",
+	"man_blond_hair":                        "1f471-200d-2642",
+	"man_bouncing_ball":                     "26f9-200d-2642",
+	"man_bowing":                            "1f647-200d-2642",
+	"man_cartwheeling":                      "1f938-200d-2642",
+	"man_climbing":                          "1f9d7-200d-2642",
+	"man_construction_worker":               "1f477-200d-2642",
+	"man_cook":                              "1f468-200d-1f373",
+	"man_curly_hair":                        "1f468-200d-1f9b1",
+	"man_detective":                         "1f575-200d-2642",
+	"man_elf":                               "1f9dd-200d-2642",
+	"man_facepalming":                       "1f926-200d-2642",
+	"man_factory_worker":                    "1f468-200d-1f3ed",
+	"man_fairy":                             "1f9da-200d-2642",
+	"man_farmer":                            "1f468-200d-1f33e",
+	"man_feeding_baby":                      "1f468-200d-1f37c",
+	"man_firefighter":                       "1f468-200d-1f692",
+	"man_frowning":                          "1f64d-200d-2642",
+	"man_genie":                             "1f9de-200d-2642",
+	"man_gesturing_no":                      "1f645-200d-2642",
+	"man_gesturing_ok":                      "1f646-200d-2642",
+	"man_getting_haircut":                   "1f487-200d-2642",
+	"man_getting_massage":                   "1f486-200d-2642",
+	"man_golfing":                           "1f3cc-200d-2642",
+	"man_guard":                             "1f482-200d-2642",
+	"man_health_worker":                     "1f468-200d-2695",
+	"man_in_lotus_position":                 "1f9d8-200d-2642",
+	"man_in_manual_wheelchair":              "1f468-200d-1f9bd",
+	"man_in_manual_wheelchair_facing_right": "1f468-200d-1f9bd-200d-27a1",
+	"man_in_motorized_wheelchair":           "1f468-200d-1f9bc",
+	"man_in_motorized_wheelchair_facing_right": "1f468-200d-1f9bc-200d-27a1",
+	"man_in_steamy_room":                       "1f9d6-200d-2642",
+	"man_in_tuxedo":                            "1f935-200d-2642",
+	"man_judge":                                "1f468-200d-2696",
+	"man_juggling":                             "1f939-200d-2642",
+	"man_kneeling":                             "1f9ce-200d-2642",
+	"man_kneeling_facing_right":                "1f9ce-200d-2642-200d-27a1",
+	"man_lifting_weights":                      "1f3cb-200d-2642",
+	"man_mage":                                 "1f9d9-200d-2642",
+	"man_mechanic":                             "1f468-200d-1f527",
+	"man_mountain_biking":                      "1f6b5-200d-2642",
+	"man_office_worker":                        "1f468-200d-1f4bc",
+	"man_pilot":                                "1f468-200d-2708",
+	"man_playing_handball":                     "1f93e-200d-2642",
+	"man_playing_water_polo":                   "1f93d-200d-2642",
+	"man_police_officer":                       "1f46e-200d-2642",
+	"man_pouting":                              "1f64e-200d-2642",
+	"man_raising_hand":                         "1f64b-200d-2642",
+	"man_red_hair":                             "1f468-200d-1f9b0",
+	"man_rowing_boat":                          "1f6a3-200d-2642",
+	"man_running":                              "1f3c3-200d-2642",
+	"man_running_facing_right":                 "1f3c3-200d-2642-200d-27a1",
+	"man_scientist":                            "1f468-200d-1f52c",
+	"man_shrugging":                            "1f937-200d-2642",
+	"man_singer":                               "1f468-200d-1f3a4",
+	"man_standing":                             "1f9cd-200d-2642",
+	"man_student":                              "1f468-200d-1f393",
+	"man_superhero":                            "1f9b8-200d-2642",
+	"man_supervillain":                         "1f9b9-200d-2642",
+	"man_surfing":                              "1f3c4-200d-2642",
+	"man_swimming":                             "1f3ca-200d-2642",
+	"man_teacher":                              "1f468-200d-1f3eb",
+	"man_technologist":                         "1f468-200d-1f4bb",
+	"man_tipping_hand":                         "1f481-200d-2642",
+	"man_vampire":                              "1f9db-200d-2642",
+	"man_walking":                              "1f6b6-200d-2642",
+	"man_walking_facing_right":                 "1f6b6-200d-2642-200d-27a1",
+	"man_wearing_turban":                       "1f473-200d-2642",
+	"man_white_hair":                           "1f468-200d-1f9b3",
+	"man_with_veil":                            "1f470-200d-2642",
+	"man_with_white_cane":                      "1f468-200d-1f9af",
+	"man_with_white_cane_facing_right":         "1f468-200d-1f9af-200d-27a1",
+	"man_zombie":                               "1f9df-200d-2642",
+	"mandarin":                                 "1f34a",
+	"mango":                                    "1f96d",
+	"mantelpiece_clock":                        "1f570",
+	"mantilla":                                 "1f9d5",
+	"manual_wheelchair":                        "1f9bd",
+	"map":                                      "1f5fa",
+	"maple_leaf":                               "1f341",
+	"maracas":                                  "1fa87",
+	"marching":                                 "1fa88",
+	"margarita":                                "1f34b-200d-1f7e9",
+	"marine":                                   "1fabc",
+	"marsupial":                                "1f998",
+	"martial_arts":                             "1f94b",
+	"mary":                                     "1faac",
+	"mask":                                     "1f637",
+	"massage":                                  "1f486",
+	"mate":                                     "1f9c9",
+	"math":                                     "1f7f0",
+	"matryoshka":                               "1fa86",
+	"mayo":                                     "1fa85",
+	"meal":                                     "1f37d",
+	"meat":                                     "1f356",
+	"meatball":                                 "1f9c6",
+	"mechanic":                                 "1f9d1-200d-1f527",
+	"mechanical":                               "2699",
+	"mechanical_arm":                           "1f9be",
+	"mechanical_leg":                           "1f9bf",
+	"medal":                                    "1f3c5",
+	"medical_symbol":                           "2695",
+	"medicine":                                 "1f48a",
+	"megaphone":                                "1f4e3",
+	"melon":                                    "1f348",
+	"melt":                                     "1fae0",
+	"melted":                                   "1fad5",
+	"melting":                                  "1fae0",
+	"melting_face":                             "1fae0",
+	"memo":                                     "1f4dd",
+	"memorial":                                 "1faa6",
+	"men_couple":                               "1f46c",
+	"men_with_bunny_ears":                      "1f46f-200d-2642",
+	"men_wrestling":                            "1f93c-200d-2642",
+	"mending":                                  "2764-200d-1fa79",
+	"mending_heart":                            "2764-200d-1fa79",
+	"menorah":                                  "1f54e",
+	"mens":                                     "1f6b9",
+	"menstruation":                             "1fa78",
+	"meow":                                     "1f408",
+	"mercy":                                    "1f97a",
+	"mermaid":                                  "1f9dc-200d-2640",
+	"merman":                                   "1f9dc-200d-2642",
+	"merperson":                                "1f9dc",
+	"merry":                                    "1f9d1-200d-1f384",
+	"merry_go_round":                           "1f3a0",
+	"merwoman":                                 "1f9dc-200d-2640",
+	"mess":                                     "1fadf",
+	"metal":                                    "1fa99",
+	"meteor":                                   "2604",
+	"metro":                                    "24c2",
+	"mexican":                                  "1fad4",
+	"mic":                                      "1f3a4",
+	"microbe":                                  "1f9a0",
+	"microphone":                               "1f3a4",
+	"microscope":                               "1f52c",
+	"middle_finger":                            "1f595",
+	"mike":                                     "1f3a4",
+	"mild":                                     "1f928",
+	"military":                                 "1fa96",
+	"military_helmet":                          "1fa96",
+	"military_medal":                           "1f396",
+	"milk":                                     "1f95b",
+	"milky_way":                                "1f30c",
+	"mind":                                     "1f92f",
+	"mindblown":                                "1f92f",
+	"mine":                                     "26cf",
+	"minibus":                                  "1f690",
+	"minidisc":                                 "1f4bd",
+	"minus":                                    "2796",
+	"miriam":                                   "1faac",
+	"mirror":                                   "1fa9e",
+	"mirror_ball":                              "1faa9",
+	"mischievous":                              "1f61b",
+	"mobile_phone":                             "1f4f1",
+	"mobility":                                 "1fa7c",
+	"moisturizer":                              "1f9f4",
+	"mojito":                                   "1f34b-200d-1f7e9",
+	"monarch":                                  "1fac5",
+	"money":                                    "1f4b0",
+	"money_face":                               "1f911",
+	"money_with_wings":                         "1f4b8",
+	"monkey":                                   "1f412",
+	"monkey_face":                              "1f435",
+	"monocle":                                  "1f9d0",
+	"monorail":                                 "1f69d",
+	"monster":                                  "1f9cc",
+	"moon":                                     "1f319",
+	"moon_cake":                                "1f96e",
+	"moon_ceremony":                            "1f391",
+	"moon_face":                                "1f31d",
+	"moose":                                    "1face",
+	"mortar":                                   "1f9f1",
+	"mortar_board":                             "1f393",
+	"mosque":                                   "1f54c",
+	"mosquito":                                 "1f99f",
+	"mostly_sunny":                             "1f324",
+	"mother_christmas":                         "1f936",
+	"mother_nature":                            "1f32c",
+	"motor_bike":                               "1f6f5",
+	"motor_boat":                               "1f6e5",
+	"motorcycle":                               "1f3cd",
+	"motorized_wheelchair":                     "1f9bc",
+	"motorway":                                 "1f6e3",
+	"mount_fuji":                               "1f5fb",
+	"mountain":                                 "26f0",
+	"mountain_biker":                           "1f6b5",
+	"mountain_cableway":                        "1f6a0",
+	"mountain_railway":                         "1f69e",
+	"mountain_sunrise":                         "1f304",
+	"mouse":                                    "1f401",
+	"mouse_trap":                               "1faa4",
+	"mousetrap":                                "1faa4",
+	"moustache":                                "1f978",
+	"mouth":                                    "1f444",
+	"movie":                                    "1f4fd",
+	"movie_camera":                             "1f3a5",
+	"movie_theater":                            "1f3a6",
+	"moving_truck":                             "1f69a",
+	"moyai":                                    "1f5ff",
+	"mrs_claus":                                "1f936",
+	"mule":                                     "1facf",
+	"multiplication":                           "2716",
+	"multiply":                                 "2716",
+	"muscle":                                   "1f4aa",
+	"mushroom":                                 "1f344",
+	"music":                                    "1f3b5",
+	"musical_keyboard":                         "1f3b9",
+	"musical_notes":                            "1f3b6",
+	"musical_score":                            "1f3bc",
+	"mustache":                                 "1f978",
+	"mute":                                     "1f507",
+	"mute_notifications":                       "1f515",
+	"mx":                                       "1f9d1-200d-1f384",
+	"mx_claus":                                 "1f9d1-200d-1f384",
+	"mystery":                                  "1fac6",
+	"mythology":                                "1fabd",
+	"naan":                                     "1fad3",
+	"nail_care":                                "1f485",
+	"nail_polish":                              "1f485",
+	"namaste":                                  "1f64f",
+	"name_badge":                               "1f4db",
+	"nap":                                      "1f971",
+	"naruto":                                   "1f365",
+	"national_park":                            "1f3de",
+	"natural":                                  "1fada",
+	"nauseated":                                "1f922",
+	"navigation":                               "1f9ed",
+	"nazar":                                    "1f9ff",
+	"nazar_amulet":                             "1f9ff",
+	"nc17":                                     "1f51e",
+	"neck":                                     "1f9e3",
+	"negative":                                 "1f9f2",
+	"neptune":                                  "1f9dc-200d-2642",
+	"nerd":                                     "1f913",
+	"nervous":                                  "1f62c",
+	"nest_with_eggs":                           "1faba",
+	"nesting_dolls":                            "1fa86",
+	"network":                                  "1f6dc",
+	"neutral":                                  "1f610",
+	"new":                                      "1f195",
+	"new_baby":                                 "1f425",
+	"new_moon":                                 "1f311",
+	"new_moon_face":                            "1f31a",
+	"new_york":                                 "1f5fd",
+	"newspaper":                                "1f5de",
+	"next_track":                               "23ed",
+	"ng":                                       "1f196",
+	"nib":                                      "2712",
+	"night":                                    "1f303",
+	"night_sky":                                "1f30c",
+	"nine":                                     "0039-20e3",
+	"ninja":                                    "1f977",
+	"no_bicycles":                              "1f6b3",
+	"no_entry":                                 "26d4",
+	"no_mail":                                  "1f4ed",
+	"no_mouth":                                 "1f636",
+	"no_pedestrians":                           "1f6b7",
+	"no_phones":                                "1f4f5",
+	"no_signal":                                "1f645",
+	"no_smoking":                               "1f6ad",
+	"no_sound":                                 "1f507",
+	"noble":                                    "1fac5",
+	"nod":                                      "1f642-200d-2195",
+	"non-potable_water":                        "1f6b1",
+	"noodles":                                  "1f35c",
+	"nope":                                     "1f645",
+	"north":                                    "2b06",
+	"north_east":                               "2197",
+	"north_west":                               "2196",
+	"nose":                                     "1f443",
+	"not_allowed":                              "1f6ab",
+	"note":                                     "1f4dd",
+	"notebook":                                 "1f4d3",
+	"nothing":                                  "1fad9",
+	"notice":                                   "1faa7",
+	"notifications":                            "1f514",
+	"nuclear":                                  "2622",
+	"number_one":                               "1f947",
+	"numbers":                                  "1f522",
+	"nursery":                                  "1f6bc",
+	"nurturing":                                "1fab4",
+	"nut_and_bolt":                             "1f529",
+	"o":                                        "1f17e",
+	"oatmeal":                                  "1f963",
+	"oberon":                                   "1f9da-200d-2642",
+	"ocean":                                    "1f30a",
+	"ocean_sunrise":                            "1f305",
+	"octagonal_sign":                           "1f6d1",
+	"octopus":                                  "1f419",
+	"oden":                                     "1f362",
+	"offer":                                    "1faf4",
+	"office":                                   "1f3e2",
+	"office_supplies":                          "1f587",
+	"office_worker":                            "1f9d1-200d-1f4bc",
+	"ogre":                                     "1f479",
+	"oh_no":                                    "1f615",
+	"oil":                                      "1fa94",
+	"oil_drum":                                 "1f6e2",
+	"oink":                                     "1f416",
+	"ok":                                       "1f44c",
+	"ok_signal":                                "1f646",
+	"old":                                      "1f9d3",
+	"old_key":                                  "1f5dd",
+	"older_man":                                "1f474",
+	"older_person":                             "1f9d3",
+	"older_woman":                              "1f475",
+	"olive":                                    "1fad2",
+	"om":                                       "1f549",
+	"on":                                       "1f51b",
+	"oncoming_automobile":                      "1f698",
+	"oncoming_bus":                             "1f68d",
+	"oncoming_car":                             "1f698",
+	"oncoming_police_car":                      "1f694",
+	"oncoming_streetcar":                       "1f68a",
+	"oncoming_taxi":                            "1f696",
+	"oncoming_train":                           "1f686",
+	"oncoming_tram":                            "1f68a",
+	"oncoming_trolley":                         "1f68a",
+	"one":                                      "0031-20e3",
+	"one_piece_swimsuit":                       "1fa71",
+	"onigiri":                                  "1f359",
+	"onion":                                    "1f9c5",
+	"oops":                                     "1f643",
+	"open":                                     "1fae2",
+	"open_book":                                "1f4d6",
+	"open_hands":                               "1f450",
+	"open_mouth":                               "1f62e",
+	"opening":                                  "1fa9f",
+	"ophiuchus":                                "26ce",
+	"oracle":                                   "1f52e",
+	"orange":                                   "1f34a",
+	"orange_book":                              "1f4d9",
+	"orange_circle":                            "1f7e0",
+	"orange_heart":                             "1f9e1",
+	"orange_square":                            "1f7e7",
+	"orangutan":                                "1f9a7",
+	"organize":                                 "1f4c1",
+	"orienteering":                             "1f9ed",
+	"orthodox_cross":                           "2626",
+	"orthoptera":                               "1f997",
+	"ostentatious":                             "1f99a",
+	"otter":                                    "1f9a6",
+	"ouch":                                     "1fabc",
+	"outbox":                                   "1f4e4",
+	"outdoors":                                 "1f97e",
+	"overcast":                                 "2601",
+	"owl":                                      "1f989",
+	"ox":                                       "1f402",
+	"oyster":                                   "1f9aa",
+	"p":                                        "1f17f",
+	"package":                                  "1f4e6",
+	"packing":                                  "1f9f3",
+	"pad":                                      "1f5d3",
+	"paella":                                   "1f958",
+	"page":                                     "1f4c4",
+	"page_with_curl":                           "1f4c3",
+	"pager":                                    "1f4df",
+	"pain":                                     "1f972",
+	"pained":                                   "1f627",
+	"paint":                                    "1fadf",
+	"paintbrush":                               "1f58c",
+	"painting":                                 "1f3a8",
+	"palette":                                  "1f3a8",
+	"palm":                                     "1f590",
+	"palm_down_hand":                           "1faf3",
+	"palm_tree":                                "1f334",
+	"palm_up_hand":                             "1faf4",
+	"palms":                                    "1f932",
+	"palms_up_together":                        "1f932",
+	"pamonha":                                  "1fad4",
+	"pancakes":                                 "1f95e",
+	"panda":                                    "1f43c",
+	"panic":                                    "1fae8",
+	"panting":                                  "1f975",
+	"pants":                                    "1fa73",
+	"paper":                                    "1f4c4",
+	"paperclip":                                "1f4ce",
+	"paperclip_chain":                          "1f587",
+	"parachute":                                "1fa82",
+	"parasail":                                 "1fa82",
+	"parasite":                                 "1fab1",
+	"parent":                                   "1f9d1-200d-1f37c",
+	"park":                                     "1f6dd",
+	"parking":                                  "1f17f",
+	"parrot":                                   "1f99c",
+	"part_alternation":                         "303d",
+	"partly_cloudy":                            "26c5",
+	"partly_sunny":                             "26c5",
+	"partly_sunny_with_rain":                   "1f326",
+	"party_ball":                               "1f38a",
+	"partying_face":                            "1f973",
+	"pass":                                     "1f3ab",
+	"passenger_ship":                           "1f6f3",
+	"passport_control":                         "1f6c2",
+	"pastry":                                   "1f967",
+	"patience":                                 "1f90c",
+	"pause":                                    "23f8",
+	"paw_prints":                               "1f43e",
+	"pawn":                                     "265f",
+	"paws":                                     "1f43e",
+	"pea":                                      "1fadb",
+	"pea_pod":                                  "1fadb",
+	"peace":                                    "262e",
+	"peace_sign":                               "270c",
+	"peach":                                    "1f351",
+	"peacock":                                  "1f99a",
+	"peahen":                                   "1f99a",
+	"peanuts":                                  "1f95c",
+	"pear":                                     "1f350",
+	"pearly":                                   "1f9b7",
+	"pedestrian":                               "1f6b6",
+	"peek":                                     "1fae3",
+	"peeking":                                  "1fae3",
+	"peep":                                     "1fae3",
+	"pen":                                      "1f58a",
+	"penalty":                                  "1f7e5",
+	"pencil":                                   "270f",
+	"penguin":                                  "1f427",
+	"pensive":                                  "1f614",
+	"people_holding_hands":                     "1f9d1-200d-1f91d-200d-1f9d1",
+	"people_hugging":                           "1fac2",
+	"pepper":                                   "1fad1",
+	"percussion":                               "1fa87",
+	"performing_arts":                          "1f3ad",
+	"persevere":                                "1f623",
+	"person":                                   "1f9d1",
+	"person_bald":                              "1f9d1-200d-1f9b2",
+	"person_beard":                             "1f9d4",
+	"person_blond_hair":                        "1f471",
+	"person_climbing":                          "1f9d7",
+	"person_curly_hair":                        "1f9d1-200d-1f9b1",
+	"person_feeding_baby":                      "1f9d1-200d-1f37c",
+	"person_frowning":                          "1f64d",
+	"person_in_lotus_position":                 "1f9d8",
+	"person_in_manual_wheelchair":              "1f9d1-200d-1f9bd",
+	"person_in_manual_wheelchair_facing_right":    "1f9d1-200d-1f9bd-200d-27a1",
+	"person_in_motorized_wheelchair":              "1f9d1-200d-1f9bc",
+	"person_in_motorized_wheelchair_facing_right": "1f9d1-200d-1f9bc-200d-27a1",
+	"person_in_steamy_room":                       "1f9d6",
+	"person_kneeling":                             "1f9ce",
+	"person_kneeling_facing_right":                "1f9ce-200d-27a1",
+	"person_pouting":                              "1f64e",
+	"person_red_hair":                             "1f9d1-200d-1f9b0",
+	"person_running_facing_right":                 "1f3c3-200d-27a1",
+	"person_standing":                             "1f9cd",
+	"person_tipping_hand":                         "1f481",
+	"person_walking_facing_right":                 "1f6b6-200d-27a1",
+	"person_white_hair":                           "1f9d1-200d-1f9b3",
+	"person_with_crown":                           "1fac5",
+	"person_with_white_cane":                      "1f9d1-200d-1f9af",
+	"person_with_white_cane_facing_right":         "1f9d1-200d-1f9af-200d-27a1",
+	"pester":                                      "1f9a1",
+	"petri":                                       "1f9eb",
+	"petri_dish":                                  "1f9eb",
+	"petrol_pump":                                 "26fd",
+	"phoenix":                                     "1f426-200d-1f525",
+	"phone":                                       "260e",
+	"phone_off":                                   "1f4f4",
+	"physics":                                     "269b",
+	"piano":                                       "1f3b9",
+	"piccolo":                                     "1fa88",
+	"pick":                                        "26cf",
+	"pick_me":                                     "1f64b",
+	"pick_up":                                     "1f6fb",
+	"picket":                                      "1faa7",
+	"pickup":                                      "1f6fb",
+	"pickup_truck":                                "1f6fb",
+	"picnic":                                      "1f9fa",
+	"picture":                                     "1f5bc",
+	"pie":                                         "1f967",
+	"piece":                                       "1f9e9",
+	"pierogi":                                     "1f95f",
+	"pig":                                         "1f416",
+	"pig_nose":                                    "1f43d",
+	"piglet":                                      "1f437",
+	"pile_of_poo":                                 "1f4a9",
+	"pill":                                        "1f48a",
+	"pilot":                                       "1f9d1-200d-2708",
+	"pin":                                         "1f4cd",
+	"pina":                                        "1f965",
+	"pinada":                                      "1fa85",
+	"pinata":                                      "1fa85",
+	"pinched":                                     "1f90c",
+	"pinched_fingers":                             "1f90c",
+	"pinching":                                    "1f90f",
+	"pinching_hand":                               "1f90f",
+	"pineapple":                                   "1f34d",
+	"ping_pong":                                   "1f3d3",
+	"pink_heart":                                  "1fa77",
+	"pipe":                                        "1fa88",
+	"pirate":                                      "2620",
+	"pirate_flag":                                 "1f3f4-200d-2620",
+	"pisces":                                      "2653",
+	"pissed":                                      "1f92c",
+	"pita":                                        "1fad3",
+	"pizza":                                       "1f355",
+	"piña":                                        "1f965",
+	"piñata":                                      "1fa85",
+	"placard":                                     "1faa7",
+	"place_holder":                                "1f4d1",
+	"place_of_worship":                            "1f6d0",
+	"planet":                                      "1fa90",
+	"plankton":                                    "1fabc",
+	"plant":                                       "1f33f",
+	"plaque":                                      "1faa7",
+	"play":                                        "25b6",
+	"play_pause":                                  "23ef",
+	"play_reverse":                                "25c0",
+	"playful":                                     "1f9a6",
+	"playground":                                  "1f6dd",
+	"playground_slide":                            "1f6dd",
+	"playing_cards":                               "1f3b4",
+	"plaything":                                   "1f9f8",
+	"pleading":                                    "1f97a",
+	"pleading_face":                               "1f97a",
+	"plumage":                                     "1fab6",
+	"plunder":                                     "1f3f4-200d-2620",
+	"plunger":                                     "1faa0",
+	"plus":                                        "2795",
+	"plush":                                       "1f9f8",
+	"pod":                                         "1fadb",
+	"point":                                       "1fa9d",
+	"point_down":                                  "1f447",
+	"point_left":                                  "1f448",
+	"point_of_information":                        "261d",
+	"point_right":                                 "1f449",
+	"point_up":                                    "1f446",
+	"pointing":                                    "1faf5",
+	"poison":                                      "2620",
+	"poke":                                        "1faf5",
+	"poker_face":                                  "1f636",
+	"polar":                                       "1f43b-200d-2744",
+	"polar_bear":                                  "1f43b-200d-2744",
+	"police":                                      "1f46e",
+	"police_car":                                  "1f693",
+	"pony":                                        "1f434",
+	"poodle":                                      "1f429",
+	"pool":                                        "1f3b1",
+	"poop":                                        "1f4a9",
+	"pop":                                         "1f9e8",
+	"popcorn":                                     "1f37f",
+	"popping":                                     "1f9e8",
+	"porkchop":                                    "1f969",
+	"porous":                                      "1f9fd",
+	"porridge":                                    "1f963",
+	"portobello":                                  "1f344-200d-1f7eb",
+	"poseidon":                                    "1f9dc-200d-2642",
+	"positive":                                    "1f9f2",
+	"post_office":                                 "1f3e4",
+	"potable_water":                               "1f6b0",
+	"potato":                                      "1f954",
+	"potsticker":                                  "1f95f",
+	"potted":                                      "1fab4",
+	"potted_plant":                                "1fab4",
+	"pouch":                                       "1f45d",
+	"poultry":                                     "1f357",
+	"pound_notes":                                 "1f4b7",
+	"pour":                                        "1fad7",
+	"pouring":                                     "1fad7",
+	"pouring_liquid":                              "1fad7",
+	"pouting_cat":                                 "1f63e",
+	"pow":                                         "1f4a2",
+	"power":                                       "270a",
+	"praise":                                      "1f64c",
+	"pray":                                        "1f64f",
+	"prayer":                                      "1f932",
+	"prayer_beads":                                "1f4ff",
+	"pregnant":                                    "1f930",
+	"pregnant_man":                                "1fac3",
+	"pregnant_person":                             "1fac4",
+	"present":                                     "1f381",
+	"preserver":                                   "1f6df",
+	"pretzel":                                     "1f968",
+	"previous_track":                              "23ee",
+	"price_tag":                                   "1f3f7",
+	"pride":                                       "1f308",
+	"prince":                                      "1f934",
+	"princess":                                    "1f478",
+	"print":                                       "1fac6",
+	"printer":                                     "1f5a8",
+	"privacy":                                     "1f50f",
+	"prohibited":                                  "1f6ab",
+	"projector":                                   "1f4fd",
+	"proof":                                       "1f9fe",
+	"prophecy":                                    "1f960",
+	"protect":                                     "1faac",
+	"protected":                                   "1f50f",
+	"protest":                                     "1faa7",
+	"puck":                                        "1f9da-200d-2642",
+	"puke":                                        "1f92e",
+	"pulse":                                       "1fac0",
+	"pumpkin":                                     "1f383",
+	"punch":                                       "1f44a",
+	"punk":                                        "1f9f7",
+	"puppy":                                       "1f436",
+	"purity":                                      "1fab7",
+	"purple_circle":                               "1f7e3",
+	"purple_heart":                                "1f49c",
+	"purple_square":                               "1f7ea",
+	"purse":                                       "1f45b",
+	"push_pin":                                    "1f4cc",
+	"put_litter_in_its_place":                     "1f6ae",
+	"puzzle":                                      "1f9e9",
+	"puzzle_piece":                                "1f9e9",
+	"queasy":                                      "1f922",
+	"queen":                                       "1f451",
+	"queer":                                       "1f3f3-200d-1f308",
+	"quench":                                      "1f9ef",
+	"question":                                    "2753",
+	"quiet":                                       "1f910",
+	"r":                                           "00ae",
+	"rabbit":                                      "1f407",
+	"raccoon":                                     "1f99d",
+	"race":                                        "1f3c1",
+	"racecar":                                     "1f3ce",
+	"radio":                                       "1f4fb",
+	"radio_button":                                "1f518",
+	"radioactive":                                 "2622",
+	"radish":                                      "1fadc",
+	"rage":                                        "1f621",
+	"railway_car":                                 "1f683",
+	"railway_track":                               "1f6e4",
+	"rainbow":                                     "1f308",
+	"rainbow_flag":                                "1f3f3-200d-1f308",
+	"rainy":                                       "1f327",
+	"raised":                                      "1f928",
+	"raised_hand":                                 "270b",
+	"raised_hands":                                "1f64c",
+	"raising_hand":                                "1f64b",
+	"ram":                                         "1f40f",
+	"ramen":                                       "1f35c",
+	"rat":                                         "1f400",
+	"rated_for_violence":                          "1f5e1",
+	"rattle":                                      "1fa87",
+	"raven":                                       "1f426-200d-2b1b",
+	"razor":                                       "1fa92",
+	"real":                                        "1fac0",
+	"realization":                                 "1f92d",
+	"rebirth":                                     "1f426-200d-1f525",
+	"rebound":                                     "1fa83",
+	"receipt":                                     "1f9fe",
+	"reception":                                   "1f6ce",
+	"record":                                      "23fa",
+	"recorder":                                    "1fa88",
+	"recovering":                                  "2764-200d-1fa79",
+	"recreational_vehicle":                        "1f699",
+	"recuperating":                                "2764-200d-1fa79",
+	"recycle":                                     "267b",
+	"red_book":                                    "1f4d5",
+	"red_circle":                                  "1f534",
+	"red_envelope":                                "1f9e7",
+	"red_faced":                                   "1f975",
+	"red_square":                                  "1f7e5",
+	"red_triangle_down":                           "1f53b",
+	"red_triangle_up":                             "1f53a",
+	"reef":                                        "1fab8",
+	"reflection":                                  "1fa9e",
+	"reflector":                                   "1fa9e",
+	"refreshing":                                  "1f34b-200d-1f7e9",
+	"regal":                                       "1fac5",
+	"registered":                                  "00ae",
+	"reincarnation":                               "1f426-200d-1f525",
+	"reinvent":                                    "1f426-200d-1f525",
+	"relaxed":                                     "263a",
+	"relief":                                      "1f62e-200d-1f4a8",
+	"relieved":                                    "1f60c",
+	"religion":                                    "1faaf",
+	"reminder_ribbon":                             "1f397",
+	"renewal":                                     "1f426-200d-1f525",
+	"repeat":                                      "1f501",
+	"repeat_one":                                  "1f502",
+	"repercussion":                                "1fa83",
+	"reply":                                       "21a9",
+	"rescue":                                      "1f6df",
+	"rescue_worker":                               "26d1",
+	"reserved":                                    "1f22f",
+	"resist":                                      "1f979",
+	"respect":                                     "1fae1",
+	"respiration":                                 "1fac1",
+	"restroom":                                    "1f6bb",
+	"return":                                      "1f504",
+	"revival":                                     "1f426-200d-1f525",
+	"revive":                                      "1f426-200d-1f525",
+	"revolving_hearts":                            "1f49e",
+	"rewind":                                      "23ea",
+	"rex":                                         "1f996",
+	"rhinoceros":                                  "1f98f",
+	"rhythm":                                      "1fa98",
+	"ribbon":                                      "1f380",
+	"rice":                                        "1f35a",
+	"rice_cracker":                                "1f358",
+	"rickshaw":                                    "1f6fa",
+	"rideshare":                                   "1f695",
+	"right":                                       "27a1",
+	"right_fist":                                  "1f91c",
+	"right_hook":                                  "21aa",
+	"right_pointing":                              "1f50e",
+	"rightwards_hand":                             "1faf1",
+	"rightwards_pushing_hand":                     "1faf8",
+	"ring":                                        "1f48d",
+	"ring_buoy":                                   "1f6df",
+	"ringed":                                      "1fa90",
+	"ringed_planet":                               "1fa90",
+	"rip":                                         "1faa6",
+	"rise":                                        "1f426-200d-1f525",
+	"roach":                                       "1fab3",
+	"road":                                        "1f6e3",
+	"road_trip":                                   "1f5fa",
+	"robot":                                       "1f916",
+	"rock":                                        "1faa8",
+	"rock_carving":                                "1f5ff",
+	"rock_on":                                     "1f918",
+	"rocket":                                      "1f680",
+	"rofl":                                        "1f923",
+	"roger":                                       "1f3f4-200d-2620",
+	"roll":                                        "1f9fb",
+	"roll_of_paper":                               "1f9fb",
+	"roller_coaster":                              "1f3a2",
+	"roller_skate":                                "1f6fc",
+	"rolling_eyes":                                "1f644",
+	"rolling_on_the_floor_laughing":               "1f923",
+	"rolodex":                                     "1f4c7",
+	"rook":                                        "1f426-200d-2b1b",
+	"rooster":                                     "1f413",
+	"root_vegetable":                              "1fadc",
+	"rope":                                        "1faa2",
+	"rose":                                        "1f339",
+	"rosette":                                     "1f3f5",
+	"rotating_light":                              "1f6a8",
+	"rotting":                                     "1fab0",
+	"roundhouse":                                  "1f6d6",
+	"router":                                      "1f6dc",
+	"rowboat":                                     "1f6a3",
+	"rowing":                                      "1f6a3",
+	"royal":                                       "1fac5",
+	"royalty":                                     "1fac5",
+	"rugby":                                       "1f3c9",
+	"ruler":                                       "1f4cf",
+	"rung":                                        "1fa9c",
+	"runner":                                      "1f3c3",
+	"running":                                     "1f3c3",
+	"running_shirt":                               "1f3bd",
+	"running_shoe":                                "1f45f",
+	"russia":                                      "1fa86",
+	"sacred":                                      "2764-200d-1f525",
+	"sad":                                         "2639",
+	"safe":                                        "1f510",
+	"safety_first":                                "26d1",
+	"safety_pin":                                  "1f9f7",
+	"safety_vest":                                 "1f9ba",
+	"sagittarius":                                 "2650",
+	"sailboat":                                    "26f5",
+	"sake":                                        "1f376",
+	"salad":                                       "1f957",
+	"salsa":                                       "1f34b-200d-1f7e9",
+	"salt":                                        "1f9c2",
+	"salty":                                       "1f9c2",
+	"salute":                                      "1fae1",
+	"saluting":                                    "1fae1",
+	"saluting_face":                               "1fae1",
+	"sandal":                                      "1f461",
+	"sandals":                                     "1fa74",
+	"sandwich":                                    "1f96a",
+	"santa":                                       "1f385",
+	"sari":                                        "1f97b",
+	"satchel":                                     "1f392",
+	"satellite":                                   "1f6f0",
+	"satellite_antenna":                           "1f4e1",
+	"saturn":                                      "1fa90",
+	"saturnine":                                   "1fa90",
+	"sauce":                                       "1fad9",
+	"saucer":                                      "1f6f8",
+	"sauropod":                                    "1f995",
+	"save":                                        "1f6df",
+	"saver":                                       "1f6df",
+	"saw":                                         "1fa9a",
+	"saxophone":                                   "1f3b7",
+	"say_cheese":                                  "1f4f8",
+	"scales":                                      "2696",
+	"scared":                                      "1f628",
+	"scarf":                                       "1f9e3",
+	"schmear":                                     "1f96f",
+	"school":                                      "1f3eb",
+	"school_bus":                                  "1f68c",
+	"school_crossing":                             "1f6b8",
+	"science":                                     "1f52c",
+	"scientist":                                   "1f9d1-200d-1f52c",
+	"scissors":                                    "2702",
+	"scoop":                                       "1fa8f",
+	"scooter":                                     "1f6f5",
+	"scorpion":                                    "1f982",
+	"scorpius":                                    "264f",
+	"scream":                                      "1f631",
+	"scream_cat":                                  "1f640",
+	"screw":                                       "1f529",
+	"screwdriver":                                 "1fa9b",
+	"scroll":                                      "1f4dc",
+	"sculling":                                    "1f6a3",
+	"seafood":                                     "1f99e",
+	"seal":                                        "1f9ad",
+	"sealed":                                      "1f4e9",
+	"search":                                      "1f50d",
+	"seashell":                                    "1f41a",
+	"seat":                                        "1f4ba",
+	"second_place":                                "1f948",
+	"secret":                                      "1f5dd",
+	"secure":                                      "1f510",
+	"security":                                    "1faaa",
+	"see":                                         "1f9e7",
+	"see_no_evil":                                 "1f648",
+	"seedling":                                    "1f331",
+	"seeing_stars":                                "1f4ab",
+	"selfie":                                      "1f933",
+	"selling":                                     "1fa9d",
+	"semi_truck":                                  "1f69b",
+	"senbei":                                      "1f358",
+	"service_dog":                                 "1f415-200d-1f9ba",
+	"services":                                    "1f6ce",
+	"settings":                                    "2699",
+	"seven":                                       "0037-20e3",
+	"sew":                                         "1faa1",
+	"sewing_needle":                               "1faa1",
+	"sewing_pin":                                  "1f4cd",
+	"sexy":                                        "1fae6",
+	"shadow":                                      "1f464",
+	"shadows":                                     "1f465",
+	"shaking_face":                                "1fae8",
+	"shampoo":                                     "1f9f4",
+	"shamrock":                                    "2618",
+	"shape":                                       "1f9f2",
+	"shark":                                       "1f988",
+	"sharp":                                       "1fa92",
+	"shave":                                       "1fa92",
+	"shaved_ice":                                  "1f367",
+	"shawarma":                                    "1f959",
+	"sheep":                                       "1f411",
+	"shell":                                       "1f41a",
+	"shelter":                                     "1f6d6",
+	"shh":                                         "1f92b",
+	"shield":                                      "1f6e1",
+	"shiitake":                                    "1f344-200d-1f7eb",
+	"shinto_shrine":                               "26e9",
+	"ship":                                        "1f6a2",
+	"shiro":                                       "1f3ef",
+	"shirt":                                       "1f455",
+	"shock":                                       "1f628",
+	"shocked":                                     "1f92f",
+	"shoe":                                        "1f45e",
+	"shoes":                                       "1fa70",
+	"shoo":                                        "1faf3",
+	"shooting_star":                               "1f320",
+	"shopping_bags":                               "1f6cd",
+	"shopping_cart":                               "1f6d2",
+	"shopping_trolley":                            "1f6d2",
+	"shorts":                                      "1fa73",
+	"shout":                                       "1f4e3",
+	"shovel":                                      "1fa8f",
+	"shower":                                      "1f6bf",
+	"shrimp":                                      "1f990",
+	"shrinking":                                   "1f4c9",
+	"shroom":                                      "1f344-200d-1f7eb",
+	"shrub":                                       "1fabb",
+	"shrug":                                       "1f937",
+	"shuffle":                                     "1f500",
+	"shush":                                       "1f92b",
+	"shushing":                                    "1f92b",
+	"shushing_face":                               "1f92b",
+	"sick":                                        "1f912",
+	"sigh":                                        "1f62e-200d-1f4a8",
+	"sign_of_the_horns":                           "1f918",
+	"signal_bars":                                 "1f4f6",
+	"signal_strength":                             "1f4f6",
+	"sikh":                                        "1faaf",
+	"sikhism":                                     "1faaf",
+	"silence":                                     "1f910",
+	"silhouette":                                  "1f464",
+	"silhouettes":                                 "1f465",
+	"silly":                                       "1fabf",
+	"silver":                                      "1f948",
+	"singer":                                      "1f9d1-200d-1f3a4",
+	"sir":                                         "1fae1",
+	"siren":                                       "1f6a8",
+	"sit":                                         "1fa91",
+	"six":                                         "0036-20e3",
+	"six_pointed":                                 "1f52f",
+	"skateboard":                                  "1f6f9",
+	"skater":                                      "1f6f9",
+	"skates":                                      "1f6fc",
+	"skeptic":                                     "1f928",
+	"skepticism":                                  "1f928",
+	"ski":                                         "1f3bf",
+	"ski_lift":                                    "1f6a1",
+	"skier":                                       "26f7",
+	"skills":                                      "1f977",
+	"skip_back":                                   "23ee",
+	"skip_forward":                                "23ed",
+	"skull":                                       "1f480",
+	"skull_and_crossbones":                        "2620",
+	"skunk":                                       "1f9a8",
+	"sky":                                         "1fa75",
+	"skydive":                                     "1fa82",
+	"skyline":                                     "1f3d9",
+	"slate":                                       "1fa76",
+	"sled":                                        "1f6f7",
+	"sledge":                                      "1f6f7",
+	"sleep":                                       "1f971",
+	"sleeping":                                    "1f634",
+	"sleepy":                                      "1f62a",
+	"sleigh":                                      "1f6f7",
+	"sleuth":                                      "1f575",
+	"slice":                                       "1f967",
+	"slide":                                       "1f6dd",
+	"sliding":                                     "1f6dd",
+	"slight_frown":                                "1f641",
+	"slight_smile":                                "1f642",
+	"slip_on":                                     "1f97f",
+	"slipper":                                     "1f97f",
+	"slot_machine":                                "1f3b0",
+	"sloth":                                       "1f9a5",
+	"slow":                                        "1f9a5",
+	"small_airplane":                              "1f6e9",
+	"small_blue_diamond":                          "1f539",
+	"small_glass":                                 "1f943",
+	"small_orange_diamond":                        "1f538",
+	"smart":                                       "1f9e0",
+	"smartphone":                                  "1f4f1",
+	"smile":                                       "1f604",
+	"smile_cat":                                   "1f638",
+	"smiley":                                      "1f603",
+	"smiley_cat":                                  "1f63a",
+	"smiling_devil":                               "1f608",
+	"smiling_face":                                "263a",
+	"smiling_face_with_hearts":                    "1f970",
+	"smiling_face_with_horns":                     "1f608",
+	"smiling_face_with_tear":                      "1f972",
+	"smiling_imp":                                 "1f608",
+	"smirk":                                       "1f60f",
+	"smirk_cat":                                   "1f63c",
+	"smitten":                                     "1f498",
+	"smoke":                                       "1f62e-200d-1f4a8",
+	"smoking":                                     "1f6ac",
+	"smooch":                                      "1f617",
+	"smooches":                                    "1f617",
+	"smug":                                        "1f60f",
+	"smug_cat":                                    "1f63c",
+	"snail":                                       "1f40c",
+	"snake":                                       "1f40d",
+	"snap":                                        "1faf0",
+	"snapdragon":                                  "1fabb",
+	"snare":                                       "1faa4",
+	"sneaker":                                     "1f45f",
+	"sneaky":                                      "1f575",
+	"sneezing":                                    "1f927",
+	"snorkeling":                                  "1f93f",
+	"snowboarder":                                 "1f3c2",
+	"snowflake":                                   "2744",
+	"snowman":                                     "2603",
+	"snowstorm":                                   "1f328",
+	"snowy":                                       "1f328",
+	"snowy_mountain":                              "1f3d4",
+	"soak":                                        "1f9fd",
+	"soaked":                                      "1f327",
+	"soap":                                        "1f9fc",
+	"soapdish":                                    "1f9fc",
+	"sob":                                         "1f62d",
+	"soccer":                                      "26bd",
+	"socks":                                       "1f9e6",
+	"soda":                                        "1f964",
+	"soft":                                        "1f964",
+	"soft_ice_cream":                              "1f366",
+	"soft_serve":                                  "1f366",
+	"softball":                                    "1f94e",
+	"softer":                                      "1f509",
+	"solid":                                       "1faa8",
+	"solidarity":                                  "1f38c",
+	"soon":                                        "1f51c",
+	"sort":                                        "1f5c2",
+	"sos":                                         "1f198",
+	"sound":                                       "1f50a",
+	"soup":                                        "1f372",
+	"sour":                                        "1f34b-200d-1f7e9",
+	"south":                                       "2b07",
+	"south_east":                                  "2198",
+	"south_west":                                  "2199",
+	"souvlaki":                                    "1f959",
+	"soybean":                                     "1fadb",
+	"space_invader":                               "1f47e",
+	"spade":                                       "1fa8f",
+	"spades":                                      "2660",
+	"spaghetti":                                   "1f35d",
+	"spark":                                       "1f9e8",
+	"sparkle":                                     "2747",
+	"sparkler":                                    "1f387",
+	"sparkles":                                    "2728",
+	"sparkling_heart":                             "1f496",
+	"speak_no_evil":                               "1f64a",
+	"speaker":                                     "1f508",
+	"speaking_head":                               "1f5e3",
+	"spectacles":                                  "1f453",
+	"speculum":                                    "1fa9e",
+	"speech":                                      "1f441-200d-1f5e8",
+	"speech_balloon":                              "1f4ac",
+	"speech_bubble":                               "1f5e8",
+	"speechless":                                  "1f636",
+	"speedboat":                                   "1f6a4",
+	"spew":                                        "1f92e",
+	"spice":                                       "1fada",
+	"spider":                                      "1f577",
+	"spider_web":                                  "1f578",
+	"spiny":                                       "1f994",
+	"spiral_calendar":                             "1f5d3",
+	"spiral_notebook":                             "1f4d2",
+	"spiral_notepad":                              "1f5d2",
+	"spiral_shell":                                "1f41a",
+	"splatter":                                    "1fadf",
+	"split":                                       "1fa93",
+	"spock":                                       "1f596",
+	"sponge":                                      "1f9fd",
+	"spooky":                                      "1f47b",
+	"spool":                                       "1f9f5",
+	"spoon":                                       "1f944",
+	"spore":                                       "1f344-200d-1f7eb",
+	"sports":                                      "26f9",
+	"spots":                                       "1f992",
+	"spring":                                      "1fabb",
+	"sprinkles":                                   "1f9c1",
+	"sprout":                                      "1f331",
+	"spy":                                         "1f575",
+	"squared_ok":                                  "1f197",
+	"squared_up":                                  "1f199",
+	"squeeze":                                     "1fa97",
+	"squeezebox":                                  "1fa97",
+	"squid":                                       "1f991",
+	"stadium":                                     "1f3df",
+	"staff":                                       "2695",
+	"stain":                                       "1fadf",
+	"star":                                        "2b50",
+	"star_and_crescent":                           "262a",
+	"star_of_david":                               "2721",
+	"star_struck":                                 "1f929",
+	"stare":                                       "1fae3",
+	"starry_eyed":                                 "1f929",
+	"start":                                       "1f3c1",
+	"station":                                     "1f689",
+	"statue":                                      "1f5fd",
+	"statue_of_liberty":                           "1f5fd",
+	"steak":                                       "1f969",
+	"stealth":                                     "1f977",
+	"steam_locomotive":                            "1f682",
+	"step":                                        "1fa9c",
+	"stethoscope":                                 "1fa7a",
+	"stew":                                        "1f372",
+	"sting":                                       "1fabc",
+	"stinger":                                     "1fabc",
+	"stink":                                       "1f9a8",
+	"stitches":                                    "1faa1",
+	"stock_market":                                "1f4b9",
+	"stocking":                                    "1f9e6",
+	"stomp":                                       "1f9b6",
+	"stop":                                        "1f91a",
+	"stop_button":                                 "23f9",
+	"stop_sign":                                   "1f6d1",
+	"stopwatch":                                   "23f1",
+	"store":                                       "1fad9",
+	"straightedge":                                "1f4cf",
+	"strawberry":                                  "1f353",
+	"streetcar":                                   "1f68b",
+	"stressed":                                    "1f625",
+	"strike":                                      "1f3b3",
+	"string":                                      "1f9f5",
+	"stringed":                                    "1fa95",
+	"stripe":                                      "1f993",
+	"striped_pole":                                "1f488",
+	"stroke":                                      "1f975",
+	"stubborn":                                    "1facf",
+	"stuck_out_tongue":                            "1f61b",
+	"stuck_out_tongue_closed_eyes":                "1f61d",
+	"stuck_out_tongue_wink":                       "1f61c",
+	"student":                                     "1f9d1-200d-1f393",
+	"studio_microphone":                           "1f399",
+	"stuffed_flatbread":                           "1f959",
+	"stuffy":                                      "1f9d0",
+	"subtract":                                    "2796",
+	"suburb":                                      "1f3e1",
+	"subway":                                      "1f687",
+	"subzero":                                     "1f976",
+	"suction":                                     "1faa0",
+	"sudden":                                      "1f92d",
+	"sugar":                                       "1f9c1",
+	"suitcase":                                    "1f9f3",
+	"sun_and_rain":                                "1f326",
+	"sun_face":                                    "1f31e",
+	"sunflower":                                   "1f33b",
+	"sunglasses":                                  "1f60e",
+	"sunny":                                       "2600",
+	"sunrise":                                     "1f305",
+	"sunscreen":                                   "1f9f4",
+	"sunset":                                      "1f306",
+	"sunshowers":                                  "1f326",
+	"superhero":                                   "1f9b8",
+	"supervillain":                                "1f9b9",
+	"surf":                                        "1f3c4",
+	"surprise":                                    "1f62e",
+	"surrender":                                   "1f3f3",
+	"sushi":                                       "1f363",
+	"suspension_railway":                          "1f69f",
+	"sutures":                                     "1faa1",
+	"swan":                                        "1f9a2",
+	"swap":                                        "2194",
+	"swat":                                        "1f5de",
+	"swearing":                                    "1f92c",
+	"sweat":                                       "1f613",
+	"sweat_drops":                                 "1f4a6",
+	"sweat_smile":                                 "1f605",
+	"sweating":                                    "1f975",
+	"sweeping":                                    "1f9f9",
+	"sweet_potato":                                "1f360",
+	"swim":                                        "1f3ca",
+	"swords":                                      "2694",
+	"symbols":                                     "1f523",
+	"synagogue":                                   "1f54d",
+	"syringe":                                     "1f489",
+	"t":                                           "1f996",
+	"t_rex":                                       "1f996",
+	"table_setting":                               "1f37d",
+	"table_tennis":                                "1f3d3",
+	"tache":                                       "1f978",
+	"taco":                                        "1f32e",
+	"tada":                                        "1f389",
+	"tag":                                         "1f3f7",
+	"tailoring":                                   "1faa1",
+	"take_off":                                    "1f6eb",
+	"takeout":                                     "1f961",
+	"takeout_box":                                 "1f961",
+	"taking_a_picture":                            "1f4f8",
+	"talisman":                                    "1f9ff",
+	"talk":                                        "1f99c",
+	"tamale":                                      "1fad4",
+	"tanabata_tree":                               "1f38b",
+	"tangerine":                                   "1f34a",
+	"tangled":                                     "1faa2",
+	"tangy":                                       "1f34b-200d-1f7e9",
+	"tap_water":                                   "1f6b0",
+	"tash":                                        "1f978",
+	"taste":                                       "1f9c2",
+	"taurus":                                      "2649",
+	"taxi":                                        "1f695",
+	"tea":                                         "1f375",
+	"teacher":                                     "1f9d1-200d-1f3eb",
+	"teal":                                        "1fa75",
+	"teapot":                                      "1fad6",
+	"tear":                                        "1f972",
+	"tears":                                       "1f602",
+	"technologist":                                "1f9d1-200d-1f4bb",
+	"teddy":                                       "1f9f8",
+	"teddy_bear":                                  "1f9f8",
+	"tegh":                                        "1faaf",
+	"telephone":                                   "260e",
+	"telescope":                                   "1f52d",
+	"television":                                  "1f4fa",
+	"tell":                                        "1faf4",
+	"temperature":                                 "1f321",
+	"temple":                                      "1f6d5",
+	"tempura":                                     "1f364",
+	"ten":                                         "1f51f",
+	"tennis":                                      "1f3be",
+	"tent":                                        "26fa",
+	"tentacles":                                   "1fabc",
+	"tequila":                                     "1f34b-200d-1f7e9",
+	"terrestrial":                                 "1f6f8",
+	"test":                                        "1f9ea",
+	"test_tube":                                   "1f9ea",
+	"thank_you":                                   "1f64f",
+	"thanks":                                      "1fac2",
+	"theater":                                     "1f3ad",
+	"theme":                                       "1f6dd",
+	"thermometer":                                 "1f321",
+	"thinking":                                    "1f914",
+	"third_place":                                 "1f949",
+	"this":                                        "1f446",
+	"thong":                                       "1fa74",
+	"thong_sandal":                                "1fa74",
+	"thongs":                                      "1fa74",
+	"thought":                                     "1f4ad",
+	"thread":                                      "1f9f5",
+	"three":                                       "0033-20e3",
+	"thumb":                                       "1faf0",
+	"thumb_tack":                                  "1f4cc",
+	"thumbs_down":                                 "1f44e",
+	"thumbs_up":                                   "1f44d",
+	"thunder_and_rain":                            "26c8",
+	"thunderstorm":                                "26c8",
+	"tichel":                                      "1f9d5",
+	"ticket":                                      "1f39f",
+	"tie":                                         "1f454",
+	"tiger":                                       "1f405",
+	"tiger_cub":                                   "1f42f",
+	"tilted":                                      "1f50e",
+	"timber":                                      "1fab5",
+	"time":                                        "1f557",
+	"time_ticking":                                "23f3",
+	"timer":                                       "23f2",
+	"times_up":                                    "231b",
+	"tipsy":                                       "1f974",
+	"tire":                                        "1f6de",
+	"tired":                                       "1f614",
+	"titania":                                     "1f9da-200d-2640",
+	"tm":                                          "2122",
+	"toast":                                       "1f942",
+	"toboggan":                                    "1f6f7",
+	"toilet":                                      "1f6bd",
+	"toiletry":                                    "1faa5",
+	"tokyo_tower":                                 "1f5fc",
+	"tomato":                                      "1f345",
+	"tomb":                                        "1faa6",
+	"tombstone":                                   "1faa6",
+	"tongue":                                      "1f445",
+	"toolbox":                                     "1f9f0",
+	"tools":                                       "1f6e0",
+	"tooth":                                       "1f9b7",
+	"toothbrush":                                  "1faa5",
+	"top":                                         "1f51d",
+	"top_hat":                                     "1f3a9",
+	"toppings":                                    "1f344-200d-1f7eb",
+	"tornado":                                     "1f32a",
+	"tortoise":                                    "1f422",
+	"touched":                                     "1f972",
+	"tough":                                       "1faa8",
+	"towels":                                      "1f9fb",
+	"tower":                                       "1f5fc",
+	"toxic":                                       "2620",
+	"trace":                                       "1fac6",
+	"trackball":                                   "1f5b2",
+	"tractor":                                     "1f69c",
+	"tractor-trailer":                             "1f69b",
+	"trademark":                                   "2122",
+	"traffic_light":                               "1f6a6",
+	"train":                                       "1f682",
+	"train_car":                                   "1f683",
+	"train_tracks":                                "1f6e4",
+	"tram":                                        "1f68b",
+	"trans":                                       "1f3f3-200d-1f308",
+	"transform":                                   "1f426-200d-1f525",
+	"transgender_flag":                            "1f3f3-200d-26a7",
+	"transgender_symbol":                          "26a7",
+	"transparent":                                 "1fa9f",
+	"transport_truck":                             "1f69b",
+	"transportation":                              "1f6fb",
+	"trap":                                        "1faa4",
+	"trash_can":                                   "1f5d1",
+	"travel":                                      "1f9f3",
+	"treat":                                       "1f9c1",
+	"tree":                                        "1f333",
+	"triangular_flag":                             "1f6a9",
+	"triangular_ruler":                            "1f4d0",
+	"trident":                                     "1f531",
+	"trim":                                        "1fa9a",
+	"triton":                                      "1f9dc-200d-2642",
+	"triumph":                                     "1f624",
+	"troll":                                       "1f9cc",
+	"trolley":                                     "1f68e",
+	"trolling":                                    "1f9cc",
+	"troops":                                      "1fae1",
+	"trophy":                                      "1f3c6",
+	"tropical_drink":                              "1f379",
+	"tropical_fish":                               "1f420",
+	"trouble":                                     "1f635-200d-1f4ab",
+	"truck":                                       "1f69b",
+	"truffle":                                     "1f344-200d-1f7eb",
+	"trumpet":                                     "1f3ba",
+	"trunk":                                       "1fabe",
+	"tshirt":                                      "1f455",
+	"tube":                                        "1f9ea",
+	"tuk":                                         "1f6fa",
+	"tulip":                                       "1f337",
+	"tumbling":                                    "1f938",
+	"turban":                                      "1f473",
+	"turkey":                                      "1f983",
+	"turn":                                        "1f6de",
+	"turnip":                                      "1fadc",
+	"turtle":                                      "1f422",
+	"tusk":                                        "1f9a3",
+	"tuxedo":                                      "1f935",
+	"tv":                                          "1f4fa",
+	"twine":                                       "1faa2",
+	"twins":                                       "1f9d1-200d-1f91d-200d-1f9d1",
+	"twist":                                       "1faa2",
+	"twisted":                                     "1f968",
+	"two":                                         "0032-20e3",
+	"two_hearts":                                  "1f495",
+	"two_men_holding_hands":                       "1f46c",
+	"two_women_holding_hands":                     "1f46d",
+	"typhoon":                                     "1f300",
+	"tyrannosaurus":                               "1f996",
+	"u":                                           "1f9f2",
+	"u5272":                                       "1f239",
+	"u5408":                                       "1f234",
+	"u55b6":                                       "1f23a",
+	"u6708":                                       "1f237",
+	"u6709":                                       "1f236",
+	"u6e80":                                       "1f235",
+	"u7121":                                       "1f21a",
+	"u7533":                                       "1f238",
+	"u7981":                                       "1f232",
+	"ufo":                                         "1f47d",
+	"ugh":                                         "1f90c",
+	"ugly":                                        "1f9a2",
+	"ultimate":                                    "1f94f",
+	"umbrella":                                    "2602",
+	"umbrella_with_rain":                          "2614",
+	"umm":                                         "1f4ac",
+	"unamused":                                    "1f612",
+	"unbounded":                                   "267e",
+	"uncomfortable":                               "1fae6",
+	"underage":                                    "1f51e",
+	"underarm":                                    "1f94e",
+	"underwater":                                  "1fae7",
+	"uneven":                                      "1f974",
+	"unicorn":                                     "1f984",
+	"universal":                                   "267e",
+	"unlocked":                                    "1f513",
+	"unlucky":                                     "1f408-200d-2b1b",
+	"unread_mail":                                 "1f4ec",
+	"unsure":                                      "1fae4",
+	"up":                                          "2b06",
+	"up_button":                                   "1f53c",
+	"up_down":                                     "2195",
+	"upper_left":                                  "2196",
+	"upper_right":                                 "2197",
+	"upside_down":                                 "1f643",
+	"upvote":                                      "1f53c",
+	"upwards_trend":                               "1f4c8",
+	"vacancy":                                     "1f233",
+	"vampire":                                     "1f9db",
+	"vase":                                        "1f3fa",
+	"vat":                                         "1faa3",
+	"vehicle":                                     "1f6de",
+	"vertical_traffic_light":                      "1f6a6",
+	"vertically":                                  "1f642-200d-2195",
+	"very_angry":                                  "1f621",
+	"vest":                                        "1f9ba",
+	"vhs":                                         "1f4fc",
+	"vibrate":                                     "1fae8",
+	"vibration_mode":                              "1f4f3",
+	"victory":                                     "270c",
+	"vicuna":                                      "1f999",
+	"vicuña":                                      "1f999",
+	"video_camera":                                "1f4f9",
+	"video_game":                                  "1f3ae",
+	"video_recorder":                              "1f4f9",
+	"videocassette":                               "1f4fc",
+	"view":                                        "1fa9f",
+	"viewer":                                      "1faf5",
+	"violet":                                      "1fabb",
+	"violin":                                      "1f3bb",
+	"virgo":                                       "264d",
+	"vise":                                        "1f5dc",
+	"voicemail":                                   "27bf",
+	"volcano":                                     "1f30b",
+	"volleyball":                                  "1f3d0",
+	"volume":                                      "1f39a",
+	"vomit":                                       "1f92e",
+	"vomiting":                                    "1f92e",
+	"vs":                                          "1f19a",
+	"waffle":                                      "1f9c7",
+	"wait_one_second":                             "261d",
+	"walking":                                     "1f6b6",
+	"wall":                                        "1f9f1",
+	"wand":                                        "1fa84",
+	"waning_crescent_moon":                        "1f318",
+	"waning_gibbous_moon":                         "1f316",
+	"warm":                                        "1f321",
+	"warning":                                     "26a0",
+	"warrior":                                     "1fa96",
+	"wastebasket":                                 "1f5d1",
+	"watch":                                       "231a",
+	"water_buffalo":                               "1f403",
+	"water_closet":                                "1f6be",
+	"water_drop":                                  "1f4a7",
+	"water_polo":                                  "1f93d",
+	"watermelon":                                  "1f349",
+	"wave":                                        "1f44b",
+	"wavy":                                        "1f974",
+	"wavy_dash":                                   "3030",
+	"waxing":                                      "1f312",
+	"waxing_crescent_moon":                        "1f312",
+	"waxing_moon":                                 "1f314",
+	"way":                                         "1f92f",
+	"wc":                                          "1f6be",
+	"wealthy":                                     "1f9d0",
+	"weapon":                                      "1fa83",
+	"weary":                                       "1f629",
+	"weary_cat":                                   "1f640",
+	"web":                                         "1f578",
+	"wedding":                                     "1f492",
+	"weight_lift":                                 "1f3cb",
+	"welcome":                                     "1f64f",
+	"welding":                                     "1f97d",
+	"well":                                        "2764-200d-1fa79",
+	"west":                                        "2b05",
+	"whale":                                       "1f433",
+	"wheel":                                       "1f6de",
+	"wheel_of_dharma":                             "2638",
+	"wheelchair":                                  "267f",
+	"wheels":                                      "1f6f9",
+	"whisper":                                     "1f62e-200d-1f4a8",
+	"whistle":                                     "1f62e-200d-1f4a8",
+	"white_and_black_square":                      "1f532",
+	"white_cane":                                  "1f9af",
+	"white_circle":                                "26aa",
+	"white_flag":                                  "1f3f3",
+	"white_flower":                                "1f4ae",
+	"white_heart":                                 "1f90d",
+	"white_large_square":                          "2b1c",
+	"white_medium_small_square":                   "25fd",
+	"white_medium_square":                         "25fb",
+	"white_small_square":                          "25ab",
+	"whoops":                                      "1f92d",
+	"why":                                         "1f97a",
+	"wi_fi":                                       "1f6dc",
+	"wifi":                                        "1f6dc",
+	"wild":                                        "1f966",
+	"wilted_flower":                               "1f940",
+	"wind":                                        "1f343",
+	"wind_chime":                                  "1f390",
+	"window":                                      "1fa9f",
+	"windy":                                       "1f32c",
+	"wine":                                        "1f377",
+	"wing":                                        "1fabd",
+	"wink":                                        "1f609",
+	"winner":                                      "1f3c6",
+	"winter":                                      "1fabe",
+	"wireless":                                    "1f6dc",
+	"wise":                                        "1f9d3",
+	"wisent":                                      "1f9ac",
+	"wish":                                        "1f320",
+	"wish_tree":                                   "1f38b",
+	"wishbone":                                    "1f9b4",
+	"witness":                                     "1f441-200d-1f5e8",
+	"wlan":                                        "1f6dc",
+	"woah":                                        "1f635-200d-1f4ab",
+	"wolf":                                        "1f43a",
+	"woman":                                       "1f469",
+	"woman_artist":                                "1f469-200d-1f3a8",
+	"woman_astronaut":                             "1f469-200d-1f680",
+	"woman_bald":                                  "1f469-200d-1f9b2",
+	"woman_beard":                                 "1f9d4-200d-2640",
+	"woman_biking":                                "1f6b4-200d-2640",
+	"woman_blond_hair":                            "1f471-200d-2640",
+	"woman_bouncing_ball":                         "26f9-200d-2640",
+	"woman_bowing":                                "1f647-200d-2640",
+	"woman_cartwheeling":                          "1f938-200d-2640",
+	"woman_climbing":                              "1f9d7-200d-2640",
+	"woman_construction_worker":                   "1f477-200d-2640",
+	"woman_cook":                                  "1f469-200d-1f373",
+	"woman_curly_hair":                            "1f469-200d-1f9b1",
+	"woman_detective":                             "1f575-200d-2640",
+	"woman_elf":                                   "1f9dd-200d-2640",
+	"woman_facepalming":                           "1f926-200d-2640",
+	"woman_factory_worker":                        "1f469-200d-1f3ed",
+	"woman_fairy":                                 "1f9da-200d-2640",
+	"woman_farmer":                                "1f469-200d-1f33e",
+	"woman_feeding_baby":                          "1f469-200d-1f37c",
+	"woman_firefighter":                           "1f469-200d-1f692",
+	"woman_frowning":                              "1f64d-200d-2640",
+	"woman_genie":                                 "1f9de-200d-2640",
+	"woman_gesturing_no":                          "1f645-200d-2640",
+	"woman_gesturing_ok":                          "1f646-200d-2640",
+	"woman_getting_haircut":                       "1f487-200d-2640",
+	"woman_getting_massage":                       "1f486-200d-2640",
+	"woman_golfing":                               "1f3cc-200d-2640",
+	"woman_guard":                                 "1f482-200d-2640",
+	"woman_health_worker":                         "1f469-200d-2695",
+	"woman_in_lotus_position":                     "1f9d8-200d-2640",
+	"woman_in_manual_wheelchair":                  "1f469-200d-1f9bd",
+	"woman_in_manual_wheelchair_facing_right":    "1f469-200d-1f9bd-200d-27a1",
+	"woman_in_motorized_wheelchair":              "1f469-200d-1f9bc",
+	"woman_in_motorized_wheelchair_facing_right": "1f469-200d-1f9bc-200d-27a1",
+	"woman_in_steamy_room":                       "1f9d6-200d-2640",
+	"woman_in_tuxedo":                            "1f935-200d-2640",
+	"woman_judge":                                "1f469-200d-2696",
+	"woman_juggling":                             "1f939-200d-2640",
+	"woman_kneeling":                             "1f9ce-200d-2640",
+	"woman_kneeling_facing_right":                "1f9ce-200d-2640-200d-27a1",
+	"woman_lifting_weights":                      "1f3cb-200d-2640",
+	"woman_mage":                                 "1f9d9-200d-2640",
+	"woman_mechanic":                             "1f469-200d-1f527",
+	"woman_mountain_biking":                      "1f6b5-200d-2640",
+	"woman_office_worker":                        "1f469-200d-1f4bc",
+	"woman_pilot":                                "1f469-200d-2708",
+	"woman_playing_handball":                     "1f93e-200d-2640",
+	"woman_playing_water_polo":                   "1f93d-200d-2640",
+	"woman_police_officer":                       "1f46e-200d-2640",
+	"woman_pouting":                              "1f64e-200d-2640",
+	"woman_raising_hand":                         "1f64b-200d-2640",
+	"woman_red_hair":                             "1f469-200d-1f9b0",
+	"woman_rowing_boat":                          "1f6a3-200d-2640",
+	"woman_running":                              "1f3c3-200d-2640",
+	"woman_running_facing_right":                 "1f3c3-200d-2640-200d-27a1",
+	"woman_scientist":                            "1f469-200d-1f52c",
+	"woman_shrugging":                            "1f937-200d-2640",
+	"woman_singer":                               "1f469-200d-1f3a4",
+	"woman_standing":                             "1f9cd-200d-2640",
+	"woman_student":                              "1f469-200d-1f393",
+	"woman_superhero":                            "1f9b8-200d-2640",
+	"woman_supervillain":                         "1f9b9-200d-2640",
+	"woman_surfing":                              "1f3c4-200d-2640",
+	"woman_swimming":                             "1f3ca-200d-2640",
+	"woman_teacher":                              "1f469-200d-1f3eb",
+	"woman_technologist":                         "1f469-200d-1f4bb",
+	"woman_tipping_hand":                         "1f481-200d-2640",
+	"woman_vampire":                              "1f9db-200d-2640",
+	"woman_walking":                              "1f6b6-200d-2640",
+	"woman_walking_facing_right":                 "1f6b6-200d-2640-200d-27a1",
+	"woman_wearing_turban":                       "1f473-200d-2640",
+	"woman_white_hair":                           "1f469-200d-1f9b3",
+	"woman_with_headscarf":                       "1f9d5",
+	"woman_with_veil":                            "1f470-200d-2640",
+	"woman_with_white_cane":                      "1f469-200d-1f9af",
+	"woman_with_white_cane_facing_right":         "1f469-200d-1f9af-200d-27a1",
+	"woman_zombie":                               "1f9df-200d-2640",
+	"women_couple":                               "1f46d",
+	"women_with_bunny_ears":                      "1f46f-200d-2640",
+	"women_wrestling":                            "1f93c-200d-2640",
+	"womens":                                     "1f6ba",
+	"wood":                                       "1fab5",
+	"woodwind":                                   "1fa88",
+	"woof":                                       "1f415",
+	"wool":                                       "1f999",
+	"wooly":                                      "1f9a3",
+	"woozy_face":                                 "1f974",
+	"work_in_progress":                           "1f6a7",
+	"work_out":                                   "1f3cb",
+	"working_on_it":                              "1f6e0",
+	"world_map":                                  "1f5fa",
+	"worm":                                       "1fab1",
+	"worried":                                    "1f61f",
+	"worry":                                      "1fae6",
+	"wrapped":                                    "1fad4",
+	"wrench":                                     "1f527",
+	"wrestling":                                  "1f93c",
+	"writing":                                    "270d",
+	"wrong":                                      "274c",
+	"wrong_way":                                  "26d4",
+	"www":                                        "1f310",
+	"x":                                          "274e",
+	"x_ray":                                      "1fa7b",
+	"xmas":                                       "1f9d1-200d-1f384",
+	"xray":                                       "1fa7b",
+	"yacht":                                      "1f6f3",
+	"yam":                                        "1f360",
+	"yarn":                                       "1f9f6",
+	"yawn":                                       "1f971",
+	"yawning":                                    "1f971",
+	"yawning_face":                               "1f971",
+	"yellow":                                     "1f7e1",
+	"yellow_circle":                              "1f7e1",
+	"yellow_heart":                               "1f49b",
+	"yellow_large_square":                        "1f7e8",
+	"yen_banknotes":                              "1f4b4",
+	"yin_yang":                                   "262f",
+	"yo_yo":                                      "1fa80",
+	"young":                                      "1f9d2",
+	"younger":                                    "1f9d2",
+	"yuebing":                                    "1f96e",
+	"yum":                                        "1f60b",
+	"yurt":                                       "1f6d6",
+	"yuèbǐng":                                    "1f96e",
+	"zany":                                       "1f92a",
+	"zany_face":                                  "1f92a",
+	"zap":                                        "26a1",
+	"zebra":                                      "1f993",
+	"zero":                                       "0030-20e3",
+	"zest":                                       "1f34b-200d-1f7e9",
+	"zip":                                        "1f90c",
+	"zip_it":                                     "1f910",
+	"zombie":                                     "1f9df",
+	"zori":                                       "1fa74",
+	"zzz":                                        "1f4a4",
+	"zōri":                                       "1fa74",
+}
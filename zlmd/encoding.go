@@ -0,0 +1,559 @@
+package zlmd
+
+import (
+	"bufio"
+	"encoding"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Unmarshaler is implemented by types that can parse the markdown produced
+// by their MarshalZulipMarkdown method.
+type Unmarshaler interface {
+	UnmarshalZulipMarkdown(text string) error
+}
+
+// Marshal renders a struct as a message of key-value lines.
+//
+// Parameters:
+//   - v (any): A struct or pointer to one, or a Markdowner
+//
+// Returns:
+//   - string: The message, one "**Key**: value" line per field
+//   - error: An error if v or one of its fields can't be encoded
+//
+// Example:
+//
+//	type Deploy struct {
+//		Service  string        `zlmd:"Service"`
+//		Version  string        `zlmd:"Version,code"`
+//		Took     time.Duration `zlmd:"Took"`
+//		Hosts    []string      `zlmd:"Hosts,omitempty"`
+//		internal string
+//	}
+//	out, _ := Marshal(Deploy{Service: "api", Version: "1.4.2", Took: 95 * time.Second, Hosts: []string{"web-1", "web-2"}})
+//	// out will be:
+//	// **Service**: api
+//	// **Version**: `1.4.2`
+//	// **Took**: 1m35s
+//	// **Hosts**:
+//	// - web-1
+//	// - web-2
+//
+// Notes:
+//   - The zlmd tag holds the key followed by options: omitempty skips zero
+//     values, code renders the value as inline code, raw writes a string as
+//     markdown without escaping and order=N moves the field, as fields are
+//     stably sorted by N (default 0); a key of "-" skips the field
+//   - Strings, booleans, numbers, time.Duration, time.Time (as a <time:>
+//     tag, to the second), encoding.TextMarshaler values and slices of these
+//     (as a list) are supported; nil pointers are omitted
+//   - Times are written as <time:> tags even when the default Config
+//     targets FlavorPortable or a server without them, so the output can
+//     always be decoded by Unmarshal
+//   - Multi-line strings are written in a fenced block, without their
+//     trailing newline
+func Marshal(v any) (string, error) {
+	var sb strings.Builder
+	if err := NewEncoder(&sb).Encode(v); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(sb.String(), "\n"), nil
+}
+
+// Unmarshal parses a message produced by Marshal into the struct pointed to
+// by v.
+//
+// Parameters:
+//   - text (string): The message
+//   - v (any): A pointer to a struct, or an Unmarshaler
+//
+// Returns:
+//   - error: An error if v is not a pointer to a struct or a value can't be
+//     parsed into its field
+//
+// Example:
+//
+//	var d Deploy
+//	err := Unmarshal("**Service**: api\n**Version**: `1.4.2`", &d)
+//	// d.Service will be "api" and d.Version "1.4.2"
+//
+// Notes:
+//   - Keys are matched to fields exactly, then case-insensitively; unknown
+//     keys and other lines, such as a heading above the pairs, are ignored
+func Unmarshal(text string, v any) error {
+	return decodeValue(strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n"), v)
+}
+
+// Encoder writes structs as messages of key-value lines to a stream,
+// separated by blank lines.
+type Encoder struct {
+	w       io.Writer
+	written bool
+}
+
+// NewEncoder returns an encoder that writes to w.
+//
+// Example:
+//
+//	enc := NewEncoder(os.Stdout)
+//	for _, d := range deploys {
+//		enc.Encode(d)
+//	}
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the encoding of v, as described for Marshal, followed by a
+// newline.
+func (e *Encoder) Encode(v any) error {
+	text, err := encodeValue(v)
+	if err != nil {
+		return err
+	}
+	if e.written {
+		text = "\n" + text
+	}
+	if _, err := io.WriteString(e.w, text+"\n"); err != nil {
+		return err
+	}
+	e.written = true
+	return nil
+}
+
+// Decoder reads messages written by an Encoder from a stream.
+type Decoder struct {
+	scanner *bufio.Scanner
+}
+
+// NewDecoder returns a decoder that reads from r.
+//
+// Example:
+//
+//	dec := NewDecoder(r)
+//	for {
+//		var d Deploy
+//		if err := dec.Decode(&d); err == io.EOF {
+//			break
+//		} else if err != nil {
+//			return err
+//		}
+//	}
+func NewDecoder(r io.Reader) *Decoder {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxMessageLength*4)
+	return &Decoder{scanner: scanner}
+}
+
+// Decode reads the next message, up to a blank line outside a fenced block,
+// and stores it in v as described for Unmarshal. It returns io.EOF when the
+// stream holds no further message.
+func (d *Decoder) Decode(v any) error {
+	var lines []string
+	fence := ""
+	for d.scanner.Scan() {
+		line := strings.TrimSuffix(d.scanner.Text(), "\r")
+		if fence == "" && strings.TrimSpace(line) == "" {
+			if len(lines) == 0 {
+				continue
+			}
+			break
+		}
+		lines = append(lines, line)
+		fence = nextFence(fence, line)
+	}
+	if err := d.scanner.Err(); err != nil {
+		return err
+	}
+	if len(lines) == 0 {
+		return io.EOF
+	}
+	return decodeValue(lines, v)
+}
+
+// encodeField is a struct field with its parsed zlmd tag.
+type encodeField struct {
+	index     int
+	key       string
+	omitEmpty bool
+	code      bool
+	raw       bool
+	order     int
+}
+
+// structFields returns the encoded fields of t in output order.
+func structFields(t reflect.Type) ([]encodeField, error) {
+	var fields []encodeField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		f := encodeField{index: i, key: sf.Name}
+		tag := sf.Tag.Get("zlmd")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name != "" {
+			f.key = name
+		}
+		for _, opt := range strings.Split(opts, ",") {
+			switch {
+			case opt == "omitempty":
+				f.omitEmpty = true
+			case opt == "code":
+				f.code = true
+			case opt == "raw":
+				f.raw = true
+			case strings.HasPrefix(opt, "order="):
+				n, err := strconv.Atoi(strings.TrimPrefix(opt, "order="))
+				if err != nil {
+					return nil, fmt.Errorf("zlmd: field %s: invalid order %q", sf.Name, opt)
+				}
+				f.order = n
+			}
+		}
+		fields = append(fields, f)
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].order < fields[j].order })
+	return fields, nil
+}
+
+// encodeValue renders v as key-value lines, each ending with a newline
+// except the last.
+func encodeValue(v any) (string, error) {
+	if m, ok := v.(Markdowner); ok {
+		return m.MarshalZulipMarkdown()
+	}
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return "", fmt.Errorf("zlmd: cannot encode %T, want a struct", v)
+	}
+
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return "", err
+	}
+	var lines []string
+	for _, f := range fields {
+		fv := rv.Field(f.index)
+		if (f.omitEmpty && fv.IsZero()) || (fv.Kind() == reflect.Pointer && fv.IsNil()) {
+			continue
+		}
+		for fv.Kind() == reflect.Pointer {
+			fv = fv.Elem()
+		}
+		line, err := encodeEntry(f, fv)
+		if err != nil {
+			return "", fmt.Errorf("zlmd: field %s: %w", rv.Type().Field(f.index).Name, err)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// encodeEntry renders one field: a key-value line, or a key followed by a
+// list or fenced block.
+func encodeEntry(f encodeField, v reflect.Value) (string, error) {
+	key := Bold(f.key) + ":"
+	if v.Kind() == reflect.Slice && v.Type().Elem().Kind() != reflect.Uint8 {
+		var sb strings.Builder
+		sb.WriteString(key)
+		for i := 0; i < v.Len(); i++ {
+			item, err := encodeScalar(f, v.Index(i))
+			if err != nil {
+				return "", err
+			}
+			if strings.Contains(item, "\n") {
+				return "", errors.New("list items must be single lines")
+			}
+			sb.WriteString("\n- " + item)
+		}
+		return sb.String(), nil
+	}
+
+	value, err := encodeScalar(f, v)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(value, "\n") {
+		return key + "\n" + FencedBlock("", value), nil
+	}
+	if value == "" {
+		return key, nil
+	}
+	return key + " " + value, nil
+}
+
+// encodeScalar renders a single value. Multi-line strings are returned
+// unescaped, to be placed in a fenced block.
+func encodeScalar(f encodeField, v reflect.Value) (string, error) {
+	var text string
+	switch {
+	case v.Type() == reflect.TypeOf(time.Time{}):
+		// Always a <time:> tag, whatever the default Config targets, so the
+		// value can be decoded again.
+		return formatTime(v.Interface().(time.Time), renderTarget{}), nil
+	case v.Type() == reflect.TypeOf(time.Duration(0)):
+		text = v.Interface().(time.Duration).String()
+	case v.Type().Implements(reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()):
+		b, err := v.Interface().(encoding.TextMarshaler).MarshalText()
+		if err != nil {
+			return "", err
+		}
+		text = string(b)
+	default:
+		switch v.Kind() {
+		case reflect.String:
+			text = v.String()
+		case reflect.Bool:
+			text = strconv.FormatBool(v.Bool())
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			text = strconv.FormatInt(v.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			text = strconv.FormatUint(v.Uint(), 10)
+		case reflect.Float32, reflect.Float64:
+			text = strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits())
+		default:
+			return "", fmt.Errorf("unsupported type %s", v.Type())
+		}
+	}
+
+	switch {
+	case strings.Contains(text, "\n"):
+		return text, nil
+	case f.code:
+		return inlineCode(text), nil
+	case f.raw:
+		return text, nil
+	default:
+		return Escape(text, EscapeAll), nil
+	}
+}
+
+// decodeValue stores the key-value lines of a message in v.
+func decodeValue(lines []string, v any) error {
+	if u, ok := v.(Unmarshaler); ok {
+		return u.UnmarshalZulipMarkdown(strings.Join(lines, "\n"))
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("zlmd: cannot decode into %T, want a pointer to a struct", v)
+	}
+	rv = rv.Elem()
+
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return err
+	}
+	byKey := map[string]encodeField{}
+	for _, f := range fields {
+		byKey[f.key] = f
+	}
+	lookup := func(key string) (encodeField, bool) {
+		if f, ok := byKey[key]; ok {
+			return f, true
+		}
+		for _, f := range fields {
+			if strings.EqualFold(f.key, key) {
+				return f, true
+			}
+		}
+		return encodeField{}, false
+	}
+
+	for i := 0; i < len(lines); i++ {
+		key, value, ok := parseKeyLine(lines[i])
+		if !ok {
+			continue
+		}
+
+		// A key without a value is followed by a list or a fenced block.
+		var items []string
+		block := false
+		if value == "" && i+1 < len(lines) {
+			if fence := nextFence("", lines[i+1]); fence != "" {
+				end := i + 2
+				for end < len(lines) && nextFence(fence, lines[end]) != "" {
+					end++
+				}
+				value = strings.Join(lines[i+2:min(end, len(lines))], "\n")
+				block = true
+				i = end
+			} else {
+				for i+1 < len(lines) && strings.HasPrefix(lines[i+1], "- ") {
+					items = append(items, strings.TrimPrefix(lines[i+1], "- "))
+					i++
+				}
+			}
+		}
+
+		f, ok := lookup(key)
+		if !ok {
+			continue
+		}
+		fv := rv.Field(f.index)
+		name := rv.Type().Field(f.index).Name
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() != reflect.Uint8 {
+			slice := reflect.MakeSlice(fv.Type(), len(items), len(items))
+			for j, item := range items {
+				if err := decodeScalar(f, slice.Index(j), item, false); err != nil {
+					return fmt.Errorf("zlmd: field %s: %w", name, err)
+				}
+			}
+			fv.Set(slice)
+			continue
+		}
+		if err := decodeScalar(f, fv, value, block); err != nil {
+			return fmt.Errorf("zlmd: field %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// decodeScalar parses text into v, allocating pointers as needed. Text from
+// a fenced block is used as is.
+func decodeScalar(f encodeField, v reflect.Value, text string, block bool) error {
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		v = v.Elem()
+	}
+
+	if !block {
+		switch {
+		case v.Type() == reflect.TypeOf(time.Time{}):
+			t, err := time.Parse(time.RFC3339, strings.TrimSuffix(strings.TrimPrefix(text, "<time:"), ">"))
+			if err != nil {
+				return err
+			}
+			v.Set(reflect.ValueOf(t))
+			return nil
+		case f.code:
+			text = parseInlineCode(text)
+		case !f.raw:
+			text = unescapeAll(text)
+		}
+	}
+
+	if u, ok := v.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText([]byte(text))
+	}
+	if v.Type() == reflect.TypeOf(time.Duration(0)) {
+		d, err := time.ParseDuration(text)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(text)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(text, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(text, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported type %s", v.Type())
+	}
+	return nil
+}
+
+// parseKeyLine splits a "**Key**: value" line.
+func parseKeyLine(line string) (key, value string, ok bool) {
+	if !strings.HasPrefix(line, "**") {
+		return "", "", false
+	}
+	key, rest, ok := strings.Cut(line[2:], "**:")
+	if !ok || key == "" {
+		return "", "", false
+	}
+	return key, strings.TrimPrefix(rest, " "), true
+}
+
+// inlineCode renders text as inline code, with a backtick run longer than
+// any inside text.
+func inlineCode(text string) string {
+	if text == "" {
+		return ""
+	}
+	longest, run := 0, 0
+	for _, r := range text {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	fence := strings.Repeat("`", longest+1)
+	if longest > 0 {
+		return fence + " " + text + " " + fence
+	}
+	return fence + text + fence
+}
+
+// parseInlineCode returns the text inside inline code written by inlineCode.
+func parseInlineCode(text string) string {
+	n := 0
+	for n < len(text) && text[n] == '`' {
+		n++
+	}
+	if n == 0 || len(text) < 2*n || strings.Repeat("`", n) != text[len(text)-n:] {
+		return text
+	}
+	inner := text[n : len(text)-n]
+	if n > 1 {
+		inner = strings.TrimPrefix(strings.TrimSuffix(inner, " "), " ")
+	}
+	return inner
+}
+
+// unescapeAll reverses Escape with EscapeAll, dropping the backslash before
+// each escaped character.
+func unescapeAll(text string) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) && strings.IndexByte(escapeAllChars+"#-+>", text[i+1]) >= 0 {
+			i++
+		}
+		sb.WriteByte(text[i])
+	}
+	return sb.String()
+}
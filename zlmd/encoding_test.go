@@ -0,0 +1,186 @@
+package zlmd
+
+import (
+	"io"
+	"net/netip"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+type deployRecord struct {
+	Service  string        `zlmd:"Service"`
+	Version  string        `zlmd:"Version,code"`
+	Took     time.Duration `zlmd:"Took"`
+	At       time.Time     `zlmd:"At,omitempty"`
+	Replicas int           `zlmd:"Replicas,order=-1"`
+	Ratio    float64       `zlmd:",omitempty"`
+	Canary   bool
+	Hosts    []string    `zlmd:"Hosts,omitempty"`
+	Addr     *netip.Addr `zlmd:"Address"`
+	Notes    string      `zlmd:"Notes,omitempty"`
+	Link     string      `zlmd:"Link,raw,omitempty"`
+	Secret   string      `zlmd:"-"`
+	internal string
+}
+
+func TestMarshal(t *testing.T) {
+	addr := netip.MustParseAddr("10.0.0.1")
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+	}{
+		{
+			name: "Fields",
+			value: deployRecord{Service: "api *v2*", Version: "1.4`2", Took: 95 * time.Second, Replicas: 3, Canary: true,
+				Hosts: []string{"web-1", "-web-2"}, Addr: &addr, Link: "[docs](https://example.com)", Secret: "x", internal: "y"},
+			expected: "**Replicas**: 3\n**Service**: api \\*v2\\*\n**Version**: `` 1.4`2 ``\n**Took**: 1m35s\n**Canary**: true\n" +
+				"**Hosts**:\n- web-1\n- \\-web-2\n**Address**: 10.0.0.1\n**Link**: [docs](https://example.com)",
+		},
+		{
+			name:     "Zero values",
+			value:    &deployRecord{At: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC), Notes: "line 1\n```\nline 2\n"},
+			expected: "**Replicas**: 0\n**Service**:\n**Version**:\n**Took**: 0s\n**At**: <time:2024-01-02T15:00:00Z>\n**Canary**: false\n**Notes**:\n````\nline 1\n```\nline 2\n````",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Marshal() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMarshal_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+	}{
+		{"Not a struct", 42},
+		{"Unsupported field", struct{ M map[string]int }{}},
+		{"Multi-line item", struct{ L []string }{[]string{"a\nb"}}},
+		{"Bad order", struct {
+			A int `zlmd:"A,order=x"`
+		}{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Marshal(tt.value); err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestMarshal_RoundTrip(t *testing.T) {
+	addr := netip.MustParseAddr("2001:db8::1")
+	in := deployRecord{
+		Service: "#api | @**all** \\ [x]", Version: "`v1`", Took: 1500 * time.Millisecond,
+		At: time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC), Replicas: -2, Ratio: 0.25, Canary: true,
+		Hosts: []string{"a_b", "> c"}, Addr: &addr, Notes: "first\n\nsecond", Link: "**bold**",
+	}
+
+	text, err := Marshal(in)
+	if err != nil {
+		t.Fatalf("Marshal() returned error: %v", err)
+	}
+	var out deployRecord
+	if err := Unmarshal(text, &out); err != nil {
+		t.Fatalf("Unmarshal() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(in, out) {
+		t.Errorf("Round trip = %+v, want %+v\nmessage:\n%s", out, in, text)
+	}
+}
+
+func TestMarshal_RoundTripConfig(t *testing.T) {
+	configs := []struct {
+		name string
+		cfg  Config
+	}{
+		{"Portable", Config{Flavor: FlavorPortable}},
+		{"Old server", Config{TargetVersion: ServerVersion{Major: 2, Minor: 1}}},
+		{"House style", Config{Bullet: "*", IndentWidth: 4, Locale: &Locale{Name: "de", TimeLayout: "02.01.2006 15:04 MST"}}},
+	}
+
+	in := deployRecord{
+		Service: "api", Took: time.Minute, At: time.Date(2024, 1, 2, 15, 0, 30, 0, time.UTC),
+		Hosts: []string{"web-1", "web-2"},
+	}
+	for _, tt := range configs {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaultConfig(tt.cfg)
+			t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+			text, err := Marshal(in)
+			if err != nil {
+				t.Fatalf("Marshal() returned error: %v", err)
+			}
+			var out deployRecord
+			if err := Unmarshal(text, &out); err != nil {
+				t.Fatalf("Unmarshal() returned error: %v\nmessage:\n%s", err, text)
+			}
+			if !reflect.DeepEqual(in, out) {
+				t.Errorf("Round trip = %+v, want %+v\nmessage:\n%s", out, in, text)
+			}
+		})
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	var got deployRecord
+	err := Unmarshal("Deploy finished\n\n**service**: api\n**Unknown**: x\n**Took**: 2m\n**hosts**:\n- web-1\n", &got)
+	if err != nil {
+		t.Fatalf("Unmarshal() returned error: %v", err)
+	}
+	expected := deployRecord{Service: "api", Took: 2 * time.Minute, Hosts: []string{"web-1"}}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unmarshal() = %+v, want %+v", got, expected)
+	}
+
+	if err := Unmarshal("**Took**: soon", &got); err == nil || !strings.Contains(err.Error(), "field Took") {
+		t.Errorf("Unmarshal() error = %v, want a field error", err)
+	}
+	if err := Unmarshal("**Took**: 1s", got); err == nil {
+		t.Error("Expected an error for a non-pointer")
+	}
+}
+
+func TestEncoder_Decoder(t *testing.T) {
+	var sb strings.Builder
+	enc := NewEncoder(&sb)
+	for _, name := range []string{"api", "web"} {
+		if err := enc.Encode(struct{ Name string }{name}); err != nil {
+			t.Fatalf("Encode() returned error: %v", err)
+		}
+	}
+	if expected := "**Name**: api\n\n**Name**: web\n"; sb.String() != expected {
+		t.Errorf("Encode() = %q, want %q", sb.String(), expected)
+	}
+
+	dec := NewDecoder(strings.NewReader(sb.String()))
+	var names []string
+	for {
+		var v struct{ Name string }
+		err := dec.Decode(&v)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Decode() returned error: %v", err)
+		}
+		names = append(names, v.Name)
+	}
+	if !reflect.DeepEqual(names, []string{"api", "web"}) {
+		t.Errorf("Decode() = %q, want [api web]", names)
+	}
+}
@@ -0,0 +1,146 @@
+package zlmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// StackTracer is implemented by errors that carry a captured stack trace.
+// FormatError renders the trace in a spoiler below the error chain.
+type StackTracer interface {
+	StackTrace() string
+}
+
+// Fielder is implemented by errors that carry structured context, such as
+// request IDs or parameters. FormatError renders the fields in a spoiler.
+type Fielder interface {
+	Fields() map[string]any
+}
+
+// FormatError renders an error chain as a nested markdown list.
+//
+// Parameters:
+//   - err (error): The error to render
+//
+// Returns:
+//   - string: The rendered chain, or "" if err is nil
+//
+// The chain is walked with errors.Unwrap, and errors combining several
+// causes (such as those created by errors.Join) get one nested branch per
+// cause. Each entry shows only the text the wrapper added, so "open config:
+// permission denied" renders as two entries. Root causes are bolded. Errors
+// implementing StackTracer or Fielder get a spoiler with their details.
+//
+// Example:
+//
+//	err := fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission))
+//	out := FormatError(err)
+//	// out will be:
+//	// - load settings
+//	//   - open config
+//	//     - **permission denied**
+func FormatError(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	var list, details strings.Builder
+	writeErrorNode(&list, &details, err, 0)
+
+	out := strings.TrimSuffix(list.String(), "\n")
+	if details.Len() > 0 {
+		out += "\n\n" + strings.TrimSuffix(details.String(), "\n")
+	}
+	return out
+}
+
+// writeErrorNode writes err and its causes as list items starting at level,
+// and any stack traces or fields to details.
+func writeErrorNode(list, details *strings.Builder, err error, level int) {
+	causes := errorCauses(err)
+	label := errorLabel(err, causes)
+
+	if len(causes) == 0 {
+		WriteListItem(list, Bold(label), level)
+	} else {
+		WriteListItem(list, label, level)
+	}
+	writeErrorDetails(details, err, label)
+
+	for _, cause := range causes {
+		writeErrorNode(list, details, cause, level+1)
+	}
+}
+
+// errorCauses returns the errors wrapped by err.
+func errorCauses(err error) []error {
+	switch e := err.(type) {
+	case interface{ Unwrap() []error }:
+		var causes []error
+		for _, cause := range e.Unwrap() {
+			if cause != nil {
+				causes = append(causes, cause)
+			}
+		}
+		return causes
+	case interface{ Unwrap() error }:
+		if cause := e.Unwrap(); cause != nil {
+			return []error{cause}
+		}
+	}
+	return nil
+}
+
+// errorLabel returns the part of err's message that its causes don't already
+// contain.
+func errorLabel(err error, causes []error) string {
+	msg := err.Error()
+	if len(causes) == 1 {
+		label := strings.TrimSuffix(msg, causes[0].Error())
+		label = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(label), ":"))
+		if label != "" && label != msg {
+			return label
+		}
+		if label == "" {
+			return fmt.Sprintf("%T", err)
+		}
+	}
+	if len(causes) > 1 {
+		return pluralize(len(causes), "error", "errors")
+	}
+	return msg
+}
+
+// writeErrorDetails writes a spoiler with err's stack trace and fields, if any.
+func writeErrorDetails(details *strings.Builder, err error, label string) {
+	var content strings.Builder
+
+	if fielder, ok := err.(Fielder); ok {
+		fields := fielder.Fields()
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			WriteKeyValue(&content, k, fmt.Sprint(fields[k]))
+		}
+	}
+
+	if tracer, ok := err.(StackTracer); ok {
+		if stack := strings.TrimSpace(tracer.StackTrace()); stack != "" {
+			if content.Len() > 0 {
+				content.WriteString("\n")
+			}
+			WriteCodeBlock(&content, "text", stack)
+			content.WriteString("\n")
+		}
+	}
+
+	if content.Len() == 0 {
+		return
+	}
+	WriteSpoiler(details, label, strings.TrimSuffix(content.String(), "\n"))
+	details.WriteString("\n")
+}
@@ -0,0 +1,83 @@
+package zlmd
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+type detailedError struct {
+	msg    string
+	stack  string
+	fields map[string]any
+}
+
+func (e *detailedError) Error() string          { return e.msg }
+func (e *detailedError) StackTrace() string     { return e.stack }
+func (e *detailedError) Fields() map[string]any { return e.fields }
+
+func TestFormatError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name:     "Nil",
+			err:      nil,
+			expected: "",
+		},
+		{
+			name:     "Single error",
+			err:      errors.New("boom"),
+			expected: "- **boom**",
+		},
+		{
+			name:     "Wrapped chain",
+			err:      fmt.Errorf("load settings: %w", fmt.Errorf("open config: %w", fs.ErrPermission)),
+			expected: "- load settings\n  - open config\n    - **permission denied**",
+		},
+		{
+			name:     "Joined errors",
+			err:      fmt.Errorf("cleanup: %w", errors.Join(errors.New("a failed"), errors.New("b failed"))),
+			expected: "- cleanup\n  - 2 errors\n    - **a failed**\n    - **b failed**",
+		},
+		{
+			name:     "Wrapper without own text",
+			err:      fmt.Errorf("%w", errors.New("inner")),
+			expected: "- *fmt.wrapError\n  - **inner**",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatError(tt.err)
+			if got != tt.expected {
+				t.Errorf("FormatError() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatError_Details(t *testing.T) {
+	root := &detailedError{
+		msg:    "connection refused",
+		stack:  "main.dial()\n\tmain.go:12",
+		fields: map[string]any{"port": 5432, "host": "db-1"},
+	}
+	got := FormatError(fmt.Errorf("query users: %w", root))
+
+	expected := "- query users\n  - **connection refused**\n\n" +
+		"```spoiler connection refused\n" +
+		"**host**: db-1\n**port**: 5432\n\n" +
+		"~~~text\nmain.dial()\n\tmain.go:12\n~~~\n```"
+	if got != expected {
+		t.Errorf("FormatError() = %q, want %q", got, expected)
+	}
+
+	if strings.Count(got, "```spoiler") != 1 {
+		t.Errorf("Expected exactly one details spoiler: %q", got)
+	}
+}
@@ -0,0 +1,277 @@
+// Package errreport reports failed calls to a sink such as a Zulip stream.
+// It wraps gRPC unary handlers, plain functions and net/http handlers, and
+// when they return an error, panic or answer with a server error, it builds
+// a markdown report with the method, a redacted summary of the request, the
+// error chain and the stack.
+package errreport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxRequestLength is the number of characters of a request summary included
+// in a report.
+const MaxRequestLength = 1000
+
+// ErrPanic is wrapped by the error Unary returns when the handler panics.
+var ErrPanic = errors.New("panic")
+
+// Sink receives each report, typically posting it to a stream.
+type Sink func(ctx context.Context, report string) error
+
+// Reporter builds reports of failed calls and hands them to its Sink. Its
+// fields must not be changed once it is in use.
+type Reporter struct {
+	Sink Sink
+	// Service names the reporting service in each report; optional.
+	Service string
+	// Ignore, if set, skips errors it returns true for, such as
+	// context.Canceled or expected not-found errors.
+	Ignore func(err error) bool
+	// OnSinkError receives the errors returned by Sink; they are dropped
+	// when it is nil.
+	OnSinkError func(err error)
+}
+
+// New creates a Reporter that hands its reports to sink.
+//
+// Example:
+//
+//	client := zulipapi.NewClient(site, email, key)
+//	rep := errreport.New(func(ctx context.Context, report string) error {
+//		_, err := client.SendStream(ctx, "errors", "orders", report)
+//		return err
+//	})
+func New(sink Sink) *Reporter {
+	return &Reporter{Sink: sink}
+}
+
+// Unary calls handler with req and reports the call if it returns an error
+// or panics. Its signature matches a gRPC unary server interceptor once the
+// method name is taken from the server info, and it works just as well
+// around any other function.
+//
+// Parameters:
+//   - ctx (context.Context): The call context, also passed to the Sink
+//   - req (any): The request, summarized as redacted JSON in the report
+//   - method (string): The full method name, such as "/orders.v1.Orders/Get"
+//   - handler (func(context.Context, any) (any, error)): The handler to call
+//
+// Returns:
+//   - any: The handler's response
+//   - error: The handler's error, or an error wrapping ErrPanic if it panicked
+//
+// Example:
+//
+//	srv := grpc.NewServer(grpc.UnaryInterceptor(func(ctx context.Context, req any,
+//		info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+//		return rep.Unary(ctx, req, info.FullMethod, handler)
+//	}))
+//
+// Notes:
+//   - A panic is recovered and turned into an error, which gRPC returns to
+//     the client with the Unknown code
+//   - Fields whose names look like credentials are redacted from the request
+func (r *Reporter) Unary(ctx context.Context, req any, method string, handler func(ctx context.Context, req any) (any, error)) (resp any, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			r.send(ctx, r.panicReport(method, p, requestBlock(req)))
+			resp, err = nil, fmt.Errorf("%w in %s: %v", ErrPanic, method, p)
+		}
+	}()
+
+	resp, err = handler(ctx, req)
+	if err != nil && (r.Ignore == nil || !r.Ignore(err)) {
+		r.send(ctx, r.errorReport(method, err, requestBlock(req)))
+	}
+	return resp, err
+}
+
+// Middleware wraps an HTTP handler, reporting requests that panic or are
+// answered with a 5xx status.
+//
+// Returns:
+//   - http.Handler: The wrapped handler
+//
+// Example:
+//
+//	http.ListenAndServe(":8080", rep.Middleware(mux))
+//
+// Notes:
+//   - A panic is answered with 500 Internal Server Error unless the handler
+//     already wrote a response; http.ErrAbortHandler is passed on unreported
+//   - The request is rendered with zlmd.FormatHTTPRequest, which redacts
+//     credentials; only the part of the body the handler left unread is shown
+func (r *Reporter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		rec := &statusRecorder{ResponseWriter: w}
+		defer func() {
+			if p := recover(); p != nil {
+				if p == http.ErrAbortHandler {
+					panic(p)
+				}
+				r.send(req.Context(), r.panicReport(req.Method+" "+req.URL.Path, p, zlmd.FormatHTTPRequest(req)))
+				if rec.status == 0 {
+					http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+				}
+			}
+		}()
+
+		next.ServeHTTP(rec, req)
+		if rec.status >= 500 {
+			err := fmt.Errorf("responded %d %s", rec.status, http.StatusText(rec.status))
+			r.send(req.Context(), r.errorReport(req.Method+" "+req.URL.Path, err, zlmd.FormatHTTPRequest(req)))
+		}
+	})
+}
+
+// errorReport renders a call that returned err.
+func (r *Reporter) errorReport(method string, err error, request string) string {
+	var sb strings.Builder
+	r.writeHeader(&sb, method, "failed")
+	sb.WriteString(": ")
+	zlmd.WriteCode(&sb, strings.Join(strings.Fields(err.Error()), " "))
+	sb.WriteString("\n")
+	writeSection(&sb, "Request", request)
+	writeSection(&sb, "Error chain", zlmd.FormatError(err))
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// panicReport renders a call that panicked with p. It must be called from
+// the deferred function that recovered, so the stack includes the panic.
+func (r *Reporter) panicReport(method string, p any, request string) string {
+	var sb strings.Builder
+	r.writeHeader(&sb, method, "panicked")
+	sb.WriteString(": ")
+	zlmd.WriteCode(&sb, fmt.Sprint(p))
+	sb.WriteString("\n")
+	writeSection(&sb, "Request", request)
+	if err, ok := p.(error); ok {
+		writeSection(&sb, "Error chain", zlmd.FormatError(err))
+	}
+	writeSection(&sb, "Stack", zlmd.FormatStack(debug.Stack()))
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// writeHeader writes "❌ **service** `method` outcome".
+func (r *Reporter) writeHeader(sb *strings.Builder, method, outcome string) {
+	sb.WriteString(zlmd.DefaultTheme.Prefix("danger") + " ")
+	if r.Service != "" {
+		zlmd.WriteBold(sb, r.Service)
+		sb.WriteString(" ")
+	}
+	zlmd.WriteCode(sb, method)
+	sb.WriteString(" " + outcome)
+}
+
+// send hands a report to the sink, detached from the call's cancellation so
+// that reports of cancelled calls are still delivered.
+func (r *Reporter) send(ctx context.Context, report string) {
+	if r.Sink == nil {
+		return
+	}
+	if err := r.Sink(context.WithoutCancel(ctx), report); err != nil && r.OnSinkError != nil {
+		r.OnSinkError(err)
+	}
+}
+
+// writeSection writes a bold label followed by body, if body is not blank.
+func writeSection(sb *strings.Builder, label, body string) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return
+	}
+	sb.WriteString("\n")
+	zlmd.WriteBold(sb, label)
+	sb.WriteString("\n" + body + "\n")
+}
+
+// requestBlock summarizes req as redacted JSON in a code block, or "" if req
+// is nil.
+func requestBlock(req any) string {
+	if req == nil {
+		return ""
+	}
+	summary := summarize(req)
+	if runes := []rune(summary); len(runes) > MaxRequestLength {
+		summary = string(runes[:MaxRequestLength]) + "…"
+	}
+	lang := "json"
+	if !json.Valid([]byte(summary)) {
+		lang = "text"
+	}
+	return zlmd.FencedBlock(lang, summary)
+}
+
+// summarize renders req as JSON with credential-like fields redacted,
+// falling back to its Go syntax when it can't be encoded.
+func summarize(req any) string {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return fmt.Sprintf("%+v", req)
+	}
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return string(data)
+	}
+	data, err = json.Marshal(redact(v))
+	if err != nil {
+		return fmt.Sprintf("%+v", req)
+	}
+	return string(data)
+}
+
+// redact replaces the values of credential-like object keys in a decoded
+// JSON value.
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if zlmd.IsSecretName(k) {
+				v[k] = zlmd.Redacted
+			} else {
+				v[k] = redact(val)
+			}
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	}
+	return v
+}
+
+// statusRecorder remembers the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader records code and passes it on.
+func (w *statusRecorder) WriteHeader(code int) {
+	if w.status == 0 {
+		w.status = code
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Write records an implicit 200 status and passes p on.
+func (w *statusRecorder) Write(p []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	return w.ResponseWriter.Write(p)
+}
+
+// Unwrap returns the underlying writer, for http.ResponseController.
+func (w *statusRecorder) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
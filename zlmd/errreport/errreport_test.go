@@ -0,0 +1,169 @@
+package errreport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type getOrder struct {
+	ID       string `json:"id"`
+	APIToken string `json:"api_token"`
+	Items    []struct {
+		Password string `json:"password"`
+	} `json:"items"`
+}
+
+// collect returns a reporter that appends its reports to reports.
+func collect(reports *[]string) *Reporter {
+	r := New(func(ctx context.Context, report string) error {
+		*reports = append(*reports, report)
+		return nil
+	})
+	r.Service = "orders"
+	return r
+}
+
+func TestReporter_Unary(t *testing.T) {
+	var reports []string
+	rep := collect(&reports)
+	rep.Ignore = func(err error) bool { return errors.Is(err, context.Canceled) }
+
+	req := getOrder{ID: "42", APIToken: "s3cret", Items: []struct {
+		Password string `json:"password"`
+	}{{Password: "hunter2"}}}
+	errNotFound := errors.New("not found")
+
+	tests := []struct {
+		name     string
+		handler  func(ctx context.Context, req any) (any, error)
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:    "Success",
+			handler: func(ctx context.Context, req any) (any, error) { return "ok", nil },
+		},
+		{
+			name:    "Ignored",
+			handler: func(ctx context.Context, req any) (any, error) { return nil, context.Canceled },
+			wantErr: true,
+		},
+		{
+			name: "Error",
+			handler: func(ctx context.Context, req any) (any, error) {
+				return nil, fmt.Errorf("load order: %w", errNotFound)
+			},
+			expected: "❌ **orders** `/orders.v1.Orders/Get` failed: `load order: not found`\n\n" +
+				"**Request**\n```json\n{\"api_token\":\"[REDACTED]\",\"id\":\"42\",\"items\":[{\"password\":\"[REDACTED]\"}]}\n```\n\n" +
+				"**Error chain**\n- load order\n  - **not found**",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reports = nil
+			_, err := rep.Unary(context.Background(), req, "/orders.v1.Orders/Get", tt.handler)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Unary() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.expected == "" {
+				if len(reports) != 0 {
+					t.Errorf("Expected no report, got %q", reports)
+				}
+				return
+			}
+			if len(reports) != 1 || reports[0] != tt.expected {
+				t.Errorf("Report = %q, want %q", reports, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReporter_Unary_Panic(t *testing.T) {
+	var reports []string
+	rep := collect(&reports)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var sinkCtxErr error
+	sink := rep.Sink
+	rep.Sink = func(ctx context.Context, report string) error {
+		sinkCtxErr = ctx.Err()
+		return sink(ctx, report)
+	}
+
+	_, err := rep.Unary(ctx, nil, "/orders.v1.Orders/Get", func(ctx context.Context, req any) (any, error) {
+		var m map[string]int
+		m["boom"]++
+		return nil, nil
+	})
+	if !errors.Is(err, ErrPanic) {
+		t.Fatalf("Unary() error = %v, want %v", err, ErrPanic)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("Expected one report, got %d", len(reports))
+	}
+	if !strings.HasPrefix(reports[0], "❌ **orders** `/orders.v1.Orders/Get` panicked: `assignment to entry in nil map`\n") {
+		t.Errorf("Unexpected header: %q", reports[0])
+	}
+	if !strings.Contains(reports[0], "**Stack**\n") || !strings.Contains(reports[0], "TestReporter_Unary_Panic") {
+		t.Errorf("Expected the panicking frame in the stack, got %q", reports[0])
+	}
+	if strings.Contains(reports[0], "**Request**") {
+		t.Errorf("Expected no request section for a nil request, got %q", reports[0])
+	}
+	if sinkCtxErr != nil {
+		t.Errorf("Expected the sink context to outlive the call, got %v", sinkCtxErr)
+	}
+}
+
+func TestReporter_Middleware(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/ok", func(w http.ResponseWriter, r *http.Request) { w.Write([]byte("ok")) })
+	mux.HandleFunc("/down", func(w http.ResponseWriter, r *http.Request) { w.WriteHeader(http.StatusBadGateway) })
+	mux.HandleFunc("/panic", func(w http.ResponseWriter, r *http.Request) { panic("boom") })
+
+	tests := []struct {
+		path     string
+		status   int
+		expected string
+	}{
+		{"/ok?token=abc", http.StatusOK, ""},
+		{"/down?token=abc", http.StatusBadGateway, "❌ **orders** `GET /down` failed: `responded 502 Bad Gateway`\n\n**Request**\n**GET** `/down?token=[REDACTED]`"},
+		{"/panic", http.StatusInternalServerError, "❌ **orders** `GET /panic` panicked: `boom`\n\n**Request**\n**GET** `/panic`\n\n**Stack**\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			var reports []string
+			rec := httptest.NewRecorder()
+			collect(&reports).Middleware(mux).ServeHTTP(rec, httptest.NewRequest("GET", tt.path, nil))
+
+			if rec.Code != tt.status {
+				t.Errorf("Status = %d, want %d", rec.Code, tt.status)
+			}
+			if tt.expected == "" {
+				if len(reports) != 0 {
+					t.Errorf("Expected no report, got %q", reports)
+				}
+				return
+			}
+			if len(reports) != 1 || !strings.HasPrefix(reports[0], tt.expected) {
+				t.Errorf("Report = %q, want prefix %q", reports, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRequestBlock_Truncation(t *testing.T) {
+	got := requestBlock(map[string]string{"note": strings.Repeat("a", MaxRequestLength)})
+	if !strings.HasPrefix(got, "```text\n") || !strings.HasSuffix(got, "…\n```") {
+		t.Errorf("requestBlock() = %q, want a truncated text block", got)
+	}
+}
@@ -1,9 +1,16 @@
 package zlmd
 
 import (
+	"fmt"
 	"strings"
+	"unicode"
 )
 
+// useLegacyEscaper makes _EscapeMarkdown use the original line-splitting
+// implementation instead of the single-pass scanner. The two produce the same
+// output; the legacy one is kept to compare against.
+var useLegacyEscaper = false
+
 // EscapeMarkdown processes a Markdown string to escape nested code fences
 // within spoiler blocks (` ```spoiler ... ``` `).
 // It replaces the inner ` ``` ` fences with ` ~~~ `.
@@ -17,6 +24,86 @@ import (
 //   - bool: True if the processing was successful (input was valid or required no changes),
 //     False if the structure was invalid (e.g., unbalanced fences).
 func _EscapeMarkdown(markdown string) (string, bool) {
+	if useLegacyEscaper {
+		return escapeMarkdownLegacy(markdown)
+	}
+	return scanSpoilerFences(markdown)
+}
+
+// scanSpoilerFences is _EscapeMarkdown as a single pass over the input,
+// writing into one preallocated builder instead of splitting it into lines.
+//
+// It reproduces escapeMarkdownLegacy exactly, whose outputs are recorded in
+// testdata/escaper_oracle.json, including its quirks:
+//   - The opening line of a spoiler loses its indentation, and the newline
+//     after its closing line is dropped
+//   - A spoiler whose closing fence is indented, or that contains any other
+//     line starting with a fence, makes the input invalid
+func scanSpoilerFences(markdown string) (string, bool) {
+	var sb strings.Builder
+	sb.Grow(len(markdown))
+	inSpoiler := false
+	inTopLevelCode := false
+
+	for start := 0; ; {
+		end := strings.IndexByte(markdown[start:], '\n')
+		last := end < 0
+		if last {
+			end = len(markdown)
+		} else {
+			end += start
+		}
+		line := markdown[start:end]
+		trimmedLine := strings.TrimSpace(line)
+
+		switch {
+		case inSpoiler:
+			if trimmedLine == "```" {
+				if strings.TrimRightFunc(line, unicode.IsSpace) != "```" {
+					return markdown, false
+				}
+				sb.WriteString("```")
+				inSpoiler = false
+			} else if strings.HasPrefix(trimmedLine, "```") {
+				return markdown, false
+			} else {
+				sb.WriteString(line)
+				sb.WriteByte('\n')
+			}
+		case inTopLevelCode:
+			sb.WriteString(line)
+			if !last {
+				sb.WriteByte('\n')
+			}
+			if trimmedLine == "```" {
+				inTopLevelCode = false
+			}
+		case strings.HasPrefix(trimmedLine, "```spoiler"):
+			inSpoiler = true
+			sb.WriteString(strings.TrimLeftFunc(line, unicode.IsSpace))
+			sb.WriteByte('\n')
+		default:
+			inTopLevelCode = strings.HasPrefix(trimmedLine, "```")
+			sb.WriteString(line)
+			if !last {
+				sb.WriteByte('\n')
+			}
+		}
+
+		if last {
+			break
+		}
+		start = end + 1
+	}
+
+	if inSpoiler || inTopLevelCode {
+		return markdown, false
+	}
+	return sb.String(), true
+}
+
+// escapeMarkdownLegacy is the original implementation of _EscapeMarkdown.
+func escapeMarkdownLegacy(markdown string) (string, bool) {
 	var result strings.Builder
 	var spoilerContent strings.Builder // Temporarily holds content within a spoiler block
 	lines := strings.Split(markdown, "\n")
@@ -190,3 +277,172 @@ func processSpoilerContent(spoilerBlock string) (string, bool) {
 
 	return finalSpoilerResult, true
 }
+
+// EscapePolicy selects which markdown constructs Escape neutralizes in
+// untrusted text before it is interpolated into a message.
+type EscapePolicy int
+
+const (
+	// EscapeNone leaves text unchanged.
+	EscapeNone EscapePolicy = iota
+	// EscapeFences neutralizes code fence lines so the text cannot close or
+	// open fenced blocks around it.
+	EscapeFences
+	// EscapeMentions neutralizes user, group and wildcard mentions so the
+	// text cannot notify anyone.
+	EscapeMentions
+	// EscapeAll escapes every markdown metacharacter, rendering the text
+	// literally. It implies EscapeFences and EscapeMentions.
+	EscapeAll
+)
+
+// String returns the policy name accepted by ParseEscapePolicy.
+func (p EscapePolicy) String() string {
+	switch p {
+	case EscapeFences:
+		return "fences"
+	case EscapeMentions:
+		return "mentions"
+	case EscapeAll:
+		return "all"
+	default:
+		return "none"
+	}
+}
+
+// ParseEscapePolicy parses a policy name: "none", "fences", "mentions" or "all".
+func ParseEscapePolicy(name string) (EscapePolicy, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "none", "":
+		return EscapeNone, nil
+	case "fences":
+		return EscapeFences, nil
+	case "mentions":
+		return EscapeMentions, nil
+	case "all":
+		return EscapeAll, nil
+	default:
+		return EscapeNone, fmt.Errorf("unknown escape policy %q", name)
+	}
+}
+
+// escapeAllChars are the characters EscapeAll prefixes with a backslash.
+const escapeAllChars = "\\`*_[]()<>|~$"
+
+// Escape neutralizes markdown in untrusted text according to policy.
+//
+// Parameters:
+//   - text (string): The untrusted text
+//   - policy (EscapePolicy): Which constructs to neutralize
+//
+// Returns:
+//   - string: The escaped text
+//
+// Example:
+//
+//	Escape("ping @**all** now", EscapeMentions)
+//	// "ping @\*\*all\*\* now"
+//
+//	Escape("```\nbreak out", EscapeFences)
+//	// "\```\nbreak out"
+//
+//	Escape("*not bold*", EscapeAll)
+//	// "\*not bold\*"
+//
+// Notes:
+//   - Escaping uses markdown backslash escapes, so the text renders as typed
+//   - EscapeAll also escapes "#", "-", "+" and ">" at the start of a line
+func Escape(text string, policy EscapePolicy) string {
+	switch policy {
+	case EscapeFences:
+		return escapeFences(text)
+	case EscapeMentions:
+		return escapeMentions(text)
+	case EscapeAll:
+		return escapeAll(text)
+	default:
+		return text
+	}
+}
+
+// escapeFences prefixes fence markers at the start of a line with a backslash.
+func escapeFences(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		indent := len(line) - len(trimmed)
+		if indent <= 3 && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")) {
+			lines[i] = line[:indent] + "\\" + trimmed
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// escapeMentions backslash-escapes the asterisks of every mention, such as
+// "@**Name**", "@_**Name**" or "@*group*", so it renders literally.
+func escapeMentions(text string) string {
+	if !strings.Contains(text, "@") {
+		return text
+	}
+
+	var sb strings.Builder
+	sb.Grow(len(text) + 8)
+	for i := 0; i < len(text); {
+		if text[i] != '@' {
+			sb.WriteByte(text[i])
+			i++
+			continue
+		}
+
+		j := i + 1
+		silent := j < len(text) && text[j] == '_'
+		if silent {
+			j++
+		}
+		stars := 0
+		for j+stars < len(text) && stars < 2 && text[j+stars] == '*' {
+			stars++
+		}
+		if stars == 0 {
+			sb.WriteByte('@')
+			i++
+			continue
+		}
+
+		sb.WriteByte('@')
+		if silent {
+			sb.WriteString("\\_")
+		}
+		marker := strings.Repeat("*", stars)
+		escaped := strings.Repeat("\\*", stars)
+		sb.WriteString(escaped)
+		j += stars
+
+		// Escape the matching closer when it is on the same line.
+		rest := text[j:]
+		if end := strings.Index(rest, marker); end >= 0 && !strings.Contains(rest[:end], "\n") {
+			sb.WriteString(rest[:end])
+			sb.WriteString(escaped)
+			j += end + stars
+		}
+		i = j
+	}
+	return sb.String()
+}
+
+// escapeAll backslash-escapes every markdown metacharacter.
+func escapeAll(text string) string {
+	var sb strings.Builder
+	sb.Grow(len(text) + len(text)/8)
+	lineStart := true
+	for _, r := range text {
+		if lineStart && strings.ContainsRune("#-+>", r) {
+			sb.WriteByte('\\')
+		} else if strings.ContainsRune(escapeAllChars, r) {
+			sb.WriteByte('\\')
+		}
+		sb.WriteRune(r)
+		lineStart = r == '\n' || (lineStart && r == ' ')
+	}
+	return sb.String()
+}
@@ -0,0 +1,127 @@
+package zlmd
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		policy   EscapePolicy
+		expected string
+	}{
+		{"None", "*x* @**all**", EscapeNone, "*x* @**all**"},
+		{"Fences", "```go\ncode\n  ~~~\n    ```", EscapeFences, "\\```go\ncode\n  \\~~~\n    ```"},
+		{"User mention", "hi @**Jane Doe|12**!", EscapeMentions, "hi @\\*\\*Jane Doe|12\\*\\*!"},
+		{"Silent mention", "@_**Bob**", EscapeMentions, "@\\_\\*\\*Bob\\*\\*"},
+		{"Group mention", "@*support* ok", EscapeMentions, "@\\*support\\* ok"},
+		{"Unclosed mention", "@**all\nrest**", EscapeMentions, "@\\*\\*all\nrest**"},
+		{"Plain at sign", "mail me@example.com", EscapeMentions, "mail me@example.com"},
+		{"All", "*bold* [x](y) `c`", EscapeAll, "\\*bold\\* \\[x\\]\\(y\\) \\`c\\`"},
+		{"All line starts", "# h\n- item\n  > q", EscapeAll, "\\# h\n\\- item\n  \\> q"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Escape(tt.text, tt.policy)
+			if got != tt.expected {
+				t.Errorf("Escape() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseEscapePolicy(t *testing.T) {
+	for _, p := range []EscapePolicy{EscapeNone, EscapeFences, EscapeMentions, EscapeAll} {
+		got, err := ParseEscapePolicy(p.String())
+		if err != nil || got != p {
+			t.Errorf("ParseEscapePolicy(%q) = %v, %v", p.String(), got, err)
+		}
+	}
+	if _, err := ParseEscapePolicy("bogus"); err == nil {
+		t.Error("Expected error for unknown policy")
+	}
+}
+
+// escaperCase is an input of _EscapeMarkdown with the output of the
+// original line-splitting implementation, which scanSpoilerFences must
+// reproduce bug for bug.
+type escaperCase struct {
+	Input  string `json:"input"`
+	Output string `json:"output"`
+	OK     bool   `json:"ok"`
+}
+
+// loadEscaperOracle reads the recorded outputs of the original
+// implementation.
+func loadEscaperOracle(tb testing.TB) []escaperCase {
+	tb.Helper()
+	data, err := os.ReadFile("testdata/escaper_oracle.json")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	var cases []escaperCase
+	if err := json.Unmarshal(data, &cases); err != nil {
+		tb.Fatal(err)
+	}
+	return cases
+}
+
+func TestEscapeMarkdown_Oracle(t *testing.T) {
+	t.Cleanup(func() { useLegacyEscaper = false })
+	for _, legacy := range []bool{false, true} {
+		useLegacyEscaper = legacy
+		for _, tt := range loadEscaperOracle(t) {
+			t.Run(escaperName(legacy)+"/"+tt.Input, func(t *testing.T) {
+				got, gotOK := _EscapeMarkdown(tt.Input)
+				if got != tt.Output || gotOK != tt.OK {
+					t.Errorf("_EscapeMarkdown() = %q, %v, want %q, %v", got, gotOK, tt.Output, tt.OK)
+				}
+			})
+		}
+	}
+}
+
+func FuzzScanSpoilerFences(f *testing.F) {
+	for _, tt := range loadEscaperOracle(f) {
+		f.Add(tt.Input)
+	}
+	f.Fuzz(func(t *testing.T, input string) {
+		got, gotOK := scanSpoilerFences(input)
+		expected, expectedOK := escapeMarkdownLegacy(input)
+		if got != expected || gotOK != expectedOK {
+			t.Errorf("scanSpoilerFences(%q) = %q, %v, want %q, %v", input, got, gotOK, expected, expectedOK)
+		}
+	})
+}
+
+func BenchmarkEscapeMarkdown(b *testing.B) {
+	block := "Some text before the logs.\n```spoiler Build log\n" +
+		strings.Repeat("2024-05-01T12:00:00Z step completed successfully\n", 50) +
+		"```\n```go\nfmt.Println(\"hi\")\n```\n"
+	input := strings.Repeat(block, 1000) // about 2.5 MB
+
+	for _, legacy := range []bool{true, false} {
+		b.Run(escaperName(legacy), func(b *testing.B) {
+			useLegacyEscaper = legacy
+			defer func() { useLegacyEscaper = false }()
+			b.SetBytes(int64(len(input)))
+			b.ReportAllocs()
+			for b.Loop() {
+				_EscapeMarkdown(input)
+			}
+		})
+	}
+}
+
+// escaperName names the implementation _EscapeMarkdown uses.
+func escaperName(legacy bool) string {
+	if legacy {
+		return "legacy"
+	}
+	return "scanner"
+}
@@ -0,0 +1,287 @@
+package zlmd
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MentionRef is a user, group or wildcard mention found by ExtractMentions.
+type MentionRef struct {
+	// Text is the mention as written, such as "@_**Alice|12**".
+	Text string `json:"text"`
+	// Name is the mentioned user, group or wildcard name.
+	Name string `json:"name"`
+	// UserID is the ID given with the "@**Name|ID**" syntax, or 0.
+	UserID int `json:"user_id,omitempty"`
+	// Silent is true for "@_" mentions, which don't notify.
+	Silent bool `json:"silent,omitempty"`
+	// Group is true for user group mentions ("@*group*").
+	Group bool `json:"group,omitempty"`
+	// Wildcard is true for @**all**, @**everyone**, @**channel**,
+	// @**stream** and @**topic**.
+	Wildcard bool `json:"wildcard,omitempty"`
+}
+
+// LinkRef is a link found by ExtractLinks.
+type LinkRef struct {
+	// Text is the link text, or "" for bare and angle-bracket URLs.
+	Text string `json:"text,omitempty"`
+	URL  string `json:"url"`
+}
+
+// FencedCode is a fenced code block found by ExtractCode.
+type FencedCode struct {
+	// Language is the info string after the opening fence, such as "go" or
+	// "spoiler Logs".
+	Language string `json:"language,omitempty"`
+	Code     string `json:"code"`
+}
+
+// TableData is a markdown table found by ExtractTables.
+type TableData struct {
+	Headers []string   `json:"headers"`
+	Rows    [][]string `json:"rows"`
+}
+
+var (
+	mentionPattern      = regexp.MustCompile(`(^|[^\\])@(_?)(?:\*\*([^*\n]+)\*\*|\*([^*\n]+)\*)`)
+	markdownLinkPattern = regexp.MustCompile(`\[([^\]\n]*)\]\(([^()\s]+)\)`)
+	angleLinkPattern    = regexp.MustCompile(`<(https?://[^>\s]+)>`)
+	bareLinkPattern     = regexp.MustCompile(`https?://[^\s<>()]+`)
+	inlineCodePattern   = regexp.MustCompile("`+[^`\n]*`+")
+)
+
+// wildcardMentions are the names Zulip treats as wildcard mentions.
+var wildcardMentions = map[string]bool{
+	"all": true, "everyone": true, "channel": true, "stream": true, "topic": true,
+}
+
+// ExtractMentions returns the mentions in markdown, in document order,
+// including those inside spoilers and quotes. Mentions inside code blocks,
+// inline code and escaped mentions are ignored.
+//
+// Example:
+//
+//	ExtractMentions("cc @**Alice|12** and @*oncall*")
+//	// []MentionRef{
+//	//	{Text: "@**Alice|12**", Name: "Alice", UserID: 12},
+//	//	{Text: "@*oncall*", Name: "oncall", Group: true},
+//	// }
+func ExtractMentions(markdown string) []MentionRef {
+	var mentions []MentionRef
+	for _, line := range proseLines(markdown) {
+		for _, m := range mentionPattern.FindAllStringSubmatch(line, -1) {
+			mention := MentionRef{Text: m[0][len(m[1]):], Silent: m[2] == "_"}
+			if m[3] != "" {
+				mention.Name = m[3]
+				if name, id, ok := strings.Cut(m[3], "|"); ok {
+					if n, err := strconv.Atoi(id); err == nil {
+						mention.Name, mention.UserID = name, n
+					}
+				}
+				mention.Wildcard = wildcardMentions[mention.Name]
+			} else {
+				mention.Name, mention.Group = m[4], true
+			}
+			mentions = append(mentions, mention)
+		}
+	}
+	return mentions
+}
+
+// ExtractLinks returns the links in markdown, in document order: inline
+// "[text](url)" links, "<url>" autolinks and bare http(s) URLs, including
+// those inside spoilers and quotes. Links inside code are ignored.
+//
+// Example:
+//
+//	ExtractLinks("See [docs](https://example.com/docs) or https://example.com.")
+//	// []LinkRef{{Text: "docs", URL: "https://example.com/docs"}, {URL: "https://example.com"}}
+func ExtractLinks(markdown string) []LinkRef {
+	type found struct {
+		at   int
+		link LinkRef
+	}
+
+	var links []LinkRef
+	for _, line := range proseLines(markdown) {
+		var matches []found
+		blank := func(loc []int) {
+			line = line[:loc[0]] + strings.Repeat(" ", loc[1]-loc[0]) + line[loc[1]:]
+		}
+
+		for _, loc := range markdownLinkPattern.FindAllStringSubmatchIndex(line, -1) {
+			matches = append(matches, found{loc[0], LinkRef{line[loc[2]:loc[3]], line[loc[4]:loc[5]]}})
+			blank(loc)
+		}
+		for _, loc := range angleLinkPattern.FindAllStringSubmatchIndex(line, -1) {
+			matches = append(matches, found{loc[0], LinkRef{URL: line[loc[2]:loc[3]]}})
+			blank(loc)
+		}
+		for _, loc := range bareLinkPattern.FindAllStringIndex(line, -1) {
+			url := strings.TrimRight(line[loc[0]:loc[1]], ".,;:!?'\"")
+			matches = append(matches, found{loc[0], LinkRef{URL: url}})
+		}
+
+		// Restore document order across the three patterns.
+		for i := 1; i < len(matches); i++ {
+			for j := i; j > 0 && matches[j].at < matches[j-1].at; j-- {
+				matches[j], matches[j-1] = matches[j-1], matches[j]
+			}
+		}
+		for _, m := range matches {
+			links = append(links, m.link)
+		}
+	}
+	return links
+}
+
+// ExtractCode returns the top-level fenced code blocks in markdown, including
+// spoiler, quote and other Zulip blocks using fence syntax.
+//
+// Example:
+//
+//	ExtractCode("Run:\n```sh\nmake test\n```")
+//	// []FencedCode{{Language: "sh", Code: "make test"}}
+func ExtractCode(markdown string) []FencedCode {
+	var blocks []FencedCode
+	var current *FencedCode
+	var fence string
+	var lines []string
+
+	for _, line := range splitLines(markdown) {
+		trimmed := strings.TrimLeft(line, " ")
+		if current == nil {
+			if f := openingFence(trimmed); f != "" {
+				fence = f
+				current = &FencedCode{Language: strings.TrimSpace(trimmed[len(f):])}
+				lines = nil
+			}
+			continue
+		}
+		if isClosingFence(trimmed, fence) {
+			current.Code = strings.Join(lines, "\n")
+			blocks = append(blocks, *current)
+			current = nil
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if current != nil {
+		// Zulip closes an unterminated fence at the end of the message.
+		current.Code = strings.Join(lines, "\n")
+		blocks = append(blocks, *current)
+	}
+	return blocks
+}
+
+// ExtractTables returns the tables in markdown outside code blocks,
+// including those inside spoilers and quotes. Escaped pipes ("\|") in cells
+// are unescaped.
+//
+// Example:
+//
+//	ExtractTables("| a | b |\n| --- | --- |\n| 1 | 2 |")
+//	// []TableData{{Headers: []string{"a", "b"}, Rows: [][]string{{"1", "2"}}}}
+func ExtractTables(markdown string) []TableData {
+	var tables []TableData
+	lines := proseLines(markdown)
+	for i := 0; i+1 < len(lines); i++ {
+		if !strings.Contains(lines[i], "|") || !isTableDelimiter(lines[i+1]) {
+			continue
+		}
+		table := TableData{Headers: splitTableRow(lines[i]), Rows: [][]string{}}
+		i += 2
+		for ; i < len(lines) && strings.Contains(lines[i], "|"); i++ {
+			table.Rows = append(table.Rows, splitTableRow(lines[i]))
+		}
+		tables = append(tables, table)
+	}
+	return tables
+}
+
+// proseLines returns the lines of markdown that Zulip renders as markdown,
+// with inline code spans blanked out. Code blocks are replaced by a single
+// empty line so that they still separate the surrounding text; spoiler and
+// quote blocks are replaced by the prose lines of their body, between
+// empty lines.
+func proseLines(markdown string) []string {
+	var lines, body []string
+	var fence, language string
+	for _, line := range splitLines(markdown) {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if isClosingFence(trimmed, fence) {
+				lines = append(lines, proseBody(language, body)...)
+				fence, body = "", nil
+			} else {
+				body = append(body, line)
+			}
+			continue
+		}
+		if f := openingFence(trimmed); f != "" {
+			fence, language = f, strings.TrimSpace(trimmed[len(f):])
+			continue
+		}
+		lines = append(lines, inlineCodePattern.ReplaceAllStringFunc(line, func(code string) string {
+			return strings.Repeat(" ", len(code))
+		}))
+	}
+	if fence != "" {
+		// Zulip closes an unterminated fence at the end of the message.
+		lines = append(lines, proseBody(language, body)...)
+	}
+	return lines
+}
+
+// proseBody returns the lines proseLines puts in place of a fenced block
+// with the given info string and body.
+func proseBody(language string, body []string) []string {
+	if !isProseBlock(language) {
+		return []string{""}
+	}
+	lines := append([]string{""}, proseLines(strings.Join(body, "\n"))...)
+	return append(lines, "")
+}
+
+// isTableDelimiter reports whether line is a table delimiter row such as
+// "| --- | :---: |".
+func isTableDelimiter(line string) bool {
+	cells := splitTableRow(line)
+	if len(cells) == 0 {
+		return false
+	}
+	for _, cell := range cells {
+		cell = strings.Trim(cell, ":")
+		if cell == "" || strings.Trim(cell, "-") != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// splitTableRow splits a table row into trimmed cells, honouring "\|".
+func splitTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	if strings.HasSuffix(line, "|") && !strings.HasSuffix(line, `\|`) {
+		line = line[:len(line)-1]
+	}
+
+	var cells []string
+	var cell strings.Builder
+	for i := 0; i < len(line); i++ {
+		switch {
+		case line[i] == '\\' && i+1 < len(line) && line[i+1] == '|':
+			cell.WriteByte('|')
+			i++
+		case line[i] == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteByte(line[i])
+		}
+	}
+	return append(cells, strings.TrimSpace(cell.String()))
+}
@@ -0,0 +1,132 @@
+package zlmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMentions(t *testing.T) {
+	markdown := "cc @**Alice|12**, @_**Bob** and @*oncall*\n" +
+		"heads up @**all** but not `@**code**` or \\@**escaped** or @\\*\\*neutralized\\*\\*\n" +
+		"```\n@**in block**\n```\n" +
+		"mail me@example.com"
+
+	expected := []MentionRef{
+		{Text: "@**Alice|12**", Name: "Alice", UserID: 12},
+		{Text: "@_**Bob**", Name: "Bob", Silent: true},
+		{Text: "@*oncall*", Name: "oncall", Group: true},
+		{Text: "@**all**", Name: "all", Wildcard: true},
+	}
+	got := ExtractMentions(markdown)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractMentions() = %+v, want %+v", got, expected)
+	}
+}
+
+func TestExtractLinks(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected []LinkRef
+	}{
+		{"None", "plain text", nil},
+		{"Inline and bare", "See [docs](https://example.com/docs) or https://example.com.", []LinkRef{
+			{Text: "docs", URL: "https://example.com/docs"},
+			{URL: "https://example.com"},
+		}},
+		{"Angle before inline", "<https://a.example> then [b](https://b.example)", []LinkRef{
+			{URL: "https://a.example"},
+			{Text: "b", URL: "https://b.example"},
+		}},
+		{"Code ignored", "`https://x.example`\n```\nhttps://y.example\n```", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractLinks(tt.markdown)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractLinks() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExtractCode(t *testing.T) {
+	markdown := "Run:\n```sh\nmake test\n```\n\n````spoiler Logs\n```\nnested\n```\n````\n~~~\nunterminated"
+
+	expected := []FencedCode{
+		{Language: "sh", Code: "make test"},
+		{Language: "spoiler Logs", Code: "```\nnested\n```"},
+		{Code: "unterminated"},
+	}
+	got := ExtractCode(markdown)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractCode() = %q, want %q", got, expected)
+	}
+}
+
+func TestExtractTables(t *testing.T) {
+	markdown := "Intro | not a table\n\n" +
+		"| a | b |\n| :--- | ---: |\n| 1 | x\\|y |\n| 2 | 3 |\n\n" +
+		"```\n| c |\n| --- |\n```\n" +
+		"only | header\n--- | ---"
+
+	expected := []TableData{
+		{Headers: []string{"a", "b"}, Rows: [][]string{{"1", "x|y"}, {"2", "3"}}},
+		{Headers: []string{"only", "header"}, Rows: [][]string{}},
+	}
+	got := ExtractTables(markdown)
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("ExtractTables() = %q, want %q", got, expected)
+	}
+}
+
+func TestExtract_ProseBlocks(t *testing.T) {
+	markdown := "````spoiler Details\n@**all** see [run](https://ci.example/1)\n" +
+		"| a |\n| --- |\n| 1 |\n```\n@**in code** https://code.example\n```\n````\n" +
+		"```quote\n@*oncall* <https://quote.example>\n```\n" +
+		"```python\n@**not prose** https://py.example\n```"
+
+	mentions := []MentionRef{
+		{Text: "@**all**", Name: "all", Wildcard: true},
+		{Text: "@*oncall*", Name: "oncall", Group: true},
+	}
+	if got := ExtractMentions(markdown); !reflect.DeepEqual(got, mentions) {
+		t.Errorf("ExtractMentions() = %+v, want %+v", got, mentions)
+	}
+
+	links := []LinkRef{
+		{Text: "run", URL: "https://ci.example/1"},
+		{URL: "https://quote.example"},
+	}
+	if got := ExtractLinks(markdown); !reflect.DeepEqual(got, links) {
+		t.Errorf("ExtractLinks() = %+v, want %+v", got, links)
+	}
+
+	tables := []TableData{{Headers: []string{"a"}, Rows: [][]string{{"1"}}}}
+	if got := ExtractTables(markdown); !reflect.DeepEqual(got, tables) {
+		t.Errorf("ExtractTables() = %q, want %q", got, tables)
+	}
+}
+
+func TestExtractMentions_ProseBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected []MentionRef
+	}{
+		{"Spoiler", Spoiler("Logs", "ping @**all**"), []MentionRef{{Text: "@**all**", Name: "all", Wildcard: true}}},
+		{"Quote", "```quote\n@**Alice|12** wrote\n```", []MentionRef{{Text: "@**Alice|12**", Name: "Alice", UserID: 12}}},
+		{"Unterminated spoiler", "```spoiler\n@**topic**", []MentionRef{{Text: "@**topic**", Name: "topic", Wildcard: true}}},
+		{"Code in spoiler", "````spoiler\n```\n@**all**\n```\n````", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExtractMentions(tt.markdown)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ExtractMentions(%q) = %+v, want %+v", tt.markdown, got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package zlmd
+
+import "strings"
+
+// UnclosedFence reports a code fence that is never closed, which makes
+// Zulip render the rest of the message as code.
+//
+// Parameters:
+//   - markdown (string): The message
+//
+// Returns:
+//   - int: The one-based line number of the unclosed fence, or 0 if every
+//     fence is closed
+//
+// Example:
+//
+//	UnclosedFence("intro\n```go\nx := 1") // 2
+//	UnclosedFence("```\nx\n```")          // 0
+//
+// Notes:
+//   - A fence is three or more backticks or tildes, indented by spaces at
+//     most; it is closed by a line of at least as many of the same
+//     character and nothing else
+//   - Fences inside a fenced block, such as a code block inside a spoiler,
+//     are part of its content and not checked
+func UnclosedFence(markdown string) int {
+	var fence string
+	opened := 0
+	for i, line := range strings.Split(markdown, "\n") {
+		if next := nextFence(fence, line); next != fence {
+			fence, opened = next, i+1
+		}
+	}
+	if fence != "" {
+		return opened
+	}
+	return 0
+}
+
+// openingFence returns the fence run starting line, or "" if the line doesn't
+// open a fenced block. Leading spaces are ignored.
+func openingFence(line string) string {
+	line = strings.TrimLeft(line, " ")
+	if len(line) < 3 || (line[0] != '`' && line[0] != '~') {
+		return ""
+	}
+	n := 0
+	for n < len(line) && line[n] == line[0] {
+		n++
+	}
+	if n < 3 {
+		return ""
+	}
+	return line[:n]
+}
+
+// isClosingFence reports whether line closes a block opened with fence: a
+// run of at least as many of the fence character and nothing else,
+// surrounding whitespace aside.
+func isClosingFence(line, fence string) bool {
+	line = strings.TrimSpace(line)
+	return strings.HasPrefix(line, fence) && strings.Trim(line, fence[:1]) == ""
+}
+
+// nextFence returns the fence open after line, given the fence open before
+// it: a fence line opens one when none is open, and a closing fence closes
+// it.
+func nextFence(open, line string) string {
+	if open != "" {
+		if isClosingFence(line, open) {
+			return ""
+		}
+		return open
+	}
+	return openingFence(line)
+}
+
+// runFenceLen returns a fence length one longer than the longest run of c in
+// text, and never shorter than three.
+func runFenceLen(text string, c byte) int {
+	longest, run := 0, 0
+	for i := 0; i < len(text); i++ {
+		if text[i] == c {
+			run++
+			if run > longest {
+				longest = run
+			}
+		} else {
+			run = 0
+		}
+	}
+	if longest < 3 {
+		return 3
+	}
+	return longest + 1
+}
@@ -0,0 +1,32 @@
+package zlmd
+
+import "testing"
+
+func TestUnclosedFence(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected int
+	}{
+		{"No fence", "plain text", 0},
+		{"Closed", "```go\nx\n```", 0},
+		{"Unclosed", "intro\n```go\nx := 1", 2},
+		{"Tilde", "~~~\nx", 1},
+		{"Closed by tilde", "~~~\nx\n~~~~", 0},
+		{"Backticks don't close tildes", "~~~\n```\nx", 1},
+		{"Longer fence", "intro\n````go\n```\nx", 2},
+		{"Indented", "  ```\nx\n  ```", 0},
+		{"Trailing whitespace", "```\nx\n```  \r", 0},
+		{"Text after closing fence", "```\nx\n``` y", 1},
+		{"Nested fence", "````spoiler x\n```\ny\n````", 0},
+		{"Two backticks", "``\nx", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnclosedFence(tt.markdown); got != tt.expected {
+				t.Errorf("UnclosedFence(%q) = %d, want %d", tt.markdown, got, tt.expected)
+			}
+		})
+	}
+}
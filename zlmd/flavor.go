@@ -0,0 +1,80 @@
+package zlmd
+
+import "strings"
+
+// Flavor selects the markdown dialect builders write.
+type Flavor int
+
+const (
+	// FlavorZulip uses all of Zulip's markdown.
+	FlavorZulip Flavor = iota
+	// FlavorPortable keeps to the syntax Zulip and GitHub Flavored Markdown
+	// render alike, so output generated once, such as release notes, can be
+	// posted to Zulip and pasted into GitHub. Spoilers become block quotes
+	// and <time:> tags are written out in UTC.
+	FlavorPortable
+)
+
+// WithFlavor sets the markdown dialect of generated output.
+//
+// Example:
+//
+//	notes := NewJobReport("release", WithFlavor(FlavorPortable))
+func WithFlavor(flavor Flavor) Option {
+	return func(o *options) {
+		o.flavor = flavor
+	}
+}
+
+// renderTarget is where generated markdown is shown: a Zulip server of some
+// version, and possibly GitHub as well.
+type renderTarget struct {
+	version ServerVersion
+	flavor  Flavor
+	// locale formats the text that replaces unsupported constructs; nil
+	// means English.
+	locale *Locale
+}
+
+// supports reports whether the target renders feature f.
+func (t renderTarget) supports(f Feature) bool {
+	if t.flavor == FlavorPortable {
+		// Every Feature is Zulip-specific syntax GitHub doesn't render.
+		return false
+	}
+	return t.version.Supports(f)
+}
+
+// target returns the render target selected by the options.
+func (o *options) target() renderTarget {
+	return renderTarget{version: o.targetVersion, flavor: o.flavor, locale: o.locale}
+}
+
+// defaultTarget returns the render target of the default Config, used by
+// the functions that take no options.
+func defaultTarget() renderTarget {
+	if cfg := defaultConfig.Load(); cfg != nil {
+		return renderTarget{version: cfg.TargetVersion, flavor: cfg.Flavor, locale: cfg.Locale}
+	}
+	return renderTarget{}
+}
+
+// spoilerBlock returns a spoiler fenced with FencedBlock, or its fallback
+// for targets that don't render spoilers.
+func spoilerBlock(heading, text string, t renderTarget) string {
+	if !t.supports(FeatureSpoiler) {
+		return quotedSpoiler(heading, text)
+	}
+	return FencedBlock("spoiler "+heading, text)
+}
+
+// quotedSpoiler renders a spoiler as a block quote with the heading in bold,
+// which every Zulip version and GitHub render, for targets that don't
+// render spoilers.
+func quotedSpoiler(heading, text string) string {
+	text = strings.TrimSuffix(text, "\n")
+	if heading != "" {
+		text = Bold(heading) + "\n" + text
+	}
+	return "> " + strings.ReplaceAll(text, "\n", "\n> ")
+}
@@ -0,0 +1,52 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFlavorPortable(t *testing.T) {
+	SetDefaultConfig(Config{Flavor: FlavorPortable})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	at := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Spoiler", Spoiler("Changes", "- fix\n```go\nx\n```"), "> **Changes**\n> - fix\n> ```go\n> x\n> ```"},
+		{"SpoilerEscapedTilde", SpoilerEscapedTilde("", "hidden\n"), "> hidden"},
+		{"ZLFormatTime", ZLFormatTime(at), "2024-05-15 14:30 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithFlavor(t *testing.T) {
+	start := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	report := func(opts ...Option) string {
+		return NewJobReport("release", opts...).Start(start).Output("built").Finish(start.Add(time.Minute), 1).Build()
+	}
+
+	got := report(WithFlavor(FlavorPortable))
+	for _, zulipOnly := range []string{"<time:", "```spoiler"} {
+		if strings.Contains(got, zulipOnly) {
+			t.Errorf("portable report contains %q:\n%s", zulipOnly, got)
+		}
+	}
+	if !strings.Contains(got, "> **Output**") {
+		t.Errorf("portable report has no quoted output:\n%s", got)
+	}
+
+	if got := report(); !strings.Contains(got, "```spoiler Output") {
+		t.Errorf("Zulip report has no spoiler:\n%s", got)
+	}
+}
@@ -0,0 +1,211 @@
+package zlmd
+
+import "strings"
+
+// FlowStyle selects how a Flow renders.
+type FlowStyle int
+
+const (
+	// FlowStyleList renders steps as list items prefixed with arrows, with
+	// branches nested below the step they leave from.
+	FlowStyleList FlowStyle = iota
+	// FlowStyleDiagram renders steps one below the other in a code block,
+	// joined by "↓" and with branches drawn beside them.
+	FlowStyleDiagram
+)
+
+// flowStep is a step of a Flow and the edge leading to it.
+type flowStep struct {
+	label    string
+	text     string
+	branches []flowBranch
+}
+
+// flowBranch is a flow that leaves a step besides the main one.
+type flowBranch struct {
+	label string
+	flow  *Flow
+}
+
+// Flow builds a sequence of steps, such as the stages of a pipeline, with
+// labeled edges and branches.
+//
+// It replaces chains written with Right, whose steps must fit on one line
+// and can't branch.
+type Flow struct {
+	steps []flowStep
+	style FlowStyle
+	opts  options
+}
+
+// NewFlow creates an empty flow using the list style.
+//
+// Parameters:
+//   - opts (...Option): Optional settings; WithTheme selects the arrow of the
+//     list style, and WithLanguage the code block language of the diagram
+//     style
+//
+// Returns:
+//   - *Flow: A new initialized Flow instance
+//
+// Example:
+//
+//	rollback := NewFlow().Step("notify").Step("rollback")
+//	flow := NewFlow().
+//	  Step("build").
+//	  Step("test").
+//	  Branch("failed", rollback).
+//	  LabeledStep("passed", "deploy")
+//	// flow.Build() will be:
+//	// - build
+//	// - → test
+//	//   - *failed* → notify
+//	//   - → rollback
+//	// - *passed* → deploy
+func NewFlow(opts ...Option) *Flow {
+	return &Flow{
+		steps: []flowStep{},
+		opts:  newOptions(opts...),
+	}
+}
+
+// Step appends a step. Text may span several lines.
+//
+// Returns:
+//   - *Flow: The same Flow instance (for method chaining)
+func (f *Flow) Step(text string) *Flow {
+	return f.LabeledStep("", text)
+}
+
+// LabeledStep appends a step, labeling the edge that leads to it, such as
+// "on success".
+//
+// Returns:
+//   - *Flow: The same Flow instance (for method chaining)
+func (f *Flow) LabeledStep(label, text string) *Flow {
+	f.steps = append(f.steps, flowStep{label: label, text: text})
+	return f
+}
+
+// Branch adds a flow that leaves the last step besides the main one, such
+// as the steps taken on failure. A non-empty label labels the edge to the
+// branch's first step, replacing the label given to that step.
+//
+// Returns:
+//   - *Flow: The same Flow instance (for method chaining)
+//
+// Notes:
+//   - Branch does nothing before the first step is added
+func (f *Flow) Branch(label string, branch *Flow) *Flow {
+	if len(f.steps) == 0 || branch == nil {
+		return f
+	}
+	last := &f.steps[len(f.steps)-1]
+	last.branches = append(last.branches, flowBranch{label: label, flow: branch})
+	return f
+}
+
+// WithStyle sets the rendering style.
+//
+// Returns:
+//   - *Flow: The same Flow instance (for method chaining)
+//
+// Example:
+//
+//	flow.WithStyle(FlowStyleDiagram)
+func (f *Flow) WithStyle(style FlowStyle) *Flow {
+	f.style = style
+	return f
+}
+
+// Build generates the markdown for the flow.
+//
+// Returns:
+//   - string: The rendered flow, or "" for an empty flow
+//
+// Example:
+//
+//	// With FlowStyleDiagram, the flow of NewFlow's example will be:
+//	// ```text
+//	// build
+//	//   ↓
+//	// test
+//	//   ├─ failed
+//	//   │  notify
+//	//   │    ↓
+//	//   │  rollback
+//	//   ↓ passed
+//	// deploy
+//	// ```
+//
+// Notes:
+//   - Branches are rendered in the style and theme of the Flow Build is
+//     called on, whatever their own settings
+func (f *Flow) Build() string {
+	if len(f.steps) == 0 {
+		return ""
+	}
+	if f.style == FlowStyleDiagram {
+		return CodeBlock(f.opts.language, strings.Join(f.diagramLines(), "\n"))
+	}
+
+	var sb strings.Builder
+	f.writeList(&sb, f.opts.theme.Prefix("arrow-right"), 0, false, "")
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (f *Flow) MarshalZulipMarkdown() (string, error) {
+	return f.Build(), nil
+}
+
+// writeList writes the steps as list items nested level deep. entered is
+// true for branches, whose first step is reached by an edge labeled entry.
+func (f *Flow) writeList(sb *strings.Builder, arrow string, level int, entered bool, entry string) {
+	indent := strings.Repeat(" ", len(listPrefix(level)))
+	for i, step := range f.steps {
+		text, label := step.text, step.label
+		if i == 0 && entry != "" {
+			label = entry
+		}
+		switch {
+		case label != "":
+			text = Italic(label) + " " + arrow + " " + text
+		case i > 0 || entered:
+			text = arrow + " " + text
+		}
+		WriteListItem(sb, strings.ReplaceAll(strings.TrimRight(text, "\n"), "\n", newline()+indent), level)
+
+		for _, b := range step.branches {
+			b.flow.writeList(sb, arrow, level+1, true, b.label)
+		}
+	}
+}
+
+// diagramLines returns the lines of the diagram style.
+func (f *Flow) diagramLines() []string {
+	var lines []string
+	for i, step := range f.steps {
+		if i > 0 {
+			lines = append(lines, strings.TrimRight("  ↓ "+step.label, " "))
+		}
+		lines = append(lines, strings.Split(strings.TrimRight(step.text, "\n"), "\n")...)
+
+		for j, b := range step.branches {
+			last := j == len(step.branches)-1 && i == len(f.steps)-1
+			head, body := "  ├─", "  │  "
+			if last {
+				head, body = "  └─", "     "
+			}
+			label := b.label
+			if label == "" && len(b.flow.steps) > 0 {
+				label = b.flow.steps[0].label
+			}
+			lines = append(lines, strings.TrimRight(head+" "+label, " "))
+			for _, line := range b.flow.diagramLines() {
+				lines = append(lines, strings.TrimRight(body+line, " "))
+			}
+		}
+	}
+	return lines
+}
@@ -0,0 +1,69 @@
+package zlmd
+
+import "testing"
+
+func TestFlow_Build(t *testing.T) {
+	pipeline := func() *Flow {
+		rollback := NewFlow().Step("notify").Step("rollback")
+		return NewFlow().
+			Step("build").
+			Step("test").
+			Branch("failed", rollback).
+			LabeledStep("passed", "deploy")
+	}
+
+	tests := []struct {
+		name     string
+		flow     *Flow
+		expected string
+	}{
+		{
+			name:     "Empty",
+			flow:     NewFlow(),
+			expected: "",
+		},
+		{
+			name:     "Chain",
+			flow:     NewFlow().Step("build").Step("test"),
+			expected: "- build\n- → test\n",
+		},
+		{
+			name:     "Branch",
+			flow:     pipeline(),
+			expected: "- build\n- → test\n  - *failed* → notify\n  - → rollback\n- *passed* → deploy\n",
+		},
+		{
+			name:     "Multi-line step",
+			flow:     NewFlow().Step("build").Step("deploy\napi and worker\n"),
+			expected: "- build\n- → deploy\n  api and worker\n",
+		},
+		{
+			name:     "Plain theme",
+			flow:     NewFlow(WithTheme(PlainTheme)).Step("a").LabeledStep("ok", "b"),
+			expected: "- a\n- *ok* -> b\n",
+		},
+		{
+			name:     "Branch before steps",
+			flow:     NewFlow().Branch("x", NewFlow().Step("y")),
+			expected: "",
+		},
+		{
+			name:     "Diagram",
+			flow:     pipeline().WithStyle(FlowStyleDiagram),
+			expected: "```text\nbuild\n  ↓\ntest\n  ├─ failed\n  │  notify\n  │    ↓\n  │  rollback\n  ↓ passed\ndeploy\n```",
+		},
+		{
+			name:     "Diagram ending in branches",
+			flow:     NewFlow(WithLanguage("")).Step("check").Branch("", NewFlow().LabeledStep("bad", "page\noncall")).Branch("good", NewFlow().Step("done")).WithStyle(FlowStyleDiagram),
+			expected: "```\ncheck\n  ├─ bad\n  │  page\n  │  oncall\n  └─ good\n     done\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.flow.Build(); got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package zlmd
+
+import (
+	"fmt"
+	"reflect"
+	"text/template"
+	"time"
+)
+
+// FuncMap returns the zlmd helpers for use in text/template templates.
+//
+// Returns:
+//   - template.FuncMap: A new map, safe to modify, holding:
+//   - bold, italic, code, link, image, heading, quote: inline and block formatting
+//   - codeblock, spoiler: fenced blocks, e.g. {{codeblock "go" .Src}}
+//   - escape: Escape with a policy name, e.g. {{.Title | escape "all"}}
+//   - progress: ProgressBar, e.g. {{progress .Done .Total 10}}
+//   - time: ZLFormatTime for a time.Time or an RFC 3339 string
+//   - bytes, duration, count: HumanBytes, HumanDuration and HumanCount
+//
+// Example:
+//
+//	t := template.Must(template.New("msg").Funcs(FuncMap()).Parse(
+//		"{{bold .Job}} finished: {{count .Rows}} rows, {{bytes .Size}}"))
+//	t.Execute(os.Stdout, map[string]any{"Job": "Backup", "Rows": 12500, "Size": 1536})
+//	// **Backup** finished: 12.5k rows, 1.5 KiB
+//
+// Notes:
+//   - Numeric arguments accept any integer or float type, so values decoded
+//     from JSON work directly; durations may also be given in seconds or as a
+//     time.ParseDuration string such as "1m30s"
+func FuncMap() template.FuncMap {
+	return template.FuncMap{
+		"bold":   Bold,
+		"italic": Italic,
+		"code":   Code,
+		"link":   Link,
+		"image":  Image,
+		"heading": func(level any, text string) (string, error) {
+			n, err := toInt(level)
+			return Heading(n, text), err
+		},
+		"quote": QuoteBlock,
+		"codeblock": func(language, text string) string {
+			return FencedBlock(language, text)
+		},
+		"spoiler": func(heading, text string) string {
+			return spoilerBlock(heading, text, defaultTarget())
+		},
+		"escape": func(policy, text string) (string, error) {
+			p, err := ParseEscapePolicy(policy)
+			return Escape(text, p), err
+		},
+		"progress": func(current, total, width any) (string, error) {
+			values, err := toInts(current, total, width)
+			if err != nil {
+				return "", err
+			}
+			return ProgressBar(values[0], values[1], values[2]), nil
+		},
+		"time": func(t any) (string, error) {
+			switch t := t.(type) {
+			case time.Time:
+				return ZLFormatTime(t), nil
+			case string:
+				parsed, err := time.Parse(time.RFC3339, t)
+				return ZLFormatTime(parsed), err
+			default:
+				return "", fmt.Errorf("time: unsupported value %v of type %T", t, t)
+			}
+		},
+		"bytes": func(n any) (string, error) {
+			v, err := toInt(n)
+			return HumanBytes(int64(v)), err
+		},
+		"duration": func(d any) (string, error) {
+			switch d := d.(type) {
+			case time.Duration:
+				return HumanDuration(d), nil
+			case string:
+				parsed, err := time.ParseDuration(d)
+				return HumanDuration(parsed), err
+			}
+			v := reflect.ValueOf(d)
+			if !v.IsValid() || !v.CanConvert(reflect.TypeOf(float64(0))) {
+				return "", fmt.Errorf("duration: unsupported value %v of type %T", d, d)
+			}
+			seconds := v.Convert(reflect.TypeOf(float64(0))).Float()
+			return HumanDuration(time.Duration(seconds * float64(time.Second))), nil
+		},
+		"count": func(n any) (string, error) {
+			v, err := toInt(n)
+			return HumanCount(int64(v)), err
+		},
+	}
+}
+
+// toInt converts any integer or float value to an int, truncating floats.
+func toInt(v any) (int, error) {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return int(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return int(rv.Float()), nil
+	default:
+		return 0, fmt.Errorf("expected a number, got %v of type %T", v, v)
+	}
+}
+
+// toInts converts each value with toInt.
+func toInts(values ...any) ([]int, error) {
+	ints := make([]int, len(values))
+	for i, v := range values {
+		n, err := toInt(v)
+		if err != nil {
+			return nil, err
+		}
+		ints[i] = n
+	}
+	return ints, nil
+}
@@ -0,0 +1,49 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestFuncMap(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmpl     string
+		data     any
+		expected string
+	}{
+		{"Formatting", `{{bold .Name}} {{italic "x"}} {{code "y"}} {{link "docs" "https://e.x"}}`, map[string]any{"Name": "Ada"}, "**Ada** *x* `y` [docs](https://e.x)"},
+		{"Heading from float", `{{heading .Level "Title"}}`, map[string]any{"Level": 2.0}, "## Title"},
+		{"Codeblock", "{{codeblock \"go\" .Src}}", map[string]any{"Src": "```\nx\n```"}, "````go\n```\nx\n```\n````"},
+		{"Spoiler", `{{spoiler "Logs" "line"}}`, nil, "```spoiler Logs\nline\n```"},
+		{"Escape pipeline", `{{.Title | escape "mentions"}}`, map[string]any{"Title": "@**all**"}, "@\\*\\*all\\*\\*"},
+		{"Progress from JSON numbers", `{{progress .Done .Total 10}}`, map[string]any{"Done": 3.0, "Total": 10.0}, "▓▓▓░░░░░░░ 30%"},
+		{"Time string", `{{time .At}}`, map[string]any{"At": "2024-01-02T03:04:05Z"}, "<time:2024-01-02T03:04:05Z>"},
+		{"Time value", `{{time .At}}`, map[string]any{"At": time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}, "<time:2024-01-02T03:04:05Z>"},
+		{"Humanize", `{{bytes 1536}} {{count 12500}} {{duration 90}} {{duration "1m30s"}}`, nil, "1.5 KiB 12.5k 1m 30s 1m 30s"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New(tt.name).Funcs(FuncMap()).Parse(tt.tmpl))
+			var sb strings.Builder
+			if err := tmpl.Execute(&sb, tt.data); err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := sb.String(); got != tt.expected {
+				t.Errorf("template output = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFuncMap_Errors(t *testing.T) {
+	for _, src := range []string{`{{progress "a" 10 10}}`, `{{escape "bogus" "x"}}`, `{{time 5}}`, `{{time "yesterday"}}`} {
+		tmpl := template.Must(template.New("err").Funcs(FuncMap()).Parse(src))
+		if err := tmpl.Execute(&strings.Builder{}, nil); err == nil {
+			t.Errorf("Expected error executing %s", src)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package zlmd
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// TruncateGraphemes shortens text to at most n characters, ending it with
+// "…" when anything was cut, without splitting a grapheme cluster: an
+// accented letter, an emoji with its skin tone or variation selector, a
+// ZWJ emoji sequence or a flag stays whole or is dropped whole.
+//
+// Parameters:
+//   - text (string): The text to shorten
+//   - n (int): The maximum length in characters (runes), the unit of
+//     Zulip's message and topic limits, including the ellipsis
+//
+// Returns:
+//   - string: text itself if it fits, otherwise its longest prefix of whole
+//     grapheme clusters that fits with the ellipsis
+//
+// Example:
+//
+//	TruncateGraphemes("deploy 👩‍💻 done", 9)   // "deploy …"
+//	TruncateGraphemes("café", 10)             // "café"
+//	TruncateGraphemes("naïve approach", 4)    // "naï…"
+//
+// Notes:
+//   - Clusters are found with a simplified form of the Unicode segmentation
+//     rules covering combining marks, emoji modifiers, ZWJ sequences,
+//     regional indicator pairs and CRLF
+func TruncateGraphemes(text string, n int) string {
+	if n <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(text) <= n {
+		return text
+	}
+	return text[:graphemePrefix(text, n-1)] + "…"
+}
+
+// graphemePrefix returns the length in bytes of the longest prefix of text
+// made of whole grapheme clusters and holding at most n runes.
+func graphemePrefix(text string, n int) int {
+	end, runes := 0, 0
+	for end < len(text) {
+		size := graphemeLen(text[end:])
+		count := utf8.RuneCountInString(text[end : end+size])
+		if runes+count > n {
+			break
+		}
+		end += size
+		runes += count
+	}
+	return end
+}
+
+// splitGraphemes splits text into pieces of at most n runes, breaking
+// between grapheme clusters. A single cluster longer than n runes is split
+// between its runes.
+func splitGraphemes(text string, n int) []string {
+	var pieces []string
+	for utf8.RuneCountInString(text) > n {
+		end := graphemePrefix(text, n)
+		if end == 0 {
+			end = len(string([]rune(text)[:n]))
+		}
+		pieces = append(pieces, text[:end])
+		text = text[end:]
+	}
+	return append(pieces, text)
+}
+
+// graphemeLen returns the length in bytes of the grapheme cluster at the
+// start of s, which must not be empty.
+func graphemeLen(s string) int {
+	r, i := utf8.DecodeRuneInString(s)
+	switch {
+	case r == '\r' && i < len(s) && s[i] == '\n':
+		return i + 1
+	case isRegionalIndicator(r):
+		if next, size := utf8.DecodeRuneInString(s[i:]); isRegionalIndicator(next) {
+			i += size
+		}
+	}
+
+	for i < len(s) {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if !isGraphemeExtender(r) {
+			break
+		}
+		i += size
+		if r == '\u200d' && i < len(s) {
+			// A zero width joiner glues the next character to the cluster.
+			_, size := utf8.DecodeRuneInString(s[i:])
+			i += size
+		}
+	}
+	return i
+}
+
+// isGraphemeExtender reports whether r continues the grapheme cluster before
+// it.
+func isGraphemeExtender(r rune) bool {
+	switch {
+	case r == '\u200d',
+		isEmojiModifier(r),
+		r >= 0xE0020 && r <= 0xE007F: // emoji tag sequences
+		return true
+	}
+	return r >= 0x300 && unicode.In(r, unicode.Mn, unicode.Me, unicode.Mc)
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= 0x1F1E6 && r <= 0x1F1FF
+}
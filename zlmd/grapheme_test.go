@@ -0,0 +1,59 @@
+package zlmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTruncateGraphemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		n        int
+		expected string
+	}{
+		{"Fits", "café", 10, "café"},
+		{"Exact", "abcd", 4, "abcd"},
+		{"ASCII", "abcdef", 4, "abc…"},
+		{"Zero", "abc", 0, ""},
+		{"One", "abc", 1, "…"},
+		{"Combining accent kept whole", "cafe\u0301 au lait", 5, "caf…"},
+		{"Combining accent included", "cafe\u0301 au lait", 6, "cafe\u0301…"},
+		{"ZWJ sequence", "deploy 👩‍💻 done", 9, "deploy …"},
+		{"Skin tone", "ok 👍🏽 yes", 5, "ok …"},
+		{"Variation selector", "⚠️ warn", 2, "…"},
+		{"Flag", "🇩🇪🇫🇷 flags", 4, "🇩🇪…"},
+		{"CJK", "東京タワー", 3, "東京…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateGraphemes(tt.text, tt.n); got != tt.expected {
+				t.Errorf("TruncateGraphemes(%q, %d) = %q, want %q", tt.text, tt.n, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitGraphemes(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		n        int
+		expected []string
+	}{
+		{"Fits", "abc", 5, []string{"abc"}},
+		{"ASCII", "abcdefg", 3, []string{"abc", "def", "g"}},
+		{"Combining accent moves to next piece", "abe\u0301cd", 3, []string{"ab", "e\u0301c", "d"}},
+		{"Cluster longer than n", "👩‍💻", 2, []string{"👩‍", "💻"}},
+		{"Empty", "", 3, []string{""}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := splitGraphemes(tt.text, tt.n); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("splitGraphemes(%q, %d) = %q, want %q", tt.text, tt.n, got, tt.expected)
+			}
+		})
+	}
+}
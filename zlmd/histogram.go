@@ -0,0 +1,84 @@
+package zlmd
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Bucket is a single range of a distribution rendered by Histogram.
+type Bucket struct {
+	// Label describes the range, for example "10-50ms" or "< 1 KiB".
+	Label string
+	// Count is the number of observations in the range.
+	Count int64
+}
+
+// Histogram renders a distribution as a table with a count bar per bucket.
+//
+// Parameters:
+//   - buckets ([]Bucket): The buckets in display order
+//   - opts (...Option): Optional settings; WithWidth sets the bar width and
+//     WithTopN keeps only the first buckets
+//
+// Returns:
+//   - string: A markdown table with bucket, count, share and bar columns,
+//     or "" for no buckets
+//
+// Example:
+//
+//	out := Histogram([]Bucket{{"<10ms", 30}, {"10-50ms", 60}, {">50ms", 10}}, WithWidth(6))
+//	// out will be:
+//	// | Bucket | Count | % | Distribution |
+//	// | --- | ---: | ---: | --- |
+//	// | <10ms | 30 | 30.0% | ███ |
+//	// | 10-50ms | 60 | 60.0% | ██████ |
+//	// | >50ms | 10 | 10.0% | █ |
+//
+// Notes:
+//   - Bars are scaled relative to the largest bucket using the BarChart renderer
+//   - Shares are computed against all buckets, including ones hidden by WithTopN
+func Histogram(buckets []Bucket, opts ...Option) string {
+	if len(buckets) == 0 {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	width := o.width
+	if width <= 0 {
+		width = DefaultProgressWidth
+	}
+
+	var total, peak int64
+	for _, b := range buckets {
+		total += b.Count
+		peak = max(peak, b.Count)
+	}
+
+	shown := buckets
+	if o.topN > 0 && len(shown) > o.topN {
+		shown = shown[:o.topN]
+	}
+
+	table := NewTableBuilder().
+		WithHeaders("Bucket", "Count", "%", "Distribution").
+		SetAlignments(AlignDefault, AlignRight, AlignRight, AlignDefault)
+
+	for _, b := range shown {
+		share := 0.0
+		if total > 0 {
+			share = float64(b.Count) / float64(total) * 100
+		}
+		table.AddRow(
+			escapeTableCell(b.Label),
+			strconv.FormatInt(b.Count, 10),
+			fmt.Sprintf("%.1f%%", share),
+			renderBar(float64(b.Count), float64(peak), width),
+		)
+	}
+
+	out := table.Build()
+	if hidden := len(buckets) - len(shown); hidden > 0 {
+		out += Italic(pluralize(hidden, "more bucket", "more buckets")+" hidden") + "\n"
+	}
+	return out
+}
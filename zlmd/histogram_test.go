@@ -0,0 +1,38 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestHistogram(t *testing.T) {
+	buckets := []Bucket{{"<10ms", 30}, {"10-50ms", 60}, {">50ms", 10}}
+
+	got := Histogram(buckets, WithWidth(6))
+	expected := "| Bucket | Count | % | Distribution |\n| --- | ---: | ---: | --- |\n" +
+		"| <10ms | 30 | 30.0% | ███ |\n" +
+		"| 10-50ms | 60 | 60.0% | ██████ |\n" +
+		"| >50ms | 10 | 10.0% | █ |\n"
+	if got != expected {
+		t.Errorf("Histogram() = %q, want %q", got, expected)
+	}
+
+	got = Histogram(buckets, WithWidth(6), WithTopN(1))
+	expected = "| Bucket | Count | % | Distribution |\n| --- | ---: | ---: | --- |\n" +
+		"| <10ms | 30 | 30.0% | ███ |\n" +
+		"*2 more buckets hidden*\n"
+	if got != expected {
+		t.Errorf("Histogram() with top N = %q, want %q", got, expected)
+	}
+}
+
+func TestHistogram_Empty(t *testing.T) {
+	if got := Histogram(nil); got != "" {
+		t.Errorf("Histogram(nil) = %q, want empty string", got)
+	}
+
+	got := Histogram([]Bucket{{"none", 0}}, WithWidth(4))
+	expected := "| Bucket | Count | % | Distribution |\n| --- | ---: | ---: | --- |\n| none | 0 | 0.0% |  |\n"
+	if got != expected {
+		t.Errorf("Histogram() with zero counts = %q, want %q", got, expected)
+	}
+}
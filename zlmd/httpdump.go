@@ -0,0 +1,246 @@
+package zlmd
+
+import (
+	"bytes"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Redacted replaces secret header and query values in HTTP dumps.
+const Redacted = "[REDACTED]"
+
+// secretHeaders are always redacted, regardless of their value.
+var secretHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+}
+
+// secretWords mark header and query parameter names whose values are redacted.
+var secretWords = []string{"token", "secret", "password", "passwd", "api-key", "apikey", "api_key", "session", "signature"}
+
+// FormatHTTPRequest renders an HTTP request for debugging messages.
+//
+// Parameters:
+//   - req (*http.Request): The request to render
+//   - opts (...Option): Optional settings; WithSelectedHeaders limits the headers
+//     and WithBodyLimit sets the body truncation length
+//
+// Returns:
+//   - string: The method and URL, headers as key-value lines and the body in a
+//     fenced block, or "" if req is nil
+//
+// Example:
+//
+//	req, _ := http.NewRequest("POST", "https://api.example.com/items?token=abc", strings.NewReader(`{"a":1}`))
+//	req.Header.Set("Content-Type", "application/json")
+//	out := FormatHTTPRequest(req)
+//	// out will be:
+//	// **POST** `https://api.example.com/items?token=[REDACTED]`
+//	// **Content-Type**: application/json
+//	// ```json
+//	// {"a":1}
+//	// ```
+//
+// Notes:
+//   - The body is read and replaced with an equivalent reader, so req can still be sent
+//   - Authorization, cookies and headers or query parameters whose names look
+//     like secrets (token, password, api key, …) are redacted
+func FormatHTTPRequest(req *http.Request, opts ...Option) string {
+	if req == nil {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	var sb strings.Builder
+	WriteBold(&sb, req.Method)
+	sb.WriteString(" ")
+	if req.URL != nil {
+		WriteCode(&sb, redactURL(req.URL))
+	}
+	sb.WriteString("\n")
+
+	writeHTTPHeaders(&sb, req.Header, o)
+	req.Body = writeHTTPBody(&sb, req.Body, req.Header, o)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// FormatHTTPResponse renders an HTTP response for debugging messages.
+//
+// Parameters:
+//   - resp (*http.Response): The response to render
+//   - opts (...Option): Optional settings; WithSelectedHeaders limits the headers
+//     and WithBodyLimit sets the body truncation length
+//
+// Returns:
+//   - string: The status line, headers as key-value lines and the body in a
+//     fenced block, or "" if resp is nil
+//
+// Example:
+//
+//	out := FormatHTTPResponse(resp)
+//	// out will be similar to:
+//	// **404 Not Found** for **GET** `https://api.example.com/items/7`
+//	// **Content-Type**: text/plain
+//	// ```text
+//	// no such item
+//	// ```
+//
+// Notes:
+//   - The body is read and replaced with an equivalent reader, so it can still be consumed
+func FormatHTTPResponse(resp *http.Response, opts ...Option) string {
+	if resp == nil {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	var sb strings.Builder
+	status := resp.Status
+	if status == "" {
+		status = strconv.Itoa(resp.StatusCode) + " " + http.StatusText(resp.StatusCode)
+	}
+	WriteBold(&sb, strings.TrimSpace(status))
+	if resp.Request != nil && resp.Request.URL != nil {
+		sb.WriteString(" for ")
+		WriteBold(&sb, resp.Request.Method)
+		sb.WriteString(" ")
+		WriteCode(&sb, redactURL(resp.Request.URL))
+	}
+	sb.WriteString("\n")
+
+	writeHTTPHeaders(&sb, resp.Header, o)
+	resp.Body = writeHTTPBody(&sb, resp.Body, resp.Header, o)
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// writeHTTPHeaders writes the selected headers as sorted key-value lines.
+func writeHTTPHeaders(sb *strings.Builder, header http.Header, o options) {
+	names := o.headers
+	if names == nil {
+		for name := range header {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	for _, name := range names {
+		values := header.Values(name)
+		if len(values) == 0 {
+			continue
+		}
+		value := strings.Join(values, ", ")
+		if IsSecretName(name) || secretHeaders[http.CanonicalHeaderKey(name)] {
+			value = Redacted
+		}
+		WriteKeyValue(sb, http.CanonicalHeaderKey(name), value)
+	}
+}
+
+// writeHTTPBody writes a truncated copy of body in a fenced block and returns
+// a reader that yields the full body again.
+func writeHTTPBody(sb *strings.Builder, body io.ReadCloser, header http.Header, o options) io.ReadCloser {
+	if body == nil || body == http.NoBody {
+		return body
+	}
+
+	data, err := io.ReadAll(body)
+	body.Close()
+	restored := io.NopCloser(bytes.NewReader(data))
+	if err != nil {
+		Errorf(sb, "reading body: %s", err)
+		return restored
+	}
+	if len(data) == 0 || o.bodyLimit == 0 {
+		return restored
+	}
+
+	text := TruncateGraphemes(string(data), o.bodyLimit)
+	fence := fenceFor(text)
+	sb.WriteString(fence)
+	sb.WriteString(bodyLanguage(header.Get("Content-Type")))
+	sb.WriteString("\n")
+	sb.WriteString(text)
+	sb.WriteString("\n")
+	sb.WriteString(fence)
+	sb.WriteString("\n")
+	return restored
+}
+
+// bodyLanguage picks a code block language from a Content-Type header.
+func bodyLanguage(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "text"
+	}
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return "json"
+	case strings.HasSuffix(mediaType, "/xml") || strings.HasSuffix(mediaType, "+xml"):
+		return "xml"
+	case mediaType == "text/html":
+		return "html"
+	default:
+		return "text"
+	}
+}
+
+// redactURL renders u with secret-looking query parameters and any
+// password replaced.
+func redactURL(u *url.URL) string {
+	c := *u
+	if c.User != nil {
+		if _, ok := c.User.Password(); ok {
+			c.User = url.UserPassword(c.User.Username(), Redacted)
+		}
+	}
+
+	if c.RawQuery != "" {
+		query := c.Query()
+		parts := make([]string, 0, len(query))
+		keys := make([]string, 0, len(query))
+		for k := range query {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			for _, v := range query[k] {
+				if IsSecretName(k) {
+					v = Redacted
+				} else {
+					v = url.QueryEscape(v)
+				}
+				parts = append(parts, url.QueryEscape(k)+"="+v)
+			}
+		}
+		c.RawQuery = strings.Join(parts, "&")
+	}
+
+	s := c.String()
+	return strings.ReplaceAll(s, url.QueryEscape(Redacted), Redacted)
+}
+
+// IsSecretName reports whether a header, parameter or field name looks like
+// it carries a credential, such as "password", "api_key" or "refreshToken".
+// The HTTP formatters redact the values of such names.
+//
+// Example:
+//
+//	IsSecretName("X-Api-Key") // true
+//	IsSecretName("user_id")   // false
+func IsSecretName(name string) bool {
+	lower := strings.ToLower(name)
+	for _, word := range secretWords {
+		if strings.Contains(lower, word) {
+			return true
+		}
+	}
+	return lower == "key" || strings.HasSuffix(lower, "-key") || strings.HasSuffix(lower, "_key")
+}
@@ -0,0 +1,219 @@
+package zlmd
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Humanizer formats byte sizes, durations and counts for human readers.
+//
+// The zero value is not useful; start from DefaultHumanizer and override the
+// fields that differ for a locale.
+//
+// Example:
+//
+//	de := DefaultHumanizer
+//	de.DecimalSeparator = ","
+//	de.Bytes(1536) // "1,5 KiB"
+type Humanizer struct {
+	// DecimalSeparator separates the integer and fractional parts.
+	DecimalSeparator string
+	// ByteUnits are the unit names for 1024^0, 1024^1, ... bytes.
+	ByteUnits []string
+	// CountSuffixes are the suffixes for 1000^1, 1000^2, ... items.
+	CountSuffixes []string
+	// DurationUnits are the suffixes for days, hours, minutes, seconds,
+	// milliseconds, microseconds and nanoseconds, in that order.
+	DurationUnits [7]string
+}
+
+// DefaultHumanizer is the English formatting used by HumanBytes,
+// HumanDuration and HumanCount, unless the default Config's Locale has a
+// Humanizer of its own.
+var DefaultHumanizer = Humanizer{
+	DecimalSeparator: ".",
+	ByteUnits:        []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"},
+	CountSuffixes:    []string{"k", "M", "B", "T"},
+	DurationUnits:    [7]string{"d", "h", "m", "s", "ms", "µs", "ns"},
+}
+
+// HumanBytes formats a byte count using binary units.
+//
+// Parameters:
+//   - n (int64): The number of bytes
+//
+// Returns:
+//   - string: The humanized size
+//
+// Example:
+//
+//	HumanBytes(512)     // "512 B"
+//	HumanBytes(1536)    // "1.5 KiB"
+//	HumanBytes(1 << 30) // "1 GiB"
+func HumanBytes(n int64) string {
+	return defaultLocale().Bytes(n)
+}
+
+// HumanDuration formats a duration using its two most significant units.
+//
+// Parameters:
+//   - d (time.Duration): The duration to format
+//
+// Returns:
+//   - string: The humanized duration
+//
+// Example:
+//
+//	HumanDuration(450 * time.Millisecond) // "450ms"
+//	HumanDuration(3200 * time.Millisecond) // "3.2s"
+//	HumanDuration(95 * time.Minute)       // "1h 35m"
+//	HumanDuration(50 * time.Hour)         // "2d 2h"
+func HumanDuration(d time.Duration) string {
+	return defaultLocale().Duration(d)
+}
+
+// HumanCount formats a count compactly with thousand-based suffixes.
+//
+// Parameters:
+//   - n (int64): The count to format
+//
+// Returns:
+//   - string: The humanized count
+//
+// Example:
+//
+//	HumanCount(999)     // "999"
+//	HumanCount(1234)    // "1.2k"
+//	HumanCount(5600000) // "5.6M"
+func HumanCount(n int64) string {
+	return defaultLocale().Count(n)
+}
+
+// Bytes formats a byte count using h's units and separators.
+func (h Humanizer) Bytes(n int64) string {
+	sign, u := magnitude(n)
+	if u < 1024 || len(h.ByteUnits) < 2 {
+		return sign + strconv.FormatUint(u, 10) + " " + h.unit(h.ByteUnits, 0, "B")
+	}
+
+	value := float64(u)
+	unit := 0
+	// Compare the rounded value so 1048575 becomes "1 MiB" rather than
+	// "1024 KiB".
+	for roundTenth(value) >= 1024 && unit < len(h.ByteUnits)-1 {
+		value /= 1024
+		unit++
+	}
+	return sign + h.decimal(value) + " " + h.ByteUnits[unit]
+}
+
+// Duration formats a duration using h's units and separators.
+func (h Humanizer) Duration(d time.Duration) string {
+	sign, ns := magnitude(int64(d))
+	u := h.DurationUnits
+	const (
+		us     = uint64(time.Microsecond)
+		ms     = uint64(time.Millisecond)
+		sec    = uint64(time.Second)
+		minute = uint64(time.Minute)
+		hr     = uint64(time.Hour)
+		day    = 24 * hr
+	)
+
+	// The fractional units are chosen after rounding, so 999.96ms becomes
+	// "1s" rather than "1000ms".
+	if ns < us {
+		return sign + strconv.FormatUint(ns, 10) + u[6]
+	}
+	if ns < ms {
+		if value := float64(ns) / float64(us); roundTenth(value) < 1000 {
+			return sign + h.decimal(value) + u[5]
+		}
+		ns = ms
+	}
+	if ns < sec {
+		if value := float64(ns) / float64(ms); roundTenth(value) < 1000 {
+			return sign + h.decimal(value) + u[4]
+		}
+		ns = sec
+	}
+	if ns < minute {
+		if value := float64(ns) / float64(sec); roundTenth(value) < 60 {
+			return sign + h.decimal(value) + u[3]
+		}
+		ns = minute
+	}
+
+	switch {
+	case ns < hr:
+		return sign + h.pair(ns/minute, u[2], ns%minute/sec, u[3])
+	case ns < day:
+		return sign + h.pair(ns/hr, u[1], ns%hr/minute, u[2])
+	default:
+		return sign + h.pair(ns/day, u[0], ns%day/hr, u[1])
+	}
+}
+
+// Count formats a count using h's suffixes and separators.
+func (h Humanizer) Count(n int64) string {
+	sign, u := magnitude(n)
+	if u < 1000 || len(h.CountSuffixes) == 0 {
+		return sign + strconv.FormatUint(u, 10)
+	}
+
+	value := float64(u) / 1000
+	suffix := 0
+	// Compare the rounded value so 999999 becomes "1M" rather than "1000k".
+	for math.Round(value) >= 1000 && suffix < len(h.CountSuffixes)-1 {
+		value /= 1000
+		suffix++
+	}
+	if value >= 100 {
+		return sign + strconv.FormatFloat(value, 'f', 0, 64) + h.CountSuffixes[suffix]
+	}
+	return sign + h.decimal(value) + h.CountSuffixes[suffix]
+}
+
+// magnitude splits n into its sign ("-" or "") and absolute value. The
+// absolute value is unsigned so that math.MinInt64, whose negation
+// overflows, is formatted too.
+func magnitude(n int64) (string, uint64) {
+	if n < 0 {
+		return "-", uint64(-(n + 1)) + 1
+	}
+	return "", uint64(n)
+}
+
+// roundTenth rounds value to the one fractional digit decimal writes.
+func roundTenth(value float64) float64 {
+	return math.Round(value*10) / 10
+}
+
+// decimal formats value with one fractional digit, dropping a trailing ".0".
+func (h Humanizer) decimal(value float64) string {
+	s := strconv.FormatFloat(value, 'f', 1, 64)
+	s = strings.TrimSuffix(s, ".0")
+	if h.DecimalSeparator != "" && h.DecimalSeparator != "." {
+		s = strings.Replace(s, ".", h.DecimalSeparator, 1)
+	}
+	return s
+}
+
+// pair formats a two-unit quantity such as "1h 35m", omitting a zero minor part.
+func (h Humanizer) pair(major uint64, majorUnit string, minor uint64, minorUnit string) string {
+	if minor == 0 {
+		return strconv.FormatUint(major, 10) + majorUnit
+	}
+	return fmt.Sprintf("%d%s %d%s", major, majorUnit, minor, minorUnit)
+}
+
+// unit returns units[i] or fallback if the slice is too short.
+func (h Humanizer) unit(units []string, i int, fallback string) string {
+	if i < len(units) {
+		return units[i]
+	}
+	return fallback
+}
@@ -0,0 +1,111 @@
+package zlmd
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestHumanBytes(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{0, "0 B"},
+		{512, "512 B"},
+		{1024, "1 KiB"},
+		{1536, "1.5 KiB"},
+		{10 * 1024 * 1024, "10 MiB"},
+		{1 << 30, "1 GiB"},
+		{-2048, "-2 KiB"},
+		{1023, "1023 B"},
+		{1048473, "1023.9 KiB"},
+		{1048525, "1 MiB"},
+		{1048575, "1 MiB"},
+		{1<<30 - 1, "1 GiB"},
+		{1<<40 - 1, "1 TiB"},
+		{math.MaxInt64, "8 EiB"},
+		{math.MinInt64, "-8 EiB"},
+	}
+
+	for _, tt := range tests {
+		if got := HumanBytes(tt.input); got != tt.expected {
+			t.Errorf("HumanBytes(%d) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestHumanDuration(t *testing.T) {
+	tests := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "0ns"},
+		{750 * time.Nanosecond, "750ns"},
+		{42 * time.Microsecond, "42µs"},
+		{1100 * time.Nanosecond, "1.1µs"},
+		{450 * time.Millisecond, "450ms"},
+		{1750 * time.Microsecond, "1.8ms"},
+		{3200 * time.Millisecond, "3.2s"},
+		{5 * time.Minute, "5m"},
+		{5*time.Minute + 7*time.Second, "5m 7s"},
+		{95 * time.Minute, "1h 35m"},
+		{50 * time.Hour, "2d 2h"},
+		{-2 * time.Second, "-2s"},
+		{999 * time.Nanosecond, "999ns"},
+		{999940 * time.Nanosecond, "999.9µs"},
+		{999960 * time.Nanosecond, "1ms"},
+		{999960 * time.Microsecond, "1s"},
+		{59940 * time.Millisecond, "59.9s"},
+		{59960 * time.Millisecond, "1m"},
+		{time.Hour - time.Second, "59m 59s"},
+		{24*time.Hour - time.Minute, "23h 59m"},
+		{math.MinInt64, "-106751d 23h"},
+	}
+
+	for _, tt := range tests {
+		if got := HumanDuration(tt.input); got != tt.expected {
+			t.Errorf("HumanDuration(%v) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestHumanCount(t *testing.T) {
+	tests := []struct {
+		input    int64
+		expected string
+	}{
+		{7, "7"},
+		{999, "999"},
+		{1000, "1k"},
+		{1234, "1.2k"},
+		{123456, "123k"},
+		{999999, "1M"},
+		{5600000, "5.6M"},
+		{-1500, "-1.5k"},
+		{999499, "999k"},
+		{999500, "1M"},
+		{999499999, "999M"},
+		{999500000, "1B"},
+		{math.MinInt64, "-9223372T"},
+	}
+
+	for _, tt := range tests {
+		if got := HumanCount(tt.input); got != tt.expected {
+			t.Errorf("HumanCount(%d) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestHumanizer_Locale(t *testing.T) {
+	de := DefaultHumanizer
+	de.DecimalSeparator = ","
+	de.CountSuffixes = []string{" Tsd.", " Mio."}
+
+	if got := de.Bytes(1536); got != "1,5 KiB" {
+		t.Errorf("Bytes() = %q, want %q", got, "1,5 KiB")
+	}
+	if got := de.Count(2500000); got != "2,5 Mio." {
+		t.Errorf("Count() = %q, want %q", got, "2,5 Mio.")
+	}
+}
@@ -0,0 +1,241 @@
+package zlmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// JobReport builds the notification for a run of a cron or other periodic
+// job: a compact line when it succeeds, or the details and the end of its
+// output in a spoiler when it fails.
+type JobReport struct {
+	name     string
+	host     string
+	started  time.Time
+	finished time.Time
+	exitCode int
+	err      error
+	output   string
+	opts     options
+}
+
+// NewJobReport creates a report for a run of the named job.
+//
+// Parameters:
+//   - name (string): The job name, such as "nightly-backup"
+//   - opts (...Option): Optional settings; WithMaxLines keeps only the last
+//     lines of the output, WithMaxLength sets the message size the output is
+//     trimmed to fit and WithTheme selects the status prefixes
+//
+// Returns:
+//   - *JobReport: A new report for a successful run, to be filled in
+//
+// Example:
+//
+//	start := time.Now()
+//	out, err := exec.Command("/usr/local/bin/backup").CombinedOutput()
+//	report := NewJobReport("nightly-backup", WithMaxLines(50)).
+//	  Start(start).
+//	  Finish(time.Now(), ExitCode(err)).
+//	  Output(string(out))
+//	msg := report.Build()
+func NewJobReport(name string, opts ...Option) *JobReport {
+	return &JobReport{name: name, opts: newOptions(opts...)}
+}
+
+// Host records the machine the job ran on.
+//
+// Returns:
+//   - *JobReport: The same JobReport instance (for method chaining)
+func (j *JobReport) Host(name string) *JobReport {
+	j.host = name
+	return j
+}
+
+// Start records when the job started.
+//
+// Returns:
+//   - *JobReport: The same JobReport instance (for method chaining)
+func (j *JobReport) Start(at time.Time) *JobReport {
+	j.started = at
+	return j
+}
+
+// Finish records when the job ended and its exit status; a non-zero code
+// marks the run as failed.
+//
+// Returns:
+//   - *JobReport: The same JobReport instance (for method chaining)
+func (j *JobReport) Finish(at time.Time, exitCode int) *JobReport {
+	j.finished = at
+	j.exitCode = exitCode
+	return j
+}
+
+// Error records why the job failed, such as a timeout or a command that
+// could not be started. A nil error is ignored.
+//
+// Returns:
+//   - *JobReport: The same JobReport instance (for method chaining)
+func (j *JobReport) Error(err error) *JobReport {
+	if err != nil {
+		j.err = err
+	}
+	return j
+}
+
+// Output records the captured output of the job, shown when it fails.
+//
+// Returns:
+//   - *JobReport: The same JobReport instance (for method chaining)
+func (j *JobReport) Output(text string) *JobReport {
+	j.output = text
+	return j
+}
+
+// Succeeded reports whether the run exited with status zero and no error.
+func (j *JobReport) Succeeded() bool {
+	return j.exitCode == 0 && j.err == nil
+}
+
+// Duration returns how long the run took, or zero if either the start or
+// the end time is missing.
+func (j *JobReport) Duration() time.Duration {
+	if j.started.IsZero() || j.finished.IsZero() || j.finished.Before(j.started) {
+		return 0
+	}
+	return j.finished.Sub(j.started)
+}
+
+// Build renders the report.
+//
+// Returns:
+//   - string: A single line for a successful run, or the failure details
+//     followed by the output in a spoiler
+//
+// Example:
+//
+//	// A successful run:
+//	// ✅ **nightly-backup** succeeded in 1m 35s · <time:2024-01-02T03:00:00Z>
+//	//
+//	// A failed run:
+//	// ❌ **nightly-backup** failed with exit code 2 after 1m 35s
+//	//
+//	// **Started**: <time:2024-01-02T03:00:00Z>
+//	// **Finished**: <time:2024-01-02T03:01:35Z>
+//	//
+//	// ````spoiler Output
+//	// ```text
+//	// pg_dump: error: connection refused
+//	// ```
+//	// ````
+//
+// Notes:
+//   - The start of long output is dropped, so the message fits the maximum
+//     message length
+//   - The output is not shown for successful runs
+func (j *JobReport) Build() string {
+	var sb strings.Builder
+	duration := j.Duration()
+
+	if j.Succeeded() {
+		sb.WriteString(j.opts.theme.Prefix("success") + " " + Bold(j.name) + " succeeded")
+		if duration > 0 {
+			sb.WriteString(" in " + j.opts.locale.Duration(duration))
+		}
+		if j.host != "" {
+			sb.WriteString(" on " + Code(j.host))
+		}
+		if !j.started.IsZero() {
+			sb.WriteString(" · " + formatTime(j.started, j.opts.target()))
+		}
+		sb.WriteString("\n")
+		return sb.String()
+	}
+
+	sb.WriteString(j.opts.theme.Prefix("danger") + " " + Bold(j.name) + " failed")
+	if j.exitCode != 0 {
+		sb.WriteString(fmt.Sprintf(" with exit code %d", j.exitCode))
+	}
+	if duration > 0 {
+		sb.WriteString(" after " + j.opts.locale.Duration(duration))
+	}
+	sb.WriteString("\n")
+
+	var details strings.Builder
+	if !j.started.IsZero() {
+		WriteKeyValue(&details, "Started", formatTime(j.started, j.opts.target()))
+	}
+	if !j.finished.IsZero() {
+		WriteKeyValue(&details, "Finished", formatTime(j.finished, j.opts.target()))
+	}
+	if j.host != "" {
+		WriteKeyValue(&details, "Host", Code(j.host))
+	}
+	if j.err != nil {
+		WriteKeyValue(&details, "Error", Escape(strings.Join(strings.Fields(j.err.Error()), " "), EscapeMentions))
+	}
+	if details.Len() > 0 {
+		sb.WriteString("\n" + details.String())
+	}
+
+	output := strings.TrimRight(strings.ReplaceAll(j.output, "\r\n", "\n"), "\n")
+	if output != "" {
+		// Leave room for the spoiler and code fences around the output.
+		budget := j.opts.maxLength - sb.Len() - 64
+		sb.WriteString("\n" + spoilerBlock("Output", FencedBlock("text", tailLines(output, j.opts.maxLines, budget)), j.opts.target()) + "\n")
+	}
+	return sb.String()
+}
+
+// tailLines keeps the end of text: at most maxLines lines, if positive, and
+// at most budget bytes, replacing what was dropped with a note.
+func tailLines(text string, maxLines, budget int) string {
+	lines := strings.Split(text, "\n")
+	first := 0
+	if maxLines > 0 && len(lines) > maxLines {
+		first = len(lines) - maxLines
+	}
+	size := 0
+	for i := len(lines) - 1; i >= first; i-- {
+		size += len(lines[i]) + 1
+		if size > budget {
+			first = i + 1
+			break
+		}
+	}
+	if first == 0 {
+		return text
+	}
+	if first == len(lines) {
+		first = len(lines) - 1
+	}
+	return fmt.Sprintf("… %d earlier lines omitted\n", first) + strings.Join(lines[first:], "\n")
+}
+
+// ExitCode returns the exit status of a command from the error returned by
+// its Run, Output or Wait method: 0 for a nil error, the process status for
+// an exit error and -1 for any other error.
+//
+// Example:
+//
+//	err := exec.Command("false").Run()
+//	code := ExitCode(err)
+//	// code will be 1
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	var exit interface{ ExitCode() int }
+	if errors.As(err, &exit) {
+		return exit.ExitCode()
+	}
+	return -1
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (j *JobReport) MarshalZulipMarkdown() (string, error) {
+	return j.Build(), nil
+}
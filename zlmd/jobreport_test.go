@@ -0,0 +1,101 @@
+package zlmd
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJobReport_Build(t *testing.T) {
+	start := time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC)
+	end := start.Add(95 * time.Second)
+
+	tests := []struct {
+		name     string
+		report   *JobReport
+		expected string
+	}{
+		{
+			name:     "Success",
+			report:   NewJobReport("nightly-backup").Host("db-1").Start(start).Finish(end, 0).Output("done\n"),
+			expected: "✅ **nightly-backup** succeeded in 1m 35s on `db-1` · <time:2024-01-02T03:00:00Z>\n",
+		},
+		{
+			name:     "Success without times",
+			report:   NewJobReport("cleanup"),
+			expected: "✅ **cleanup** succeeded\n",
+		},
+		{
+			name:   "Failure",
+			report: NewJobReport("nightly-backup").Start(start).Finish(end, 2).Output("dumping\r\npg_dump: error: connection refused\n"),
+			expected: "❌ **nightly-backup** failed with exit code 2 after 1m 35s\n\n" +
+				"**Started**: <time:2024-01-02T03:00:00Z>\n**Finished**: <time:2024-01-02T03:01:35Z>\n\n" +
+				"````spoiler Output\n```text\ndumping\npg_dump: error: connection refused\n```\n````\n",
+		},
+		{
+			name:     "Error without output",
+			report:   NewJobReport("sync").Error(errors.New("context deadline\nexceeded @**all**")),
+			expected: "❌ **sync** failed\n\n**Error**: context deadline exceeded @\\*\\*all\\*\\*\n",
+		},
+		{
+			name:     "Theme",
+			report:   NewJobReport("sync", WithTheme(PlainTheme)).Finish(end, 1),
+			expected: "[ERROR] **sync** failed with exit code 1\n\n**Finished**: <time:2024-01-02T03:01:35Z>\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.report.Build(); got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestJobReport_Build_Truncation(t *testing.T) {
+	var lines []string
+	for i := range 1000 {
+		lines = append(lines, fmt.Sprintf("line %04d %s", i, strings.Repeat("x", 40)))
+	}
+	output := strings.Join(lines, "\n")
+
+	got := NewJobReport("etl", WithMaxLines(10)).Finish(time.Now(), 1).Output(output).Build()
+	if !strings.Contains(got, "… 990 earlier lines omitted\nline 0990") || !strings.Contains(got, lines[999]+"\n```") {
+		t.Errorf("Expected the last 10 lines, got %q", got)
+	}
+
+	got = NewJobReport("etl", WithMaxLength(2000)).Finish(time.Now(), 1).Output(output).Build()
+	if len(got) > 2000 {
+		t.Errorf("Expected the message to fit 2000 bytes, got %d", len(got))
+	}
+	if !strings.Contains(got, "earlier lines omitted") || !strings.Contains(got, lines[999]+"\n```") {
+		t.Errorf("Expected the end of the output, got %q", got)
+	}
+}
+
+func TestExitCode(t *testing.T) {
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"Nil", nil, 0},
+		{"Exit error", exitErr, 3},
+		{"Wrapped", fmt.Errorf("backup: %w", exitErr), 3},
+		{"Other", errors.New("timeout"), -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExitCode(tt.err); got != tt.expected {
+				t.Errorf("ExitCode() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+// Package k8sreport renders the state of a Kubernetes workload as a Zulip
+// message for deploy bots: a header naming the resource and its rollout
+// status, a table of its conditions, a timeline of recent events and the
+// output of kubectl describe in a spoiler. It is fed by plain structs, so
+// callers can fill them from client-go, kubectl JSON output or a watch.
+package k8sreport
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxEvents is the number of most recent events shown in the timeline.
+const MaxEvents = 10
+
+// Resource identifies a Kubernetes object.
+type Resource struct {
+	Kind      string // such as "Deployment"
+	Namespace string
+	Name      string
+	// Cluster names the cluster or context; optional.
+	Cluster string
+	// URL links to the object in a dashboard; optional.
+	URL string
+}
+
+// String returns the resource as kubectl names it, such as
+// "deployment/api".
+func (r Resource) String() string {
+	if r.Kind == "" {
+		return r.Name
+	}
+	return strings.ToLower(r.Kind) + "/" + r.Name
+}
+
+// Condition is a status condition of a resource.
+type Condition struct {
+	Type   string // such as "Available"
+	Status string // "True", "False" or "Unknown"
+	Reason string
+	// Message is the human-readable detail of the condition.
+	Message            string
+	LastTransitionTime time.Time
+}
+
+// Event is a Kubernetes event concerning the resource or its children.
+type Event struct {
+	Type   string // "Normal" or "Warning"
+	Reason string // such as "ScalingReplicaSet"
+	// Object names the object the event is about, such as "pod/api-7c9f-x2";
+	// optional.
+	Object  string
+	Message string
+	Count   int
+	Time    time.Time
+}
+
+// Replicas holds the replica counts of a rolling workload.
+type Replicas struct {
+	Desired   int
+	Updated   int
+	Ready     int
+	Available int
+}
+
+// Report is the state of a resource to render.
+type Report struct {
+	Resource Resource
+	// Revision is the rollout revision, such as "12"; optional.
+	Revision string
+	// Image is the container image being rolled out; optional.
+	Image string
+	// Replicas is omitted from the message when nil.
+	Replicas   *Replicas
+	Conditions []Condition
+	Events     []Event
+	// Describe is the output of kubectl describe, shown in a spoiler.
+	Describe string
+}
+
+// negativeConditions are condition types that are healthy when False.
+var negativeConditions = map[string]bool{
+	"ReplicaFailure":     true,
+	"MemoryPressure":     true,
+	"DiskPressure":       true,
+	"PIDPressure":        true,
+	"NetworkUnavailable": true,
+}
+
+// Markdown renders the report.
+//
+// Returns:
+//   - string: The rendered message
+//
+// Example:
+//
+//	r := k8sreport.Report{
+//		Resource: k8sreport.Resource{Kind: "Deployment", Namespace: "prod", Name: "api"},
+//		Revision: "12",
+//		Replicas: &k8sreport.Replicas{Desired: 3, Updated: 2, Ready: 2, Available: 2},
+//		Conditions: []k8sreport.Condition{{Type: "Available", Status: "True", Reason: "MinimumReplicasAvailable"}},
+//	}
+//	fmt.Println(r.Markdown())
+//	// ⏳ **deployment/api** in `prod` rollout in progress · revision 12
+//	//
+//	// **Replicas**: 2/3 updated · 2/3 ready · 2/3 available
+//	//
+//	// | Condition | Status | Reason | Message |
+//	// | --- | --- | --- | --- |
+//	// | Available | ✅ True | MinimumReplicasAvailable |  |
+//
+// Notes:
+//   - The rollout has failed when a condition reports ProgressDeadlineExceeded
+//     or a replica failure, and is complete once every replica is updated,
+//     ready and available
+//   - Only the MaxEvents most recent events are shown
+func (r *Report) Markdown() string {
+	var sb strings.Builder
+	status, style := r.status()
+
+	sb.WriteString(zlmd.DefaultTheme.Prefix(style) + " ")
+	name := zlmd.Bold(r.Resource.String())
+	if r.Resource.URL != "" {
+		name = zlmd.Link(name, r.Resource.URL)
+	}
+	sb.WriteString(name)
+	if r.Resource.Namespace != "" {
+		sb.WriteString(" in " + zlmd.Code(r.Resource.Namespace))
+	}
+	if r.Resource.Cluster != "" {
+		sb.WriteString(" on " + zlmd.Code(r.Resource.Cluster))
+	}
+	sb.WriteString(" " + status)
+	if r.Revision != "" {
+		sb.WriteString(" · revision " + r.Revision)
+	}
+	sb.WriteString("\n")
+
+	if r.Image != "" || r.Replicas != nil {
+		sb.WriteString("\n")
+	}
+	if r.Image != "" {
+		zlmd.WriteKeyValue(&sb, "Image", zlmd.Code(r.Image))
+	}
+	if rs := r.Replicas; rs != nil {
+		zlmd.WriteKeyValue(&sb, "Replicas", fmt.Sprintf("%d/%d updated · %d/%d ready · %d/%d available",
+			rs.Updated, rs.Desired, rs.Ready, rs.Desired, rs.Available, rs.Desired))
+	}
+
+	if len(r.Conditions) > 0 {
+		table := zlmd.NewTableBuilder().WithHeaders("Condition", "Status", "Reason", "Message")
+		for _, c := range r.Conditions {
+			table.AddRow(cell(c.Type), zlmd.DefaultTheme.Prefix(conditionStyle(c))+" "+cell(c.Status),
+				cell(c.Reason), cell(c.Message))
+		}
+		sb.WriteString("\n" + table.Build())
+	}
+
+	if len(r.Events) > 0 {
+		events := r.Events
+		if len(events) > MaxEvents {
+			events = latest(events, MaxEvents)
+		}
+		tl := zlmd.NewTimeline()
+		for _, e := range events {
+			tl.AddEvent(zlmd.TimelineEvent{Time: e.Time, Style: eventStyle(e), Text: eventText(e)})
+		}
+		sb.WriteString("\n" + tl.Build())
+		if n := len(r.Events) - len(events); n > 0 {
+			zlmd.WriteItalic(&sb, fmt.Sprintf("%d earlier events omitted", n))
+			sb.WriteString("\n")
+		}
+	}
+
+	if describe := strings.TrimRight(r.Describe, "\n"); describe != "" {
+		heading := "kubectl describe " + r.Resource.String()
+		if r.Resource.Namespace != "" {
+			heading += " -n " + r.Resource.Namespace
+		}
+		sb.WriteString("\n" + zlmd.FencedBlock("spoiler "+heading, zlmd.FencedBlock("text", describe)) + "\n")
+	}
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements zlmd.Markdowner by returning Markdown.
+func (r *Report) MarshalZulipMarkdown() (string, error) {
+	return r.Markdown(), nil
+}
+
+// status summarizes the rollout as a phrase and a theme style.
+func (r *Report) status() (string, string) {
+	degraded := false
+	for _, c := range r.Conditions {
+		if c.Reason == "ProgressDeadlineExceeded" || (negativeConditions[c.Type] && c.Status == "True") {
+			return "rollout failed", "danger"
+		}
+		degraded = degraded || conditionStyle(c) == "danger"
+	}
+	if rs := r.Replicas; rs != nil && (rs.Updated < rs.Desired || rs.Ready < rs.Desired || rs.Available < rs.Desired) {
+		return "rollout in progress", "pending"
+	}
+	if degraded {
+		return "is degraded", "warning"
+	}
+	if r.Replicas != nil {
+		return "rollout complete", "success"
+	}
+	for _, e := range r.Events {
+		if e.Type == "Warning" {
+			return "has warnings", "warning"
+		}
+	}
+	return "is healthy", "success"
+}
+
+// conditionStyle returns the theme style of a condition, treating False as
+// healthy for the pressure and failure conditions.
+func conditionStyle(c Condition) string {
+	switch c.Status {
+	case "True":
+		if negativeConditions[c.Type] {
+			return "danger"
+		}
+		return "success"
+	case "False":
+		if negativeConditions[c.Type] {
+			return "success"
+		}
+		return "danger"
+	default:
+		return "pending"
+	}
+}
+
+// eventStyle returns the theme style of an event.
+func eventStyle(e Event) string {
+	if e.Type == "Warning" {
+		return "warning"
+	}
+	return "info"
+}
+
+// eventText renders an event as "**Reason** object: message (×count)".
+func eventText(e Event) string {
+	text := zlmd.Bold(inline(e.Reason))
+	if e.Object != "" {
+		text += " " + zlmd.Code(e.Object)
+	}
+	if msg := inline(e.Message); msg != "" {
+		text += ": " + msg
+	}
+	if e.Count > 1 {
+		text += fmt.Sprintf(" (×%d)", e.Count)
+	}
+	return text
+}
+
+// latest returns the n most recent events.
+func latest(events []Event, n int) []Event {
+	sorted := append([]Event(nil), events...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Time.Before(sorted[j].Time)
+	})
+	return sorted[len(sorted)-n:]
+}
+
+// inline collapses whitespace and neutralizes mentions in text reported by
+// the cluster.
+func inline(text string) string {
+	return zlmd.Escape(strings.Join(strings.Fields(text), " "), zlmd.EscapeMentions)
+}
+
+// cell prepares text for a table cell.
+func cell(text string) string {
+	return strings.ReplaceAll(inline(text), "|", `\|`)
+}
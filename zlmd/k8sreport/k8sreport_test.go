@@ -0,0 +1,105 @@
+package k8sreport
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReport_Markdown(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	r := Report{
+		Resource: Resource{Kind: "Deployment", Namespace: "prod", Name: "api", Cluster: "eu-1", URL: "https://k8s.example.com/api"},
+		Revision: "12",
+		Image:    "registry.example.com/api:1.4.0",
+		Replicas: &Replicas{Desired: 3, Updated: 1, Ready: 2, Available: 2},
+		Conditions: []Condition{
+			{Type: "Available", Status: "True", Reason: "MinimumReplicasAvailable", Message: "Deployment has minimum availability."},
+			{Type: "Progressing", Status: "Unknown", Reason: "ReplicaSetUpdated", Message: "a | b"},
+		},
+		Events: []Event{
+			{Type: "Warning", Reason: "BackOff", Object: "pod/api-7c9f-x2", Message: "Back-off restarting\n failed container", Count: 4, Time: start.Add(2 * time.Minute)},
+			{Type: "Normal", Reason: "ScalingReplicaSet", Message: "Scaled up to 1", Count: 1, Time: start},
+		},
+		Describe: "Name: api\nReplicas: 3 desired\n",
+	}
+
+	expected := "⏳ [**deployment/api**](https://k8s.example.com/api) in `prod` on `eu-1` rollout in progress · revision 12\n\n" +
+		"**Image**: `registry.example.com/api:1.4.0`\n" +
+		"**Replicas**: 1/3 updated · 2/3 ready · 2/3 available\n\n" +
+		"| Condition | Status | Reason | Message |\n| --- | --- | --- | --- |\n" +
+		"| Available | ✅ True | MinimumReplicasAvailable | Deployment has minimum availability. |\n" +
+		"| Progressing | ⏳ Unknown | ReplicaSetUpdated | a \\| b |\n\n" +
+		"- ℹ️ <time:2024-03-01T09:00:00Z> **ScalingReplicaSet**: Scaled up to 1\n" +
+		"- ⚠️ <time:2024-03-01T09:02:00Z> (+2m) **BackOff** `pod/api-7c9f-x2`: Back-off restarting failed container (×4)\n\n" +
+		"````spoiler kubectl describe deployment/api -n prod\n```text\nName: api\nReplicas: 3 desired\n```\n````\n"
+	if got := r.Markdown(); got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestReport_Markdown_Status(t *testing.T) {
+	tests := []struct {
+		name     string
+		report   Report
+		expected string
+	}{
+		{
+			"Complete",
+			Report{Resource: Resource{Kind: "Deployment", Name: "api"}, Replicas: &Replicas{Desired: 2, Updated: 2, Ready: 2, Available: 2}},
+			"✅ **deployment/api** rollout complete\n",
+		},
+		{
+			"Deadline exceeded",
+			Report{Resource: Resource{Kind: "Deployment", Name: "api"}, Replicas: &Replicas{Desired: 2, Updated: 1},
+				Conditions: []Condition{{Type: "Progressing", Status: "False", Reason: "ProgressDeadlineExceeded"}}},
+			"❌ **deployment/api** rollout failed\n",
+		},
+		{
+			"Node pressure",
+			Report{Resource: Resource{Kind: "Node", Name: "node-1"}, Conditions: []Condition{
+				{Type: "Ready", Status: "True"}, {Type: "DiskPressure", Status: "False"}, {Type: "MemoryPressure", Status: "Unknown"},
+			}},
+			"✅ **node/node-1** is healthy\n",
+		},
+		{
+			"Not ready",
+			Report{Resource: Resource{Kind: "Pod", Name: "api-1"}, Conditions: []Condition{{Type: "Ready", Status: "False"}}},
+			"⚠️ **pod/api-1** is degraded\n",
+		},
+		{
+			"Warnings",
+			Report{Resource: Resource{Name: "api"}, Events: []Event{{Type: "Warning", Reason: "FailedMount"}}},
+			"⚠️ **api** has warnings\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.report.Markdown(); !strings.HasPrefix(got, tt.expected) {
+				t.Errorf("Markdown() = %q, want prefix %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestReport_Markdown_Events(t *testing.T) {
+	start := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+	var events []Event
+	for i := range MaxEvents + 3 {
+		events = append(events, Event{Type: "Normal", Reason: fmt.Sprintf("Step%02d", i), Time: start.Add(time.Duration(i) * time.Minute)})
+	}
+	r := Report{Resource: Resource{Name: "api"}, Events: events}
+
+	got := r.Markdown()
+	if n := strings.Count(got, "- ℹ️"); n != MaxEvents {
+		t.Errorf("Expected %d events, got %d", MaxEvents, n)
+	}
+	if strings.Contains(got, "Step02") || !strings.Contains(got, "Step03") {
+		t.Errorf("Expected the most recent events, got %q", got)
+	}
+	if !strings.HasSuffix(got, "*3 earlier events omitted*\n") {
+		t.Errorf("Expected omission note, got %q", got)
+	}
+}
@@ -0,0 +1,159 @@
+package zlmd
+
+import "strings"
+
+// KVStyle selects how a KVBlock renders its pairs.
+type KVStyle int
+
+const (
+	// KVStyleCode renders pairs as padded plain text inside a code block.
+	KVStyleCode KVStyle = iota
+	// KVStyleTable renders pairs as a two-column markdown table.
+	KVStyleTable
+)
+
+// KVBlock collects key-value pairs and renders them with aligned values.
+//
+// It replaces repeated WriteKeyValue calls, whose output is ragged when keys
+// have different lengths.
+type KVBlock struct {
+	keys        []string
+	values      []string
+	style       KVStyle
+	keyHeader   string
+	valueHeader string
+	bidi        BidiPolicy
+	widthMode   WidthMode
+}
+
+// NewKVBlock creates an empty key-value block using the code block style.
+//
+// Returns:
+//   - *KVBlock: A new initialized KVBlock instance
+//
+// Example:
+//
+//	block := NewKVBlock().Add("Service", "api").Add("Version", "1.4.2")
+//	// block.Build() will be:
+//	// ```text
+//	// Service: api
+//	// Version: 1.4.2
+//	// ```
+func NewKVBlock() *KVBlock {
+	return &KVBlock{
+		keys:        []string{},
+		values:      []string{},
+		style:       KVStyleCode,
+		keyHeader:   "Key",
+		valueHeader: "Value",
+	}
+}
+
+// Add appends a key-value pair.
+//
+// Returns:
+//   - *KVBlock: The same KVBlock instance (for method chaining)
+func (b *KVBlock) Add(key, value string) *KVBlock {
+	b.keys = append(b.keys, key)
+	b.values = append(b.values, value)
+	return b
+}
+
+// WithStyle sets the rendering style.
+//
+// Returns:
+//   - *KVBlock: The same KVBlock instance (for method chaining)
+//
+// Example:
+//
+//	block.WithStyle(KVStyleTable)
+func (b *KVBlock) WithStyle(style KVStyle) *KVBlock {
+	b.style = style
+	return b
+}
+
+// WithHeaders sets the column headers used by the table style.
+// The defaults are "Key" and "Value".
+//
+// Returns:
+//   - *KVBlock: The same KVBlock instance (for method chaining)
+func (b *KVBlock) WithHeaders(key, value string) *KVBlock {
+	b.keyHeader = key
+	b.valueHeader = value
+	return b
+}
+
+// WithBidiPolicy isolates keys and values according to policy, so
+// right-to-left text doesn't reorder the separators around it.
+//
+// Returns:
+//   - *KVBlock: The same KVBlock instance (for method chaining)
+func (b *KVBlock) WithBidiPolicy(policy BidiPolicy) *KVBlock {
+	b.bidi = policy
+	return b
+}
+
+// WithWidthMode sets how keys are measured when aligning the values of the
+// code style.
+//
+// Returns:
+//   - *KVBlock: The same KVBlock instance (for method chaining)
+//
+// Example:
+//
+//	NewKVBlock().Add("✅ api", "up").Add("⚠️ db", "slow").WithWidthMode(WidthEmoji)
+func (b *KVBlock) WithWidthMode(mode WidthMode) *KVBlock {
+	b.widthMode = mode
+	return b
+}
+
+// Len returns the number of pairs in the block.
+func (b *KVBlock) Len() int {
+	return len(b.keys)
+}
+
+// Build generates the markdown for the collected pairs.
+//
+// Returns:
+//   - string: The rendered block, or "" if no pairs were added
+//
+// Example:
+//
+//	out := NewKVBlock().Add("Host", "db-1").Add("Uptime", "4d").WithStyle(KVStyleTable).Build()
+//	// out will be:
+//	// | Key | Value |
+//	// | --- | --- |
+//	// | **Host** | db-1 |
+//	// | **Uptime** | 4d |
+func (b *KVBlock) Build() string {
+	if len(b.keys) == 0 {
+		return ""
+	}
+
+	if b.style == KVStyleTable {
+		table := NewTableBuilder().WithHeaders(b.keyHeader, b.valueHeader)
+		for i, key := range b.keys {
+			key := IsolateBidi(escapeTableCell(key), b.bidi)
+			table.AddRow(Bold(key), IsolateBidi(escapeTableCell(b.values[i]), b.bidi))
+		}
+		return table.Build()
+	}
+
+	width := 0
+	for _, key := range b.keys {
+		width = max(width, TextWidth(key, b.widthMode))
+	}
+
+	lines := make([]string, len(b.keys))
+	for i, key := range b.keys {
+		lines[i] = padRight(IsolateBidi(key, b.bidi)+": ", width+2, b.widthMode) + IsolateBidi(b.values[i], b.bidi)
+	}
+
+	content := strings.Join(lines, "\n")
+	return CodeBlock("text", content)
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (b *KVBlock) MarshalZulipMarkdown() (string, error) {
+	return b.Build(), nil
+}
@@ -0,0 +1,48 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestKVBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		block    *KVBlock
+		expected string
+	}{
+		{
+			name:     "Empty",
+			block:    NewKVBlock(),
+			expected: "",
+		},
+		{
+			name:     "Code style",
+			block:    NewKVBlock().Add("Service", "api").Add("Version", "1.4.2").Add("Env", "prod"),
+			expected: "```text\nService: api\nVersion: 1.4.2\nEnv:     prod\n```",
+		},
+		{
+			name:     "Wide keys",
+			block:    NewKVBlock().Add("東京", "up").Add("Region", "ap-1").Add("café", "open"),
+			expected: "```text\n東京:   up\nRegion: ap-1\ncafé:   open\n```",
+		},
+		{
+			name:     "Table style",
+			block:    NewKVBlock().Add("Host", "db-1").Add("Uptime", "4d").WithStyle(KVStyleTable),
+			expected: "| Key | Value |\n| --- | --- |\n| **Host** | db-1 |\n| **Uptime** | 4d |\n",
+		},
+		{
+			name:     "Table style with custom headers",
+			block:    NewKVBlock().Add("cpu", "a|b").WithStyle(KVStyleTable).WithHeaders("Metric", "Reading"),
+			expected: "| Metric | Reading |\n| --- | --- |\n| **cpu** | a\\|b |\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.block.Build()
+			if got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package zlmd
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// LineEnding selects the line terminator of generated markdown.
+type LineEnding int32
+
+const (
+	// LineEndingLF ends lines with "\n", as Zulip stores messages.
+	LineEndingLF LineEnding = iota
+	// LineEndingCRLF ends lines with "\r\n", for output written to files
+	// that are diffed against content produced on Windows.
+	LineEndingCRLF
+)
+
+// FinalNewline selects whether blocks end with a line terminator.
+type FinalNewline int32
+
+const (
+	// FinalNewlineDefault keeps the trailing newlines each builder has
+	// always emitted: one after a quote or table, two after a section.
+	FinalNewlineDefault FinalNewline = iota
+	// FinalNewlineAlways ends every block with exactly one line terminator.
+	FinalNewlineAlways
+	// FinalNewlineNever ends blocks with their last line of content.
+	FinalNewlineNever
+)
+
+var (
+	lineEnding   atomic.Int32
+	finalNewline atomic.Int32
+)
+
+// SetLineEnding sets the line terminator used by the package: by the Write
+// helpers, Paragraph and BR, QuoteBlock, Section and TableBuilder.
+// Line endings in text passed to QuoteBlock and Section are converted too,
+// so CRLF input doesn't produce output with mixed line endings.
+//
+// It is safe to call concurrently with rendering, but is meant to be called
+// once, during program initialization.
+//
+// Example:
+//
+//	zlmd.SetLineEnding(zlmd.LineEndingCRLF)
+//	os.WriteFile("report.md", []byte(section.Build()), 0o644)
+func SetLineEnding(le LineEnding) {
+	lineEnding.Store(int32(le))
+}
+
+// SetFinalNewline sets whether QuoteBlock, Section and TableBuilder end
+// their output with a line terminator.
+//
+// Example:
+//
+//	zlmd.SetFinalNewline(zlmd.FinalNewlineNever)
+//	table.Build() // ends with "|"
+func SetFinalNewline(policy FinalNewline) {
+	finalNewline.Store(int32(policy))
+}
+
+// newline returns the configured line terminator.
+func newline() string {
+	if LineEnding(lineEnding.Load()) == LineEndingCRLF {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// convertLineEndings replaces the line endings in text, LF or CRLF, with
+// the configured line terminator.
+func convertLineEndings(text string) string {
+	if strings.IndexByte(text, '\r') >= 0 {
+		text = strings.ReplaceAll(text, "\r\n", "\n")
+	}
+	if nl := newline(); nl != "\n" {
+		text = strings.ReplaceAll(text, "\n", nl)
+	}
+	return text
+}
+
+// finishBlock applies the final newline policy and line ending to a block
+// rendered with LF line endings.
+func finishBlock(text string) string {
+	switch FinalNewline(finalNewline.Load()) {
+	case FinalNewlineAlways:
+		text = strings.TrimRight(text, "\r\n") + "\n"
+	case FinalNewlineNever:
+		text = strings.TrimRight(text, "\r\n")
+	}
+	return convertLineEndings(text)
+}
@@ -0,0 +1,76 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+// setOutputStyle sets the package line ending and final newline policy for
+// the rest of the test.
+func setOutputStyle(t *testing.T, le LineEnding, policy FinalNewline) {
+	t.Helper()
+	SetLineEnding(le)
+	SetFinalNewline(policy)
+	t.Cleanup(func() {
+		SetLineEnding(LineEndingLF)
+		SetFinalNewline(FinalNewlineDefault)
+	})
+}
+
+func TestLineEnding(t *testing.T) {
+	table := func() string {
+		return NewTableBuilder().WithHeaders("A").AddRow("1").Build()
+	}
+	section := func() string {
+		return NewSection(2, "Title").AddBullet("one").Build()
+	}
+
+	tests := []struct {
+		name     string
+		ending   LineEnding
+		policy   FinalNewline
+		render   func() string
+		expected string
+	}{
+		{"Quote LF", LineEndingLF, FinalNewlineDefault, func() string { return QuoteBlock("a\r\nb") }, "> a\n> b\n"},
+		{"Quote CRLF", LineEndingCRLF, FinalNewlineDefault, func() string { return QuoteBlock("a\nb") }, "> a\r\n> b\r\n"},
+		{"Quote never", LineEndingLF, FinalNewlineNever, func() string { return QuoteBlock("a") }, "> a"},
+		{"Table CRLF", LineEndingCRLF, FinalNewlineDefault, table, "| A |\r\n| --- |\r\n| 1 |\r\n"},
+		{"Table never", LineEndingLF, FinalNewlineNever, table, "| A |\n| --- |\n| 1 |"},
+		{"Section default", LineEndingLF, FinalNewlineDefault, section, "## Title\n\n* one\n\n"},
+		{"Section always", LineEndingCRLF, FinalNewlineAlways, section, "## Title\r\n\r\n* one\r\n"},
+		{"Section never", LineEndingLF, FinalNewlineNever, section, "## Title\n\n* one"},
+		{"Paragraph CRLF", LineEndingCRLF, FinalNewlineNever, func() string { return Paragraph("p") }, "p\r\n\r\n"},
+		{"Write helper CRLF", LineEndingCRLF, FinalNewlineDefault, func() string {
+			var sb strings.Builder
+			WriteHeading(&sb, 1, "T")
+			WriteListItem(&sb, "x", 0)
+			return sb.String()
+		}, "# T\r\n- x\r\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setOutputStyle(t, tt.ending, tt.policy)
+			if got := tt.render(); got != tt.expected {
+				t.Errorf("render() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLineEnding_TableSize(t *testing.T) {
+	setOutputStyle(t, LineEndingCRLF, FinalNewlineNever)
+
+	table := NewTableBuilder().WithHeaders("A", "B").AddRow("1", "2")
+	var sb strings.Builder
+	if _, err := table.WriteTo(&sb); err != nil {
+		t.Fatalf("WriteTo() error = %v", err)
+	}
+	if got, want := sb.String(), table.Build(); got != want {
+		t.Errorf("WriteTo() wrote %q, want %q", got, want)
+	}
+	if got, want := table.Size(), len(table.Build()); got != want {
+		t.Errorf("Size() = %d, want %d", got, want)
+	}
+}
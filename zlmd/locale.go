@@ -0,0 +1,159 @@
+package zlmd
+
+import (
+	"strings"
+	"time"
+)
+
+// Locale holds the language-dependent formatting of generated text: numbers
+// and units, dates written out as text, and lists of words.
+//
+// Start from English and override the fields that differ:
+//
+//	de := zlmd.English.Clone("de")
+//	h := zlmd.DefaultHumanizer
+//	h.DecimalSeparator = ","
+//	de.Humanizer = &h
+//	de.TimeLayout = "02.01.2006 15:04 MST"
+//	de.And, de.Or, de.SerialComma = "und", "oder", false
+type Locale struct {
+	// Name identifies the locale, such as "en" or "de".
+	Name string
+	// Humanizer formats byte sizes, durations and counts; nil means
+	// DefaultHumanizer.
+	Humanizer *Humanizer
+	// TimeLayout is the time.Format layout of times written out as text,
+	// where a <time:> tag can't be used. Times are shown in UTC.
+	TimeLayout string
+	// And and Or are the conjunctions of the last item of a list.
+	And string
+	Or  string
+	// SerialComma puts a comma before the conjunction of lists of three or
+	// more items: "a, b, and c".
+	SerialComma bool
+}
+
+// English is the default locale.
+var English = &Locale{
+	Name:        "en",
+	TimeLayout:  "2006-01-02 15:04 MST",
+	And:         "and",
+	Or:          "or",
+	SerialComma: true,
+}
+
+// Clone returns a copy of l under a new name, for deriving a locale.
+func (l *Locale) Clone(name string) *Locale {
+	clone := *l
+	clone.Name = name
+	return &clone
+}
+
+// humanizer returns the Humanizer of l, or DefaultHumanizer.
+func (l *Locale) humanizer() Humanizer {
+	if l.Humanizer != nil {
+		return *l.Humanizer
+	}
+	return DefaultHumanizer
+}
+
+// Bytes is HumanBytes in locale l.
+func (l *Locale) Bytes(n int64) string {
+	return l.humanizer().Bytes(n)
+}
+
+// Duration is HumanDuration in locale l.
+func (l *Locale) Duration(d time.Duration) string {
+	return l.humanizer().Duration(d)
+}
+
+// Count is HumanCount in locale l.
+func (l *Locale) Count(n int64) string {
+	return l.humanizer().Count(n)
+}
+
+// FormatTime writes t out as text in UTC, for targets that don't render
+// <time:> tags.
+//
+// Example:
+//
+//	English.FormatTime(t) // "2024-05-15 14:30 UTC"
+func (l *Locale) FormatTime(t time.Time) string {
+	layout := l.TimeLayout
+	if layout == "" {
+		layout = English.TimeLayout
+	}
+	return t.UTC().Format(layout)
+}
+
+// JoinAnd joins items into a list ending with "and": "a", "a and b" or
+// "a, b, and c".
+func (l *Locale) JoinAnd(items ...string) string {
+	return l.join(items, l.And)
+}
+
+// JoinOr joins items into a list ending with "or": "a", "a or b" or
+// "a, b, or c".
+func (l *Locale) JoinOr(items ...string) string {
+	return l.join(items, l.Or)
+}
+
+// join joins items into a list ending with conjunction.
+func (l *Locale) join(items []string, conjunction string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " " + conjunction + " " + items[1]
+	}
+
+	last := " " + conjunction + " "
+	if l.SerialComma {
+		last = "," + last
+	}
+	return strings.Join(items[:len(items)-1], ", ") + last + items[len(items)-1]
+}
+
+// JoinAnd joins items into a list ending with "and" in the locale of the
+// default Config.
+//
+// Example:
+//
+//	JoinAnd("api", "db", "worker") // "api, db, and worker"
+func JoinAnd(items ...string) string {
+	return defaultLocale().JoinAnd(items...)
+}
+
+// JoinOr joins items into a list ending with "or" in the locale of the
+// default Config.
+//
+// Example:
+//
+//	JoinOr("api", "db") // "api or db"
+func JoinOr(items ...string) string {
+	return defaultLocale().JoinOr(items...)
+}
+
+// defaultLocale returns the Locale of the default Config, or English.
+func defaultLocale() *Locale {
+	if cfg := defaultConfig.Load(); cfg != nil && cfg.Locale != nil {
+		return cfg.Locale
+	}
+	return English
+}
+
+// WithLocale selects the locale of numbers, times written out as text and
+// lists. A nil locale is ignored.
+//
+// Example:
+//
+//	report := NewJobReport("backup", WithLocale(de))
+func WithLocale(locale *Locale) Option {
+	return func(o *options) {
+		if locale != nil {
+			o.locale = locale
+		}
+	}
+}
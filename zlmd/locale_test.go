@@ -0,0 +1,86 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// testGerman returns a German locale.
+func testGerman() *Locale {
+	de := English.Clone("de")
+	h := DefaultHumanizer
+	h.DecimalSeparator = ","
+	de.Humanizer = &h
+	de.TimeLayout = "02.01.2006 15:04 MST"
+	de.And, de.Or, de.SerialComma = "und", "oder", false
+	return de
+}
+
+func TestLocale_Join(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   *Locale
+		items    []string
+		expected string
+	}{
+		{"None", English, nil, ""},
+		{"One", English, []string{"a"}, "a"},
+		{"Two", English, []string{"a", "b"}, "a and b"},
+		{"Three", English, []string{"a", "b", "c"}, "a, b, and c"},
+		{"No serial comma", testGerman(), []string{"a", "b", "c"}, "a, b und c"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.locale.JoinAnd(tt.items...); got != tt.expected {
+				t.Errorf("JoinAnd(%q) = %q, want %q", tt.items, got, tt.expected)
+			}
+		})
+	}
+
+	if got, want := testGerman().JoinOr("a", "b"), "a oder b"; got != want {
+		t.Errorf("JoinOr() = %q, want %q", got, want)
+	}
+}
+
+func TestLocale_Default(t *testing.T) {
+	at := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	SetDefaultConfig(Config{Locale: testGerman(), Flavor: FlavorPortable})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"HumanBytes", HumanBytes(1536), "1,5 KiB"},
+		{"HumanDuration", HumanDuration(3200 * time.Millisecond), "3,2s"},
+		{"HumanCount", HumanCount(1234), "1,2k"},
+		{"JoinAnd", JoinAnd("a", "b", "c"), "a, b und c"},
+		{"ZLFormatTime", ZLFormatTime(at), "15.05.2024 14:30 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWithLocale(t *testing.T) {
+	start := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	got := NewJobReport("backup", WithLocale(testGerman()), WithFlavor(FlavorPortable)).
+		Start(start).Finish(start.Add(1500*time.Millisecond), 0).Build()
+	for _, want := range []string{"in 1,5s", "15.05.2024 14:30 UTC"} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Build() = %q, want it to contain %q", got, want)
+		}
+	}
+
+	if got := HumanBytes(1536); got != "1.5 KiB" {
+		t.Errorf("HumanBytes() = %q, want the English default", got)
+	}
+}
@@ -0,0 +1,187 @@
+package zlmd
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// LogWriter is an io.Writer that collects log lines and emits them as fenced
+// code block messages that never exceed the configured message length.
+//
+// It is safe for concurrent use and can be handed directly to the standard
+// library logger or any third-party logger that accepts an io.Writer.
+type LogWriter struct {
+	mu       sync.Mutex
+	opts     options
+	partial  []byte
+	lines    []string
+	size     int
+	fenceLen int
+}
+
+// NewLogWriter creates a LogWriter that buffers lines and flushes them in
+// message-size-safe batches.
+//
+// Parameters:
+//   - opts (...Option): Optional settings such as WithLanguage, WithMaxLength and WithFlushFunc
+//
+// Returns:
+//   - *LogWriter: A writer implementing io.Writer
+//
+// Example:
+//
+//	w := NewLogWriter(WithFlushFunc(send))
+//	logger := log.New(w, "", log.LstdFlags)
+//	logger.Println("starting job")
+//	defer w.Close()
+//	// send receives "```text\n2024/01/02 15:04:05 starting job\n```"
+//
+// Notes:
+//   - A batch is flushed when the next line would push the message over the limit
+//   - Lines longer than a whole message are truncated and marked with an ellipsis
+//   - Call Flush or Close to emit the final, partially filled batch
+//   - Without WithFlushFunc, writes return ErrNoFlushFunc once a batch is
+//     full, and Flush returns it while lines are buffered
+//   - If the flush callback returns an error, the batch stays buffered and
+//     is sent, with the lines written since, by the next successful flush
+func NewLogWriter(opts ...Option) *LogWriter {
+	return &LogWriter{
+		opts:     newOptions(opts...),
+		fenceLen: 3,
+	}
+}
+
+// Write buffers p, splitting it into lines. Complete lines are added to the
+// current batch, which is flushed whenever it is full.
+func (w *LogWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.partial[:i])
+		w.partial = append(w.partial[:0], w.partial[i+1:]...)
+		if err := w.addLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush emits any buffered lines, including an unterminated trailing line,
+// as a single message.
+func (w *LogWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		line := string(w.partial)
+		w.partial = w.partial[:0]
+		if err := w.addLine(line); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+// Close flushes any buffered lines. The writer remains usable afterwards.
+func (w *LogWriter) Close() error {
+	return w.Flush()
+}
+
+// addLine appends line to the current batch, flushing first if the line
+// would not fit. If the flush fails, line is still added, and the buffered
+// lines are sent by the next successful flush.
+func (w *LogWriter) addLine(line string) error {
+	line = strings.TrimSuffix(line, "\r")
+
+	fenceLen := max(w.fenceLen, fenceLenFor(line))
+	budget := w.opts.maxLength - w.overhead(fenceLen)
+	if utf8.RuneCountInString(line) > budget {
+		line = TruncateGraphemes(line, budget)
+		fenceLen = max(w.fenceLen, fenceLenFor(line))
+	}
+
+	var err error
+	if len(w.lines) > 0 && w.size+1+utf8.RuneCountInString(line) > w.opts.maxLength-w.overhead(fenceLen) {
+		err = w.flush()
+	}
+	w.lines = append(w.lines, line)
+	w.measure()
+	return err
+}
+
+// overhead returns the number of characters the fences and language
+// identifier add around the batch content.
+func (w *LogWriter) overhead(fenceLen int) int {
+	return 2*fenceLen + utf8.RuneCountInString(w.opts.language) + 2
+}
+
+// measure recomputes the size and fence length of the buffered lines.
+func (w *LogWriter) measure() {
+	w.size, w.fenceLen = 0, 3
+	for i, line := range w.lines {
+		if i > 0 {
+			w.size++
+		}
+		w.size += utf8.RuneCountInString(line)
+		w.fenceLen = max(w.fenceLen, fenceLenFor(line))
+	}
+}
+
+// flush renders the buffered lines and hands them to the flush callback,
+// as several messages if a failed flush left more lines than fit in one.
+// Lines are only dropped once the callback has accepted them.
+func (w *LogWriter) flush() error {
+	if len(w.lines) == 0 {
+		return nil
+	}
+	if w.opts.flush == nil {
+		return ErrNoFlushFunc
+	}
+
+	for len(w.lines) > 0 {
+		n, fenceLen := w.batch()
+		var sb strings.Builder
+		fence := strings.Repeat("`", fenceLen)
+		sb.WriteString(fence)
+		sb.WriteString(w.opts.language)
+		sb.WriteString("\n")
+		sb.WriteString(strings.Join(w.lines[:n], "\n"))
+		sb.WriteString("\n")
+		sb.WriteString(fence)
+
+		if err := w.opts.flush(sb.String()); err != nil {
+			w.measure()
+			return err
+		}
+		w.lines = append(w.lines[:0], w.lines[n:]...)
+	}
+	w.measure()
+	return nil
+}
+
+// batch returns the number of leading buffered lines that fit in one
+// message, at least one, and the fence length they need.
+func (w *LogWriter) batch() (int, int) {
+	size, fenceLen := 0, 3
+	for i, line := range w.lines {
+		lineFence := max(fenceLen, fenceLenFor(line))
+		n := utf8.RuneCountInString(line)
+		if i > 0 {
+			n++
+			if size+n > w.opts.maxLength-w.overhead(lineFence) {
+				return i, fenceLen
+			}
+		}
+		size += n
+		fenceLen = lineFence
+	}
+	return len(w.lines), fenceLen
+}
@@ -0,0 +1,159 @@
+package zlmd
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestLogWriter_FlushOnClose(t *testing.T) {
+	var messages []string
+	w := NewLogWriter(WithFlushFunc(func(msg string) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	logger := log.New(w, "", 0)
+	logger.Println("first")
+	logger.Println("second")
+
+	if len(messages) != 0 {
+		t.Fatalf("Expected no messages before Close, got %d", len(messages))
+	}
+
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() returned error: %v", err)
+	}
+
+	expected := "```text\nfirst\nsecond\n```"
+	if len(messages) != 1 || messages[0] != expected {
+		t.Errorf("Unexpected messages\nExpected:\n%q\nGot:\n%q", expected, messages)
+	}
+}
+
+func TestLogWriter_PartialLines(t *testing.T) {
+	var messages []string
+	w := NewLogWriter(WithLanguage("log"), WithFlushFunc(func(msg string) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	fmt.Fprint(w, "hel")
+	fmt.Fprint(w, "lo\r\nwor")
+	fmt.Fprint(w, "ld")
+	w.Flush()
+
+	expected := "```log\nhello\nworld\n```"
+	if len(messages) != 1 || messages[0] != expected {
+		t.Errorf("Unexpected messages\nExpected:\n%q\nGot:\n%q", expected, messages)
+	}
+}
+
+func TestLogWriter_SizeLimit(t *testing.T) {
+	var messages []string
+	w := NewLogWriter(WithMaxLength(40), WithFlushFunc(func(msg string) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	for i := 0; i < 20; i++ {
+		fmt.Fprintf(w, "line %02d\n", i)
+	}
+	fmt.Fprintln(w, strings.Repeat("x", 100))
+	w.Close()
+
+	if len(messages) < 2 {
+		t.Fatalf("Expected output to be split into several messages, got %d", len(messages))
+	}
+
+	var lines int
+	for _, msg := range messages {
+		if n := utf8.RuneCountInString(msg); n > 40 {
+			t.Errorf("Message exceeds limit (%d > 40): %q", n, msg)
+		}
+		if !strings.HasPrefix(msg, "```text\n") || !strings.HasSuffix(msg, "\n```") {
+			t.Errorf("Message is not a fenced block: %q", msg)
+		}
+		lines += strings.Count(msg, "\n") - 1
+	}
+
+	if lines != 21 {
+		t.Errorf("Expected 21 lines across all messages, got %d", lines)
+	}
+
+	if !strings.Contains(messages[len(messages)-1], "…") {
+		t.Errorf("Expected oversized line to be truncated with an ellipsis: %q", messages[len(messages)-1])
+	}
+}
+
+func TestLogWriter_NestedFence(t *testing.T) {
+	var messages []string
+	w := NewLogWriter(WithFlushFunc(func(msg string) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	fmt.Fprintln(w, "```")
+	w.Flush()
+
+	expected := "````text\n```\n````"
+	if len(messages) != 1 || messages[0] != expected {
+		t.Errorf("Unexpected messages\nExpected:\n%q\nGot:\n%q", expected, messages)
+	}
+}
+
+func TestLogWriter_NoFlushFunc(t *testing.T) {
+	w := NewLogWriter(WithMaxLength(20))
+	if _, err := fmt.Fprintln(w, "first"); err != nil {
+		t.Fatalf("Write() returned error before a batch was full: %v", err)
+	}
+	if _, err := fmt.Fprintln(w, "second line"); !errors.Is(err, ErrNoFlushFunc) {
+		t.Errorf("Write() error = %v, want ErrNoFlushFunc", err)
+	}
+	if err := w.Flush(); !errors.Is(err, ErrNoFlushFunc) {
+		t.Errorf("Flush() error = %v, want ErrNoFlushFunc", err)
+	}
+	if err := NewLogWriter().Flush(); err != nil {
+		t.Errorf("Flush() of an empty writer returned error: %v", err)
+	}
+}
+
+func TestLogWriter_FailedFlush(t *testing.T) {
+	var messages []string
+	fail := true
+	w := NewLogWriter(WithMaxLength(25), WithFlushFunc(func(msg string) error {
+		if fail {
+			return errors.New("send failed")
+		}
+		messages = append(messages, msg)
+		return nil
+	}))
+
+	fmt.Fprintln(w, "first")
+	if _, err := fmt.Fprintln(w, "second line"); err == nil {
+		t.Fatal("Write() error = nil, want the flush error")
+	}
+	if err := w.Flush(); err == nil {
+		t.Fatal("Flush() error = nil, want the flush error")
+	}
+
+	fail = false
+	fmt.Fprintln(w, "third")
+	if err := w.Flush(); err != nil {
+		t.Fatalf("Flush() returned error: %v", err)
+	}
+
+	expected := []string{"```text\nfirst\n```", "```text\nsecond line\n```", "```text\nthird\n```"}
+	if !reflect.DeepEqual(messages, expected) {
+		t.Errorf("Unexpected messages\nExpected:\n%q\nGot:\n%q", expected, messages)
+	}
+	for _, msg := range messages {
+		if n := utf8.RuneCountInString(msg); n > 25 {
+			t.Errorf("message %q is %d characters, over the limit", msg, n)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package zlmd
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Markdowner is implemented by types that render themselves as Zulip
+// markdown. The builders of this package implement it, so any of them, or a
+// third-party type, can be added to a Section or passed to Render.
+//
+// Example:
+//
+//	type Deploy struct{ Service, Version string }
+//
+//	func (d Deploy) MarshalZulipMarkdown() (string, error) {
+//		return Bold(d.Service) + " " + Code(d.Version), nil
+//	}
+type Markdowner interface {
+	MarshalZulipMarkdown() (string, error)
+}
+
+var (
+	_ Markdowner = (*Section)(nil)
+	_ Markdowner = (*TableBuilder)(nil)
+	_ Markdowner = (*Timeline)(nil)
+	_ Markdowner = (*KVBlock)(nil)
+	_ Markdowner = (*JobReport)(nil)
+	_ Markdowner = (*StatusMessage)(nil)
+	_ Markdowner = (*Flow)(nil)
+	_ Markdowner = InlineSpan{}
+	_ Markdowner = (*Digest)(nil)
+	_ Markdowner = (*PollBuilder)(nil)
+	_ Markdowner = (*TodoBuilder)(nil)
+)
+
+// Render renders any value as markdown, the way fmt.Sprint renders it as
+// text.
+//
+// Parameters:
+//   - v (any): The value to render
+//
+// Returns:
+//   - string: The markdown for v
+//   - error: The error returned by a Markdowner
+//
+// Example:
+//
+//	table := NewTableBuilder().WithHeaders("Name").AddRow("api")
+//	out, err := Render(table)
+//	// out will be "| Name |\n| --- |\n| api |\n"
+//
+//	out, _ = Render(fmt.Errorf("deploy: %w", io.ErrUnexpectedEOF))
+//	// out will be "- deploy\n  - **unexpected EOF**"
+//
+// Notes:
+//   - A Markdowner renders itself, an error is rendered with FormatError, a
+//     fmt.Stringer with its String method and anything else with fmt.Sprint
+//   - Strings are used as they are; escape untrusted text with Escape first
+//   - nil, including a nil pointer, renders as ""
+func Render(v any) (string, error) {
+	if v == nil {
+		return "", nil
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return "", nil
+	}
+
+	switch v := v.(type) {
+	case Markdowner:
+		return v.MarshalZulipMarkdown()
+	case string:
+		return v, nil
+	case error:
+		return FormatError(v), nil
+	case fmt.Stringer:
+		return v.String(), nil
+	default:
+		return fmt.Sprint(v), nil
+	}
+}
@@ -0,0 +1,69 @@
+package zlmd
+
+import (
+	"errors"
+	"io"
+	"net/netip"
+	"testing"
+)
+
+type deploy struct{ service, version string }
+
+func (d deploy) MarshalZulipMarkdown() (string, error) {
+	if d.version == "" {
+		return "", errors.New("deploy: missing version")
+	}
+	return Bold(d.service) + " " + Code(d.version), nil
+}
+
+func TestRender(t *testing.T) {
+	var nilTable *TableBuilder
+
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+		wantErr  bool
+	}{
+		{"Nil", nil, "", false},
+		{"Nil pointer", nilTable, "", false},
+		{"Markdowner", deploy{"api", "v1.2.0"}, "**api** `v1.2.0`", false},
+		{"Markdowner error", deploy{service: "api"}, "", true},
+		{"Builder", NewTableBuilder().WithHeaders("Name").AddRow("api"), "| Name |\n| --- |\n| api |\n", false},
+		{"String", "*as is*", "*as is*", false},
+		{"Error", io.ErrUnexpectedEOF, "- **unexpected EOF**", false},
+		{"Stringer", netip.MustParseAddr("10.0.0.1"), "10.0.0.1", false},
+		{"Plain value", 42, "42", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Render(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Render() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("Render() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSection_Add(t *testing.T) {
+	section := NewSection(2, "Deploys").
+		Add(deploy{"api", "v1.2.0"}).
+		Add(NewKVBlock().Add("Region", "eu-1"))
+
+	got, err := section.MarshalZulipMarkdown()
+	if err != nil {
+		t.Fatalf("MarshalZulipMarkdown() returned error: %v", err)
+	}
+	if expected := "## Deploys\n\n**api** `v1.2.0`\n" + NewKVBlock().Add("Region", "eu-1").Build() + "\n\n"; got != expected {
+		t.Errorf("MarshalZulipMarkdown() = %q, want %q", got, expected)
+	}
+
+	_, err = NewSection(2, "Deploys").Add(deploy{service: "api"}).Add("ok").MarshalZulipMarkdown()
+	if err == nil || err.Error() != "deploy: missing version" {
+		t.Errorf("MarshalZulipMarkdown() error = %v, want the Markdowner's error", err)
+	}
+}
@@ -0,0 +1,72 @@
+package zlmd
+
+import "strings"
+
+// MathInline creates an inline LaTeX formula, which Zulip renders with
+// KaTeX.
+//
+// Parameters:
+//   - tex (string): The formula, in LaTeX math mode
+//
+// Returns:
+//   - string: The formula between "$$" delimiters
+//
+// Example:
+//
+//	MathInline(`e^{i\pi} + 1 = 0`) // "$$e^{i\pi} + 1 = 0$$"
+//	MathInline("cost $5")          // "$$cost \$5$$"
+//
+// Notes:
+//   - Unescaped "$" in tex is escaped as "\$", so "$$" can't end the
+//     formula early; it renders as a dollar sign
+//   - Line breaks are replaced by spaces, since an inline formula must stay
+//     on one line; LaTeX ignores them anyway
+//   - A formula starting with "_" is prefixed with "{}", as Zulip doesn't
+//     treat "$$_" as the start of a formula
+func MathInline(tex string) string {
+	tex = strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(tex))
+	tex = escapeMathDollars(tex)
+	if strings.HasPrefix(tex, "_") {
+		tex = "{}" + tex
+	}
+	return "$$" + tex + "$$"
+}
+
+// MathBlock creates a displayed LaTeX formula, which Zulip renders with
+// KaTeX on its own lines.
+//
+// Parameters:
+//   - tex (string): The formula, in LaTeX math mode; it may span several
+//     lines
+//
+// Returns:
+//   - string: A "```math" block holding the formula
+//
+// Example:
+//
+//	MathBlock(`\int_0^1 x^2 \, dx = \frac{1}{3}`)
+//	// "```math\n\\int_0^1 x^2 \\, dx = \\frac{1}{3}\n```"
+//
+// Notes:
+//   - Unescaped "$" in tex is escaped as "\$", as in MathInline
+//   - The fence is lengthened when tex contains backtick runs, as with
+//     FencedBlock
+//   - Does not add a trailing newline
+func MathBlock(tex string) string {
+	return FencedBlock("math", escapeMathDollars(strings.Trim(tex, "\n")))
+}
+
+// escapeMathDollars backslash-escapes each "$" of tex that isn't already
+// escaped.
+func escapeMathDollars(tex string) string {
+	var sb strings.Builder
+	escaped := false
+	for _, r := range tex {
+		if r == '$' && !escaped {
+			sb.WriteByte('\\')
+		}
+		escaped = r == '\\' && !escaped
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,48 @@
+package zlmd
+
+import "testing"
+
+func TestMathInline(t *testing.T) {
+	tests := []struct {
+		name     string
+		tex      string
+		expected string
+	}{
+		{"Formula", `e^{i\pi} + 1 = 0`, `$$e^{i\pi} + 1 = 0$$`},
+		{"Dollar", "cost $5", `$$cost \$5$$`},
+		{"Double dollar", "a$$b", `$$a\$\$b$$`},
+		{"Escaped dollar", `cost \$5`, `$$cost \$5$$`},
+		{"Escaped backslash before dollar", `a\\$b`, `$$a\\\$b$$`},
+		{"Line breaks", "a +\nb", "$$a + b$$"},
+		{"Leading underscore", "_i", "$${}_i$$"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MathInline(tt.tex); got != tt.expected {
+				t.Errorf("MathInline() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMathBlock(t *testing.T) {
+	tests := []struct {
+		name     string
+		tex      string
+		expected string
+	}{
+		{"Formula", `\frac{1}{3}`, "```math\n\\frac{1}{3}\n```"},
+		{"Multi-line", "a \\\\\nb\n", "```math\na \\\\\nb\n```"},
+		{"Dollar", "$$x$$", "```math\n\\$\\$x\\$\\$\n```"},
+		{"Backticks", "```", "````math\n```\n````"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MathBlock(tt.tex); got != tt.expected {
+				t.Errorf("MathBlock() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package zlmd
+
+import (
+	"strings"
+)
+
+// Status is the state of a single cell in a StatusMatrix.
+type Status int
+
+const (
+	// StatusUnknown means no information is available.
+	StatusUnknown Status = iota
+	// StatusOK means the cell is healthy.
+	StatusOK
+	// StatusWarning means the cell is degraded.
+	StatusWarning
+	// StatusError means the cell is failing.
+	StatusError
+	// StatusPending means the cell is in progress.
+	StatusPending
+)
+
+// String returns the lowercase name of the status, as used in legends.
+func (s Status) String() string {
+	switch s {
+	case StatusOK:
+		return "ok"
+	case StatusWarning:
+		return "warning"
+	case StatusError:
+		return "error"
+	case StatusPending:
+		return "pending"
+	default:
+		return "unknown"
+	}
+}
+
+// Style returns the theme style name used to render the status.
+func (s Status) Style() string {
+	switch s {
+	case StatusOK:
+		return "success"
+	case StatusWarning:
+		return "warning"
+	case StatusError:
+		return "danger"
+	case StatusPending:
+		return "pending"
+	default:
+		return "unknown"
+	}
+}
+
+// StatusMatrix renders a grid of statuses, such as services × environments,
+// as a table of emoji cells followed by a legend.
+//
+// Parameters:
+//   - rows ([]string): The row labels, rendered in bold in the first column
+//   - cols ([]string): The column labels, rendered as table headers
+//   - status (func(r, c string) Status): Returns the status of a row/column pair
+//   - opts (...Option): Optional settings; WithTheme selects the cell prefixes
+//
+// Returns:
+//   - string: The markdown table and legend, or "" if rows or cols is empty
+//
+// Example:
+//
+//	out := StatusMatrix([]string{"api"}, []string{"staging", "prod"}, func(r, c string) Status {
+//	  if c == "prod" {
+//	    return StatusWarning
+//	  }
+//	  return StatusOK
+//	})
+//	// out will be:
+//	// |  | staging | prod |
+//	// | --- | :---: | :---: |
+//	// | **api** | ✅ | ⚠️ |
+//	//
+//	// ✅ ok · ⚠️ warning
+//
+// Notes:
+//   - The legend lists only the statuses that appear in the grid, in severity order
+func StatusMatrix(rows, cols []string, status func(r, c string) Status, opts ...Option) string {
+	if len(rows) == 0 || len(cols) == 0 {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	headers := append([]string{""}, cols...)
+	table := NewTableBuilder().WithHeaders(headers...)
+	for i := range cols {
+		table.SetAlignment(i+1, AlignCenter)
+	}
+
+	seen := map[Status]bool{}
+	for _, r := range rows {
+		cells := make([]string, 0, len(cols)+1)
+		cells = append(cells, Bold(escapeTableCell(r)))
+		for _, c := range cols {
+			s := status(r, c)
+			seen[s] = true
+			cells = append(cells, o.theme.Prefix(s.Style()))
+		}
+		table.AddRow(cells...)
+	}
+
+	var legend []string
+	for _, s := range []Status{StatusOK, StatusPending, StatusWarning, StatusError, StatusUnknown} {
+		if seen[s] {
+			legend = append(legend, o.theme.Prefix(s.Style())+" "+s.String())
+		}
+	}
+
+	return table.Build() + "\n" + strings.Join(legend, " · ") + "\n"
+}
@@ -0,0 +1,47 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestStatusMatrix(t *testing.T) {
+	rows := []string{"api", "worker"}
+	cols := []string{"staging", "prod"}
+	status := func(r, c string) Status {
+		switch {
+		case r == "worker" && c == "prod":
+			return StatusError
+		case c == "prod":
+			return StatusWarning
+		default:
+			return StatusOK
+		}
+	}
+
+	got := StatusMatrix(rows, cols, status)
+	expected := "|  | staging | prod |\n| --- | :---: | :---: |\n" +
+		"| **api** | ✅ | ⚠️ |\n" +
+		"| **worker** | ✅ | ❌ |\n" +
+		"\n✅ ok · ⚠️ warning · ❌ error\n"
+	if got != expected {
+		t.Errorf("StatusMatrix() = %q, want %q", got, expected)
+	}
+
+	got = StatusMatrix(rows[:1], cols[:1], status, WithTheme(PlainTheme))
+	expected = "|  | staging |\n| --- | :---: |\n| **api** | [OK] |\n\n[OK] ok\n"
+	if got != expected {
+		t.Errorf("StatusMatrix() with plain theme = %q, want %q", got, expected)
+	}
+}
+
+func TestStatusMatrix_Empty(t *testing.T) {
+	if got := StatusMatrix(nil, []string{"prod"}, nil); got != "" {
+		t.Errorf("StatusMatrix() with no rows = %q, want empty string", got)
+	}
+}
+
+func TestStatus_String(t *testing.T) {
+	if StatusPending.String() != "pending" || Status(42).String() != "unknown" {
+		t.Errorf("Unexpected status names: %q %q", StatusPending, Status(42))
+	}
+}
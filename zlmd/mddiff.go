@@ -0,0 +1,160 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ChangeKind describes how a block differs between two markdown documents.
+type ChangeKind int
+
+const (
+	// ChangeAdded means the block only exists in the new document.
+	ChangeAdded ChangeKind = iota + 1
+	// ChangeRemoved means the block only exists in the old document.
+	ChangeRemoved
+	// ChangeModified means the block was edited in place.
+	ChangeModified
+)
+
+// String returns the lowercase name of the change kind.
+func (k ChangeKind) String() string {
+	switch k {
+	case ChangeAdded:
+		return "added"
+	case ChangeRemoved:
+		return "removed"
+	case ChangeModified:
+		return "modified"
+	default:
+		return "unchanged"
+	}
+}
+
+// BlockChange is a single block-level difference found by DiffMarkdown.
+type BlockChange struct {
+	Kind ChangeKind
+	// Old and New hold the block text on each side; Old is empty for added
+	// blocks and New is empty for removed ones.
+	Old, New string
+	// OldIndex and NewIndex are the zero-based block positions on each side,
+	// or -1 when the block doesn't exist on that side.
+	OldIndex, NewIndex int
+}
+
+// MarkdownBlocks splits markdown into its top-level blocks: runs of lines
+// separated by blank lines, with fenced blocks kept whole even when they
+// contain blank lines. Trailing whitespace is stripped from every line.
+//
+// Example:
+//
+//	MarkdownBlocks("# Title\n\nText\n\n```\na\n\nb\n```")
+//	// []string{"# Title", "Text", "```\na\n\nb\n```"}
+func MarkdownBlocks(markdown string) []string {
+	var blocks, current []string
+	var fence string
+	flush := func() {
+		if len(current) > 0 {
+			blocks = append(blocks, strings.Join(current, "\n"))
+			current = nil
+		}
+	}
+
+	for _, line := range splitLines(markdown) {
+		line = strings.TrimRight(line, " \t\r")
+		trimmed := strings.TrimLeft(line, " ")
+		switch {
+		case fence != "":
+			current = append(current, line)
+			if isClosingFence(trimmed, fence) {
+				fence = ""
+			}
+		case line == "":
+			flush()
+		default:
+			if f := openingFence(trimmed); f != "" {
+				fence = f
+			}
+			current = append(current, line)
+		}
+	}
+	flush()
+	return blocks
+}
+
+// DiffMarkdown compares two markdown documents block by block, ignoring
+// differences in blank lines and trailing whitespace.
+//
+// Parameters:
+//   - old (string): The original document
+//   - new (string): The changed document
+//
+// Returns:
+//   - []BlockChange: The changes in document order, or nil if the documents
+//     are equivalent
+//
+// Example:
+//
+//	changes := DiffMarkdown("# Status\n\nAll good", "# Status\n\nDegraded\n\nSee log")
+//	// changes[0]: ChangeModified, "All good" → "Degraded"
+//	// changes[1]: ChangeAdded, "See log"
+//
+// Notes:
+//   - A removed block directly followed by an added block is reported as a
+//     single modification
+func DiffMarkdown(old, new string) []BlockChange {
+	ops := diffLines(MarkdownBlocks(old), MarkdownBlocks(new))
+
+	var changes []BlockChange
+	for i := 0; i < len(ops); i++ {
+		if ops[i].kind == ' ' {
+			continue
+		}
+
+		// Collect the run of removals followed by additions and pair them up.
+		var removed, added []diffOp
+		for ; i < len(ops) && ops[i].kind == '-'; i++ {
+			removed = append(removed, ops[i])
+		}
+		for ; i < len(ops) && ops[i].kind == '+'; i++ {
+			added = append(added, ops[i])
+		}
+		i--
+
+		for k := 0; k < max(len(removed), len(added)); k++ {
+			switch {
+			case k < len(removed) && k < len(added):
+				changes = append(changes, BlockChange{ChangeModified, removed[k].text, added[k].text, removed[k].a, added[k].b})
+			case k < len(removed):
+				changes = append(changes, BlockChange{ChangeRemoved, removed[k].text, "", removed[k].a, -1})
+			default:
+				changes = append(changes, BlockChange{ChangeAdded, "", added[k].text, -1, added[k].b})
+			}
+		}
+	}
+	return changes
+}
+
+// SummarizeChanges counts changes by kind, such as "1 added, 2 modified".
+//
+// Example:
+//
+//	SummarizeChanges(DiffMarkdown(a, b)) // "1 added, 2 modified"
+//	SummarizeChanges(nil)                // "no changes"
+func SummarizeChanges(changes []BlockChange) string {
+	counts := map[ChangeKind]int{}
+	for _, c := range changes {
+		counts[c.Kind]++
+	}
+
+	var parts []string
+	for _, kind := range []ChangeKind{ChangeAdded, ChangeRemoved, ChangeModified} {
+		if counts[kind] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[kind], kind))
+		}
+	}
+	if len(parts) == 0 {
+		return "no changes"
+	}
+	return strings.Join(parts, ", ")
+}
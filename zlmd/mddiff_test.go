@@ -0,0 +1,61 @@
+package zlmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMarkdownBlocks(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected []string
+	}{
+		{"Empty", "", nil},
+		{"Paragraphs", "# Title  \n\n\nline one\nline two\n", []string{"# Title", "line one\nline two"}},
+		{"Fence with blank line", "Intro\n\n```\na\n\nb\n```\nafter", []string{"Intro", "```\na\n\nb\n```\nafter"}},
+		{"Longer closing fence needed", "````\n```\n\n````", []string{"````\n```\n\n````"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MarkdownBlocks(tt.markdown)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("MarkdownBlocks() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDiffMarkdown(t *testing.T) {
+	old := "# Status\n\nAll good\n\nFooter"
+	new := "# Status\n\n\nDegraded\n\nSee log\n\nFooter  \n"
+
+	got := DiffMarkdown(old, new)
+	expected := []BlockChange{
+		{ChangeModified, "All good", "Degraded", 1, 1},
+		{ChangeAdded, "", "See log", -1, 2},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("DiffMarkdown() = %+v, want %+v", got, expected)
+	}
+
+	if got := DiffMarkdown("a\n\nb", "a\n\n\nb\n"); got != nil {
+		t.Errorf("Expected no changes for whitespace-only edits, got %+v", got)
+	}
+
+	removed := DiffMarkdown("a\n\nb", "a")
+	if len(removed) != 1 || removed[0].Kind != ChangeRemoved || removed[0].OldIndex != 1 || removed[0].NewIndex != -1 {
+		t.Errorf("Expected a single removal, got %+v", removed)
+	}
+}
+
+func TestSummarizeChanges(t *testing.T) {
+	changes := []BlockChange{{Kind: ChangeModified}, {Kind: ChangeAdded}, {Kind: ChangeModified}}
+	if got := SummarizeChanges(changes); got != "1 added, 2 modified" {
+		t.Errorf("SummarizeChanges() = %q, want %q", got, "1 added, 2 modified")
+	}
+	if got := SummarizeChanges(nil); got != "no changes" {
+		t.Errorf("SummarizeChanges(nil) = %q, want %q", got, "no changes")
+	}
+}
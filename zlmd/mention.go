@@ -0,0 +1,189 @@
+package zlmd
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Mention creates a Zulip mention of a user, which notifies them.
+//
+// Parameters:
+//   - name (string): The user's full name
+//   - userID (int): The user's ID; zero or less mentions by name only
+//
+// Returns:
+//   - string: The mention
+//
+// Example:
+//
+//	Mention("Alice Smith", 1234) // "@**Alice Smith|1234**"
+//	Mention("Alice Smith", 0)    // "@**Alice Smith**"
+//
+// Notes:
+//   - Prefer passing the ID: a mention by name only is ambiguous when two
+//     users share a name, and breaks when the user is renamed
+//   - Zulip mention syntax has no escapes, so "*" and line breaks are
+//     removed from name
+func Mention(name string, userID int) string {
+	var sb strings.Builder
+	WriteMention(&sb, name, userID)
+	return sb.String()
+}
+
+// WriteMention writes a mention of a user to the provided StringBuilder.
+//
+// Parameters:
+//   - sb (*strings.Builder): The string builder to write to
+//   - name (string): The user's full name
+//   - userID (int): The user's ID; zero or less mentions by name only
+//
+// Returns:
+//   - None
+//
+// Example:
+//
+//	var sb strings.Builder
+//	WriteMention(&sb, "Alice Smith", 1234)
+//	// sb now contains "@**Alice Smith|1234**"
+//
+// Notes:
+//   - Does not add a trailing newline
+func WriteMention(sb *strings.Builder, name string, userID int) {
+	sb.WriteString("@**")
+	sb.WriteString(mentionName(name))
+	if userID > 0 {
+		sb.WriteByte('|')
+		sb.WriteString(strconv.Itoa(userID))
+	}
+	sb.WriteString("**")
+}
+
+// mentionName removes the characters that would end a mention early.
+func mentionName(name string) string {
+	name = strings.NewReplacer("*", "", "\r", " ", "\n", " ").Replace(name)
+	return strings.TrimSpace(name)
+}
+
+// GroupMention creates a Zulip mention of a user group, which notifies its
+// members.
+//
+// Parameters:
+//   - name (string): The group name, such as "backend" or "oncall"
+//
+// Returns:
+//   - string: The mention
+//
+// Example:
+//
+//	GroupMention("oncall") // "@*oncall*"
+//
+// Notes:
+//   - Zulip group names can't contain "*", so the mention syntax has no way
+//     to write one; "*" in name is backslash-escaped so the mention can't
+//     end early and mention a different group, and renders as text instead
+//   - Line breaks in name are replaced by spaces
+func GroupMention(name string) string {
+	var sb strings.Builder
+	WriteGroupMention(&sb, name)
+	return sb.String()
+}
+
+// WriteGroupMention writes a mention of a user group to the provided
+// StringBuilder.
+//
+// Parameters:
+//   - sb (*strings.Builder): The string builder to write to
+//   - name (string): The group name
+//
+// Returns:
+//   - None
+//
+// Example:
+//
+//	var sb strings.Builder
+//	WriteGroupMention(&sb, "oncall")
+//	// sb now contains "@*oncall*"
+//
+// Notes:
+//   - Does not add a trailing newline
+func WriteGroupMention(sb *strings.Builder, name string) {
+	name = strings.NewReplacer("\\", "\\\\", "*", "\\*", "\r", " ", "\n", " ").Replace(name)
+	sb.WriteString("@*")
+	sb.WriteString(strings.TrimSpace(name))
+	sb.WriteString("*")
+}
+
+// Wildcard is a wildcard mention, which notifies every subscriber of a
+// channel or every participant of a topic.
+type Wildcard string
+
+const (
+	// WildcardAll notifies every subscriber of the channel.
+	WildcardAll Wildcard = "all"
+	// WildcardEveryone notifies every subscriber of the channel.
+	WildcardEveryone Wildcard = "everyone"
+	// WildcardChannel notifies every subscriber of the channel; servers
+	// older than 9.0 get @**stream** instead.
+	WildcardChannel Wildcard = "channel"
+	// WildcardStream is the name of WildcardChannel before Zulip 9.0.
+	WildcardStream Wildcard = "stream"
+	// WildcardTopic notifies the participants of the topic; it needs Zulip
+	// 8.0.
+	WildcardTopic Wildcard = "topic"
+)
+
+// WildcardMention creates a wildcard mention, such as @**all**.
+//
+// Parameters:
+//   - w (Wildcard): The wildcard, one of the Wildcard constants
+//   - opts (...Option): Optional settings; WithDefangedWildcards renders the
+//     mention inside inline code, so it shows but notifies nobody
+//
+// Returns:
+//   - string: The mention
+//
+// Example:
+//
+//	WildcardMention(WildcardTopic)                            // "@**topic**"
+//	WildcardMention(WildcardAll, WithDefangedWildcards(true)) // "`@**all**`"
+//
+// Notes:
+//   - Values other than the Wildcard constants are always defanged
+//   - When the target server version doesn't render the wildcard,
+//     WildcardChannel becomes @**stream**, and WildcardTopic is defanged
+//     rather than replaced by a wildcard that notifies more people
+func WildcardMention(w Wildcard, opts ...Option) string {
+	o := newOptions(opts...)
+	mention := "@**" + string(w) + "**"
+
+	defang := o.defangWildcards
+	switch w {
+	case WildcardAll, WildcardEveryone, WildcardStream:
+	case WildcardChannel:
+		if !o.targetVersion.Supports(FeatureChannelWildcard) {
+			mention = "@**" + string(WildcardStream) + "**"
+		}
+	case WildcardTopic:
+		defang = defang || !o.targetVersion.Supports(FeatureTopicWildcard)
+	default:
+		defang = true
+	}
+	if defang {
+		return inlineCode(mention)
+	}
+	return mention
+}
+
+// WithDefangedWildcards renders the wildcard mentions of WildcardMention
+// inside inline code when defang is true, for automated messages that
+// must not notify whole channels, such as ones quoting user input or sent
+// from test environments.
+//
+// Example:
+//
+//	SetDefaultConfig(Config{DefangWildcards: os.Getenv("ENV") != "prod"})
+func WithDefangedWildcards(defang bool) Option {
+	return func(o *options) {
+		o.defangWildcards = defang
+	}
+}
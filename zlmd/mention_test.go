@@ -0,0 +1,116 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMention(t *testing.T) {
+	tests := []struct {
+		name     string
+		user     string
+		userID   int
+		expected string
+	}{
+		{"With ID", "Alice Smith", 1234, "@**Alice Smith|1234**"},
+		{"Without ID", "Alice Smith", 0, "@**Alice Smith**"},
+		{"Negative ID", "Alice", -1, "@**Alice**"},
+		{"Unsafe name", " Bob **x**\nY ", 7, "@**Bob x Y|7**"},
+		{"Unicode", "Zoë 张", 42, "@**Zoë 张|42**"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Mention(tt.user, tt.userID); got != tt.expected {
+				t.Errorf("Mention(%q, %d) = %q, want %q", tt.user, tt.userID, got, tt.expected)
+			}
+			var sb strings.Builder
+			WriteMention(&sb, tt.user, tt.userID)
+			if got := sb.String(); got != tt.expected {
+				t.Errorf("WriteMention(%q, %d) = %q, want %q", tt.user, tt.userID, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMention_Extract(t *testing.T) {
+	refs := ExtractMentions("cc " + Mention("Alice Smith", 1234))
+	if len(refs) != 1 || refs[0].Name != "Alice Smith" || refs[0].UserID != 1234 {
+		t.Errorf("ExtractMentions() = %+v, want Alice Smith with ID 1234", refs)
+	}
+}
+
+func TestGroupMention(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    string
+		expected string
+	}{
+		{"Simple", "oncall", "@*oncall*"},
+		{"Spaces", "Backend team", "@*Backend team*"},
+		{"Asterisk", "a*b", "@*a\\*b*"},
+		{"Backslash", `a\b`, `@*a\\b*`},
+		{"Line break", "x\ny", "@*x y*"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GroupMention(tt.group); got != tt.expected {
+				t.Errorf("GroupMention(%q) = %q, want %q", tt.group, got, tt.expected)
+			}
+			var sb strings.Builder
+			WriteGroupMention(&sb, tt.group)
+			if got := sb.String(); got != tt.expected {
+				t.Errorf("WriteGroupMention(%q) = %q, want %q", tt.group, got, tt.expected)
+			}
+		})
+	}
+
+	refs := ExtractMentions("cc " + GroupMention("oncall"))
+	if len(refs) != 1 || !refs[0].Group || refs[0].Name != "oncall" {
+		t.Errorf("ExtractMentions() = %+v, want the oncall group", refs)
+	}
+}
+
+func TestWildcardMention(t *testing.T) {
+	old := WithTargetVersion(ServerVersion{Major: 7})
+	tests := []struct {
+		name     string
+		wildcard Wildcard
+		opts     []Option
+		expected string
+	}{
+		{"All", WildcardAll, nil, "@**all**"},
+		{"Everyone", WildcardEveryone, nil, "@**everyone**"},
+		{"Stream", WildcardStream, nil, "@**stream**"},
+		{"Channel", WildcardChannel, nil, "@**channel**"},
+		{"Topic", WildcardTopic, nil, "@**topic**"},
+		{"Defanged", WildcardAll, []Option{WithDefangedWildcards(true)}, "`@**all**`"},
+		{"Channel on old server", WildcardChannel, []Option{old}, "@**stream**"},
+		{"Topic on old server", WildcardTopic, []Option{old}, "`@**topic**`"},
+		{"Unknown", Wildcard("here"), nil, "`@**here**`"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := WildcardMention(tt.wildcard, tt.opts...); got != tt.expected {
+				t.Errorf("WildcardMention(%q) = %q, want %q", tt.wildcard, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestWildcardMention_Config(t *testing.T) {
+	SetDefaultConfig(Config{DefangWildcards: true})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	if got, want := WildcardMention(WildcardEveryone), "`@**everyone**`"; got != want {
+		t.Errorf("WildcardMention() = %q, want %q", got, want)
+	}
+	if got, want := WildcardMention(WildcardEveryone, WithDefangedWildcards(false)), "@**everyone**"; got != want {
+		t.Errorf("WildcardMention() with override = %q, want %q", got, want)
+	}
+	if refs := ExtractMentions(WildcardMention(WildcardAll)); len(refs) != 0 {
+		t.Errorf("ExtractMentions() of a defanged wildcard = %+v, want none", refs)
+	}
+}
@@ -0,0 +1,182 @@
+package zlmd
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"unicode/utf8"
+)
+
+// MessageWriter is an io.Writer that collects markdown and hands it to a
+// flush callback in messages that fit the message length limit.
+//
+// Unlike LogWriter, which wraps everything it receives in a code block, it
+// passes markdown through as written. When a message fills up inside a
+// fenced block, such as a code block or spoiler, the block is closed at the
+// end of the message and reopened at the start of the next one, so every
+// message renders on its own. It is safe for concurrent use.
+type MessageWriter struct {
+	mu      sync.Mutex
+	opts    options
+	partial []byte
+	lines   []string
+	size    int
+	// content counts the lines written to the current message, not
+	// counting a reopened fence.
+	content int
+	// open is the line that opened the fenced block the writer is in, and
+	// fence its fence marker, or both are empty outside a block.
+	open  string
+	fence string
+}
+
+// NewMessageWriter creates a MessageWriter.
+//
+// Parameters:
+//   - opts (...Option): Optional settings; WithMaxLength sets the message
+//     size and WithFlushFunc receives each message
+//
+// Returns:
+//   - *MessageWriter: A writer implementing io.Writer
+//
+// Example:
+//
+//	w := NewMessageWriter(WithFlushFunc(func(msg string) error {
+//	  _, err := client.SendStream(ctx, "builds", "nightly", msg)
+//	  return err
+//	}))
+//	report.Execute(w, data)
+//	w.Close()
+//
+// Notes:
+//   - Messages are broken between lines; a line longer than a whole message
+//     is split by runes
+//   - Call Flush or Close to send the final, partially filled message
+//   - Without WithFlushFunc, writes return ErrNoFlushFunc once a message is
+//     full, and Flush returns it while lines are buffered
+func NewMessageWriter(opts ...Option) *MessageWriter {
+	return &MessageWriter{opts: newOptions(opts...)}
+}
+
+// Write buffers p, adding each complete line to the current message and
+// flushing the message whenever the next line would not fit.
+func (w *MessageWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.partial = append(w.partial, p...)
+	for {
+		i := bytes.IndexByte(w.partial, '\n')
+		if i < 0 {
+			break
+		}
+		line := string(w.partial[:i])
+		w.partial = append(w.partial[:0], w.partial[i+1:]...)
+		if err := w.addLine(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// Flush sends the current message, including an unterminated trailing
+// line, closing any open fenced block. Writing can continue afterwards; the
+// block is then reopened in the next message.
+func (w *MessageWriter) Flush() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if len(w.partial) > 0 {
+		line := string(w.partial)
+		w.partial = w.partial[:0]
+		if err := w.addLine(line); err != nil {
+			return err
+		}
+	}
+	return w.flush()
+}
+
+// Close flushes the current message. The writer remains usable afterwards.
+func (w *MessageWriter) Close() error {
+	return w.Flush()
+}
+
+// addLine appends line to the current message, flushing first if it would
+// not fit together with the fence needed to close the message. The line is
+// kept even if the flush fails, so only the failed message is lost.
+func (w *MessageWriter) addLine(line string) error {
+	line = strings.TrimSuffix(line, "\r")
+	if w.content == 0 && w.fence == "" && strings.TrimSpace(line) == "" {
+		return nil
+	}
+
+	open, fence := w.open, w.fence
+	trimmed := strings.TrimLeft(line, " ")
+	if fence != "" {
+		if isClosingFence(trimmed, fence) {
+			open, fence = "", ""
+		}
+	} else if f := openingFence(trimmed); f != "" {
+		open, fence = line, f
+	}
+
+	budget := w.opts.maxLength - closingCost(fence)
+	if w.open != "" {
+		budget -= utf8.RuneCountInString(w.open) + 1
+	}
+	var err error
+	for _, piece := range splitGraphemes(line, max(budget, 1)) {
+		n := utf8.RuneCountInString(piece)
+		if len(w.lines) > 0 {
+			n++
+		}
+		if w.content > 0 && w.size+n+closingCost(fence) > w.opts.maxLength {
+			if ferr := w.flush(); ferr != nil && err == nil {
+				err = ferr
+			}
+			n = utf8.RuneCountInString(piece)
+			if len(w.lines) > 0 {
+				n++
+			}
+		}
+		w.lines = append(w.lines, piece)
+		w.size += n
+		w.content++
+	}
+	w.open, w.fence = open, fence
+	return err
+}
+
+// flush sends the current message, closing the open fenced block, and
+// starts the next message by reopening it.
+func (w *MessageWriter) flush() error {
+	if w.content == 0 {
+		return nil
+	}
+	if w.opts.flush == nil {
+		return ErrNoFlushFunc
+	}
+
+	message := strings.TrimRight(strings.Join(w.lines, "\n"), "\n")
+	if w.fence != "" {
+		message += "\n" + w.fence
+	}
+
+	w.lines = w.lines[:0]
+	w.size = 0
+	w.content = 0
+	if w.open != "" {
+		w.lines = append(w.lines, w.open)
+		w.size = utf8.RuneCountInString(w.open)
+	}
+	return w.opts.flush(message)
+}
+
+// closingCost returns the number of characters needed to close fence at the
+// end of a message.
+func closingCost(fence string) int {
+	if fence == "" {
+		return 0
+	}
+	return len(fence) + 1
+}
@@ -0,0 +1,142 @@
+package zlmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func collectMessages(opts ...Option) (*MessageWriter, *[]string) {
+	var messages []string
+	opts = append(opts, WithFlushFunc(func(msg string) error {
+		messages = append(messages, msg)
+		return nil
+	}))
+	return NewMessageWriter(opts...), &messages
+}
+
+func TestMessageWriter(t *testing.T) {
+	tests := []struct {
+		name      string
+		maxLength int
+		input     string
+		expected  []string
+	}{
+		{
+			name:      "Fits in one message",
+			maxLength: 100,
+			input:     "**build** passed\n\nall good\n",
+			expected:  []string{"**build** passed\n\nall good"},
+		},
+		{
+			name:      "Breaks between lines",
+			maxLength: 10,
+			input:     "aaaa\nbbbb\ncccc\n",
+			expected:  []string{"aaaa\nbbbb", "cccc"},
+		},
+		{
+			name:      "Closes and reopens a code block",
+			maxLength: 20,
+			input:     "```go\nline one\nline two\nline three\n```\ndone\n",
+			expected: []string{
+				"```go\nline one\n```",
+				"```go\nline two\n```",
+				"```go\nline three\n```",
+				"done",
+			},
+		},
+		{
+			name:      "Longer fence inside spoiler",
+			maxLength: 40,
+			input:     "````spoiler Log\n```\nfirst\n```\nsecond line here\n````\n",
+			expected: []string{
+				"````spoiler Log\n```\nfirst\n```\n````",
+				"````spoiler Log\nsecond line here\n````",
+			},
+		},
+		{
+			name:      "Splits an overlong line",
+			maxLength: 5,
+			input:     "abcdefghijkl\n",
+			expected:  []string{"abcde", "fghij", "kl"},
+		},
+		{
+			name:      "Skips leading blank lines",
+			maxLength: 10,
+			input:     "aaaa\nbbbb\n\ncccc\n",
+			expected:  []string{"aaaa\nbbbb", "cccc"},
+		},
+		{
+			name:      "Holds unterminated line until Flush",
+			maxLength: 100,
+			input:     "first\nsecond",
+			expected:  []string{"first\nsecond"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, messages := collectMessages(WithMaxLength(tt.maxLength))
+			if _, err := w.Write([]byte(tt.input)); err != nil {
+				t.Fatalf("Write() returned error: %v", err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() returned error: %v", err)
+			}
+
+			if strings.Join(*messages, "\n---\n") != strings.Join(tt.expected, "\n---\n") {
+				t.Errorf("MessageWriter sent %q, want %q", *messages, tt.expected)
+			}
+			for _, msg := range *messages {
+				if n := utf8.RuneCountInString(msg); n > tt.maxLength {
+					t.Errorf("message %q has %d characters, limit %d", msg, n, tt.maxLength)
+				}
+			}
+		})
+	}
+}
+
+func TestMessageWriter_PartialWrites(t *testing.T) {
+	w, messages := collectMessages(WithMaxLength(100))
+
+	w.Write([]byte("hel"))
+	w.Write([]byte("lo\nwor"))
+	if len(*messages) != 0 {
+		t.Fatalf("MessageWriter sent %q before Flush", *messages)
+	}
+	w.Write([]byte("ld\n"))
+	w.Flush()
+	if len(*messages) != 1 || (*messages)[0] != "hello\nworld" {
+		t.Errorf("MessageWriter sent %q, want %q", *messages, []string{"hello\nworld"})
+	}
+
+	w.Flush()
+	if len(*messages) != 1 {
+		t.Errorf("empty Flush() sent %q", (*messages)[1:])
+	}
+}
+
+func TestMessageWriter_FlushError(t *testing.T) {
+	errSend := errors.New("send failed")
+	w := NewMessageWriter(WithMaxLength(10), WithFlushFunc(func(string) error {
+		return errSend
+	}))
+
+	if _, err := w.Write([]byte("aaaa\nbbbb\ncccc\n")); !errors.Is(err, errSend) {
+		t.Errorf("Write() error = %v, want %v", err, errSend)
+	}
+	if err := w.Close(); !errors.Is(err, errSend) {
+		t.Errorf("Close() error = %v, want %v", err, errSend)
+	}
+}
+
+func TestMessageWriter_NoFlushFunc(t *testing.T) {
+	w := NewMessageWriter()
+	if _, err := w.Write([]byte("hello\n")); err != nil {
+		t.Fatalf("Write() returned error before a message was full: %v", err)
+	}
+	if err := w.Flush(); !errors.Is(err, ErrNoFlushFunc) {
+		t.Errorf("Flush() error = %v, want ErrNoFlushFunc", err)
+	}
+}
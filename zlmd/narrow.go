@@ -0,0 +1,225 @@
+package zlmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Narrow is a view of a Zulip organization identified by a narrow link, such
+// as a stream, a topic, a conversation or a single message in one of them.
+type Narrow struct {
+	// Realm is the organization's base URL, such as
+	// "https://chat.example.com", or "" for a link without a host.
+	Realm string
+	// StreamID and Stream identify the stream; either may be unset. In links
+	// with a StreamID, Stream is only a slug in which spaces are written as
+	// hyphens, so StreamID is the reliable identifier when present; without
+	// one, Stream is the exact stream name.
+	StreamID int
+	Stream   string
+	Topic    string
+	// UserIDs lists the participants of a direct message conversation.
+	UserIDs []int
+	// MessageID is the message the link points at, or 0.
+	MessageID int
+}
+
+// ParseNarrowURL decodes a Zulip narrow link, as copied from the web app or
+// produced by Narrow.String.
+//
+// Parameters:
+//   - u (string): An absolute link, or just its "#narrow/..." fragment
+//
+// Returns:
+//   - Narrow: The decoded narrow
+//   - error: If u is not a narrow link or uses operators Narrow can't
+//     represent, such as searches or "is:starred"
+//
+// Example:
+//
+//	n, err := ParseNarrowURL("https://chat.example.com/#narrow/stream/42-build-alerts/topic/deploy.20failed/near/1234")
+//	// n.Realm: "https://chat.example.com"
+//	// n.StreamID: 42, n.Stream: "build-alerts"
+//	// n.Topic: "deploy failed", n.MessageID: 1234
+//
+// Notes:
+//   - Both the current operator names (channel, with, dm) and the older ones
+//     (stream, subject, near, pm-with) are accepted
+//   - Operands use Zulip's hash encoding, where "." takes the place of "%"
+func ParseNarrowURL(u string) (Narrow, error) {
+	parsed, err := url.Parse(strings.TrimSpace(u))
+	if err != nil {
+		return Narrow{}, fmt.Errorf("zlmd: invalid narrow link: %w", err)
+	}
+
+	var n Narrow
+	if parsed.Host != "" {
+		n.Realm = parsed.Scheme + "://" + parsed.Host + strings.TrimSuffix(parsed.Path, "/")
+	}
+	fragment, ok := strings.CutPrefix(parsed.EscapedFragment(), "narrow/")
+	if !ok {
+		return Narrow{}, fmt.Errorf("zlmd: %q is not a narrow link", u)
+	}
+
+	terms := strings.Split(strings.TrimSuffix(fragment, "/"), "/")
+	if len(terms)%2 != 0 {
+		return Narrow{}, fmt.Errorf("zlmd: narrow link %q has an operator without an operand", u)
+	}
+	for i := 0; i < len(terms); i += 2 {
+		operator := terms[i]
+		operand, err := decodeHashComponent(terms[i+1])
+		if err != nil {
+			return Narrow{}, fmt.Errorf("zlmd: narrow link %q: %s: %w", u, operator, err)
+		}
+
+		switch operator {
+		case "stream", "channel":
+			// The ID is read from the encoded operand, where String escapes
+			// names that would otherwise read as one.
+			if n.StreamID, n.Stream, err = splitSlug(terms[i+1]); err != nil {
+				return Narrow{}, fmt.Errorf("zlmd: narrow link %q: %s: %w", u, operator, err)
+			}
+		case "topic", "subject":
+			n.Topic = operand
+		case "near", "with", "id":
+			if n.MessageID, err = strconv.Atoi(operand); err != nil || n.MessageID <= 0 {
+				return Narrow{}, fmt.Errorf("zlmd: narrow link %q: invalid message ID %q", u, operand)
+			}
+		case "dm", "pm-with":
+			ids, _, _ := strings.Cut(operand, "-")
+			for _, field := range strings.Split(ids, ",") {
+				id, err := strconv.Atoi(field)
+				if err != nil || id <= 0 {
+					return Narrow{}, fmt.Errorf("zlmd: narrow link %q: invalid user ID %q", u, field)
+				}
+				n.UserIDs = append(n.UserIDs, id)
+			}
+		default:
+			return Narrow{}, fmt.Errorf("zlmd: narrow link %q: unsupported operator %q", u, operator)
+		}
+	}
+	return n, nil
+}
+
+// String renders the narrow as a link: absolute if Realm is set, otherwise
+// just the "#narrow/..." fragment.
+//
+// Example:
+//
+//	Narrow{StreamID: 42, Stream: "build alerts", Topic: "deploy failed"}.String()
+//	// "#narrow/stream/42-build-alerts/topic/deploy.20failed"
+//
+//	Narrow{Stream: "build alerts"}.String()
+//	// "#narrow/stream/build.20alerts"
+//
+//	Narrow{Stream: "2024-releases"}.String()
+//	// "#narrow/stream/.32024-releases"
+//
+// Notes:
+//   - The older operator names are used, which every server version accepts
+//   - A narrow with only a MessageID renders as "#narrow/id/...", which
+//     finds the message wherever it is
+//   - Without a StreamID, a name that starts like an "<id>-<slug>" operand,
+//     such as "2024-releases" or "42", has its first digit escaped so that
+//     it isn't read back as stream 2024 named "releases"
+func (n Narrow) String() string {
+	var sb strings.Builder
+	if n.Realm != "" {
+		sb.WriteString(strings.TrimSuffix(n.Realm, "/") + "/")
+	}
+	sb.WriteString("#narrow")
+
+	switch {
+	case n.StreamID > 0 || n.Stream != "":
+		// The "<id>-<slug>" form only works with an ID: without one, Zulip
+		// reads the operand as the exact name, and a slug names another
+		// stream.
+		var operand string
+		switch {
+		case n.StreamID > 0:
+			slug := strings.ReplaceAll(n.Stream, " ", "-")
+			operand = encodeHashComponent(strings.TrimSuffix(strconv.Itoa(n.StreamID)+"-"+slug, "-"))
+		case hasSlugID(n.Stream):
+			operand = fmt.Sprintf(".%X", n.Stream[0]) + encodeHashComponent(n.Stream[1:])
+		default:
+			operand = encodeHashComponent(n.Stream)
+		}
+		sb.WriteString("/stream/" + operand)
+		if n.Topic != "" {
+			sb.WriteString("/topic/" + encodeHashComponent(n.Topic))
+		}
+	case len(n.UserIDs) > 0:
+		ids := make([]string, len(n.UserIDs))
+		for i, id := range n.UserIDs {
+			ids[i] = strconv.Itoa(id)
+		}
+		sb.WriteString("/pm-with/" + strings.Join(ids, ","))
+	}
+	switch {
+	case n.MessageID > 0 && n.StreamID == 0 && n.Stream == "" && len(n.UserIDs) == 0:
+		sb.WriteString("/id/" + strconv.Itoa(n.MessageID))
+	case n.MessageID > 0:
+		sb.WriteString("/near/" + strconv.Itoa(n.MessageID))
+	}
+	return sb.String()
+}
+
+// splitSlug splits a stream operand, still hash-encoded, such as
+// "42-build-alerts" into its ID and decoded name. Operands without a
+// leading ID are decoded as the name.
+func splitSlug(raw string) (int, string, error) {
+	digits, slug, _ := strings.Cut(raw, "-")
+	if id, err := strconv.Atoi(digits); err == nil && id > 0 {
+		name, err := decodeHashComponent(slug)
+		return id, name, err
+	}
+	name, err := decodeHashComponent(raw)
+	return 0, name, err
+}
+
+// hasSlugID reports whether splitSlug would read a stream ID out of the
+// encoded name, which happens when it starts with digits followed by a
+// hyphen or nothing.
+func hasSlugID(name string) bool {
+	digits, _, _ := strings.Cut(name, "-")
+	id, err := strconv.Atoi(digits)
+	return err == nil && id > 0
+}
+
+// decodeHashComponent reverses encodeHashComponent.
+func decodeHashComponent(s string) (string, error) {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' && i+2 < len(s) && isHex(s[i+1]) && isHex(s[i+2]) {
+			sb.WriteByte('%')
+			continue
+		}
+		sb.WriteByte(s[i])
+	}
+	return url.PathUnescape(sb.String())
+}
+
+// encodeHashComponent encodes s the way the Zulip web app encodes narrow
+// operands: like JavaScript's encodeURIComponent, with "." in place of "%"
+// and the characters ".", "(" and ")" escaped too.
+func encodeHashComponent(s string) string {
+	const hex = "0123456789ABCDEF"
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if isLetter(c) || (c >= '0' && c <= '9') || strings.IndexByte("-_!~*'", c) >= 0 {
+			sb.WriteByte(c)
+			continue
+		}
+		sb.WriteByte('.')
+		sb.WriteByte(hex[c>>4])
+		sb.WriteByte(hex[c&0xf])
+	}
+	return sb.String()
+}
+
+func isHex(b byte) bool {
+	return (b >= '0' && b <= '9') || (b >= 'a' && b <= 'f') || (b >= 'A' && b <= 'F')
+}
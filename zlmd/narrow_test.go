@@ -0,0 +1,129 @@
+package zlmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseNarrowURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Narrow
+	}{
+		{
+			name:     "Topic permalink",
+			input:    "https://chat.example.com/#narrow/stream/42-build-alerts/topic/deploy.20failed/near/1234",
+			expected: Narrow{Realm: "https://chat.example.com", StreamID: 42, Stream: "build-alerts", Topic: "deploy failed", MessageID: 1234},
+		},
+		{
+			name:     "Current operator names",
+			input:    "https://chat.example.com/#narrow/channel/7-general/topic/v1.2E2.20.28beta.29/with/99",
+			expected: Narrow{Realm: "https://chat.example.com", StreamID: 7, Stream: "general", Topic: "v1.2 (beta)", MessageID: 99},
+		},
+		{
+			name:     "Stream name only",
+			input:    "#narrow/stream/general",
+			expected: Narrow{Stream: "general"},
+		},
+		{
+			name:     "Stream ID only",
+			input:    "#narrow/stream/42",
+			expected: Narrow{StreamID: 42},
+		},
+		{
+			name:     "Unicode topic",
+			input:    "#narrow/stream/3-ops/topic/.F0.9F.94.A5.20fire/",
+			expected: Narrow{StreamID: 3, Stream: "ops", Topic: "🔥 fire"},
+		},
+		{
+			name:     "Direct message",
+			input:    "https://chat.example.com/#narrow/dm/12,34-group/near/5",
+			expected: Narrow{Realm: "https://chat.example.com", UserIDs: []int{12, 34}, MessageID: 5},
+		},
+		{
+			name:     "Realm with path",
+			input:    "https://example.com/zulip/#narrow/pm-with/8-Alex",
+			expected: Narrow{Realm: "https://example.com/zulip", UserIDs: []int{8}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNarrowURL(tt.input)
+			if err != nil {
+				t.Fatalf("ParseNarrowURL() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("ParseNarrowURL() = %+v, want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseNarrowURL_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"Not a narrow", "https://chat.example.com/#settings/profile"},
+		{"No fragment", "https://chat.example.com/"},
+		{"Missing operand", "#narrow/stream/1-general/topic"},
+		{"Bad message ID", "#narrow/stream/1-general/near/abc"},
+		{"Bad user ID", "#narrow/dm/x-group"},
+		{"Unsupported operator", "#narrow/is/starred"},
+		{"Bad encoding", "#narrow/topic/100%25.zz%"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := ParseNarrowURL(tt.input); err == nil {
+				t.Errorf("ParseNarrowURL() = %+v, want an error", got)
+			}
+		})
+	}
+}
+
+func TestNarrow_String(t *testing.T) {
+	tests := []struct {
+		name     string
+		narrow   Narrow
+		expected string
+	}{
+		{"Topic", Narrow{StreamID: 42, Stream: "build alerts", Topic: "deploy failed"}, "#narrow/stream/42-build-alerts/topic/deploy.20failed"},
+		{"Special characters", Narrow{StreamID: 7, Topic: "v1.2 (beta) 100%"}, "#narrow/stream/7/topic/v1.2E2.20.28beta.29.20100.25"},
+		{"Stream name only", Narrow{Stream: "general"}, "#narrow/stream/general"},
+		{"Stream name with spaces", Narrow{Stream: "build alerts"}, "#narrow/stream/build.20alerts"},
+		{"Stream name like a slug", Narrow{Stream: "2024-releases"}, "#narrow/stream/.32024-releases"},
+		{"Numeric stream name", Narrow{Stream: "42"}, "#narrow/stream/.342"},
+		{"Permalink", Narrow{Realm: "https://chat.example.com/", StreamID: 1, Stream: "ops", Topic: "x", MessageID: 9}, "https://chat.example.com/#narrow/stream/1-ops/topic/x/near/9"},
+		{"Direct message", Narrow{UserIDs: []int{12, 34}}, "#narrow/pm-with/12,34"},
+		{"Message only", Narrow{MessageID: 12}, "#narrow/id/12"},
+		{"Empty", Narrow{}, "#narrow"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.narrow.String(); got != tt.expected {
+				t.Errorf("Narrow.String() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNarrow_RoundTrip(t *testing.T) {
+	narrows := []Narrow{
+		{Realm: "https://chat.example.com", StreamID: 5, Stream: "ci", Topic: "café/v2.0 (#12) 50% done", MessageID: 77},
+		{UserIDs: []int{1, 2, 3}, MessageID: 4},
+		{Stream: "2024-releases", Topic: "q1"},
+		{Stream: "42"},
+		{Stream: "0-day"},
+		{StreamID: 9, Stream: "2024-releases"},
+	}
+	for _, n := range narrows {
+		got, err := ParseNarrowURL(n.String())
+		if err != nil || !reflect.DeepEqual(got, n) {
+			t.Errorf("ParseNarrowURL(%q) = %+v, %v, want %+v", n.String(), got, err, n)
+		}
+	}
+}
@@ -0,0 +1,242 @@
+package zlmd
+
+import (
+	"errors"
+	"time"
+)
+
+// Option configures the optional behaviour of zlmd writers and builders.
+//
+// Options are applied in order, so later options override earlier ones.
+//
+// Example:
+//
+//	w := NewLogWriter(WithLanguage("log"), WithMaxLength(4000))
+type Option func(*options)
+
+// options holds the settings shared by everything that accepts an Option.
+type options struct {
+	language        string
+	maxLength       int
+	flush           func(message string) error
+	maxDepth        int
+	maxNodes        int
+	maxHunks        int
+	maxLines        int
+	context         int
+	sortDesc        bool
+	topN            int
+	width           int
+	theme           *Theme
+	bodyLimit       int
+	headers         []string
+	fenceStyle      FenceStyle
+	fenceLength     int
+	bullet          string
+	indentWidth     int
+	escape          EscapePolicy
+	targetVersion   ServerVersion
+	flavor          Flavor
+	widthMode       WidthMode
+	locale          *Locale
+	defangWildcards bool
+	showDuration    bool
+	dateOnly        bool
+	minutePrecision bool
+	timeZone        *time.Location
+}
+
+// defaultOptions returns the settings used when no Option is supplied: the
+// built-in defaults with the Config set by SetDefaultConfig applied.
+func defaultOptions() options {
+	o := options{
+		language:  "text",
+		maxLength: MaxMessageLength,
+		context:   3,
+		theme:     DefaultTheme,
+		locale:    English,
+		bodyLimit: 2000,
+	}
+	if cfg := defaultConfig.Load(); cfg != nil {
+		WithConfig(*cfg)(&o)
+	}
+	return o
+}
+
+// newOptions returns the default settings with opts applied on top.
+func newOptions(opts ...Option) options {
+	o := defaultOptions()
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&o)
+		}
+	}
+	return o
+}
+
+// WithLanguage sets the language identifier used on generated code fences.
+//
+// Example:
+//
+//	w := NewLogWriter(WithLanguage("json"))
+//	// flushed messages start with ```json
+func WithLanguage(language string) Option {
+	return func(o *options) {
+		o.language = language
+	}
+}
+
+// WithMaxLength sets the maximum length of a single generated message.
+// Values less than or equal to zero are ignored.
+//
+// Example:
+//
+//	w := NewLogWriter(WithMaxLength(2000))
+func WithMaxLength(n int) Option {
+	return func(o *options) {
+		if n > 0 {
+			o.maxLength = n
+		}
+	}
+}
+
+// ErrNoFlushFunc is returned by LogWriter and MessageWriter when a message
+// is ready to be sent but no WithFlushFunc was given. The message stays
+// buffered.
+var ErrNoFlushFunc = errors.New("zlmd: no flush function; pass WithFlushFunc")
+
+// WithFlushFunc sets the callback that receives each completed message.
+// There is no default: writers without one return ErrNoFlushFunc instead
+// of sending. A nil function is ignored.
+//
+// Example:
+//
+//	w := NewLogWriter(WithFlushFunc(func(msg string) error {
+//	  return client.Send(stream, topic, msg)
+//	}))
+func WithFlushFunc(fn func(message string) error) Option {
+	return func(o *options) {
+		if fn != nil {
+			o.flush = fn
+		}
+	}
+}
+
+// WithMaxDepth limits how many levels of a hierarchy are rendered.
+// Zero or a negative value means no limit.
+//
+// Example:
+//
+//	out := Tree(root, WithMaxDepth(2))
+func WithMaxDepth(n int) Option {
+	return func(o *options) {
+		o.maxDepth = max(n, 0)
+	}
+}
+
+// WithMaxNodes limits how many nodes or items are rendered before the
+// remainder is summarized. Zero or a negative value means no limit.
+//
+// Example:
+//
+//	out := Tree(root, WithMaxNodes(50))
+func WithMaxNodes(n int) Option {
+	return func(o *options) {
+		o.maxNodes = max(n, 0)
+	}
+}
+
+// WithMaxHunks limits how many diff hunks are rendered before the remainder
+// is reported as hidden. Zero or a negative value means no limit.
+//
+// Example:
+//
+//	out := DiffBlock(patch, WithMaxHunks(3))
+func WithMaxHunks(n int) Option {
+	return func(o *options) {
+		o.maxHunks = max(n, 0)
+	}
+}
+
+// WithMaxLines limits how many content lines are rendered before the
+// remainder is reported as hidden. Zero or a negative value means no limit.
+//
+// Example:
+//
+//	out := DiffBlock(patch, WithMaxLines(40))
+func WithMaxLines(n int) Option {
+	return func(o *options) {
+		o.maxLines = max(n, 0)
+	}
+}
+
+// WithContextLines sets how many unchanged lines surround each change in a
+// computed diff. The default is 3; negative values are treated as zero.
+//
+// Example:
+//
+//	patch := DiffStrings(before, after, WithContextLines(1))
+func WithContextLines(n int) Option {
+	return func(o *options) {
+		o.context = max(n, 0)
+	}
+}
+
+// WithSortDescending orders chart entries from the largest value to the smallest.
+//
+// Example:
+//
+//	out := BarChart(labels, values, 30, WithSortDescending())
+func WithSortDescending() Option {
+	return func(o *options) {
+		o.sortDesc = true
+	}
+}
+
+// WithTopN keeps only the first n entries of a chart, after sorting, and
+// summarizes the rest. Zero or a negative value means no limit.
+//
+// Example:
+//
+//	out := BarChart(labels, values, 30, WithSortDescending(), WithTopN(5))
+func WithTopN(n int) Option {
+	return func(o *options) {
+		o.topN = max(n, 0)
+	}
+}
+
+// WithWidth sets the width in characters of rendered bars.
+// Zero or a negative value selects DefaultProgressWidth.
+//
+// Example:
+//
+//	out := Histogram(buckets, WithWidth(15))
+func WithWidth(n int) Option {
+	return func(o *options) {
+		o.width = max(n, 0)
+	}
+}
+
+// WithBodyLimit sets how many characters of an HTTP body are rendered before
+// it is truncated. The default is 2000; zero hides the body entirely.
+//
+// Example:
+//
+//	out := FormatHTTPResponse(resp, WithBodyLimit(500))
+func WithBodyLimit(n int) Option {
+	return func(o *options) {
+		o.bodyLimit = max(n, 0)
+	}
+}
+
+// WithSelectedHeaders restricts rendered HTTP headers to the given names.
+// By default every header is rendered.
+//
+// Example:
+//
+//	out := FormatHTTPRequest(req, WithSelectedHeaders("Content-Type", "User-Agent"))
+func WithSelectedHeaders(names ...string) Option {
+	return func(o *options) {
+		o.headers = append([]string(nil), names...)
+	}
+}
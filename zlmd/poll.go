@@ -0,0 +1,92 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PollBuilder builds a Zulip /poll widget: a message that Zulip shows as a
+// poll users vote in.
+type PollBuilder struct {
+	question string
+	options  []string
+}
+
+// NewPoll creates a poll with the given question and no options.
+//
+// Parameters:
+//   - question (string): The question, on a single line
+//
+// Returns:
+//   - *PollBuilder: A new initialized PollBuilder instance
+//
+// Example:
+//
+//	poll, err := NewPoll("Standup time?").Option("9:30").Option("10:00").Build()
+//	// poll will be:
+//	// "/poll Standup time?\n9:30\n10:00"
+func NewPoll(question string) *PollBuilder {
+	return &PollBuilder{question: question, options: []string{}}
+}
+
+// Option appends an option.
+//
+// Returns:
+//   - *PollBuilder: The same PollBuilder instance (for method chaining)
+func (p *PollBuilder) Option(text string) *PollBuilder {
+	p.options = append(p.options, text)
+	return p
+}
+
+// Options appends several options.
+//
+// Returns:
+//   - *PollBuilder: The same PollBuilder instance (for method chaining)
+func (p *PollBuilder) Options(texts ...string) *PollBuilder {
+	p.options = append(p.options, texts...)
+	return p
+}
+
+// Build generates the poll message.
+//
+// Returns:
+//   - string: The message, which must be sent on its own: Zulip only turns
+//     messages that start with /poll into polls
+//   - error: Non-nil if the question is blank, or an option is blank or
+//     repeated, or either spans several lines
+//
+// Notes:
+//   - A poll without options is valid; users add options in the widget
+//   - Surrounding whitespace is trimmed from the question and options
+func (p *PollBuilder) Build() (string, error) {
+	question := strings.TrimSpace(p.question)
+	if question == "" {
+		return "", fmt.Errorf("zlmd: poll question is empty")
+	}
+	if strings.ContainsAny(question, "\r\n") {
+		return "", fmt.Errorf("zlmd: poll question %q spans several lines", question)
+	}
+
+	var sb strings.Builder
+	sb.WriteString("/poll " + question)
+	seen := make(map[string]bool, len(p.options))
+	for i, option := range p.options {
+		option = strings.TrimSpace(option)
+		switch {
+		case option == "":
+			return "", fmt.Errorf("zlmd: poll option %d is empty", i+1)
+		case strings.ContainsAny(option, "\r\n"):
+			return "", fmt.Errorf("zlmd: poll option %q spans several lines", option)
+		case seen[option]:
+			return "", fmt.Errorf("zlmd: poll option %q is repeated", option)
+		}
+		seen[option] = true
+		sb.WriteString("\n" + option)
+	}
+	return sb.String(), nil
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (p *PollBuilder) MarshalZulipMarkdown() (string, error) {
+	return p.Build()
+}
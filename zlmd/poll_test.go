@@ -0,0 +1,57 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPollBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name     string
+		poll     *PollBuilder
+		expected string
+	}{
+		{"Options", NewPoll("Standup time?").Option("9:30").Option("10:00"), "/poll Standup time?\n9:30\n10:00"},
+		{"Options variadic", NewPoll("Lunch?").Options("pizza", "sushi"), "/poll Lunch?\npizza\nsushi"},
+		{"No options", NewPoll("Ideas?"), "/poll Ideas?"},
+		{"Trimmed", NewPoll("  Ship it? ").Option(" yes "), "/poll Ship it?\nyes"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.poll.Build()
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPollBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		poll     *PollBuilder
+		expected string
+	}{
+		{"Empty question", NewPoll(" ").Option("a"), "question is empty"},
+		{"Multi-line question", NewPoll("a\nb"), "spans several lines"},
+		{"Empty option", NewPoll("Q").Options("a", ""), "option 2 is empty"},
+		{"Multi-line option", NewPoll("Q").Option("a\nb"), "spans several lines"},
+		{"Repeated option", NewPoll("Q").Options("a", " a"), `option "a" is repeated`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.poll.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Build() error = %v, want it to contain %q", err, tt.expected)
+			}
+			if got != "" {
+				t.Errorf("Build() = %q, want empty on error", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package zlmd
+
+import (
+	"bytes"
+	"sync"
+)
+
+// maxPooledBuffer is the capacity above which buffers are not returned to
+// the pool, so one huge message doesn't pin its memory for good.
+const maxPooledBuffer = 64 << 10
+
+// bufferPool holds the scratch buffers used by builders that render many
+// pieces into one string.
+//
+// strings.Builder can't be pooled usefully: its String method hands out its
+// memory, so Reset has to drop it. A bytes.Buffer keeps its memory, and
+// copying it into the result costs the one allocation a string needs anyway.
+var bufferPool = sync.Pool{
+	New: func() any { return new(bytes.Buffer) },
+}
+
+// getBuffer returns an empty buffer from the pool.
+func getBuffer() *bytes.Buffer {
+	return bufferPool.Get().(*bytes.Buffer)
+}
+
+// putBuffer resets buf and returns it to the pool. buf must not be used
+// afterwards.
+func putBuffer(buf *bytes.Buffer) {
+	if buf.Cap() > maxPooledBuffer {
+		return
+	}
+	buf.Reset()
+	bufferPool.Put(buf)
+}
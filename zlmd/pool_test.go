@@ -0,0 +1,50 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBufferPool(t *testing.T) {
+	buf := getBuffer()
+	buf.WriteString("leftover")
+	putBuffer(buf)
+
+	if got := getBuffer(); got.Len() != 0 {
+		t.Errorf("getBuffer() returned %q, want an empty buffer", got.String())
+	}
+}
+
+// raceEnabled is set when testing with the race detector.
+var raceEnabled bool
+
+func TestBufferPool_Allocations(t *testing.T) {
+	if raceEnabled {
+		t.Skip("sync.Pool is unreliable under the race detector")
+	}
+
+	table := NewTableBuilder().WithHeaders("Service", "Status")
+	for range 50 {
+		table.AddRow("api", "✅ healthy")
+	}
+	section := NewSection(2, "Status").AddText(strings.Repeat("Details. ", 100)).AddTable(table)
+
+	tests := []struct {
+		name string
+		max  float64
+		fn   func()
+	}{
+		// One allocation for the result, which a pooled buffer can't avoid.
+		{"TableBuilder.Build", 1, func() { table.Build() }},
+		{"Section.Build", 1, func() { section.Build() }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn()
+			if n := testing.AllocsPerRun(100, tt.fn); n > tt.max+0.5 {
+				t.Errorf("%s made %.1f allocations per run, want at most %.0f", tt.name, n, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+// Package profreport renders pprof "top" output as a Zulip table of the
+// hottest functions, for bots posting profiling snapshots.
+package profreport
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// Entry is a single function row of a pprof top listing.
+type Entry struct {
+	Function string
+	// Flat and Cum are kept as printed by pprof (for example "2.50s" or
+	// "512kB") since their unit depends on the profile type.
+	Flat    string
+	FlatPct float64
+	SumPct  float64
+	Cum     string
+	CumPct  float64
+}
+
+// Profile is a parsed pprof top listing. Callers that already hold a parsed
+// profile can build one directly from its entries.
+type Profile struct {
+	// Type is the sample type, such as "cpu" or "inuse_space".
+	Type string
+	// Summary is the "Showing nodes accounting for …" line, if present.
+	Summary string
+	Entries []Entry
+}
+
+// ParseTop reads the output of `go tool pprof -top` and returns the profile.
+//
+// Parameters:
+//   - r (io.Reader): The pprof -top output
+//
+// Returns:
+//   - *Profile: The parsed listing
+//   - error: An error if reading from r fails
+//
+// Example:
+//
+//	out, _ := exec.Command("go", "tool", "pprof", "-top", "cpu.out").Output()
+//	profile, err := profreport.ParseTop(bytes.NewReader(out))
+func ParseTop(r io.Reader) (*Profile, error) {
+	profile := &Profile{}
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case strings.HasPrefix(line, "Type:"):
+			profile.Type = strings.TrimSpace(strings.TrimPrefix(line, "Type:"))
+			continue
+		case strings.HasPrefix(line, "Showing nodes"):
+			profile.Summary = line
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 6 {
+			continue
+		}
+		flatPct, err1 := parsePercent(fields[1])
+		sumPct, err2 := parsePercent(fields[2])
+		cumPct, err3 := parsePercent(fields[4])
+		if err1 != nil || err2 != nil || err3 != nil {
+			continue
+		}
+
+		profile.Entries = append(profile.Entries, Entry{
+			Function: strings.Join(fields[5:], " "),
+			Flat:     fields[0],
+			FlatPct:  flatPct,
+			SumPct:   sumPct,
+			Cum:      fields[3],
+			CumPct:   cumPct,
+		})
+	}
+	return profile, scanner.Err()
+}
+
+// Markdown renders the top n entries as a table with flat and cumulative
+// values and percentages.
+//
+// Parameters:
+//   - n (int): The number of entries to show; zero or negative shows all
+//
+// Returns:
+//   - string: The profile header, table and a note about hidden entries
+//
+// Example:
+//
+//	out := profile.Markdown(10)
+//	// out will be similar to:
+//	// **Type**: cpu
+//	// Showing nodes accounting for 10s, 80% of 12.5s total
+//	//
+//	// | Function | Flat | Flat% | Cum | Cum% |
+//	// | --- | ---: | ---: | ---: | ---: |
+//	// | `runtime.mallocgc` | 2.50s | 20.0% | 3.10s | 24.8% |
+func (p *Profile) Markdown(n int) string {
+	var sb strings.Builder
+
+	if p.Type != "" {
+		zlmd.WriteKeyValue(&sb, "Type", p.Type)
+	}
+	if p.Summary != "" {
+		sb.WriteString(p.Summary)
+		sb.WriteString("\n")
+	}
+	if sb.Len() > 0 {
+		sb.WriteString("\n")
+	}
+
+	shown := p.Entries
+	if n > 0 && len(shown) > n {
+		shown = shown[:n]
+	}
+
+	table := zlmd.NewTableBuilder().
+		WithHeaders("Function", "Flat", "Flat%", "Cum", "Cum%").
+		SetAlignments(zlmd.AlignDefault, zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight)
+	for _, e := range shown {
+		table.AddRow(
+			zlmd.Code(e.Function),
+			e.Flat,
+			fmt.Sprintf("%.1f%%", e.FlatPct),
+			e.Cum,
+			fmt.Sprintf("%.1f%%", e.CumPct),
+		)
+	}
+	sb.WriteString(table.Build())
+
+	if len(shown) < len(p.Entries) {
+		zlmd.WriteItalic(&sb, fmt.Sprintf("%d of %d functions shown", len(shown), len(p.Entries)))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// parsePercent parses a value such as "20.00%".
+func parsePercent(s string) (float64, error) {
+	if !strings.HasSuffix(s, "%") {
+		return 0, fmt.Errorf("profreport: invalid percentage %q", s)
+	}
+	return strconv.ParseFloat(strings.TrimSuffix(s, "%"), 64)
+}
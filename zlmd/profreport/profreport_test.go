@@ -0,0 +1,60 @@
+package profreport
+
+import (
+	"strings"
+	"testing"
+)
+
+const topOutput = `File: app
+Type: cpu
+Time: Jan 2, 2024 at 3:04pm (UTC)
+Duration: 30s, Total samples = 12.5s (41.6%)
+Showing nodes accounting for 10s, 80% of 12.5s total
+Dropped 12 nodes (cum <= 0.06s)
+      flat  flat%   sum%        cum   cum%
+     2.50s 20.00% 20.00%      3.10s 24.80%  runtime.mallocgc
+     1.20s  9.60% 29.60%      1.20s  9.60%  syscall.Syscall
+     0.80s  6.40% 36.00%      5.00s 40.00%  main.(*server).handle
+`
+
+func TestParseTop(t *testing.T) {
+	profile, err := ParseTop(strings.NewReader(topOutput))
+	if err != nil {
+		t.Fatalf("ParseTop() returned error: %v", err)
+	}
+	if profile.Type != "cpu" {
+		t.Errorf("Type = %q, want %q", profile.Type, "cpu")
+	}
+	if len(profile.Entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(profile.Entries))
+	}
+
+	e := profile.Entries[2]
+	if e.Function != "main.(*server).handle" || e.Cum != "5.00s" || e.CumPct != 40 {
+		t.Errorf("Unexpected entry: %+v", e)
+	}
+}
+
+func TestProfile_Markdown(t *testing.T) {
+	profile, _ := ParseTop(strings.NewReader(topOutput))
+
+	got := profile.Markdown(2)
+	expected := "**Type**: cpu\nShowing nodes accounting for 10s, 80% of 12.5s total\n\n" +
+		"| Function | Flat | Flat% | Cum | Cum% |\n| --- | ---: | ---: | ---: | ---: |\n" +
+		"| `runtime.mallocgc` | 2.50s | 20.0% | 3.10s | 24.8% |\n" +
+		"| `syscall.Syscall` | 1.20s | 9.6% | 1.20s | 9.6% |\n" +
+		"*2 of 3 functions shown*\n"
+	if got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestProfile_MarkdownFromEntries(t *testing.T) {
+	profile := &Profile{Entries: []Entry{{Function: "f", Flat: "1", FlatPct: 50, Cum: "2", CumPct: 100}}}
+
+	got := profile.Markdown(0)
+	expected := "| Function | Flat | Flat% | Cum | Cum% |\n| --- | ---: | ---: | ---: | ---: |\n| `f` | 1 | 50.0% | 2 | 100.0% |\n"
+	if got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
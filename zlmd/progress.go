@@ -0,0 +1,134 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// ProgressFilled is the character used for the completed part of a progress bar.
+	ProgressFilled = "▓"
+	// ProgressEmpty is the character used for the remaining part of a progress bar.
+	ProgressEmpty = "░"
+	// DefaultProgressWidth is the bar width used when a non-positive width is given.
+	DefaultProgressWidth = 20
+)
+
+// ProgressBar renders a text progress bar followed by the completion percentage.
+//
+// Parameters:
+//   - current (int): The number of completed units
+//   - total (int): The total number of units
+//   - width (int): The number of characters in the bar (DefaultProgressWidth if <= 0)
+//
+// Returns:
+//   - string: The rendered progress bar
+//
+// Example:
+//
+//	bar := ProgressBar(42, 100, 10)
+//	// bar will be "▓▓▓▓░░░░░░ 42%"
+//
+// Notes:
+//   - current is clamped to the range [0, total]
+//   - A non-positive total renders an empty bar at 0%
+func ProgressBar(current, total int, width int) string {
+	if width <= 0 {
+		width = DefaultProgressWidth
+	}
+
+	current, total = clampProgress(current, total)
+
+	filled, percent := 0, 0
+	if total > 0 {
+		filled = current * width / total
+		percent = current * 100 / total
+	}
+
+	return fmt.Sprintf("%s%s %d%%",
+		strings.Repeat(ProgressFilled, filled),
+		strings.Repeat(ProgressEmpty, width-filled),
+		percent)
+}
+
+// ProgressBarETA renders a progress bar like ProgressBar and appends an
+// estimated time to completion based on the elapsed time so far.
+//
+// Parameters:
+//   - current (int): The number of completed units
+//   - total (int): The total number of units
+//   - width (int): The number of characters in the bar (DefaultProgressWidth if <= 0)
+//   - elapsed (time.Duration): The time spent to complete current units
+//
+// Returns:
+//   - string: The rendered progress bar with an ETA suffix
+//
+// Example:
+//
+//	bar := ProgressBarETA(25, 100, 10, 30*time.Second)
+//	// bar will be "▓▓░░░░░░░░ 25% (ETA 1m30s)"
+//
+// Notes:
+//   - The ETA is omitted when nothing has been completed yet or the work is done
+func ProgressBarETA(current, total int, width int, elapsed time.Duration) string {
+	bar := ProgressBar(current, total, width)
+
+	current, total = clampProgress(current, total)
+	if current <= 0 || current >= total || elapsed <= 0 {
+		return bar
+	}
+
+	remaining := time.Duration(float64(elapsed) * float64(total-current) / float64(current))
+	return fmt.Sprintf("%s (ETA %s)", bar, remaining.Round(time.Second))
+}
+
+// ProgressMessage renders a complete status message for a long-running job,
+// suitable for bots that repeatedly edit a single Zulip message.
+//
+// Parameters:
+//   - title (string): The job title, rendered in bold
+//   - current (int): The number of completed units
+//   - total (int): The total number of units
+//   - details (...string): Optional detail lines rendered as a bullet list
+//
+// Returns:
+//   - string: The full markdown status message
+//
+// Example:
+//
+//	msg := ProgressMessage("Reindexing", 3, 4, "Current shard: `eu-2`")
+//	// msg will be:
+//	// **Reindexing**
+//	// ▓▓▓▓▓▓▓▓▓▓▓▓▓▓▓░░░░░ 75% (3/4)
+//	// - Current shard: `eu-2`
+func ProgressMessage(title string, current, total int, details ...string) string {
+	var sb strings.Builder
+
+	WriteBold(&sb, title)
+	sb.WriteString("\n")
+
+	c, t := clampProgress(current, total)
+	sb.WriteString(fmt.Sprintf("%s (%d/%d)", ProgressBar(c, t, DefaultProgressWidth), c, t))
+
+	for _, detail := range details {
+		sb.WriteString("\n")
+		sb.WriteString(ListItem(detail, 0))
+	}
+
+	return sb.String()
+}
+
+// clampProgress normalizes current and total so that 0 <= current <= total.
+func clampProgress(current, total int) (int, int) {
+	if total < 0 {
+		total = 0
+	}
+	if current < 0 {
+		current = 0
+	}
+	if current > total {
+		current = total
+	}
+	return current, total
+}
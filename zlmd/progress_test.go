@@ -0,0 +1,83 @@
+package zlmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProgressBar(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  int
+		total    int
+		width    int
+		expected string
+	}{
+		{
+			name:     "Partial progress",
+			current:  42,
+			total:    100,
+			width:    10,
+			expected: "▓▓▓▓░░░░░░ 42%",
+		},
+		{
+			name:     "Complete",
+			current:  8,
+			total:    8,
+			width:    8,
+			expected: "▓▓▓▓▓▓▓▓ 100%",
+		},
+		{
+			name:     "Current exceeds total",
+			current:  12,
+			total:    8,
+			width:    4,
+			expected: "▓▓▓▓ 100%",
+		},
+		{
+			name:     "Zero total",
+			current:  3,
+			total:    0,
+			width:    4,
+			expected: "░░░░ 0%",
+		},
+		{
+			name:     "Default width",
+			current:  0,
+			total:    10,
+			width:    0,
+			expected: "░░░░░░░░░░░░░░░░░░░░ 0%",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ProgressBar(tt.current, tt.total, tt.width)
+			if got != tt.expected {
+				t.Errorf("ProgressBar() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProgressBarETA(t *testing.T) {
+	got := ProgressBarETA(25, 100, 10, 30*time.Second)
+	expected := "▓▓░░░░░░░░ 25% (ETA 1m30s)"
+	if got != expected {
+		t.Errorf("ProgressBarETA() = %q, want %q", got, expected)
+	}
+
+	got = ProgressBarETA(0, 100, 10, 30*time.Second)
+	expected = "░░░░░░░░░░ 0%"
+	if got != expected {
+		t.Errorf("ProgressBarETA() without progress = %q, want %q", got, expected)
+	}
+}
+
+func TestProgressMessage(t *testing.T) {
+	got := ProgressMessage("Reindexing", 3, 4, "Current shard: `eu-2`")
+	expected := "**Reindexing**\n▓▓▓▓▓▓▓▓▓▓▓▓▓▓▓░░░░░ 75% (3/4)\n- Current shard: `eu-2`"
+	if got != expected {
+		t.Errorf("ProgressMessage() = %q, want %q", got, expected)
+	}
+}
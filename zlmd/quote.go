@@ -0,0 +1,112 @@
+package zlmd
+
+import (
+	"strings"
+	"time"
+)
+
+// QuoteMessage quotes a message as a block quote that starts with an
+// italic line naming its author and when it was sent, for bots that archive
+// or digest conversations.
+//
+// Parameters:
+//   - author (string): The sender's name; markdown in it is escaped
+//   - sentAt (time.Time): When the message was sent; the zero time leaves
+//     the time out of the attribution
+//   - body (string): The message's markdown, which may contain quotes of its
+//     own
+//
+// Returns:
+//   - string: The quote, ending with a newline like QuoteBlock
+//
+// Example:
+//
+//	QuoteMessage("Alice", sentAt, "> is it fixed?\nyes, in 1.2")
+//	// "> *Alice wrote <time:2024-05-15T14:30:00Z>:*\n> > is it fixed?\n> \n> yes, in 1.2\n"
+//
+// Notes:
+//   - Unlike the quote Zulip's "Quote and reply" inserts, there is no
+//     link back to the original message, so the quote stays readable when
+//     the original is gone; use QuoteReply for that quote
+//   - Quotes nested in body are ended with a blank line where the next line
+//     would otherwise continue them
+//   - The time uses ZLFormatTime, so it follows the default Config's
+//     TargetVersion and Flavor
+func QuoteMessage(author string, sentAt time.Time, body string) string {
+	attribution := Escape(author, EscapeAll) + " wrote"
+	if !sentAt.IsZero() {
+		attribution += " " + ZLFormatTime(sentAt)
+	}
+	return QuoteBlock(Italic(attribution+":") + "\n" + endNestedQuotes(body))
+}
+
+// QuoteReply quotes a message the way Zulip's "Quote and reply" does: a
+// silent mention of the author linking to the message, followed by the
+// message in a quote block.
+//
+// Parameters:
+//   - authorName (string): The sender's full name
+//   - messageLink (string): The link to the quoted message, such as
+//     "#narrow/stream/9-ops/topic/deploys/near/1234"; empty leaves the link
+//     out
+//   - quoted (string): The quoted markdown
+//
+// Returns:
+//   - string: The reply's quote, ending with a newline like QuoteBlock, to
+//     be followed by the reply
+//
+// Example:
+//
+//	QuoteReply("Alice", "#narrow/near/1234", "is it fixed?")
+//	// "@_**Alice** [said](#narrow/near/1234):\n```quote\nis it fixed?\n```\n"
+//
+// Notes:
+//   - The quote block's fence is lengthened when quoted contains fences of
+//     its own, so code blocks and nested quotes are kept intact
+//   - When the default Config uses FlavorPortable, quoted is written as a
+//     "> " block quote, since GitHub shows a quote block as code
+func QuoteReply(authorName string, messageLink string, quoted string) string {
+	header := "@_**" + mentionName(authorName) + "**"
+	if messageLink != "" {
+		header += " " + Link("said", escapeLinkURL(messageLink)) + ":"
+	} else {
+		header += " said:"
+	}
+
+	quoted = strings.TrimRight(strings.ReplaceAll(quoted, "\r\n", "\n"), "\n")
+	if defaultTarget().flavor == FlavorPortable {
+		return finishBlock(header + "\n> " + strings.ReplaceAll(endNestedQuotes(quoted), "\n", "\n> ") + "\n")
+	}
+	return finishBlock(header + "\n" + FencedBlock("quote", quoted) + "\n")
+}
+
+// endNestedQuotes inserts a blank line after each block quote in markdown
+// that is followed directly by a line outside it, which markdown would
+// otherwise treat as a lazy continuation of the quote.
+func endNestedQuotes(markdown string) string {
+	lines := strings.Split(strings.TrimRight(strings.ReplaceAll(markdown, "\r\n", "\n"), "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	var fence string
+	inQuote := false
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		switch {
+		case fence != "":
+			if isClosingFence(trimmed, fence) {
+				fence = ""
+			}
+		case strings.HasPrefix(trimmed, ">"):
+			inQuote = true
+		case trimmed == "":
+			inQuote = false
+		default:
+			if inQuote {
+				out = append(out, "")
+				inQuote = false
+			}
+			fence = openingFence(trimmed)
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
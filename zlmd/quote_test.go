@@ -0,0 +1,70 @@
+package zlmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuoteMessage(t *testing.T) {
+	sentAt := time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		author   string
+		sentAt   time.Time
+		body     string
+		expected string
+	}{
+		{"Simple", "Alice", sentAt, "deployed", "> *Alice wrote <time:2024-05-15T14:30:00Z>:*\n> deployed\n"},
+		{"No time", "Alice", time.Time{}, "deployed", "> *Alice wrote:*\n> deployed\n"},
+		{"Author escaped", "*bob*", time.Time{}, "hi", "> *\\*bob\\* wrote:*\n> hi\n"},
+		{"Nested quote", "Alice", time.Time{}, "> is it fixed?\nyes", "> *Alice wrote:*\n> > is it fixed?\n> \n> yes\n"},
+		{"Nested quote ended", "Alice", time.Time{}, "> is it fixed?\n\nyes", "> *Alice wrote:*\n> > is it fixed?\n> \n> yes\n"},
+		{"Code block", "Alice", time.Time{}, "```\n> not a quote\nx\n```", "> *Alice wrote:*\n> ```\n> > not a quote\n> x\n> ```\n"},
+		{"Trailing newline", "Alice", time.Time{}, "hi\r\n", "> *Alice wrote:*\n> hi\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteMessage(tt.author, tt.sentAt, tt.body); got != tt.expected {
+				t.Errorf("QuoteMessage() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQuoteReply(t *testing.T) {
+	tests := []struct {
+		name     string
+		author   string
+		link     string
+		quoted   string
+		expected string
+	}{
+		{"Simple", "Alice", "#narrow/near/1234", "is it fixed?", "@_**Alice** [said](#narrow/near/1234):\n```quote\nis it fixed?\n```\n"},
+		{"No link", "Alice", "", "hi", "@_**Alice** said:\n```quote\nhi\n```\n"},
+		{"Author cleaned", "*Bob*\nSmith", "", "hi", "@_**Bob Smith** said:\n```quote\nhi\n```\n"},
+		{"Link escaped", "Alice", "https://x.test/a (1)", "hi", "@_**Alice** [said](https://x.test/a%20%281%29):\n```quote\nhi\n```\n"},
+		{"Code block", "Alice", "", "see\n```go\nx := 1\n```", "@_**Alice** said:\n````quote\nsee\n```go\nx := 1\n```\n````\n"},
+		{"Nested quote block", "Alice", "", "````quote\nold\n````", "@_**Alice** said:\n`````quote\n````quote\nold\n````\n`````\n"},
+		{"Trailing newline", "Alice", "", "hi\r\n", "@_**Alice** said:\n```quote\nhi\n```\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := QuoteReply(tt.author, tt.link, tt.quoted); got != tt.expected {
+				t.Errorf("QuoteReply() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestQuoteReply_Portable(t *testing.T) {
+	SetDefaultConfig(Config{Flavor: FlavorPortable})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	got := QuoteReply("Alice", "", "> old\nnew")
+	expected := "@_**Alice** said:\n> > old\n> \n> new\n"
+	if got != expected {
+		t.Errorf("QuoteReply() = %q, want %q", got, expected)
+	}
+}
@@ -0,0 +1,100 @@
+package zlmd
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Realm builds absolute links into a Zulip organization. The zero value
+// builds relative links, which work inside messages but not elsewhere.
+type Realm struct {
+	base string
+}
+
+// NewRealm validates the base URL of an organization.
+//
+// Parameters:
+//   - base (string): The organization's URL, such as "https://chat.example.com"
+//
+// Returns:
+//   - Realm: A Realm building links below base
+//   - error: If base is not an absolute http or https URL, or has a query
+//     or fragment
+//
+// Example:
+//
+//	realm, err := NewRealm("https://chat.example.com/")
+//	realm.Topic(42, "build alerts", "deploy failed")
+//	// "https://chat.example.com/#narrow/stream/42-build-alerts/topic/deploy.20failed"
+func NewRealm(base string) (Realm, error) {
+	u, err := url.Parse(strings.TrimSpace(base))
+	if err != nil {
+		return Realm{}, fmt.Errorf("zlmd: invalid realm URL: %w", err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return Realm{}, fmt.Errorf("zlmd: realm URL %q must be an absolute http or https URL", base)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return Realm{}, fmt.Errorf("zlmd: realm URL %q must not have credentials, a query or a fragment", base)
+	}
+	return Realm{base: u.Scheme + "://" + u.Host + strings.TrimSuffix(u.EscapedPath(), "/")}, nil
+}
+
+// String returns the base URL without a trailing slash.
+func (r Realm) String() string {
+	return r.base
+}
+
+// User links to a user's profile.
+func (r Realm) User(userID int) string {
+	return r.base + "/#user/" + strconv.Itoa(userID)
+}
+
+// Stream links to a stream by ID. The name only makes the link readable; the
+// ID is what Zulip resolves, so the link survives renames.
+func (r Realm) Stream(streamID int, name string) string {
+	return r.Narrow(Narrow{StreamID: streamID, Stream: name})
+}
+
+// Topic links to a topic in a stream identified by ID.
+func (r Realm) Topic(streamID int, stream, topic string) string {
+	return r.Narrow(Narrow{StreamID: streamID, Stream: stream, Topic: topic})
+}
+
+// Message links to a message by ID alone.
+//
+// Notes:
+//   - Use Narrow with the stream and topic set to link to the message in
+//     its conversation, like the web app's "Copy link to message"
+func (r Realm) Message(messageID int) string {
+	return r.Narrow(Narrow{MessageID: messageID})
+}
+
+// Narrow links to n in this organization, replacing n.Realm.
+func (r Realm) Narrow(n Narrow) string {
+	n.Realm = r.base
+	return n.String()
+}
+
+// Upload returns the absolute URL of an uploaded file.
+//
+// Parameters:
+//   - path (string): The path returned by the upload API, such as
+//     "/user_uploads/2/ab/cdef/report.pdf", with or without the leading
+//     "/user_uploads/"
+//
+// Returns:
+//   - string: The absolute URL of the file
+//
+// Example:
+//
+//	realm.Upload("/user_uploads/2/ab/cdef/report.pdf")
+//	// "https://chat.example.com/user_uploads/2/ab/cdef/report.pdf"
+func (r Realm) Upload(path string) string {
+	path = strings.TrimPrefix(path, r.base)
+	path = strings.TrimPrefix(path, "/")
+	path = strings.TrimPrefix(path, "user_uploads/")
+	return r.base + "/user_uploads/" + path
+}
@@ -0,0 +1,53 @@
+package zlmd
+
+import "time"
+
+// Reminder formats a reminder that shows its due time in each reader's
+// timezone.
+//
+// Parameters:
+//   - text (string): What to be reminded of, as markdown
+//   - at (time.Time): When the reminder is due
+//
+// Returns:
+//   - string: The reminder line
+//
+// Example:
+//
+//	Reminder("Rotate the **staging** certificates", time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+//	// "⏰ Reminder for <time:2024-03-01T09:00:00Z>: Rotate the **staging** certificates"
+func Reminder(text string, at time.Time) string {
+	return "⏰ Reminder for " + ZLFormatTime(at) + ": " + text
+}
+
+// NextLocalTime returns the next time after now at which the clock in loc
+// reads hour:minute, for scheduling "at 9:00 local time".
+//
+// Parameters:
+//   - now (time.Time): The reference time
+//   - hour (int): The hour, 0-23
+//   - minute (int): The minute, 0-59
+//   - loc (*time.Location): The timezone the clock time is read in; UTC if nil
+//
+// Returns:
+//   - time.Time: The next matching time, strictly after now, in loc
+//
+// Example:
+//
+//	berlin, _ := time.LoadLocation("Europe/Berlin")
+//	at := NextLocalTime(time.Now(), 9, 0, berlin)
+//
+// Notes:
+//   - On days when a DST change skips the clock time, the result is
+//     normalized as by time.Date
+func NextLocalTime(now time.Time, hour, minute int, loc *time.Location) time.Time {
+	if loc == nil {
+		loc = time.UTC
+	}
+	local := now.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), hour, minute, 0, 0, loc)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, hour, minute, 0, 0, loc)
+	}
+	return next
+}
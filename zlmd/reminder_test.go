@@ -0,0 +1,39 @@
+package zlmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminder(t *testing.T) {
+	got := Reminder("Rotate **certs**", time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC))
+	expected := "⏰ Reminder for <time:2024-03-01T09:00:00Z>: Rotate **certs**"
+	if got != expected {
+		t.Errorf("Reminder() = %q, want %q", got, expected)
+	}
+}
+
+func TestNextLocalTime(t *testing.T) {
+	berlin := time.FixedZone("CET", 3600)
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		loc      *time.Location
+		expected time.Time
+	}{
+		{"Later today", time.Date(2024, 3, 1, 6, 0, 0, 0, time.UTC), berlin, time.Date(2024, 3, 1, 9, 0, 0, 0, berlin)},
+		{"Tomorrow", time.Date(2024, 3, 1, 8, 30, 0, 0, time.UTC), berlin, time.Date(2024, 3, 2, 9, 0, 0, 0, berlin)},
+		{"Exactly now", time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC), nil, time.Date(2024, 3, 2, 9, 0, 0, 0, time.UTC)},
+		{"Month end", time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC), nil, time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextLocalTime(tt.now, 9, 0, tt.loc)
+			if !got.Equal(tt.expected) {
+				t.Errorf("NextLocalTime() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
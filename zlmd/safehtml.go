@@ -0,0 +1,340 @@
+package zlmd
+
+import (
+	"html"
+	"html/template"
+	"sort"
+	"strings"
+)
+
+// allowedTags maps the elements SanitizeHTML keeps to the attributes it keeps
+// on them. They cover the HTML Zulip renders for messages.
+var allowedTags = map[string][]string{
+	"a":          {"href", "title", "class"},
+	"b":          nil,
+	"blockquote": nil,
+	"br":         nil,
+	"code":       {"class"},
+	"del":        nil,
+	"div":        {"class"},
+	"em":         nil,
+	"h1":         nil,
+	"h2":         nil,
+	"h3":         nil,
+	"h4":         nil,
+	"h5":         nil,
+	"h6":         nil,
+	"hr":         nil,
+	"i":          nil,
+	"img":        {"src", "alt", "title", "class"},
+	"li":         nil,
+	"ol":         {"start"},
+	"p":          nil,
+	"pre":        {"class"},
+	"s":          nil,
+	"span":       {"class", "title"},
+	"strong":     nil,
+	"sub":        nil,
+	"sup":        nil,
+	"table":      nil,
+	"tbody":      nil,
+	"td":         {"align"},
+	"th":         {"align"},
+	"thead":      nil,
+	"time":       {"datetime"},
+	"tr":         nil,
+	"ul":         nil,
+}
+
+// voidTags are allowed elements that have no closing tag.
+var voidTags = map[string]bool{"br": true, "hr": true, "img": true}
+
+// droppedTags are elements removed together with their content.
+var droppedTags = map[string]bool{
+	"script": true, "style": true, "iframe": true, "object": true, "embed": true,
+	"noscript": true, "template": true, "textarea": true, "title": true, "xmp": true,
+}
+
+// SanitizeHTML makes rendered message HTML safe to embed in a web page.
+//
+// Parameters:
+//   - s (string): The HTML to sanitize, such as the output of Process
+//
+// Returns:
+//   - string: The HTML with only the elements and attributes of rendered
+//     Zulip messages left, and all text re-escaped
+//
+// Example:
+//
+//	SanitizeHTML(`<p onclick="x()">Hi <script>alert(1)</script><a href="javascript:x()">link</a></p>`)
+//	// "<p>Hi <a>link</a></p>"
+//
+// Notes:
+//   - Script, style and similar elements are removed with their content;
+//     other unknown elements are removed but their text is kept
+//   - Links and images keep only http, https and mailto URLs, or relative ones
+//   - Unclosed elements are closed at the end, so the result can't affect the
+//     markup around it
+func SanitizeHTML(s string) string {
+	var sb strings.Builder
+	var open []string
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '<')
+		if i < 0 {
+			sb.WriteString(escapeText(s))
+			break
+		}
+		sb.WriteString(escapeText(s[:i]))
+		s = s[i:]
+
+		if strings.HasPrefix(s, "<!--") {
+			end := strings.Index(s[4:], "-->")
+			if end < 0 {
+				break
+			}
+			s = s[4+end+3:]
+			continue
+		}
+
+		name, attrs, closing, rest, ok := parseTag(s)
+		if !ok {
+			sb.WriteString("&lt;")
+			s = s[1:]
+			continue
+		}
+		s = rest
+
+		switch {
+		case closing:
+			for j := len(open) - 1; j >= 0; j-- {
+				if open[j] == name {
+					closeTags(&sb, open[j:])
+					open = open[:j]
+					break
+				}
+			}
+		case droppedTags[name]:
+			s = skipElement(s, name)
+		case hasTag(name):
+			sb.WriteString("<" + name + sanitizeAttrs(name, attrs) + ">")
+			if !voidTags[name] {
+				open = append(open, name)
+			}
+		}
+	}
+
+	closeTags(&sb, open)
+	return sb.String()
+}
+
+// ProcessHTML renders markdown like Process and sanitizes the result, so it
+// can be inserted into an html/template page as is.
+//
+// Parameters:
+//   - markdown (string): The Zulip markdown to render
+//
+// Returns:
+//   - template.HTML: The sanitized HTML
+//   - error: Any error returned by Process
+//
+// Example:
+//
+//	body, err := ProcessHTML(message)
+//	page.Execute(w, struct{ Body template.HTML }{body})
+func ProcessHTML(markdown string) (template.HTML, error) {
+	rendered, err := Process(markdown)
+	if err != nil {
+		return "", err
+	}
+	return template.HTML(SanitizeHTML(rendered)), nil
+}
+
+// EscapeHTML prepares untrusted text for a page that shows markdown source
+// before it is rendered, such as a message editor preview: the text is
+// escaped for markdown according to policy and then for HTML.
+//
+// Parameters:
+//   - text (string): The untrusted text
+//   - policy (EscapePolicy): Which markdown constructs to neutralize
+//
+// Returns:
+//   - string: Text safe in both a message and an HTML page
+//
+// Example:
+//
+//	EscapeHTML(`<b>@**all**</b>`, EscapeMentions)
+//	// "&lt;b&gt;@\*\*all\*\*&lt;/b&gt;"
+func EscapeHTML(text string, policy EscapePolicy) string {
+	return html.EscapeString(Escape(text, policy))
+}
+
+// HTMLFuncMap returns helpers for html/template pages that preview messages.
+//
+// Returns:
+//   - template.FuncMap: A new map holding:
+//   - markdown: ProcessHTML, e.g. {{markdown .Content}}
+//   - sanitize: SanitizeHTML for HTML rendered elsewhere, e.g. {{sanitize .RenderedContent}}
+//
+// Example:
+//
+//	t := template.Must(template.New("page").Funcs(HTMLFuncMap()).Parse(
+//		`<div class="message">{{markdown .Content}}</div>`))
+func HTMLFuncMap() template.FuncMap {
+	return template.FuncMap{
+		"markdown": ProcessHTML,
+		"sanitize": func(s string) template.HTML {
+			return template.HTML(SanitizeHTML(s))
+		},
+	}
+}
+
+// parseTag parses the tag at the start of s, which begins with '<'.
+func parseTag(s string) (name string, attrs map[string]string, closing bool, rest string, ok bool) {
+	i := 1
+	if i < len(s) && s[i] == '/' {
+		closing = true
+		i++
+	}
+	start := i
+	for i < len(s) && isTagNameByte(s[i]) {
+		i++
+	}
+	if i == start || !isLetter(s[start]) {
+		return "", nil, false, s, false
+	}
+	name = strings.ToLower(s[start:i])
+
+	attrs = map[string]string{}
+	for i < len(s) {
+		for i < len(s) && (isSpace(s[i]) || s[i] == '/') {
+			i++
+		}
+		if i >= len(s) {
+			return "", nil, false, s, false
+		}
+		if s[i] == '>' {
+			return name, attrs, closing, s[i+1:], true
+		}
+
+		start := i
+		for i < len(s) && !isSpace(s[i]) && s[i] != '=' && s[i] != '>' && s[i] != '/' {
+			i++
+		}
+		key := strings.ToLower(s[start:i])
+		for i < len(s) && isSpace(s[i]) {
+			i++
+		}
+		value := ""
+		if i < len(s) && s[i] == '=' {
+			i++
+			for i < len(s) && isSpace(s[i]) {
+				i++
+			}
+			if i < len(s) && (s[i] == '"' || s[i] == '\'') {
+				end := strings.IndexByte(s[i+1:], s[i])
+				if end < 0 {
+					return "", nil, false, s, false
+				}
+				value = s[i+1 : i+1+end]
+				i += end + 2
+			} else {
+				start := i
+				for i < len(s) && !isSpace(s[i]) && s[i] != '>' {
+					i++
+				}
+				value = s[start:i]
+			}
+		}
+		if _, seen := attrs[key]; !seen && key != "" {
+			attrs[key] = html.UnescapeString(value)
+		}
+	}
+	return "", nil, false, s, false
+}
+
+// sanitizeAttrs renders the allowed attributes of an element, in name order.
+func sanitizeAttrs(tag string, attrs map[string]string) string {
+	var kept []string
+	for _, name := range allowedTags[tag] {
+		value, ok := attrs[name]
+		if !ok || ((name == "href" || name == "src") && !safeURL(value)) {
+			continue
+		}
+		kept = append(kept, name+`="`+html.EscapeString(value)+`"`)
+	}
+	if len(kept) == 0 {
+		return ""
+	}
+	sort.Strings(kept)
+	return " " + strings.Join(kept, " ")
+}
+
+// safeURL reports whether u is relative or uses an http, https or mailto
+// scheme.
+func safeURL(u string) bool {
+	u = strings.Map(func(r rune) rune {
+		if r <= ' ' || r == 0x7f {
+			return -1
+		}
+		return r
+	}, u)
+	colon := strings.IndexByte(u, ':')
+	if colon < 0 {
+		return true
+	}
+	if sep := strings.IndexAny(u, "/?#"); sep >= 0 && sep < colon {
+		return true
+	}
+	switch strings.ToLower(u[:colon]) {
+	case "http", "https", "mailto":
+		return true
+	}
+	return false
+}
+
+// skipElement returns s after the closing tag of the dropped element name,
+// or "" if it is never closed.
+func skipElement(s, name string) string {
+	lower := strings.ToLower(s)
+	end := strings.Index(lower, "</"+name)
+	if end < 0 {
+		return ""
+	}
+	gt := strings.IndexByte(s[end:], '>')
+	if gt < 0 {
+		return ""
+	}
+	return s[end+gt+1:]
+}
+
+// escapeText re-escapes a text node, so stray markup characters and
+// entities are normalized.
+func escapeText(s string) string {
+	return html.EscapeString(html.UnescapeString(s))
+}
+
+// hasTag reports whether name is an allowed element without attributes.
+func hasTag(name string) bool {
+	_, ok := allowedTags[name]
+	return ok
+}
+
+// closeTags writes the closing tags of the open elements, innermost first.
+func closeTags(sb *strings.Builder, open []string) {
+	for i := len(open) - 1; i >= 0; i-- {
+		sb.WriteString("</" + open[i] + ">")
+	}
+}
+
+func isLetter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z')
+}
+
+func isTagNameByte(b byte) bool {
+	return isLetter(b) || (b >= '0' && b <= '9') || b == '-'
+}
+
+func isSpace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\n' || b == '\r' || b == '\f'
+}
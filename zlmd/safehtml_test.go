@@ -0,0 +1,84 @@
+package zlmd
+
+import (
+	"bytes"
+	"html/template"
+	"testing"
+)
+
+func TestSanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{"Allowed", `<p>Hi <strong>there</strong><br/></p>`, `<p>Hi <strong>there</strong><br></p>`},
+		{"Event handlers", `<p onclick="x()" class="a">x</p>`, `<p>x</p>`},
+		{"Script", `a<script>alert("<p>")</script>b<SCRIPT src=x></SCRIPT>c`, `abc`},
+		{"Unknown element", `<marquee><em>hey</em></marquee>`, `<em>hey</em>`},
+		{"Safe link", `<a href="https://example.com/?a=1&amp;b=2" title='T "q"'>x</a>`, `<a href="https://example.com/?a=1&amp;b=2" title="T &#34;q&#34;">x</a>`},
+		{"JavaScript link", `<a href="java&#x09;script:alert(1)">x</a><a href=" JavaScript:x">y</a>`, `<a>x</a><a>y</a>`},
+		{"Relative link", `<a href="/#narrow/stream/1">x</a>`, `<a href="/#narrow/stream/1">x</a>`},
+		{"Image data URL", `<img src="data:image/svg+xml,x" alt="a">`, `<img alt="a">`},
+		{"Unclosed", `<div><span class="x">text`, `<div><span class="x">text</span></div>`},
+		{"Stray close", `</div><p>x</em></p></p>`, `<p>x</p>`},
+		{"Misnested", `<p><em>a</p>b`, `<p><em>a</em></p>b`},
+		{"Text", `1 < 2 & "x" > 0 &amp; &lt;b&gt;`, `1 &lt; 2 &amp; &#34;x&#34; &gt; 0 &amp; &lt;b&gt;`},
+		{"Unterminated tag", `<p title="x>y`, `&lt;p title=&#34;x&gt;y`},
+		{"Comment", `a<!-- <script>x</script> -->b`, `ab`},
+		{"Time", `<time datetime="2024-01-02T15:00:00Z">Jan 2</time>`, `<time datetime="2024-01-02T15:00:00Z">Jan 2</time>`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SanitizeHTML(tt.input); got != tt.expected {
+				t.Errorf("SanitizeHTML() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProcessHTML(t *testing.T) {
+	got, err := ProcessHTML(`hi <img src=x onerror="alert(1)">`)
+	if err != nil {
+		t.Fatalf("ProcessHTML() returned error: %v", err)
+	}
+	if expected := template.HTML(`Processed: hi <img src="x">`); got != expected {
+		t.Errorf("ProcessHTML() = %q, want %q", got, expected)
+	}
+}
+
+func TestEscapeHTML(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		policy   EscapePolicy
+		expected string
+	}{
+		{"Mentions", `<b>@**all**</b>`, EscapeMentions, `&lt;b&gt;@\*\*all\*\*&lt;/b&gt;`},
+		{"All", `a & *b*`, EscapeAll, `a &amp; \*b\*`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := EscapeHTML(tt.text, tt.policy); got != tt.expected {
+				t.Errorf("EscapeHTML() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestHTMLFuncMap(t *testing.T) {
+	tmpl := template.Must(template.New("page").Funcs(HTMLFuncMap()).Parse(
+		`<div>{{markdown .Content}}</div><div>{{sanitize .Rendered}}</div><p>{{.Content}}</p>`))
+
+	var buf bytes.Buffer
+	err := tmpl.Execute(&buf, map[string]string{"Content": "<script>x</script>ok", "Rendered": `<p onmouseover="x">y</p>`})
+	if err != nil {
+		t.Fatalf("Execute() returned error: %v", err)
+	}
+	expected := `<div>Processed: ok</div><div><p>y</p></div><p>&lt;script&gt;x&lt;/script&gt;ok</p>`
+	if got := buf.String(); got != expected {
+		t.Errorf("Execute() = %q, want %q", got, expected)
+	}
+}
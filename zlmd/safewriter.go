@@ -0,0 +1,91 @@
+package zlmd
+
+import (
+	"bytes"
+	"io"
+	"sync"
+)
+
+// SafeWriter is an io.Writer that escapes markdown in everything written to
+// it before passing it on, so arbitrary program output can be embedded in a
+// message body.
+//
+// Escaping works line by line, so a line split across several Write calls
+// is escaped exactly as if it had been written at once. It is safe for
+// concurrent use.
+type SafeWriter struct {
+	mu      sync.Mutex
+	w       io.Writer
+	policy  EscapePolicy
+	partial []byte
+}
+
+// NewSafeWriter creates a writer that escapes text according to policy and
+// writes it to w.
+//
+// Parameters:
+//   - w (io.Writer): The destination, such as a strings.Builder holding a message
+//   - policy (EscapePolicy): Which markdown constructs to neutralize
+//
+// Returns:
+//   - *SafeWriter: A writer implementing io.Writer
+//
+// Example:
+//
+//	var body strings.Builder
+//	w := NewSafeWriter(&body, EscapeAll)
+//	cmd := exec.Command("make", "test")
+//	cmd.Stdout, cmd.Stderr = w, w
+//	cmd.Run()
+//	w.Close()
+//	// body holds the output with every markdown metacharacter escaped
+//
+// Notes:
+//   - Complete lines are written as soon as they arrive; an unterminated
+//     final line is held back until Flush or Close
+func NewSafeWriter(w io.Writer, policy EscapePolicy) *SafeWriter {
+	return &SafeWriter{w: w, policy: policy}
+}
+
+// Write escapes the complete lines in p, together with any line left
+// unterminated by earlier writes, and writes them to the destination.
+//
+// Returns:
+//   - int: len(p) unless the destination failed
+//   - error: The destination's error
+func (s *SafeWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.partial = append(s.partial, p...)
+	end := bytes.LastIndexByte(s.partial, '\n')
+	if end < 0 {
+		return len(p), nil
+	}
+	complete := string(s.partial[:end+1])
+	s.partial = append(s.partial[:0], s.partial[end+1:]...)
+	if _, err := io.WriteString(s.w, Escape(complete, s.policy)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush escapes and writes an unterminated final line, if any.
+func (s *SafeWriter) Flush() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.partial) == 0 {
+		return nil
+	}
+	line := string(s.partial)
+	s.partial = s.partial[:0]
+	_, err := io.WriteString(s.w, Escape(line, s.policy))
+	return err
+}
+
+// Close flushes the final line. It does not close the destination, and the
+// writer remains usable afterwards.
+func (s *SafeWriter) Close() error {
+	return s.Flush()
+}
@@ -0,0 +1,78 @@
+package zlmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type failWriter struct{}
+
+func (failWriter) Write(p []byte) (int, error) { return 0, errors.New("disk full") }
+
+func TestSafeWriter(t *testing.T) {
+	input := "# build\n```\nping @**all** and *stars*\n- done"
+
+	tests := []struct {
+		name   string
+		policy EscapePolicy
+		chunks []int
+	}{
+		{"All at once", EscapeAll, []int{len(input)}},
+		{"Byte by byte", EscapeAll, nil},
+		{"Mentions split mid-mention", EscapeMentions, []int{17, 5, 3}},
+		{"Fences split mid-fence", EscapeFences, []int{9, 1, 20}},
+		{"None", EscapeNone, []int{4, 4}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			w := NewSafeWriter(&sb, tt.policy)
+
+			rest := input
+			for i := 0; len(rest) > 0; i++ {
+				n := 1
+				if i < len(tt.chunks) {
+					n = min(tt.chunks[i], len(rest))
+				}
+				if written, err := w.Write([]byte(rest[:n])); err != nil || written != n {
+					t.Fatalf("Write() = %d, %v, want %d, nil", written, err, n)
+				}
+				rest = rest[n:]
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("Close() returned error: %v", err)
+			}
+
+			if expected := Escape(input, tt.policy); sb.String() != expected {
+				t.Errorf("SafeWriter wrote %q, want %q", sb.String(), expected)
+			}
+		})
+	}
+}
+
+func TestSafeWriter_HoldsPartialLine(t *testing.T) {
+	var sb strings.Builder
+	w := NewSafeWriter(&sb, EscapeAll)
+
+	w.Write([]byte("a_b\nc_"))
+	if got := sb.String(); got != "a\\_b\n" {
+		t.Errorf("After Write() = %q, want %q", got, "a\\_b\n")
+	}
+	w.Flush()
+	if got := sb.String(); got != "a\\_b\nc\\_" {
+		t.Errorf("After Flush() = %q, want %q", got, "a\\_b\nc\\_")
+	}
+}
+
+func TestSafeWriter_Error(t *testing.T) {
+	w := NewSafeWriter(failWriter{}, EscapeAll)
+	if _, err := w.Write([]byte("x\n")); err == nil {
+		t.Error("Expected the destination error from Write")
+	}
+	w.Write([]byte("y"))
+	if err := w.Flush(); err == nil {
+		t.Error("Expected the destination error from Flush")
+	}
+}
@@ -1,15 +1,17 @@
 package zlmd
 
-import (
-	"strconv"
-	"strings"
-)
+import "strconv"
 
 // Section represents a markdown document section with a title and content.
 type Section struct {
 	Level   int
 	Title   string
 	Content []string
+
+	bullet string
+	escape EscapePolicy
+	bidi   BidiPolicy
+	err    error
 }
 
 // NewSection creates a new markdown section with the specified heading level and title.
@@ -17,6 +19,9 @@ type Section struct {
 // Parameters:
 //   - level (int): The heading level (1-6)
 //   - title (string): The section title
+//   - opts (...Option): Optional settings; WithConfig, or the default
+//     Config, selects the bullet marker and the Escape policy applied to
+//     bullet and numbered items
 //
 // Returns:
 //   - *Section: A new initialized Section instance
@@ -25,7 +30,7 @@ type Section struct {
 //
 //	section := NewSection(2, "Introduction")
 //	// Creates a section with ## heading
-func NewSection(level int, title string) *Section {
+func NewSection(level int, title string, opts ...Option) *Section {
 	// Limit level to valid markdown heading levels (1-6)
 	if level < 1 {
 		level = 1
@@ -33,13 +38,32 @@ func NewSection(level int, title string) *Section {
 		level = 6
 	}
 
+	o := newOptions(opts...)
+	bullet := o.bullet
+	if bullet == "" {
+		bullet = "*"
+	}
+
 	return &Section{
 		Level:   level,
 		Title:   title,
 		Content: []string{},
+		bullet:  bullet,
+		escape:  o.escape,
 	}
 }
 
+// WithBidiPolicy isolates the text of bullet and numbered items added
+// afterwards according to policy, so right-to-left text doesn't move the
+// list markers.
+//
+// Returns:
+//   - *Section: The same Section instance (for method chaining)
+func (s *Section) WithBidiPolicy(policy BidiPolicy) *Section {
+	s.bidi = policy
+	return s
+}
+
 // AddBullet adds a bullet point to the section.
 //
 // Parameters:
@@ -51,9 +75,10 @@ func NewSection(level int, title string) *Section {
 // Example:
 //
 //	section.AddBullet("This is a bullet point")
-//	// Adds "* This is a bullet point" to the section content
+//	// Adds "* This is a bullet point" to the section content, or starts it
+//	// with the Bullet of the section's Config
 func (s *Section) AddBullet(text string) *Section {
-	s.Content = append(s.Content, "* "+text)
+	s.Content = append(s.Content, s.bullet+" "+IsolateBidi(Escape(text, s.escape), s.bidi))
 	return s
 }
 
@@ -71,7 +96,7 @@ func (s *Section) AddBullet(text string) *Section {
 //	section.AddNumberedItem(1, "First step")
 //	// Adds "1. First step" to the section content
 func (s *Section) AddNumberedItem(number int, text string) *Section {
-	s.Content = append(s.Content, strconv.Itoa(number)+". "+text)
+	s.Content = append(s.Content, strconv.Itoa(number)+". "+IsolateBidi(Escape(text, s.escape), s.bidi))
 	return s
 }
 
@@ -120,24 +145,55 @@ func (s *Section) AddTable(table *TableBuilder) *Section {
 //	sectionStr := section.Build()
 //	// Generates a formatted markdown section with heading and content
 func (s *Section) Build() string {
-	var sb strings.Builder
+	buf := getBuffer()
+	defer putBuffer(buf)
 
 	// Add heading
 	for i := 0; i < s.Level; i++ {
-		sb.WriteString("#")
+		buf.WriteString("#")
 	}
-	sb.WriteString(" ")
-	sb.WriteString(s.Title)
-	sb.WriteString("\n\n")
+	buf.WriteString(" ")
+	buf.WriteString(s.Title)
+	buf.WriteString("\n\n")
 
 	// Add content
 	for _, item := range s.Content {
-		sb.WriteString(item)
-		sb.WriteString("\n")
+		buf.WriteString(item)
+		buf.WriteString("\n")
 	}
 
 	// Add final newline
-	sb.WriteString("\n")
+	buf.WriteString("\n")
+
+	return finishBlock(buf.String())
+}
+
+// Add renders v with Render and adds it to the section. An error returned by
+// a Markdowner is recorded and reported by MarshalZulipMarkdown.
+//
+// Parameters:
+//   - v (any): A Markdowner, such as another builder, or any other value
+//
+// Returns:
+//   - *Section: The same Section instance (for method chaining)
+//
+// Example:
+//
+//	section.Add(NewTimeline().Add(start, "info", "Deploy started"))
+func (s *Section) Add(v any) *Section {
+	text, err := Render(v)
+	if err != nil {
+		if s.err == nil {
+			s.err = err
+		}
+		return s
+	}
+	s.Content = append(s.Content, text)
+	return s
+}
 
-	return sb.String()
+// MarshalZulipMarkdown implements Markdowner, returning Build and the first
+// error recorded by Add.
+func (s *Section) MarshalZulipMarkdown() (string, error) {
+	return s.Build(), s.err
 }
@@ -1,4 +1,8 @@
 // Package zlmd provides utility functions for formatting text output.
+//
+// The emoji and arrows written by the shortcuts come from the Theme of the
+// default Config; SetDefaultConfig(Config{Theme: PlainTheme}) switches them
+// to ASCII.
 package zlmd
 
 import (
@@ -11,7 +15,7 @@ import (
 // to allow for error propagation.
 func NoErr(info *strings.Builder, err error) error {
 	if err != nil {
-		info.WriteString(fmt.Sprintf("❌ %s\n", err))
+		defaultTheme().Statusf(info, "danger", "%s", err)
 		return err
 	}
 	return nil
@@ -22,7 +26,7 @@ func NoErr(info *strings.Builder, err error) error {
 // to allow for error propagation.
 func NoErrWarn(info *strings.Builder, err error) error {
 	if err != nil {
-		info.WriteString(fmt.Sprintf("⚠️ %s\n", err))
+		defaultTheme().Statusf(info, "warning", "%s", err)
 		return err
 	}
 	return nil
@@ -31,7 +35,7 @@ func NoErrWarn(info *strings.Builder, err error) error {
 // Warnf formats a warning message with a warning emoji and appends it to
 // the provided StringBuilder. It supports printf-style formatting.
 func Warnf(info *strings.Builder, format string, args ...interface{}) {
-	info.WriteString(fmt.Sprintf("⚠️ %s\n", fmt.Sprintf(format, args...)))
+	defaultTheme().Statusf(info, "warning", format, args...)
 }
 
 // WarnUsage formats a usage warning message with a warning emoji and appends it
@@ -43,25 +47,25 @@ func WarnUsage(info *strings.Builder, format string, args ...interface{}) {
 // Infof formats an informational message with an info emoji and appends it to
 // the provided StringBuilder. It supports printf-style formatting.
 func Infof(info *strings.Builder, format string, args ...interface{}) {
-	info.WriteString(fmt.Sprintf("ℹ️ %s\n", fmt.Sprintf(format, args...)))
+	defaultTheme().Statusf(info, "info", format, args...)
 }
 
 // Successf formats a success message with a checkmark emoji and appends it to
 // the provided StringBuilder. It supports printf-style formatting.
 func Successf(info *strings.Builder, format string, args ...interface{}) {
-	info.WriteString(fmt.Sprintf("✅ %s\n", fmt.Sprintf(format, args...)))
+	defaultTheme().Statusf(info, "success", format, args...)
 }
 
 // Errorf formats an error message with a red X emoji and appends it to
 // the provided StringBuilder. It supports printf-style formatting.
 func Errorf(info *strings.Builder, format string, args ...interface{}) {
-	info.WriteString(fmt.Sprintf("❌ %s\n", fmt.Sprintf(format, args...)))
+	defaultTheme().Statusf(info, "danger", format, args...)
 }
 
 // Debugf formats a debug message with a magnifying glass emoji and appends it to
 // the provided StringBuilder. It supports printf-style formatting.
 func Debugf(info *strings.Builder, format string, args ...interface{}) {
-	info.WriteString(fmt.Sprintf("🔍 %s\n", fmt.Sprintf(format, args...)))
+	defaultTheme().Statusf(info, "debug", format, args...)
 }
 
 type ArrowType int
@@ -80,7 +84,7 @@ const (
 // Example:
 // Point(info, "Hello, world!") -> " → Hello, world!"
 func Point(info *strings.Builder, text string) {
-	info.WriteString(fmt.Sprintf(" %s %s", ArrowRight, text))
+	info.WriteString(fmt.Sprintf(" %s %s", defaultTheme().Prefix("arrow-right"), text))
 }
 
 // Pointnl formats a text with a right arrow emoji and appends it to the provided StringBuilder.
@@ -97,8 +101,10 @@ func Pointnl(info *strings.Builder, text string) {
 //
 // Example:
 // Right(info, "A", "B", "C") -> "A → B → C"
+//
+// For labeled edges, multi-line steps or branches, use Flow.
 func Right(info *strings.Builder, text ...string) {
-	arrow(info, ArrowRight, true, text...)
+	arrow(info, "arrow-right", true, text...)
 }
 
 // Left formats a text with a left arrow emoji and appends it to the provided StringBuilder.
@@ -106,8 +112,10 @@ func Right(info *strings.Builder, text ...string) {
 //
 // Example:
 // Left(info, "A", "B", "C") -> "A ← B ← C"
+//
+// For labeled edges, multi-line steps or branches, use Flow.
 func Left(info *strings.Builder, text ...string) {
-	arrow(info, ArrowLeft, true, text...)
+	arrow(info, "arrow-left", true, text...)
 }
 
 // LeftRight formats a text with a left and right arrow emoji and appends it to the provided StringBuilder.
@@ -115,8 +123,10 @@ func Left(info *strings.Builder, text ...string) {
 //
 // Example:
 // LeftRight(info, "A", "B", "C") -> "A ↔ B ↔ C"
+//
+// For labeled edges, multi-line steps or branches, use Flow.
 func LeftRight(info *strings.Builder, text ...string) {
-	arrow(info, ArrowLeftRight, true, text...)
+	arrow(info, "arrow-left-right", true, text...)
 }
 
 // RightDotted formats a text with a right dotted arrow emoji and appends it to the provided StringBuilder.
@@ -126,7 +136,7 @@ func LeftRight(info *strings.Builder, text ...string) {
 // RightDotted(info, "A", "B", "C") -> "A ⤑ B ⤑ C"
 
 func RightDotted(info *strings.Builder, text ...string) {
-	arrow(info, ArrowRightDotted, true, text...)
+	arrow(info, "arrow-right-dotted", true, text...)
 }
 
 // LeftDotted formats a text with a left dotted arrow emoji and appends it to the provided StringBuilder.
@@ -135,7 +145,7 @@ func RightDotted(info *strings.Builder, text ...string) {
 // Example:
 // LeftDotted(info, "A", "B", "C") -> "A ⬸ B ⬸ C"
 func LeftDotted(info *strings.Builder, text ...string) {
-	arrow(info, ArrowLeftDotted, true, text...)
+	arrow(info, "arrow-left-dotted", true, text...)
 }
 
 // Rightnl formats a text with a right arrow emoji and appends it to the provided StringBuilder.
@@ -144,13 +154,13 @@ func LeftDotted(info *strings.Builder, text ...string) {
 // Example:
 // Rightnl(info, "A", "B", "C") -> "A → B → C\n"
 func Rightnl(info *strings.Builder, text string) {
-	arrow(info, ArrowRight, true, text)
+	arrow(info, "arrow-right", true, text)
 }
 
 // Leftnl formats a text with a left arrow emoji and appends it to the provided StringBuilder.
 // It also appends a newline.
 func Leftnl(info *strings.Builder, text string) {
-	arrow(info, ArrowLeft, true, text)
+	arrow(info, "arrow-left", true, text)
 }
 
 // RightDottednl formats a text with a right dotted arrow emoji and appends it to the provided StringBuilder.
@@ -159,7 +169,7 @@ func Leftnl(info *strings.Builder, text string) {
 // Example:
 // RightDottednl(info, "A", "B", "C") -> "A ⤑ B ⤑ C\n"
 func RightDottednl(info *strings.Builder, text string) {
-	arrow(info, ArrowRightDotted, true, text)
+	arrow(info, "arrow-right-dotted", true, text)
 }
 
 // LeftDottednl formats a text with a left dotted arrow emoji and appends it to the provided StringBuilder.
@@ -168,15 +178,17 @@ func RightDottednl(info *strings.Builder, text string) {
 // Example:
 // LeftDottednl(info, "A", "B", "C") -> "A ⬸ B ⬸ C\n"
 func LeftDottednl(info *strings.Builder, text string) {
-	arrow(info, ArrowLeftDotted, true, text)
+	arrow(info, "arrow-left-dotted", true, text)
 }
 
-// arrow formats a text with an arrow emoji and appends it to the provided StringBuilder.
+// arrow formats a text with the arrow the default theme registers for style
+// and appends it to the provided StringBuilder.
 // It also appends a newline if newline is true.
 //
 // Example:
-// arrow(info, ArrowRight, true, "A", "B", "C") -> "A → B → C\n"
-func arrow(info *strings.Builder, arrowType string, newline bool, text ...string) {
+// arrow(info, "arrow-right", true, "A", "B", "C") -> "A → B → C\n"
+func arrow(info *strings.Builder, style string, newline bool, text ...string) {
+	arrowType := defaultTheme().Prefix(style)
 	if len(text) == 1 {
 		info.WriteString(fmt.Sprintf("%s %s", arrowType, text[0]))
 	} else {
@@ -188,28 +200,11 @@ func arrow(info *strings.Builder, arrowType string, newline bool, text ...string
 }
 
 // Badge creates a colored badge/tag for important information.
-// style can be: "primary", "success", "warning", "danger", "info"
+// style can be: "primary", "success", "warning", "danger", "info", "rejected",
+// or any style registered on DefaultTheme, or on the Theme of the default
+// Config if it has one.
 func Badge(info *strings.Builder, text string, style string) {
-	var emoji string
-
-	switch style {
-	case "primary":
-		emoji = "🔵"
-	case "success":
-		emoji = "✅"
-	case "warning":
-		emoji = "⚠️"
-	case "danger":
-		emoji = "❌"
-	case "info":
-		emoji = "ℹ️"
-	case "rejected":
-		emoji = "✴️"
-	default:
-		emoji = "🧷"
-	}
-
-	info.WriteString(fmt.Sprintf("%s `%s`\n", emoji, text))
+	defaultTheme().Badge(info, text, style)
 }
 
 // Usage formats a usage message with a warning emoji and appends it to
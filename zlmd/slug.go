@@ -0,0 +1,122 @@
+package zlmd
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Slug returns the anchor GitHub generates for a heading, so links to
+// "#"+Slug(heading) resolve.
+//
+// The heading's markdown is stripped, letters are lowercased, spaces become
+// hyphens, and punctuation and symbols, including emoji, are dropped;
+// letters and digits of every script, "-" and "_" are kept.
+//
+// Parameters:
+//   - heading (string): The heading text, without the leading "#"s
+//
+// Returns:
+//   - string: The anchor, without "#"
+//
+// Example:
+//
+//	Slug("Release 2.0: What's new?") // "release-20-whats-new"
+//	Slug("**Über** `zlmd` 🚀")         // "über-zlmd-"
+//
+// Notes:
+//   - Zulip doesn't give headings in messages anchors; links to them only
+//     resolve where the output is rendered as GitHub Flavored Markdown, as
+//     with FlavorPortable
+//   - A document with repeated headings needs a Slugger, which numbers the
+//     duplicates the way GitHub does
+func Slug(heading string) string {
+	var sb strings.Builder
+	// Only the text of links is part of the rendered heading.
+	heading = markdownLinkPattern.ReplaceAllString(heading, "$1")
+	for _, r := range strings.ToLower(StripMarkdown(heading)) {
+		switch {
+		case r == ' ':
+			sb.WriteByte('-')
+		case r == '-' || r == '_' || unicode.In(r, unicode.L, unicode.M, unicode.N):
+			sb.WriteRune(r)
+		}
+	}
+	return sb.String()
+}
+
+// Slugger generates the anchors of the headings of one document, adding
+// "-1", "-2" and so on to repeated headings, like GitHub.
+//
+// Example:
+//
+//	var s Slugger
+//	s.Slug("Usage") // "usage"
+//	s.Slug("Usage") // "usage-1"
+type Slugger struct {
+	seen map[string]int
+}
+
+// Slug returns the anchor of the next heading in the document.
+func (s *Slugger) Slug(heading string) string {
+	if s.seen == nil {
+		s.seen = map[string]int{}
+	}
+	base := Slug(heading)
+	slug := base
+	for {
+		if _, ok := s.seen[slug]; !ok {
+			break
+		}
+		s.seen[base]++
+		slug = base + "-" + strconv.Itoa(s.seen[base])
+	}
+	s.seen[slug] = 0
+	return slug
+}
+
+// TableOfContents returns a nested list linking to the headings of markdown,
+// for the top of a long document. Headings in code blocks are skipped.
+//
+// Parameters:
+//   - markdown (string): The document
+//
+// Returns:
+//   - string: One list item per heading, indented by level relative to the
+//     highest heading, or "" if there are no headings
+//
+// Example:
+//
+//	TableOfContents("# Notes\n## Fixes\n## Fixes")
+//	// "- [Notes](#notes)\n  - [Fixes](#fixes)\n  - [Fixes](#fixes-1)"
+func TableOfContents(markdown string) string {
+	type heading struct {
+		level int
+		text  string
+	}
+	var headings []heading
+	top := 6
+	for _, line := range proseLines(markdown) {
+		trimmed := strings.TrimLeft(line, " ")
+		if len(line)-len(trimmed) > 3 {
+			continue
+		}
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		if level < 1 || level > 6 || (len(trimmed) > level && trimmed[level] != ' ') {
+			continue
+		}
+		text := strings.TrimSpace(strings.TrimRight(strings.TrimSpace(trimmed[level:]), "#"))
+		if text == "" {
+			continue
+		}
+		headings = append(headings, heading{level, text})
+		top = min(top, level)
+	}
+
+	var s Slugger
+	items := make([]string, len(headings))
+	for i, h := range headings {
+		items[i] = strings.Repeat("  ", h.level-top) + "- " + Link(h.text, "#"+s.Slug(h.text))
+	}
+	return strings.Join(items, "\n")
+}
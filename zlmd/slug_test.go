@@ -0,0 +1,66 @@
+package zlmd
+
+import "testing"
+
+func TestSlug(t *testing.T) {
+	tests := []struct {
+		name     string
+		heading  string
+		expected string
+	}{
+		{"Simple", "Getting Started", "getting-started"},
+		{"Punctuation", "Release 2.0: What's new?", "release-20-whats-new"},
+		{"Markdown", "**Über** `zlmd` 🚀", "über-zlmd-"},
+		{"Link", "See [docs](https://example.com)", "see-docs"},
+		{"Hyphens and underscores", "snake_case - kebab-case", "snake_case---kebab-case"},
+		{"Other scripts", "東京 Обзор", "東京-обзор"},
+		{"Empty", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Slug(tt.heading); got != tt.expected {
+				t.Errorf("Slug(%q) = %q, want %q", tt.heading, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSlugger(t *testing.T) {
+	var s Slugger
+	headings := []string{"Usage", "Usage", "Usage 1", "Usage"}
+	expected := []string{"usage", "usage-1", "usage-1-1", "usage-2"}
+	for i, h := range headings {
+		if got := s.Slug(h); got != expected[i] {
+			t.Errorf("Slug(%q) #%d = %q, want %q", h, i, got, expected[i])
+		}
+	}
+}
+
+func TestTableOfContents(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{
+			name:     "Nested",
+			markdown: "## Notes\ntext\n### Fixes\n### Fixes ##\n## Thanks",
+			expected: "- [Notes](#notes)\n  - [Fixes](#fixes)\n  - [Fixes](#fixes-1)\n- [Thanks](#thanks)",
+		},
+		{
+			name:     "Code blocks and non-headings",
+			markdown: "# Title\n```sh\n# comment\n```\n#hashtag\n    # indented code",
+			expected: "- [Title](#title)",
+		},
+		{"No headings", "plain", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TableOfContents(tt.markdown); got != tt.expected {
+				t.Errorf("TableOfContents() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
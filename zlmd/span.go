@@ -0,0 +1,116 @@
+package zlmd
+
+import "strings"
+
+// spanStyle is a set of inline styles of an InlineSpan.
+type spanStyle uint8
+
+const (
+	spanBold spanStyle = 1 << iota
+	spanItalic
+	spanStrike
+	spanCode
+)
+
+// InlineSpan is text with inline formatting, built with Span.
+//
+// Styles are a set rather than nested wrappers: whatever order they are
+// applied in, the span renders them in the one order Zulip displays
+// correctly, with code innermost and the link outermost.
+type InlineSpan struct {
+	text  string
+	style spanStyle
+	url   string
+}
+
+// Span starts an inline span of plain text. Markdown in text is escaped, so
+// it renders as typed whichever styles are applied.
+//
+// Parameters:
+//   - text (string): The text
+//
+// Returns:
+//   - InlineSpan: The unformatted span; InlineSpan values are immutable, so
+//     a span can be reused as the base of several others
+//
+// Example:
+//
+//	Span("go test").Code().Bold().String()             // "**`go test`**"
+//	Span("go test").Bold().Code().String()             // "**`go test`**"
+//	Span("a*b").Italic().Link("https://x.io").String() // "[*a\*b*](https://x.io)"
+//
+// Notes:
+//   - Runs of whitespace, including line breaks, become one space and the
+//     ends of text are trimmed: markers next to a space or across lines
+//     don't render
+//   - Code text is never escaped: backticks in it get a longer fence
+func Span(text string) InlineSpan {
+	return InlineSpan{text: strings.Join(strings.Fields(text), " ")}
+}
+
+// Bold returns the span in bold.
+func (s InlineSpan) Bold() InlineSpan {
+	s.style |= spanBold
+	return s
+}
+
+// Italic returns the span in italics.
+func (s InlineSpan) Italic() InlineSpan {
+	s.style |= spanItalic
+	return s
+}
+
+// Strike returns the span struck through.
+func (s InlineSpan) Strike() InlineSpan {
+	s.style |= spanStrike
+	return s
+}
+
+// Code returns the span as inline code.
+func (s InlineSpan) Code() InlineSpan {
+	s.style |= spanCode
+	return s
+}
+
+// Link returns the span linking to url. Characters in url that would end
+// the link early are percent-encoded.
+func (s InlineSpan) Link(url string) InlineSpan {
+	s.url = url
+	return s
+}
+
+// String renders the span, or "" if its text is empty.
+func (s InlineSpan) String() string {
+	if s.text == "" {
+		return ""
+	}
+
+	var text string
+	if s.style&spanCode != 0 {
+		text = inlineCode(s.text)
+	} else {
+		text = Escape(s.text, EscapeAll)
+	}
+	if s.style&spanStrike != 0 {
+		text = "~~" + text + "~~"
+	}
+	switch {
+	case s.style&(spanBold|spanItalic) == spanBold|spanItalic:
+		text = "***" + text + "***"
+	case s.style&spanBold != 0:
+		text = Bold(text)
+	case s.style&spanItalic != 0:
+		text = Italic(text)
+	}
+	if s.url != "" {
+		// EscapeAll already escaped the brackets of plain text; code can't
+		// contain an unescaped "]" that ends the link text.
+		text = Link(text, escapeLinkURL(s.url))
+	}
+	return text
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning String.
+func (s InlineSpan) MarshalZulipMarkdown() (string, error) {
+	return s.String(), nil
+}
@@ -0,0 +1,43 @@
+package zlmd
+
+import "testing"
+
+func TestSpan(t *testing.T) {
+	tests := []struct {
+		name     string
+		span     InlineSpan
+		expected string
+	}{
+		{"Plain", Span("deploy"), "deploy"},
+		{"Bold", Span("deploy").Bold(), "**deploy**"},
+		{"Code inside bold", Span("go test").Code().Bold(), "**`go test`**"},
+		{"Bold then code", Span("go test").Bold().Code(), "**`go test`**"},
+		{"Bold italic", Span("now").Italic().Bold(), "***now***"},
+		{"Strike", Span("v1").Strike().Bold(), "**~~v1~~**"},
+		{"Link outermost", Span("docs").Link("https://x.io").Bold(), "[**docs**](https://x.io)"},
+		{"Markers escaped", Span("a*b_c").Italic(), "*a\\*b\\_c*"},
+		{"Brackets escaped", Span("[x]").Link("https://x.io"), "[\\[x\\]](https://x.io)"},
+		{"URL encoded", Span("x").Link("https://x.io/a (b)"), "[x](https://x.io/a%20%28b%29)"},
+		{"Code not escaped", Span("a*b").Code(), "`a*b`"},
+		{"Code with backticks", Span("`x`").Code().Bold(), "**`` `x` ``**"},
+		{"Mention escaped", Span("@**all**").Bold(), "**@\\*\\*all\\*\\***"},
+		{"Whitespace", Span("  two\nlines ").Bold(), "**two lines**"},
+		{"Empty", Span(" ").Bold(), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.span.String(); got != tt.expected {
+				t.Errorf("String() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpan_Immutable(t *testing.T) {
+	base := Span("x")
+	_ = base.Bold()
+	if got := base.String(); got != "x" {
+		t.Errorf("String() = %q after Bold on a copy, want %q", got, "x")
+	}
+}
@@ -0,0 +1,90 @@
+package zlmd
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// sparkTicks are the block characters used by Sparkline, from lowest to highest.
+var sparkTicks = []rune("▁▂▃▄▅▆▇█")
+
+// Sparkline renders values as a compact block-character sparkline.
+//
+// Parameters:
+//   - values ([]float64): The data points, oldest first
+//
+// Returns:
+//   - string: One block character per value, or "" for no values
+//
+// Example:
+//
+//	line := Sparkline([]float64{1, 2, 5, 7})
+//	// line will be "▁▂▆█"
+//
+// Notes:
+//   - Values are scaled between the minimum and maximum of the series
+//   - A flat series renders with the lowest block
+//   - NaN and infinite values render as a space
+func Sparkline(values []float64) string {
+	lo, hi, ok := sparkRange(values)
+	if !ok {
+		return strings.Repeat(" ", len(values))
+	}
+
+	var sb strings.Builder
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			sb.WriteRune(' ')
+			continue
+		}
+		i := 0
+		if hi > lo {
+			i = int(math.Round((v - lo) / (hi - lo) * float64(len(sparkTicks)-1)))
+		}
+		sb.WriteRune(sparkTicks[i])
+	}
+	return sb.String()
+}
+
+// SparklineMinMax renders a sparkline followed by the series minimum and maximum.
+//
+// Parameters:
+//   - values ([]float64): The data points, oldest first
+//
+// Returns:
+//   - string: The sparkline with a "min … max …" annotation, or "" for no values
+//
+// Example:
+//
+//	line := SparklineMinMax([]float64{12, 40, 33, 8})
+//	// line will be "▂█▆▁ min 8 max 40"
+func SparklineMinMax(values []float64) string {
+	lo, hi, ok := sparkRange(values)
+	if !ok {
+		return Sparkline(values)
+	}
+	return fmt.Sprintf("%s min %s max %s", Sparkline(values), formatFloat(lo), formatFloat(hi))
+}
+
+// sparkRange returns the minimum and maximum finite values, and false if
+// there are none.
+func sparkRange(values []float64) (float64, float64, bool) {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			continue
+		}
+		lo = math.Min(lo, v)
+		hi = math.Max(hi, v)
+	}
+	return lo, hi, lo <= hi
+}
+
+// formatFloat formats v with up to two decimals, dropping trailing zeros.
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
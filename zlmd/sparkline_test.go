@@ -0,0 +1,40 @@
+package zlmd
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSparkline(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected string
+	}{
+		{"Empty", nil, ""},
+		{"Rising", []float64{1, 2, 5, 7}, "▁▂▆█"},
+		{"Flat", []float64{3, 3, 3}, "▁▁▁"},
+		{"Full range", []float64{0, 1, 2, 3, 4, 5, 6, 7}, "▁▂▃▄▅▆▇█"},
+		{"Gaps", []float64{0, math.NaN(), 10}, "▁ █"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Sparkline(tt.values); got != tt.expected {
+				t.Errorf("Sparkline() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSparklineMinMax(t *testing.T) {
+	got := SparklineMinMax([]float64{12, 40, 33, 8})
+	expected := "▂█▆▁ min 8 max 40"
+	if got != expected {
+		t.Errorf("SparklineMinMax() = %q, want %q", got, expected)
+	}
+
+	if got := SparklineMinMax([]float64{0.25, 1.5}); got != "▁█ min 0.25 max 1.5" {
+		t.Errorf("SparklineMinMax() = %q", got)
+	}
+}
@@ -0,0 +1,122 @@
+package zlmd
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// SplitMessage splits markdown into messages that each fit within the
+// maximum message length, breaking between top-level blocks where possible.
+//
+// Parameters:
+//   - markdown (string): The message to split
+//   - opts (...Option): Optional settings; WithMaxLength sets the per-message limit
+//
+// Returns:
+//   - []string: The messages in order, or nil if markdown is blank
+//
+// Example:
+//
+//	parts := SplitMessage(report, WithMaxLength(4000))
+//	for _, part := range parts {
+//		send(part)
+//	}
+//
+// Notes:
+//   - Blocks are found with MarkdownBlocks, so runs of blank lines collapse
+//     into one and trailing whitespace is dropped
+//   - A block longer than a whole message is split by lines; a fenced block
+//     is re-fenced in every part so each message renders on its own
+//   - A single line longer than a whole message is split by runes
+func SplitMessage(markdown string, opts ...Option) []string {
+	o := newOptions(opts...)
+
+	var messages []string
+	current := getBuffer()
+	defer putBuffer(current)
+	size := 0
+	flush := func() {
+		if size > 0 {
+			messages = append(messages, current.String())
+			current.Reset()
+			size = 0
+		}
+	}
+	add := func(part string) {
+		n := utf8.RuneCountInString(part)
+		if size > 0 && size+2+n > o.maxLength {
+			flush()
+		}
+		if size > 0 {
+			current.WriteString("\n\n")
+			size += 2
+		}
+		current.WriteString(part)
+		size += n
+	}
+
+	for _, block := range MarkdownBlocks(markdown) {
+		if utf8.RuneCountInString(block) <= o.maxLength {
+			add(block)
+			continue
+		}
+		for _, part := range splitBlock(block, o.maxLength) {
+			add(part)
+		}
+	}
+	flush()
+	return messages
+}
+
+// splitBlock splits a block longer than limit into parts of at most limit
+// runes, re-fencing each part of a block that starts with a fence.
+func splitBlock(block string, limit int) []string {
+	lines := strings.Split(block, "\n")
+	open, closing := "", ""
+	if fence := openingFence(strings.TrimLeft(lines[0], " ")); fence != "" {
+		open, closing = lines[0], fence
+		lines = lines[1:]
+		if n := len(lines); n > 0 && isClosingFence(strings.TrimLeft(lines[n-1], " "), fence) {
+			lines = lines[:n-1]
+		}
+	}
+
+	budget := limit
+	if open != "" {
+		budget -= utf8.RuneCountInString(open) + utf8.RuneCountInString(closing) + 2
+	}
+	if budget < 1 {
+		budget = 1
+	}
+
+	var parts []string
+	var chunk []string
+	size := 0
+	emit := func() {
+		if len(chunk) == 0 {
+			return
+		}
+		body := strings.Join(chunk, "\n")
+		if open != "" {
+			body = open + "\n" + body + "\n" + closing
+		}
+		parts = append(parts, body)
+		chunk, size = nil, 0
+	}
+
+	for _, line := range lines {
+		for _, piece := range splitGraphemes(line, budget) {
+			n := utf8.RuneCountInString(piece)
+			if len(chunk) > 0 && size+1+n > budget {
+				emit()
+			}
+			if len(chunk) > 0 {
+				size++
+			}
+			chunk = append(chunk, piece)
+			size += n
+		}
+	}
+	emit()
+	return parts
+}
@@ -0,0 +1,48 @@
+package zlmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		max      int
+		expected []string
+	}{
+		{"Blank", "\n\n", 100, nil},
+		{"Fits", "# Title\n\nBody", 100, []string{"# Title\n\nBody"}},
+		{"Between blocks", "aaaa\n\nbbbb\n\ncccc", 10, []string{"aaaa\n\nbbbb", "cccc"}},
+		{"Long paragraph by lines", "one\ntwo\nthree", 8, []string{"one\ntwo", "three"}},
+		{"Long line by runes", "ééééé", 2, []string{"éé", "éé", "é"}},
+		{"Fenced block re-fenced", "```go\na := 1\nb := 2\n```", 18, []string{"```go\na := 1\n```", "```go\nb := 2\n```"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitMessage(tt.markdown, WithMaxLength(tt.max))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("SplitMessage() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSplitMessage_Limit(t *testing.T) {
+	var sb strings.Builder
+	for i := 0; i < 200; i++ {
+		sb.WriteString("paragraph with some words in it\n")
+		if i%7 == 0 {
+			sb.WriteString("\n```\ncode line\n```\n\n")
+		}
+	}
+	for _, part := range SplitMessage(sb.String(), WithMaxLength(300)) {
+		if n := utf8.RuneCountInString(part); n > 300 {
+			t.Fatalf("Part of %d runes exceeds the limit: %q", n, part)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+package zlmd
+
+import (
+	"fmt"
+	"path"
+	"runtime/debug"
+	"strings"
+)
+
+// FormatStack renders a goroutine dump, such as the output of debug.Stack or
+// runtime.Stack, for posting to Zulip.
+//
+// Parameters:
+//   - buf ([]byte): The raw goroutine dump
+//   - opts (...Option): Optional settings; WithTopN limits how many goroutines
+//     are shown in the cleaned block
+//
+// Returns:
+//   - string: A summary line, the cleaned goroutines in a code block and the
+//     full dump in a spoiler, or "" for an empty dump
+//
+// Example:
+//
+//	out := FormatStack(debug.Stack())
+//	// out will be similar to:
+//	// 1 goroutine, top frame `main.run` at `main.go:42`
+//	// ```text
+//	// goroutine 1 [running]:
+//	// main.run()
+//	// 	/src/app/main.go:42 +0x1d
+//	// main.main()
+//	// 	/src/app/main.go:12 +0x25
+//	// ```
+//	// ```spoiler Full stack dump
+//	// ~~~text
+//	// ...
+//	// ~~~
+//	// ```
+//
+// Notes:
+//   - Frames from the runtime and runtime/debug packages and panic frames are removed
+//     from the cleaned block but kept in the full dump
+//   - Goroutines left without frames after filtering are shown with their header only
+func FormatStack(buf []byte, opts ...Option) string {
+	o := newOptions(opts...)
+
+	dump := strings.TrimSpace(strings.ReplaceAll(string(buf), "\r\n", "\n"))
+	if dump == "" {
+		return ""
+	}
+
+	goroutines := parseGoroutines(dump)
+	shown := goroutines
+	if o.topN > 0 && len(shown) > o.topN {
+		shown = shown[:o.topN]
+	}
+
+	blocks := make([]string, len(shown))
+	for i, g := range shown {
+		blocks[i] = g.String()
+	}
+	if hidden := len(goroutines) - len(shown); hidden > 0 {
+		blocks = append(blocks, fmt.Sprintf("… %s", pluralize(hidden, "more goroutine", "more goroutines")))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(stackSummary(goroutines))
+	sb.WriteString("\n")
+	WriteCodeBlock(&sb, "text", strings.Join(blocks, "\n\n"))
+	sb.WriteString("\n")
+	writeSpoiler(&sb, "Full stack dump", CodeBlock("text", dump), o.target())
+	return sb.String()
+}
+
+// RecoverToMarkdown renders a recovered panic value together with the stack
+// of the panicking goroutine.
+//
+// Parameters:
+//   - recovered (any): The value returned by recover()
+//   - opts (...Option): Optional settings passed to FormatStack; WithTheme selects the prefix
+//
+// Returns:
+//   - string: The panic report, or "" if recovered is nil
+//
+// Example:
+//
+//	defer func() {
+//	  if r := recover(); r != nil {
+//	    report := RecoverToMarkdown(r)
+//	    // report starts with "❌ **panic**: `index out of range`"
+//	    // and names the function that panicked as the top frame
+//	  }
+//	}()
+//
+// Notes:
+//   - Must be called from the deferred function so the stack still contains the panic site
+func RecoverToMarkdown(recovered any, opts ...Option) string {
+	if recovered == nil {
+		return ""
+	}
+	o := newOptions(opts...)
+
+	var sb strings.Builder
+	sb.WriteString(o.theme.Prefix("danger"))
+	sb.WriteString(" ")
+	WriteBold(&sb, "panic")
+	sb.WriteString(": ")
+	WriteCode(&sb, fmt.Sprint(recovered))
+	sb.WriteString("\n\n")
+	sb.WriteString(FormatStack(debug.Stack(), opts...))
+	return sb.String()
+}
+
+// goroutine is a parsed goroutine from a stack dump.
+type goroutine struct {
+	header string
+	frames []stackFrame
+	// panicAt is the index of the first frame below a panic call, so that
+	// deferred recovery handlers are not reported as the failing frame.
+	panicAt int
+}
+
+// stackFrame is a function line and its file:line location.
+type stackFrame struct {
+	function string
+	location string
+}
+
+// String renders the goroutine in the standard dump format.
+func (g goroutine) String() string {
+	lines := []string{g.header}
+	for _, f := range g.frames {
+		lines = append(lines, f.function)
+		if f.location != "" {
+			lines = append(lines, "\t"+f.location)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// parseGoroutines splits a dump into goroutines, dropping runtime frames.
+func parseGoroutines(dump string) []goroutine {
+	var goroutines []goroutine
+	for _, block := range strings.Split(dump, "\n\n") {
+		lines := strings.Split(strings.TrimSpace(block), "\n")
+		if len(lines) == 0 || lines[0] == "" {
+			continue
+		}
+
+		g := goroutine{header: strings.TrimSuffix(lines[0], ":") + ":"}
+		for i := 1; i < len(lines); i++ {
+			f := stackFrame{function: lines[i]}
+			if i+1 < len(lines) && strings.HasPrefix(lines[i+1], "\t") {
+				f.location = strings.TrimSpace(lines[i+1])
+				i++
+			}
+			if strings.HasPrefix(f.function, "panic(") {
+				g.panicAt = len(g.frames)
+			}
+			if !isInternalFrame(f.function) {
+				g.frames = append(g.frames, f)
+			}
+		}
+		goroutines = append(goroutines, g)
+	}
+	return goroutines
+}
+
+// isInternalFrame reports whether a function line belongs to the runtime,
+// the panic machinery or zlmd's own stack capture.
+func isInternalFrame(function string) bool {
+	return strings.HasPrefix(function, "runtime.") ||
+		strings.HasPrefix(function, "runtime/debug.") ||
+		strings.HasPrefix(function, "panic(") ||
+		strings.Contains(function, "/zlmd.RecoverToMarkdown(") ||
+		strings.Contains(function, "/zlmd.FormatStack(")
+}
+
+// stackSummary describes the dump in a single line.
+func stackSummary(goroutines []goroutine) string {
+	summary := pluralize(len(goroutines), "goroutine", "goroutines")
+	if len(goroutines) == 0 || goroutines[0].panicAt >= len(goroutines[0].frames) {
+		return summary
+	}
+
+	top := goroutines[0].frames[goroutines[0].panicAt]
+	function := top.function
+	if i := strings.LastIndex(function, "("); i > 0 {
+		function = function[:i]
+	}
+	function = path.Base(function)
+
+	location := top.location
+	if i := strings.LastIndex(location, " +0x"); i > 0 {
+		location = location[:i]
+	}
+	location = path.Base(location)
+
+	return fmt.Sprintf("%s, top frame %s at %s", summary, Code(function), Code(location))
+}
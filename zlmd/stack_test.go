@@ -0,0 +1,78 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleDump = `goroutine 1 [running]:
+runtime/debug.Stack()
+	/usr/local/go/src/runtime/debug/stack.go:26 +0x5e
+main.run()
+	/src/app/main.go:42 +0x1d
+main.main()
+	/src/app/main.go:12 +0x25
+
+goroutine 7 [chan receive]:
+main.worker(0xc000012345)
+	/src/app/worker.go:8 +0x3a
+created by main.main in goroutine 1
+	/src/app/main.go:10 +0x66
+
+goroutine 9 [select]:
+runtime.gopark(0x0?)
+	/usr/local/go/src/runtime/proc.go:402 +0xce
+`
+
+func TestFormatStack(t *testing.T) {
+	got := FormatStack([]byte(sampleDump))
+
+	if !strings.HasPrefix(got, "3 goroutines, top frame `main.run` at `main.go:42`\n") {
+		t.Errorf("Unexpected summary line: %q", got)
+	}
+
+	cleaned := "```text\ngoroutine 1 [running]:\nmain.run()\n\t/src/app/main.go:42 +0x1d\nmain.main()\n\t/src/app/main.go:12 +0x25\n\n" +
+		"goroutine 7 [chan receive]:\nmain.worker(0xc000012345)\n\t/src/app/worker.go:8 +0x3a\ncreated by main.main in goroutine 1\n\t/src/app/main.go:10 +0x66\n\n" +
+		"goroutine 9 [select]:\n```"
+	if !strings.Contains(got, cleaned) {
+		t.Errorf("Cleaned block missing or incorrect:\n%s", got)
+	}
+
+	if !strings.Contains(got, "```spoiler Full stack dump\n~~~text\ngoroutine 1 [running]:\nruntime/debug.Stack()") {
+		t.Errorf("Full dump spoiler missing or incorrect:\n%s", got)
+	}
+}
+
+func TestFormatStack_TopN(t *testing.T) {
+	got := FormatStack([]byte(sampleDump), WithTopN(1))
+	if strings.Contains(got, "```text\ngoroutine 1 [running]:\nmain.run()\n\t/src/app/main.go:42 +0x1d\nmain.main()\n\t/src/app/main.go:12 +0x25\n\n… 2 more goroutines\n```") == false {
+		t.Errorf("Expected remaining goroutines to be summarized:\n%s", got)
+	}
+}
+
+func TestFormatStack_Empty(t *testing.T) {
+	if got := FormatStack(nil); got != "" {
+		t.Errorf("FormatStack(nil) = %q, want empty string", got)
+	}
+}
+
+func TestRecoverToMarkdown(t *testing.T) {
+	if got := RecoverToMarkdown(nil); got != "" {
+		t.Errorf("RecoverToMarkdown(nil) = %q, want empty string", got)
+	}
+
+	var report string
+	func() {
+		defer func() {
+			report = RecoverToMarkdown(recover(), WithTheme(PlainTheme))
+		}()
+		panic("boom")
+	}()
+
+	if !strings.HasPrefix(report, "[ERROR] **panic**: `boom`\n\n") {
+		t.Errorf("Unexpected report header: %q", report)
+	}
+	if !strings.Contains(report, "top frame `zlmd.TestRecoverToMarkdown.func1`") {
+		t.Errorf("Expected the panicking function to be the top frame: %q", report)
+	}
+}
@@ -0,0 +1,104 @@
+package zlmd
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Reading speeds used by Stats to estimate the read time.
+const (
+	readWordsPerMinute = 200
+	readCodeLineTime   = time.Second
+)
+
+// MessageStats describes the size and content of a message, as returned by
+// Stats.
+type MessageStats struct {
+	// Length is the number of characters of the markdown, as Zulip counts
+	// them against MaxMessageLength.
+	Length int `json:"length"`
+	// Characters is the number of characters of the rendered text.
+	Characters int `json:"characters"`
+	// Words is the number of words of prose, including table cells and the
+	// text of spoilers and quotes, but not code.
+	Words int `json:"words"`
+	// CodeLines is the number of lines in code blocks.
+	CodeLines int `json:"code_lines"`
+	// TableCells is the number of cells in tables, headers included.
+	TableCells int `json:"table_cells"`
+	// Mentions is the number of user, group and wildcard mentions.
+	Mentions int `json:"mentions"`
+	// ReadTime is the estimated time to read the message.
+	ReadTime time.Duration `json:"read_time"`
+}
+
+// Stats measures markdown, so a bot can decide before sending whether to
+// post it as is, fold it into a spoiler or split it.
+//
+// Parameters:
+//   - markdown (string): The message
+//
+// Returns:
+//   - MessageStats: The measurements
+//
+// Example:
+//
+//	s := Stats(report)
+//	switch {
+//	case s.Length > MaxMessageLength:
+//		messages = SplitMessage(report)
+//	case s.ReadTime > time.Minute:
+//		messages = []string{Spoiler("Details", report)}
+//	}
+//
+// Notes:
+//   - The read time assumes 200 words per minute for prose and a second
+//     per line of code, rounded to the second
+//   - Spoilers and quotes written with fences count as prose; everything
+//     inside them, including nested code and tables, is counted
+func Stats(markdown string) MessageStats {
+	s := contentStats(markdown)
+	s.Length = utf8.RuneCountInString(markdown)
+	s.Characters = utf8.RuneCountInString(StripMarkdown(markdown))
+	s.ReadTime = (time.Duration(s.Words)*time.Minute/readWordsPerMinute +
+		time.Duration(s.CodeLines)*readCodeLineTime).Round(time.Second)
+	return s
+}
+
+// contentStats counts the words, code lines, table cells and mentions of
+// markdown, descending into spoilers and quotes.
+func contentStats(markdown string) MessageStats {
+	var s MessageStats
+	s.Words = len(strings.Fields(StripMarkdown(strings.Join(proseLines(markdown), "\n"))))
+	s.CodeLines = codeLines(markdown)
+	for _, table := range ExtractTables(markdown) {
+		s.TableCells += len(table.Headers)
+		for _, row := range table.Rows {
+			s.TableCells += len(row)
+		}
+	}
+	s.Mentions = len(ExtractMentions(markdown))
+	return s
+}
+
+// codeLines counts the lines in the code blocks of markdown, descending into
+// spoilers and quotes.
+func codeLines(markdown string) int {
+	n := 0
+	for _, block := range ExtractCode(markdown) {
+		if isProseBlock(block.Language) {
+			n += codeLines(block.Code)
+		} else if block.Code != "" {
+			n += strings.Count(block.Code, "\n") + 1
+		}
+	}
+	return n
+}
+
+// isProseBlock reports whether a fenced block with the given info string
+// holds markdown rather than code.
+func isProseBlock(language string) bool {
+	name, _, _ := strings.Cut(language, " ")
+	return name == "spoiler" || name == "quote"
+}
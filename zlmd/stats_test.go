@@ -0,0 +1,58 @@
+package zlmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStats(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected MessageStats
+	}{
+		{
+			name:     "Empty",
+			markdown: "",
+			expected: MessageStats{},
+		},
+		{
+			name:     "Prose",
+			markdown: "**Deploy** finished for @**Alice|12**",
+			expected: MessageStats{Length: 37, Characters: 26, Words: 4, Mentions: 1, ReadTime: time.Second},
+		},
+		{
+			name:     "Code",
+			markdown: "Run:\n```sh\nmake\nmake test\n```",
+			expected: MessageStats{Length: 29, Characters: 19, Words: 1, CodeLines: 2, ReadTime: 2 * time.Second},
+		},
+		{
+			name:     "Table",
+			markdown: "| a | b |\n| --- | --- |\n| 1 | 2 |",
+			expected: MessageStats{Length: 33, Characters: 11, Words: 6, TableCells: 4, ReadTime: 2 * time.Second},
+		},
+		{
+			name:     "Spoiler",
+			markdown: "```spoiler Logs\nping @*oncall*\n~~~\nx\n~~~\n```",
+			expected: MessageStats{Length: 44, Characters: 29, Words: 2, CodeLines: 1, Mentions: 1, ReadTime: 2 * time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Stats(tt.markdown); got != tt.expected {
+				t.Errorf("Stats(%q) = %+v, want %+v", tt.markdown, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStats_ReadTime(t *testing.T) {
+	markdown := ""
+	for range 400 {
+		markdown += "word "
+	}
+	if got := Stats(markdown).ReadTime; got != 2*time.Minute {
+		t.Errorf("ReadTime = %v, want %v", got, 2*time.Minute)
+	}
+}
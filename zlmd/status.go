@@ -0,0 +1,127 @@
+package zlmd
+
+import (
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// StatusMessage holds a message template and its state for bots that keep
+// editing a single Zulip message while a long job runs.
+//
+// Each tick the caller updates the state and calls Update, which renders the
+// full markdown and reports whether it differs from the previously rendered
+// content, so unchanged messages don't need to be re-sent.
+type StatusMessage struct {
+	mu    sync.Mutex
+	tmpl  *template.Template
+	state map[string]any
+	last  string
+	sent  bool
+}
+
+// NewStatusMessage parses a text/template and returns a StatusMessage with
+// empty state.
+//
+// Parameters:
+//   - tmpl (string): A text/template source; the state is available as the dot value
+//
+// Returns:
+//   - *StatusMessage: A new status message
+//   - error: An error if the template cannot be parsed
+//
+// Example:
+//
+//	status, err := NewStatusMessage("{{bold .Job}}\n{{progress .Done .Total 10}}")
+//	status.Set("Job", "Backup").Set("Done", 3).Set("Total", 10)
+//	msg, changed, err := status.Update()
+//	// msg will be "**Backup**\n▓▓▓░░░░░░░ 30%", changed will be true
+//
+// Notes:
+//   - Templates can use the helpers from FuncMap, such as bold, progress and time
+//   - Missing state keys render as "<no value>" like any text/template
+func NewStatusMessage(tmpl string) (*StatusMessage, error) {
+	t, err := template.New("status").Funcs(FuncMap()).Parse(tmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &StatusMessage{
+		tmpl:  t,
+		state: map[string]any{},
+	}, nil
+}
+
+// Set stores a state value under key.
+//
+// Returns:
+//   - *StatusMessage: The same StatusMessage instance (for method chaining)
+func (s *StatusMessage) Set(key string, value any) *StatusMessage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.state[key] = value
+	return s
+}
+
+// Render renders the template with the current state without recording it.
+//
+// Returns:
+//   - string: The rendered markdown
+//   - error: An error if the template fails to execute
+func (s *StatusMessage) Render() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.render()
+}
+
+// Update renders the template with the current state and reports whether the
+// result differs from the last content returned by Update.
+//
+// Returns:
+//   - string: The rendered markdown
+//   - bool: True if the content changed (always true on the first call)
+//   - error: An error if the template fails to execute
+//
+// Example:
+//
+//	msg, changed, err := status.Update()
+//	if err == nil && changed {
+//	  client.EditMessage(id, msg)
+//	}
+func (s *StatusMessage) Update() (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	content, err := s.render()
+	if err != nil {
+		return "", false, err
+	}
+
+	changed := !s.sent || content != s.last
+	s.last = content
+	s.sent = true
+	return content, changed, nil
+}
+
+// Last returns the content recorded by the most recent Update call.
+func (s *StatusMessage) Last() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.last
+}
+
+// render executes the template; the caller must hold s.mu.
+func (s *StatusMessage) render() (string, error) {
+	var sb strings.Builder
+	if err := s.tmpl.Execute(&sb, s.state); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Render.
+func (s *StatusMessage) MarshalZulipMarkdown() (string, error) {
+	return s.Render()
+}
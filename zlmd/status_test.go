@@ -0,0 +1,54 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestStatusMessage_Update(t *testing.T) {
+	status, err := NewStatusMessage("{{bold .Job}}\n{{progress .Done .Total 10}}")
+	if err != nil {
+		t.Fatalf("NewStatusMessage() returned error: %v", err)
+	}
+
+	status.Set("Job", "Backup").Set("Done", 3).Set("Total", 10)
+
+	msg, changed, err := status.Update()
+	if err != nil {
+		t.Fatalf("Update() returned error: %v", err)
+	}
+	expected := "**Backup**\n▓▓▓░░░░░░░ 30%"
+	if msg != expected {
+		t.Errorf("Update() = %q, want %q", msg, expected)
+	}
+	if !changed {
+		t.Error("Expected first Update() to report a change")
+	}
+
+	_, changed, _ = status.Update()
+	if changed {
+		t.Error("Expected Update() without state changes to report no change")
+	}
+
+	status.Set("Done", 4)
+	msg, changed, _ = status.Update()
+	if !changed {
+		t.Error("Expected Update() after state change to report a change")
+	}
+	if status.Last() != msg {
+		t.Errorf("Last() = %q, want %q", status.Last(), msg)
+	}
+}
+
+func TestStatusMessage_Errors(t *testing.T) {
+	if _, err := NewStatusMessage("{{bold"); err == nil {
+		t.Error("Expected parse error for malformed template")
+	}
+
+	status, err := NewStatusMessage("{{progress .Done}}")
+	if err != nil {
+		t.Fatalf("NewStatusMessage() returned error: %v", err)
+	}
+	if _, _, err := status.Update(); err == nil {
+		t.Error("Expected execution error for wrong argument count")
+	}
+}
@@ -0,0 +1,72 @@
+package zlmd
+
+import (
+	"regexp"
+	"strings"
+)
+
+// unlinkablePattern matches the characters Zulip's #**stream>topic** syntax
+// can't contain; names with them are linked with a narrow link instead, as
+// the Zulip web app does.
+var unlinkablePattern = regexp.MustCompile("[`>*&\\[\\]]|\\$\\$")
+
+// StreamLink creates a link to a stream, which Zulip renders with the
+// stream's name and icon.
+//
+// Parameters:
+//   - streamName (string): The stream name
+//
+// Returns:
+//   - string: The link
+//
+// Example:
+//
+//	StreamLink("build alerts") // "#**build alerts**"
+//	StreamLink("ops > prod")   // "[#ops \> prod](#narrow/stream/ops.20.3E.20prod)"
+//
+// Notes:
+//   - A name containing characters the #**...** syntax can't hold, such as
+//     ">" (which separates the topic) or "*", becomes a markdown link to the
+//     stream's narrow, like the links the Zulip web app inserts for them
+//   - Line breaks in streamName are replaced by spaces
+func StreamLink(streamName string) string {
+	name := linkName(streamName)
+	if unlinkablePattern.MatchString(name) {
+		return Link("#"+Escape(name, EscapeAll), Narrow{Stream: name}.String())
+	}
+	return "#**" + name + "**"
+}
+
+// TopicLink creates a link to a topic, which Zulip renders with the stream
+// and topic names.
+//
+// Parameters:
+//   - stream (string): The stream name
+//   - topic (string): The topic name
+//
+// Returns:
+//   - string: The link
+//
+// Example:
+//
+//	TopicLink("build alerts", "deploy failed") // "#**build alerts>deploy failed**"
+//	TopicLink("ops", "2 > 1")                  // "[#ops > 2 \> 1](#narrow/stream/ops/topic/2.20.3E.201)"
+//
+// Notes:
+//   - When either name contains characters the #**...** syntax can't hold,
+//     such as ">" or "*", the result is a markdown link to the topic's
+//     narrow, like the links the Zulip web app inserts for them
+//   - Line breaks are replaced by spaces
+func TopicLink(stream, topic string) string {
+	streamName, topicName := linkName(stream), linkName(topic)
+	if unlinkablePattern.MatchString(streamName) || unlinkablePattern.MatchString(topicName) {
+		text := "#" + Escape(streamName, EscapeAll) + " > " + Escape(topicName, EscapeAll)
+		return Link(text, Narrow{Stream: streamName, Topic: topicName}.String())
+	}
+	return "#**" + streamName + ">" + topicName + "**"
+}
+
+// linkName returns a stream or topic name on one line.
+func linkName(name string) string {
+	return strings.TrimSpace(strings.NewReplacer("\r", " ", "\n", " ").Replace(name))
+}
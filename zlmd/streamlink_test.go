@@ -0,0 +1,89 @@
+package zlmd
+
+import "testing"
+
+func TestStreamLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   string
+		expected string
+	}{
+		{"Simple", "general", "#**general**"},
+		{"Spaces", "build alerts", "#**build alerts**"},
+		{"Unicode", "équipe 日本", "#**équipe 日本**"},
+		{"Greater than", "ops > prod", "[#ops \\> prod](#narrow/stream/ops.20.3E.20prod)"},
+		{"Asterisks", "a**b", "[#a\\*\\*b](#narrow/stream/a**b)"},
+		{"Brackets", "[x]", "[#\\[x\\]](#narrow/stream/.5Bx.5D)"},
+		{"Line break", "a\nb", "#**a b**"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := StreamLink(tt.stream); got != tt.expected {
+				t.Errorf("StreamLink(%q) = %q, want %q", tt.stream, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStreamLink_Narrow(t *testing.T) {
+	for _, stream := range []string{"ops > prod", "2024-q1 > prod", "42*"} {
+		t.Run(stream, func(t *testing.T) {
+			refs := ExtractLinks(StreamLink(stream))
+			if len(refs) != 1 {
+				t.Fatalf("ExtractLinks() = %+v, want one link", refs)
+			}
+			n, err := ParseNarrowURL(refs[0].URL)
+			if err != nil || n.StreamID != 0 || n.Stream != stream {
+				t.Errorf("ParseNarrowURL(%q) = %+v, %v; want stream %q", refs[0].URL, n, err, stream)
+			}
+		})
+	}
+}
+
+func TestTopicLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		stream   string
+		topic    string
+		expected string
+	}{
+		{"Simple", "build alerts", "deploy failed", "#**build alerts>deploy failed**"},
+		{"Punctuation", "ops", "v1.2: rollout (eu)", "#**ops>v1.2: rollout (eu)**"},
+		{"Greater than in topic", "ops", "2 > 1", "[#ops > 2 \\> 1](#narrow/stream/ops/topic/2.20.3E.201)"},
+		{"Asterisk in topic", "ops", "*urgent*", "[#ops > \\*urgent\\*](#narrow/stream/ops/topic/*urgent*)"},
+		{"Special stream", "a&b", "x", "[#a&b > x](#narrow/stream/a.26b/topic/x)"},
+		{"Line break", "ops", "a\nb", "#**ops>a b**"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TopicLink(tt.stream, tt.topic); got != tt.expected {
+				t.Errorf("TopicLink(%q, %q) = %q, want %q", tt.stream, tt.topic, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTopicLink_Narrow(t *testing.T) {
+	tests := []struct {
+		stream string
+		topic  string
+	}{
+		{"ops", "2 > 1"},
+		{"2024-releases", "v2 > v1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.stream, func(t *testing.T) {
+			refs := ExtractLinks(TopicLink(tt.stream, tt.topic))
+			if len(refs) != 1 {
+				t.Fatalf("ExtractLinks() = %+v, want one link", refs)
+			}
+			n, err := ParseNarrowURL(refs[0].URL)
+			if err != nil || n.StreamID != 0 || n.Stream != tt.stream || n.Topic != tt.topic {
+				t.Errorf("ParseNarrowURL(%q) = %+v, %v; want %s > %s", refs[0].URL, n, err, tt.stream, tt.topic)
+			}
+		})
+	}
+}
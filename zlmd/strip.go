@@ -0,0 +1,164 @@
+package zlmd
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	stripHeadingPattern   = regexp.MustCompile(`^ {0,3}#{1,6}(\s+|$)`)
+	stripQuotePattern     = regexp.MustCompile(`^ {0,3}>\s?`)
+	stripBulletPattern    = regexp.MustCompile(`^(\s*)[*+]\s+`)
+	stripRulePattern      = regexp.MustCompile(`^ {0,3}([-*_])(\s*[-*_]){2,}\s*$`)
+	stripImagePattern     = regexp.MustCompile(`!\[([^\]\n]*)\]\(([^()\s]+)\)`)
+	stripUserPattern      = regexp.MustCompile(`@_?\*\*([^*|\n]+)(?:\|\d+)?\*\*`)
+	stripGroupPattern     = regexp.MustCompile(`@_?\*([^*\n]+)\*`)
+	stripTopicPattern     = regexp.MustCompile(`#\*\*([^*>\n]+)>([^*\n]+)\*\*`)
+	stripStreamPattern    = regexp.MustCompile(`#\*\*([^*\n]+)\*\*`)
+	stripTimePattern      = regexp.MustCompile(`<time:([^>\n]+)>`)
+	stripBoldPattern      = regexp.MustCompile(`\*\*([^*\n]+)\*\*|__([^_\n]+)__`)
+	stripStrikePattern    = regexp.MustCompile(`~~([^~\n]+)~~`)
+	stripItalicPattern    = regexp.MustCompile(`\*([^*\s][^*\n]*)\*`)
+	stripUnderlinePattern = regexp.MustCompile(`(^|[^\p{L}\p{N}])_([^_\s][^_\n]*)_($|[^\p{L}\p{N}])`)
+	stripMathPattern      = regexp.MustCompile(`\$\$([^$\n]+)\$\$`)
+)
+
+// StripMarkdown renders markdown as plain text, for notification fallbacks
+// and search indexes.
+//
+// Parameters:
+//   - markdown (string): Zulip-flavored markdown
+//
+// Returns:
+//   - string: The text with formatting removed
+//
+// Example:
+//
+//	StripMarkdown("## Deploy\n**api** is up, see [logs](https://ci.example.com/1) @**Alice|12**")
+//	// "Deploy\napi is up, see logs (https://ci.example.com/1) @Alice"
+//
+// Notes:
+//   - Links become "text (url)", mentions become "@Name" and stream links
+//     become "#stream" or "#stream > topic"
+//   - Fenced blocks keep their content without the fences; spoilers keep
+//     their heading on its own line
+//   - Table rows become cells separated by " | " and the delimiter row is dropped
+//   - Backslash escapes are resolved, so "\*" becomes "*"
+func StripMarkdown(markdown string) string {
+	var out []string
+	var fence string
+	for _, line := range splitLines(markdown) {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if isClosingFence(trimmed, fence) {
+				fence = ""
+				continue
+			}
+			out = append(out, line)
+			continue
+		}
+		if f := openingFence(trimmed); f != "" {
+			fence = f
+			if heading, ok := strings.CutPrefix(strings.TrimSpace(trimmed[len(f):]), "spoiler"); ok && strings.TrimSpace(heading) != "" {
+				out = append(out, stripInline(strings.TrimSpace(heading)))
+			}
+			continue
+		}
+
+		switch {
+		case stripRulePattern.MatchString(line) || isTableDelimiter(line):
+			continue
+		case strings.HasPrefix(trimmed, "|"):
+			out = append(out, strings.Join(stripCells(splitTableRow(line)), " | "))
+			continue
+		}
+
+		line = stripHeadingPattern.ReplaceAllString(line, "")
+		for stripQuotePattern.MatchString(line) {
+			line = stripQuotePattern.ReplaceAllString(line, "")
+		}
+		line = stripBulletPattern.ReplaceAllString(line, "$1- ")
+		out = append(out, stripInline(line))
+	}
+	return strings.Join(out, "\n")
+}
+
+// stripCells strips inline formatting from each table cell.
+func stripCells(cells []string) []string {
+	for i, cell := range cells {
+		cells[i] = stripInline(cell)
+	}
+	return cells
+}
+
+// stripInline removes inline formatting from a single line. Code spans are
+// kept verbatim without their backticks.
+func stripInline(line string) string {
+	var sb strings.Builder
+	last := 0
+	for _, loc := range inlineCodePattern.FindAllStringIndex(line, -1) {
+		sb.WriteString(stripSpan(line[last:loc[0]]))
+		sb.WriteString(strings.TrimSpace(strings.Trim(line[loc[0]:loc[1]], "`")))
+		last = loc[1]
+	}
+	sb.WriteString(stripSpan(line[last:]))
+	return sb.String()
+}
+
+// stripSpan removes inline formatting from text containing no code spans.
+func stripSpan(text string) string {
+	// Hide escaped characters from the patterns by mapping them into the
+	// private use area, then restore them once formatting is gone.
+	text = mapEscapes(text, func(c byte) rune { return 0xE000 + rune(c) })
+
+	text = stripImagePattern.ReplaceAllString(text, "$1 ($2)")
+	text = markdownLinkPattern.ReplaceAllStringFunc(text, func(m string) string {
+		sub := markdownLinkPattern.FindStringSubmatch(m)
+		if sub[1] == "" || sub[1] == sub[2] {
+			return sub[2]
+		}
+		return sub[1] + " (" + sub[2] + ")"
+	})
+	text = angleLinkPattern.ReplaceAllString(text, "$1")
+	text = stripTimePattern.ReplaceAllString(text, "$1")
+	text = stripUserPattern.ReplaceAllString(text, "@$1")
+	text = stripGroupPattern.ReplaceAllString(text, "@$1")
+	text = stripTopicPattern.ReplaceAllString(text, "#$1 > $2")
+	text = stripStreamPattern.ReplaceAllString(text, "#$1")
+	text = stripBoldPattern.ReplaceAllString(text, "$1$2")
+	text = stripStrikePattern.ReplaceAllString(text, "$1")
+	text = stripItalicPattern.ReplaceAllString(text, "$1")
+	text = stripUnderlinePattern.ReplaceAllString(text, "$1$2$3")
+	text = stripMathPattern.ReplaceAllString(text, "$1")
+
+	return strings.Map(func(r rune) rune {
+		if r >= 0xE000 && r < 0xE080 {
+			return r - 0xE000
+		}
+		return r
+	}, text)
+}
+
+// mapEscapes replaces each backslash-escaped ASCII punctuation character in
+// text with the rune returned by replace.
+func mapEscapes(text string, replace func(c byte) rune) string {
+	if !strings.Contains(text, `\`) {
+		return text
+	}
+	var sb strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\\' && i+1 < len(text) && isASCIIPunct(text[i+1]) {
+			sb.WriteRune(replace(text[i+1]))
+			i++
+			continue
+		}
+		sb.WriteByte(text[i])
+	}
+	return sb.String()
+}
+
+// isASCIIPunct reports whether c is ASCII punctuation, the set of characters
+// markdown allows to be backslash-escaped.
+func isASCIIPunct(c byte) bool {
+	return (c >= '!' && c <= '/') || (c >= ':' && c <= '@') || (c >= '[' && c <= '`') || (c >= '{' && c <= '~')
+}
@@ -0,0 +1,37 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func TestStripMarkdown(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		expected string
+	}{
+		{"Plain", "hello world", "hello world"},
+		{"Heading and emphasis", "## Deploy\n**api** is *up* and __fast__, ~~not~~ _slow_", "Deploy\napi is up and fast, not slow"},
+		{"Links", "See [logs](https://ci.example.com/1), <https://a.example> and [https://b.example](https://b.example)", "See logs (https://ci.example.com/1), https://a.example and https://b.example"},
+		{"Image", "![graph](https://img.example/g.png)", "graph (https://img.example/g.png)"},
+		{"Mentions", "cc @**Alice|12**, @_**Bob** and @*oncall*", "cc @Alice, @Bob and @oncall"},
+		{"Stream links", "in #**ops>deploys** and #**general**", "in #ops > deploys and #general"},
+		{"Time", "at <time:2024-01-02T03:04:05Z>", "at 2024-01-02T03:04:05Z"},
+		{"Code span kept", "run `make **all**` now", "run make **all** now"},
+		{"Escapes", `a \*literal\* star and snake\_case`, "a *literal* star and snake_case"},
+		{"Snake case untouched", "my_var_name", "my_var_name"},
+		{"Fenced block", "Output:\n```text\n**raw**\n```", "Output:\n**raw**"},
+		{"Spoiler", "```spoiler Details\nhidden *text*\n```", "Details\nhidden *text*"},
+		{"Quote and lists", "> quoted **bold**\n* one\n  + two\n---", "quoted bold\n- one\n  - two"},
+		{"Table", "| **Name** | Age |\n| :--- | ---: |\n| Ann \\| B | 3 |", "Name | Age\nAnn | B | 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := StripMarkdown(tt.markdown)
+			if got != tt.expected {
+				t.Errorf("StripMarkdown() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -1,7 +1,11 @@
 package zlmd
 
 import (
-	"strings"
+	"bytes"
+	"io"
+	"maps"
+	"slices"
+	"unicode/utf8"
 )
 
 // Alignment represents the text alignment in a table column.
@@ -24,10 +28,16 @@ type TableBuilder struct {
 	rows          [][]string
 	alignments    []Alignment
 	headerBuilder func(string) string
+	bidi          BidiPolicy
+	escape        EscapePolicy
 }
 
 // NewTableBuilder creates a new markdown table builder.
 //
+// Parameters:
+//   - opts (...Option): Optional settings; the Escape policy of WithConfig,
+//     or of the default Config, is applied to the cells of added rows
+//
 // Returns:
 //   - *TableBuilder: A new initialized TableBuilder instance with empty headers, rows, and default alignment
 //
@@ -35,7 +45,8 @@ type TableBuilder struct {
 //
 //	table := NewTableBuilder()
 //	// Creates an empty table builder
-func NewTableBuilder() *TableBuilder {
+func NewTableBuilder(opts ...Option) *TableBuilder {
+	o := newOptions(opts...)
 	return &TableBuilder{
 		headers:    []string{},
 		rows:       [][]string{},
@@ -43,6 +54,7 @@ func NewTableBuilder() *TableBuilder {
 		headerBuilder: func(s string) string {
 			return s
 		},
+		escape: o.escape,
 	}
 }
 
@@ -100,6 +112,21 @@ func (t *TableBuilder) WithBoldHeaders() *TableBuilder {
 	})
 }
 
+// WithBidiPolicy isolates header and cell text according to policy, so
+// right-to-left values don't reorder the pipes around them.
+//
+// Returns:
+//   - *TableBuilder: The same TableBuilder instance (for method chaining)
+//
+// Example:
+//
+//	table.WithBidiPolicy(BidiIsolateRTL)
+//	// Cells holding Hebrew or Arabic text are wrapped in isolate marks
+func (t *TableBuilder) WithBidiPolicy(policy BidiPolicy) *TableBuilder {
+	t.bidi = policy
+	return t
+}
+
 // AddRow adds a row to the table.
 //
 // Parameters:
@@ -115,7 +142,9 @@ func (t *TableBuilder) WithBoldHeaders() *TableBuilder {
 func (t *TableBuilder) AddRow(cells ...string) *TableBuilder {
 	// Make a copy of the cells to avoid external modification
 	row := make([]string, len(cells))
-	copy(row, cells)
+	for i, cell := range cells {
+		row[i] = Escape(cell, t.escape)
+	}
 	t.rows = append(t.rows, row)
 	return t
 }
@@ -143,6 +172,48 @@ func (t *TableBuilder) AddRows(rows [][]string) *TableBuilder {
 	return t
 }
 
+// FromMaps adds one row per map, taking each cell from the key named by its
+// column header. If the table has no headers yet, the keys of all maps
+// become the headers, sorted, so the output doesn't depend on Go's random
+// map iteration order.
+//
+// Parameters:
+//   - rows ([]map[string]string): The rows, in the order they are rendered
+//
+// Returns:
+//   - *TableBuilder: The same TableBuilder instance (for method chaining)
+//
+// Example:
+//
+//	table.FromMaps([]map[string]string{
+//	  {"service": "api", "status": "up"},
+//	  {"service": "db", "status": "down"},
+//	})
+//	// | service | status |
+//
+// Notes:
+//   - Keys that match no header are ignored, and missing keys give empty cells
+func (t *TableBuilder) FromMaps(rows []map[string]string) *TableBuilder {
+	if len(t.headers) == 0 {
+		keys := map[string]bool{}
+		for _, row := range rows {
+			for k := range row {
+				keys[k] = true
+			}
+		}
+		t.WithHeaders(slices.Sorted(maps.Keys(keys))...)
+	}
+
+	for _, row := range rows {
+		cells := make([]string, len(t.headers))
+		for i, h := range t.headers {
+			cells[i] = row[h]
+		}
+		t.AddRow(cells...)
+	}
+	return t
+}
+
 // SetAlignment sets the alignment for a specific column.
 //
 // Parameters:
@@ -198,57 +269,166 @@ func (t *TableBuilder) Build() string {
 		return ""
 	}
 
-	var sb strings.Builder
+	buf := getBuffer()
+	defer putBuffer(buf)
+	t.writeTable(buf)
+	return buf.String()
+}
+
+// WriteTo writes the table to w as Build renders it, a chunk at a time, so
+// a very large table is never held in memory as a whole string.
+//
+// Parameters:
+//   - w (io.Writer): The destination, such as a MessageWriter or a file
+//
+// Returns:
+//   - int64: The number of bytes written
+//   - error: The first error returned by w
+//
+// Example:
+//
+//	w := NewMessageWriter(WithFlushFunc(send))
+//	table.WriteTo(w)
+//	w.Close()
+func (t *TableBuilder) WriteTo(w io.Writer) (int64, error) {
+	if len(t.headers) == 0 {
+		return 0, nil
+	}
+
+	cw := &chunkWriter{w: w, buf: getBuffer()}
+	defer putBuffer(cw.buf)
+	t.writeTable(cw)
+	cw.flush()
+	return cw.n, cw.err
+}
+
+// RowCount returns the number of data rows added to the table.
+func (t *TableBuilder) RowCount() int {
+	return len(t.rows)
+}
+
+// Size returns the number of characters Build would return, without
+// rendering the table, so callers can check it against a message limit
+// first.
+//
+// Example:
+//
+//	if table.Size() > MaxMessageLength {
+//	  // send the table as a file upload instead
+//	}
+func (t *TableBuilder) Size() int {
+	if len(t.headers) == 0 {
+		return 0
+	}
+
+	var c runeCounter
+	t.writeTable(&c)
+	return int(c)
+}
+
+// writeTable renders the table to w.
+func (t *TableBuilder) writeTable(w io.StringWriter) {
+	eol := newline()
 
 	// Write header row
-	sb.WriteString("| ")
+	w.WriteString("| ")
 	for i, header := range t.headers {
 		if i > 0 {
-			sb.WriteString(" | ")
+			w.WriteString(" | ")
 		}
-		sb.WriteString(t.headerBuilder(header))
+		w.WriteString(t.headerBuilder(IsolateBidi(header, t.bidi)))
 	}
-	sb.WriteString(" |\n")
+	w.WriteString(" |")
+	w.WriteString(eol)
 
 	// Write separator row with alignment markers
-	sb.WriteString("| ")
+	w.WriteString("| ")
 	for i, alignment := range t.alignments {
 		if i > 0 {
-			sb.WriteString(" | ")
+			w.WriteString(" | ")
 		}
 
 		switch alignment {
 		case AlignLeft:
-			sb.WriteString(":---")
+			w.WriteString(":---")
 		case AlignCenter:
-			sb.WriteString(":---:")
+			w.WriteString(":---:")
 		case AlignRight:
-			sb.WriteString("---:")
+			w.WriteString("---:")
 		default:
-			sb.WriteString("---")
+			w.WriteString("---")
 		}
 	}
-	sb.WriteString(" |\n")
+	w.WriteString(" |")
 
-	// Write data rows
+	// Write data rows, each ending the line before it
 	for _, row := range t.rows {
-		sb.WriteString("| ")
+		w.WriteString(eol)
+		w.WriteString("| ")
 		for i, cell := range row {
 			if i > 0 {
-				sb.WriteString(" | ")
+				w.WriteString(" | ")
 			}
 			if i < len(t.headers) {
-				sb.WriteString(cell)
+				w.WriteString(IsolateBidi(cell, t.bidi))
 			}
 		}
 
 		// Add empty cells if row has fewer cells than headers
 		for i := len(row); i < len(t.headers); i++ {
-			sb.WriteString(" | ")
+			w.WriteString(" | ")
 		}
 
-		sb.WriteString(" |\n")
+		w.WriteString(" |")
+	}
+	if FinalNewline(finalNewline.Load()) != FinalNewlineNever {
+		w.WriteString(eol)
+	}
+}
+
+// chunkSize is the amount of output chunkWriter collects before passing it
+// on.
+const chunkSize = 32 << 10
+
+// chunkWriter collects small writes into chunks for an io.Writer and keeps
+// the first error it returns, after which further writes are dropped.
+type chunkWriter struct {
+	w   io.Writer
+	buf *bytes.Buffer
+	n   int64
+	err error
+}
+
+// WriteString buffers s, passing the buffer on once it holds a chunk.
+func (c *chunkWriter) WriteString(s string) (int, error) {
+	c.buf.WriteString(s)
+	if c.buf.Len() >= chunkSize {
+		c.flush()
+	}
+	return len(s), c.err
+}
+
+// flush passes the buffered output on.
+func (c *chunkWriter) flush() {
+	if c.err == nil && c.buf.Len() > 0 {
+		n, err := c.w.Write(c.buf.Bytes())
+		c.n += int64(n)
+		c.err = err
 	}
+	c.buf.Reset()
+}
+
+// runeCounter is an io.StringWriter that counts the characters written to
+// it.
+type runeCounter int
+
+// WriteString adds the characters in s to the count.
+func (c *runeCounter) WriteString(s string) (int, error) {
+	*c += runeCounter(utf8.RuneCountInString(s))
+	return len(s), nil
+}
 
-	return sb.String()
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (t *TableBuilder) MarshalZulipMarkdown() (string, error) {
+	return t.Build(), nil
 }
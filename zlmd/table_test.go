@@ -1,8 +1,10 @@
 package zlmd
 
 import (
+	"fmt"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func TestTableBuilder_Empty(t *testing.T) {
@@ -71,6 +73,27 @@ func TestTableBuilder_AddRows(t *testing.T) {
 	}
 }
 
+func TestTableBuilder_FromMaps(t *testing.T) {
+	rows := []map[string]string{
+		{"status": "up", "service": "api", "region": "eu"},
+		{"service": "db", "status": "down"},
+	}
+
+	// Rendering repeatedly exercises different map iteration orders.
+	expected := "| region | service | status |\n| --- | --- | --- |\n| eu | api | up |\n|  | db | down |\n"
+	for range 20 {
+		if result := NewTableBuilder().FromMaps(rows).Build(); result != expected {
+			t.Fatalf("FromMaps formatting incorrect\nExpected:\n%q\nGot:\n%q", expected, result)
+		}
+	}
+
+	result := NewTableBuilder().WithHeaders("service", "owner").FromMaps(rows).Build()
+	expected = "| service | owner |\n| --- | --- |\n| api |  |\n| db |  |\n"
+	if result != expected {
+		t.Errorf("FromMaps with headers formatting incorrect\nExpected:\n%q\nGot:\n%q", expected, result)
+	}
+}
+
 func TestTableBuilder_Alignments(t *testing.T) {
 	table := NewTableBuilder().
 		WithHeaders("Left", "Center", "Right").
@@ -170,3 +193,46 @@ func TestTableBuilder_Chaining(t *testing.T) {
 		t.Errorf("Method chaining produced incorrect output: %q", result)
 	}
 }
+
+func TestTableBuilder_WriteToAndSize(t *testing.T) {
+	large := NewTableBuilder().WithHeaders("Host", "Status").SetAlignments(AlignLeft, AlignRight)
+	for i := range 2000 {
+		large.AddRow(fmt.Sprintf("node-%04d", i), "✅ ready")
+	}
+
+	tests := []struct {
+		name  string
+		table *TableBuilder
+		rows  int
+	}{
+		{"Empty", NewTableBuilder(), 0},
+		{"Headers only", NewTableBuilder().WithHeaders("A", "B"), 0},
+		{"Ragged rows", NewTableBuilder().WithHeaders("Name", "Age").AddRow("Ünïcode").AddRow("a", "b", "c"), 2},
+		{"Larger than a chunk", large, 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expected := tt.table.Build()
+
+			var sb strings.Builder
+			n, err := tt.table.WriteTo(&sb)
+			if err != nil || sb.String() != expected || n != int64(len(expected)) {
+				t.Errorf("WriteTo() = %d, %v, wrote %d bytes, want %d bytes matching Build()", n, err, sb.Len(), len(expected))
+			}
+			if got := tt.table.Size(); got != utf8.RuneCountInString(expected) {
+				t.Errorf("Size() = %d, want %d", got, utf8.RuneCountInString(expected))
+			}
+			if got := tt.table.RowCount(); got != tt.rows {
+				t.Errorf("RowCount() = %d, want %d", got, tt.rows)
+			}
+		})
+	}
+}
+
+func TestTableBuilder_WriteToError(t *testing.T) {
+	table := NewTableBuilder().WithHeaders("A").AddRow("1")
+	if n, err := table.WriteTo(failWriter{}); err == nil || n != 0 {
+		t.Errorf("WriteTo() = %d, %v, want 0 and an error", n, err)
+	}
+}
@@ -0,0 +1,267 @@
+// Package testreport turns `go test -json` output into a Zulip message
+// summarizing the run: pass/fail counts, a table of failed tests with their
+// durations, and the output of each failure in a spoiler.
+package testreport
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxFailures is the number of failed tests detailed by Markdown before the
+// remainder is summarized.
+const MaxFailures = 20
+
+// Event is a single line of `go test -json` output.
+type Event struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64
+	Output  string
+}
+
+// Result is the outcome of a single test, or of a package when Test is empty.
+type Result struct {
+	Package string
+	Test    string
+	// Action is the final action reported: "pass", "fail" or "skip".
+	Action  string
+	Elapsed time.Duration
+	Output  string
+}
+
+// Report aggregates the results of a test run.
+type Report struct {
+	Tests    []Result
+	Packages []Result
+	Passed   int
+	Failed   int
+	Skipped  int
+	Elapsed  time.Duration
+}
+
+// Parse reads `go test -json` output and aggregates it into a Report.
+//
+// Parameters:
+//   - r (io.Reader): The JSON event stream
+//
+// Returns:
+//   - *Report: The aggregated results
+//   - error: An error if reading from r fails
+//
+// Example:
+//
+//	out, _ := exec.Command("go", "test", "-json", "./...").Output()
+//	report, err := testreport.Parse(bytes.NewReader(out))
+//
+// Notes:
+//   - Lines that are not JSON events, such as build errors printed by older
+//     Go versions, are ignored
+func Parse(r io.Reader) (*Report, error) {
+	type key struct{ pkg, test string }
+
+	results := map[key]*Result{}
+	var order []key
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var ev Event
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil || ev.Action == "" {
+			continue
+		}
+
+		k := key{ev.Package, ev.Test}
+		res, ok := results[k]
+		if !ok {
+			res = &Result{Package: ev.Package, Test: ev.Test}
+			results[k] = res
+			order = append(order, k)
+		}
+
+		switch ev.Action {
+		case "output", "build-output":
+			res.Output += ev.Output
+		case "pass", "fail", "skip":
+			res.Action = ev.Action
+			res.Elapsed = time.Duration(ev.Elapsed * float64(time.Second))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	report := &Report{}
+	for _, k := range order {
+		res := *results[k]
+		if res.Test == "" {
+			if res.Action == "" && res.Output != "" {
+				res.Action = "fail"
+			}
+			report.Packages = append(report.Packages, res)
+			report.Elapsed += res.Elapsed
+			continue
+		}
+
+		report.Tests = append(report.Tests, res)
+		switch res.Action {
+		case "pass":
+			report.Passed++
+		case "fail":
+			report.Failed++
+		case "skip":
+			report.Skipped++
+		}
+	}
+	return report, nil
+}
+
+// Failures returns the failed tests, followed by failed packages that have
+// no failed tests of their own (typically build failures).
+func (r *Report) Failures() []Result {
+	var failures []Result
+	failedPkgs := map[string]bool{}
+	for _, t := range r.Tests {
+		if t.Action == "fail" {
+			failures = append(failures, t)
+			failedPkgs[t.Package] = true
+		}
+	}
+	for _, p := range r.Packages {
+		if p.Action == "fail" && !failedPkgs[p.Package] {
+			failures = append(failures, p)
+		}
+	}
+	return failures
+}
+
+// OK reports whether the run had no failed tests or packages.
+func (r *Report) OK() bool {
+	return len(r.Failures()) == 0
+}
+
+// Markdown renders the report as a Zulip message.
+//
+// Returns:
+//   - string: The status line, a table of failures and their output in spoilers
+//
+// Example:
+//
+//	msg := report.Markdown()
+//	// msg will be similar to:
+//	// ❌ **Tests failed**: 1 failed, 41 passed, 2 skipped in 3.4s
+//	//
+//	// | Package | Test | Duration |
+//	// | --- | --- | ---: |
+//	// | example.com/app | TestLogin | 120ms |
+//	//
+//	// ```spoiler TestLogin
+//	// ~~~text
+//	// login_test.go:31: unexpected status 500
+//	// ~~~
+//	// ```
+func (r *Report) Markdown() string {
+	var sb strings.Builder
+
+	failures := r.Failures()
+	if len(failures) == 0 {
+		sb.WriteString(zlmd.DefaultTheme.Prefix("success"))
+		sb.WriteString(" ")
+		zlmd.WriteBold(&sb, "Tests passed")
+	} else {
+		sb.WriteString(zlmd.DefaultTheme.Prefix("danger"))
+		sb.WriteString(" ")
+		zlmd.WriteBold(&sb, "Tests failed")
+	}
+	sb.WriteString(": ")
+	sb.WriteString(r.counts(len(failures)))
+	sb.WriteString("\n")
+
+	if len(failures) == 0 {
+		return sb.String()
+	}
+
+	shown := failures
+	if len(shown) > MaxFailures {
+		shown = shown[:MaxFailures]
+	}
+
+	table := zlmd.NewTableBuilder().
+		WithHeaders("Package", "Test", "Duration").
+		SetAlignments(zlmd.AlignDefault, zlmd.AlignDefault, zlmd.AlignRight)
+	for _, f := range shown {
+		table.AddRow(f.Package, testName(f), zlmd.HumanDuration(f.Elapsed))
+	}
+	sb.WriteString("\n")
+	sb.WriteString(table.Build())
+
+	for _, f := range shown {
+		output := strings.TrimRight(failureOutput(f.Output), "\n")
+		if output == "" {
+			continue
+		}
+		sb.WriteString("\n")
+		zlmd.WriteSpoiler(&sb, testName(f), zlmd.CodeBlock("text", output))
+		sb.WriteString("\n")
+	}
+
+	if len(shown) < len(failures) {
+		sb.WriteString("\n")
+		zlmd.WriteItalic(&sb, fmt.Sprintf("%d of %d failures shown", len(shown), len(failures)))
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements zlmd.Markdowner by returning Markdown.
+func (r *Report) MarshalZulipMarkdown() (string, error) {
+	return r.Markdown(), nil
+}
+
+// counts formats the pass/fail/skip summary.
+func (r *Report) counts(failed int) string {
+	parts := []string{}
+	if failed > 0 {
+		parts = append(parts, fmt.Sprintf("%d failed", failed))
+	}
+	parts = append(parts, fmt.Sprintf("%d passed", r.Passed))
+	if r.Skipped > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", r.Skipped))
+	}
+	return fmt.Sprintf("%s in %s", strings.Join(parts, ", "), zlmd.HumanDuration(r.Elapsed))
+}
+
+// testName returns the display name of a result.
+func testName(res Result) string {
+	if res.Test == "" {
+		return "(package)"
+	}
+	return res.Test
+}
+
+// failureOutput drops the framing lines go test adds around every test, such
+// as "=== RUN" and "--- FAIL", keeping the test's own output.
+func failureOutput(output string) string {
+	lines := strings.Split(output, "\n")
+	kept := lines[:0]
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "=== ") ||
+			strings.HasPrefix(trimmed, "--- FAIL") ||
+			strings.HasPrefix(trimmed, "--- PASS") ||
+			strings.HasPrefix(trimmed, "--- SKIP") {
+			continue
+		}
+		kept = append(kept, line)
+	}
+	return strings.Join(kept, "\n")
+}
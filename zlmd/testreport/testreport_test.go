@@ -0,0 +1,84 @@
+package testreport
+
+import (
+	"strings"
+	"testing"
+)
+
+const sampleRun = `{"Action":"start","Package":"example.com/app"}
+{"Action":"run","Package":"example.com/app","Test":"TestLogin"}
+{"Action":"output","Package":"example.com/app","Test":"TestLogin","Output":"=== RUN   TestLogin\n"}
+{"Action":"output","Package":"example.com/app","Test":"TestLogin","Output":"    login_test.go:31: unexpected status 500\n"}
+{"Action":"output","Package":"example.com/app","Test":"TestLogin","Output":"--- FAIL: TestLogin (0.12s)\n"}
+{"Action":"fail","Package":"example.com/app","Test":"TestLogin","Elapsed":0.12}
+{"Action":"run","Package":"example.com/app","Test":"TestLogout"}
+{"Action":"pass","Package":"example.com/app","Test":"TestLogout","Elapsed":0.01}
+{"Action":"run","Package":"example.com/app","Test":"TestSlow"}
+{"Action":"skip","Package":"example.com/app","Test":"TestSlow","Elapsed":0}
+{"Action":"output","Package":"example.com/app","Output":"FAIL\n"}
+{"Action":"fail","Package":"example.com/app","Elapsed":1.5}
+not json
+`
+
+func TestParse(t *testing.T) {
+	report, err := Parse(strings.NewReader(sampleRun))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+
+	if report.Passed != 1 || report.Failed != 1 || report.Skipped != 1 {
+		t.Errorf("Unexpected counts: passed=%d failed=%d skipped=%d", report.Passed, report.Failed, report.Skipped)
+	}
+	if len(report.Packages) != 1 || report.Packages[0].Action != "fail" {
+		t.Errorf("Unexpected packages: %+v", report.Packages)
+	}
+	if report.OK() {
+		t.Error("Expected report with failures not to be OK")
+	}
+
+	failures := report.Failures()
+	if len(failures) != 1 || failures[0].Test != "TestLogin" {
+		t.Errorf("Unexpected failures: %+v", failures)
+	}
+}
+
+func TestReport_Markdown(t *testing.T) {
+	report, _ := Parse(strings.NewReader(sampleRun))
+
+	got := report.Markdown()
+	expected := "❌ **Tests failed**: 1 failed, 1 passed, 1 skipped in 1.5s\n\n" +
+		"| Package | Test | Duration |\n| --- | --- | ---: |\n| example.com/app | TestLogin | 120ms |\n\n" +
+		"```spoiler TestLogin\n~~~text\n    login_test.go:31: unexpected status 500\n~~~\n```\n"
+	if got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestReport_MarkdownPassed(t *testing.T) {
+	run := `{"Action":"pass","Package":"example.com/app","Test":"TestA","Elapsed":0.2}
+{"Action":"pass","Package":"example.com/app","Elapsed":0.3}
+`
+	report, _ := Parse(strings.NewReader(run))
+
+	got := report.Markdown()
+	expected := "✅ **Tests passed**: 1 passed in 300ms\n"
+	if got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestReport_BuildFailure(t *testing.T) {
+	run := `{"Action":"output","Package":"example.com/broken","Output":"# example.com/broken\n"}
+{"Action":"output","Package":"example.com/broken","Output":"./x.go:3:1: syntax error\n"}
+{"Action":"fail","Package":"example.com/broken","Elapsed":0}
+`
+	report, _ := Parse(strings.NewReader(run))
+
+	failures := report.Failures()
+	if len(failures) != 1 || failures[0].Package != "example.com/broken" || failures[0].Test != "" {
+		t.Fatalf("Unexpected failures: %+v", failures)
+	}
+	if !strings.Contains(report.Markdown(), "| example.com/broken | (package) |") {
+		t.Errorf("Expected package failure row: %q", report.Markdown())
+	}
+}
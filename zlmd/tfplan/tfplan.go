@@ -0,0 +1,242 @@
+// Package tfplan turns the output of `terraform plan` into a Zulip message
+// for infrastructure change review: the add/change/destroy counts, a table
+// of the affected resources and the full plan in a spoiler. Both the
+// machine-readable `terraform show -json` format and the human-readable
+// text are understood.
+package tfplan
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxResources is the number of changed resources listed by Markdown before
+// the remainder is only counted.
+const MaxResources = 50
+
+// Action is the change planned for a resource.
+type Action string
+
+const (
+	ActionCreate  Action = "create"
+	ActionUpdate  Action = "update"
+	ActionDelete  Action = "delete"
+	ActionReplace Action = "replace"
+	ActionRead    Action = "read"
+)
+
+// style returns the theme style for a.
+func (a Action) style() string {
+	switch a {
+	case ActionCreate:
+		return "success"
+	case ActionUpdate:
+		return "warning"
+	case ActionDelete, ActionReplace:
+		return "danger"
+	default:
+		return "info"
+	}
+}
+
+// Change is a planned change to one resource.
+type Change struct {
+	// Address is the full resource address, such as
+	// "module.db.aws_db_instance.main".
+	Address string
+	Action  Action
+}
+
+// Plan is a parsed terraform plan.
+type Plan struct {
+	Changes []Change
+	// Output is the human-readable plan shown in the spoiler. Parse sets it
+	// for text input; for JSON input it is empty and may be filled from
+	// `terraform show`.
+	Output string
+}
+
+// ansi matches the terminal color codes terraform emits without -no-color.
+var ansi = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// textChange matches the comment line that introduces each resource in a
+// text plan, such as "# aws_instance.web will be created".
+var textChange = regexp.MustCompile(`^\s*# (.+?) (?:\(.+\) )?(will be created|will be updated in-place|will be destroyed|must be replaced|is tainted, so must be replaced|will be read during apply)`)
+
+var textActions = map[string]Action{
+	"will be created":                 ActionCreate,
+	"will be updated in-place":        ActionUpdate,
+	"will be destroyed":               ActionDelete,
+	"must be replaced":                ActionReplace,
+	"is tainted, so must be replaced": ActionReplace,
+	"will be read during apply":       ActionRead,
+}
+
+// Parse reads a terraform plan.
+//
+// Parameters:
+//   - r (io.Reader): The output of `terraform show -json <planfile>`, or of
+//     `terraform plan` itself
+//
+// Returns:
+//   - *Plan: The planned changes
+//   - error: An error if reading fails or the JSON is invalid
+//
+// Example:
+//
+//	out, _ := exec.Command("terraform", "plan", "-no-color").Output()
+//	plan, err := tfplan.Parse(bytes.NewReader(out))
+//
+// Notes:
+//   - Input starting with "{" is decoded as JSON; anything else as text,
+//     with color codes removed
+//   - Resources without changes are omitted
+func Parse(r io.Reader) (*Plan, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		return parseJSON(trimmed)
+	}
+	return parseText(string(data)), nil
+}
+
+// parseJSON decodes the resource changes of a JSON plan.
+func parseJSON(data []byte) (*Plan, error) {
+	var raw struct {
+		ResourceChanges []struct {
+			Address string `json:"address"`
+			Change  struct {
+				Actions []string `json:"actions"`
+			} `json:"change"`
+		} `json:"resource_changes"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("tfplan: invalid JSON plan: %w", err)
+	}
+
+	plan := &Plan{}
+	for _, rc := range raw.ResourceChanges {
+		var action Action
+		switch actions := strings.Join(rc.Change.Actions, ","); actions {
+		case "create", "update", "delete", "read":
+			action = Action(actions)
+		case "delete,create", "create,delete":
+			action = ActionReplace
+		default:
+			continue
+		}
+		plan.Changes = append(plan.Changes, Change{Address: rc.Address, Action: action})
+	}
+	return plan, nil
+}
+
+// parseText scans a text plan for the line introducing each change.
+func parseText(text string) *Plan {
+	text = ansi.ReplaceAllString(strings.ReplaceAll(text, "\r\n", "\n"), "")
+	plan := &Plan{Output: strings.TrimSpace(text)}
+	for _, line := range strings.Split(text, "\n") {
+		if m := textChange.FindStringSubmatch(line); m != nil {
+			plan.Changes = append(plan.Changes, Change{Address: m[1], Action: textActions[m[2]]})
+		}
+	}
+	return plan
+}
+
+// Counts returns the number of resources to add, change and destroy, as
+// terraform summarizes them: a replacement counts as an add and a destroy.
+func (p *Plan) Counts() (add, change, destroy int) {
+	for _, c := range p.Changes {
+		switch c.Action {
+		case ActionCreate:
+			add++
+		case ActionUpdate:
+			change++
+		case ActionDelete:
+			destroy++
+		case ActionReplace:
+			add++
+			destroy++
+		}
+	}
+	return add, change, destroy
+}
+
+// Markdown renders the plan.
+//
+// Returns:
+//   - string: The rendered message
+//
+// Example:
+//
+//	fmt.Println(plan.Markdown())
+//	// ❌ **Terraform plan**: 1 to add, 1 to change, 1 to destroy
+//	//
+//	// | Action | Resource |
+//	// | --- | --- |
+//	// | ✅ create | `aws_s3_bucket.logs` |
+//	// | ⚠️ update | `aws_instance.web` |
+//	// | ❌ delete | `aws_instance.old` |
+//	//
+//	// ````spoiler Full plan
+//	// ...
+//
+// Notes:
+//   - The header is a warning when resources are changed and a danger when
+//     any are destroyed
+//   - Only the first MaxResources changes are listed
+func (p *Plan) Markdown() string {
+	var sb strings.Builder
+
+	add, change, destroy := p.Counts()
+	style := "success"
+	switch {
+	case destroy > 0:
+		style = "danger"
+	case change > 0:
+		style = "warning"
+	case len(p.Changes) > 0:
+		style = "info"
+	}
+	sb.WriteString(zlmd.DefaultTheme.Prefix(style) + " ")
+	zlmd.WriteBold(&sb, "Terraform plan")
+	if len(p.Changes) == 0 {
+		sb.WriteString(": no changes\n")
+	} else {
+		sb.WriteString(fmt.Sprintf(": %d to add, %d to change, %d to destroy\n", add, change, destroy))
+	}
+
+	if len(p.Changes) > 0 {
+		shown := p.Changes
+		if len(shown) > MaxResources {
+			shown = shown[:MaxResources]
+		}
+		table := zlmd.NewTableBuilder().WithHeaders("Action", "Resource")
+		for _, c := range shown {
+			table.AddRow(zlmd.DefaultTheme.Prefix(c.Action.style())+" "+string(c.Action), zlmd.Code(c.Address))
+		}
+		sb.WriteString("\n" + table.Build())
+		if len(shown) < len(p.Changes) {
+			sb.WriteString("\n")
+			zlmd.WriteItalic(&sb, fmt.Sprintf("%d of %d changes shown", len(shown), len(p.Changes)))
+			sb.WriteString("\n")
+		}
+	}
+
+	if output := strings.TrimSpace(p.Output); output != "" {
+		sb.WriteString("\n" + zlmd.FencedBlock("spoiler Full plan", zlmd.FencedBlock("text", output)) + "\n")
+	}
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements zlmd.Markdowner by returning Markdown.
+func (p *Plan) MarshalZulipMarkdown() (string, error) {
+	return p.Markdown(), nil
+}
@@ -0,0 +1,114 @@
+package tfplan
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const textPlan = "Terraform will perform the following actions:\n\n" +
+	"  \x1b[1m# aws_instance.old\x1b[0m will be destroyed\n" +
+	"  - resource \"aws_instance\" \"old\" {}\n\n" +
+	"  # aws_instance.web will be updated in-place\n" +
+	"  ~ resource \"aws_instance\" \"web\" {\n      ~ instance_type = \"t3.small\" -> \"t3.large\"\n    }\n\n" +
+	"  # module.db.aws_db_instance.main[\"primary\"] must be replaced\n" +
+	"-/+ resource \"aws_db_instance\" \"main\" {}\n\n" +
+	"  # aws_s3_bucket.logs will be created\n" +
+	"  + resource \"aws_s3_bucket\" \"logs\" {}\n\n" +
+	"Plan: 2 to add, 1 to change, 2 to destroy.\n"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []Change
+	}{
+		{
+			"Text",
+			textPlan,
+			[]Change{
+				{"aws_instance.old", ActionDelete},
+				{"aws_instance.web", ActionUpdate},
+				{`module.db.aws_db_instance.main["primary"]`, ActionReplace},
+				{"aws_s3_bucket.logs", ActionCreate},
+			},
+		},
+		{
+			"JSON",
+			`{"format_version":"1.2","resource_changes":[` +
+				`{"address":"aws_s3_bucket.logs","change":{"actions":["create"]}},` +
+				`{"address":"aws_instance.web","change":{"actions":["no-op"]}},` +
+				`{"address":"aws_instance.db","change":{"actions":["create","delete"]}},` +
+				`{"address":"data.aws_ami.ubuntu","change":{"actions":["read"]}}]}`,
+			[]Change{
+				{"aws_s3_bucket.logs", ActionCreate},
+				{"aws_instance.db", ActionReplace},
+				{"data.aws_ami.ubuntu", ActionRead},
+			},
+		},
+		{"No changes", "No changes. Your infrastructure matches the configuration.\n", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := Parse(strings.NewReader(tt.input))
+			if err != nil {
+				t.Fatalf("Parse() returned error: %v", err)
+			}
+			if !reflect.DeepEqual(plan.Changes, tt.expected) {
+				t.Errorf("Parse() = %v, want %v", plan.Changes, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParse_InvalidJSON(t *testing.T) {
+	if _, err := Parse(strings.NewReader(`{"resource_changes": [`)); err == nil {
+		t.Error("Expected an error for invalid JSON")
+	}
+}
+
+func TestPlan_Markdown(t *testing.T) {
+	plan, err := Parse(strings.NewReader(textPlan))
+	if err != nil {
+		t.Fatalf("Parse() returned error: %v", err)
+	}
+
+	expected := "❌ **Terraform plan**: 2 to add, 1 to change, 2 to destroy\n\n" +
+		"| Action | Resource |\n| --- | --- |\n" +
+		"| ❌ delete | `aws_instance.old` |\n" +
+		"| ⚠️ update | `aws_instance.web` |\n" +
+		"| ❌ replace | `module.db.aws_db_instance.main[\"primary\"]` |\n" +
+		"| ✅ create | `aws_s3_bucket.logs` |\n\n" +
+		"````spoiler Full plan\n```text\n" + strings.TrimSpace(ansi.ReplaceAllString(textPlan, "")) + "\n```\n````\n"
+	if got := plan.Markdown(); got != expected {
+		t.Errorf("Markdown() = %q, want %q", got, expected)
+	}
+}
+
+func TestPlan_Markdown_Summary(t *testing.T) {
+	var many []Change
+	for i := range MaxResources + 2 {
+		many = append(many, Change{fmt.Sprintf("aws_instance.web[%d]", i), ActionCreate})
+	}
+
+	tests := []struct {
+		name     string
+		plan     Plan
+		expected string
+	}{
+		{"No changes", Plan{}, "✅ **Terraform plan**: no changes\n"},
+		{"Create only", Plan{Changes: []Change{{"aws_s3_bucket.logs", ActionCreate}}},
+			"ℹ️ **Terraform plan**: 1 to add, 0 to change, 0 to destroy\n\n| Action | Resource |\n| --- | --- |\n| ✅ create | `aws_s3_bucket.logs` |\n"},
+		{"Truncated", Plan{Changes: many}, fmt.Sprintf("*%d of %d changes shown*\n", MaxResources, MaxResources+2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.plan.Markdown(); !strings.HasSuffix(got, tt.expected) {
+				t.Errorf("Markdown() = %q, want suffix %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package zlmd
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"sync"
+)
+
+// Theme maps badge style names to the prefix rendered in front of badge text.
+//
+// Themes are safe for concurrent use. The package-level Badge function uses
+// DefaultTheme; callers that need different styles for one destination can
+// copy a theme with Clone and pass it around or select it with WithTheme.
+type Theme struct {
+	mu       sync.RWMutex
+	name     string
+	styles   map[string]string
+	fallback string
+}
+
+// NewTheme creates an empty theme that renders unknown styles with fallback.
+//
+// Parameters:
+//   - name (string): A descriptive name for the theme
+//   - fallback (string): The prefix used for styles that are not registered
+//
+// Returns:
+//   - *Theme: A new theme without registered styles
+//
+// Example:
+//
+//	theme := NewTheme("deploys", "•").Register("deployed", "🚀")
+func NewTheme(name string, fallback string) *Theme {
+	return &Theme{
+		name:     name,
+		styles:   map[string]string{},
+		fallback: fallback,
+	}
+}
+
+// DefaultTheme is the emoji theme used by Badge.
+var DefaultTheme = NewTheme("emoji", "🧷").
+	Register("primary", "🔵").
+	Register("success", "✅").
+	Register("warning", "⚠️").
+	Register("danger", "❌").
+	Register("info", "ℹ️").
+	Register("rejected", "✴️").
+	Register("pending", "⏳").
+	Register("debug", "🔍").
+	Register("arrow-right", ArrowRight).
+	Register("arrow-left", ArrowLeft).
+	Register("arrow-left-right", ArrowLeftRight).
+	Register("arrow-right-dotted", ArrowRightDotted).
+	Register("arrow-left-dotted", ArrowLeftDotted)
+
+// PlainTheme renders badges with bracketed text prefixes instead of emoji,
+// and arrows as ASCII, for destinations that strip or mangle emoji. Select it
+// for the shortcuts with SetDefaultConfig, and for a single builder with
+// WithTheme.
+var PlainTheme = NewTheme("plain", "[NOTE]").
+	Register("primary", "[*]").
+	Register("success", "[OK]").
+	Register("warning", "[WARN]").
+	Register("danger", "[ERROR]").
+	Register("info", "[INFO]").
+	Register("rejected", "[REJECTED]").
+	Register("pending", "[PENDING]").
+	Register("debug", "[DEBUG]").
+	Register("arrow-right", "->").
+	Register("arrow-left", "<-").
+	Register("arrow-left-right", "<->").
+	Register("arrow-right-dotted", "..>").
+	Register("arrow-left-dotted", "<..")
+
+// Name returns the name of the theme.
+func (t *Theme) Name() string {
+	return t.name
+}
+
+// Register adds or replaces the prefix for style.
+//
+// Returns:
+//   - *Theme: The same Theme instance (for method chaining)
+//
+// Example:
+//
+//	DefaultTheme.Register("deployed", "🚀")
+//	// Badge(&sb, "v1.2.0", "deployed") now writes "🚀 `v1.2.0`\n"
+func (t *Theme) Register(style, prefix string) *Theme {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.styles[style] = prefix
+	return t
+}
+
+// Prefix returns the prefix registered for style, or the theme fallback.
+func (t *Theme) Prefix(style string) string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	if prefix, ok := t.styles[style]; ok {
+		return prefix
+	}
+	return t.fallback
+}
+
+// Clone returns an independent copy of the theme under a new name, so
+// defaults can be overridden without affecting other users of the original.
+//
+// Example:
+//
+//	theme := DefaultTheme.Clone("ops").Register("warning", "🔥")
+func (t *Theme) Clone(name string) *Theme {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	return &Theme{
+		name:     name,
+		styles:   maps.Clone(t.styles),
+		fallback: t.fallback,
+	}
+}
+
+// Badge writes a badge using the theme's prefix for style.
+//
+// Example:
+//
+//	PlainTheme.Badge(&sb, "disk 91%", "warning")
+//	// sb now contains "[WARN] `disk 91%`\n"
+func (t *Theme) Badge(info *strings.Builder, text string, style string) {
+	info.WriteString(fmt.Sprintf("%s `%s`\n", t.Prefix(style), text))
+}
+
+// Statusf writes a status line: the theme's prefix for style followed by the
+// formatted message. The shortcuts Warnf, Infof, Successf, Errorf and Debugf
+// are Statusf on the theme of the default Config.
+//
+// Example:
+//
+//	PlainTheme.Statusf(&sb, "warning", "disk at %d%%", 91)
+//	// sb now contains "[WARN] disk at 91%\n"
+func (t *Theme) Statusf(info *strings.Builder, style string, format string, args ...interface{}) {
+	info.WriteString(t.Prefix(style) + " " + fmt.Sprintf(format, args...) + "\n")
+}
+
+// defaultTheme returns the Theme of the default Config, or DefaultTheme.
+func defaultTheme() *Theme {
+	if cfg := defaultConfig.Load(); cfg != nil && cfg.Theme != nil {
+		return cfg.Theme
+	}
+	return DefaultTheme
+}
+
+// WithTheme selects the theme used by builders that render badges or status
+// prefixes. A nil theme is ignored.
+//
+// Example:
+//
+//	plain := DefaultTheme.Clone("ops").Register("deployed", "[DEPLOYED]")
+//	opts := []Option{WithTheme(plain)}
+func WithTheme(theme *Theme) Option {
+	return func(o *options) {
+		if theme != nil {
+			o.theme = theme
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package zlmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestBadge(t *testing.T) {
+	tests := []struct {
+		style    string
+		expected string
+	}{
+		{"primary", "🔵 `text`\n"},
+		{"success", "✅ `text`\n"},
+		{"warning", "⚠️ `text`\n"},
+		{"danger", "❌ `text`\n"},
+		{"info", "ℹ️ `text`\n"},
+		{"rejected", "✴️ `text`\n"},
+		{"unknown", "🧷 `text`\n"},
+	}
+
+	for _, tt := range tests {
+		var sb strings.Builder
+		Badge(&sb, "text", tt.style)
+		if sb.String() != tt.expected {
+			t.Errorf("Badge(%q) = %q, want %q", tt.style, sb.String(), tt.expected)
+		}
+	}
+}
+
+func TestTheme_Clone(t *testing.T) {
+	theme := DefaultTheme.Clone("deploys").
+		Register("deployed", "🚀").
+		Register("warning", "🔥")
+
+	var sb strings.Builder
+	theme.Badge(&sb, "v1.2.0", "deployed")
+	theme.Badge(&sb, "slow", "warning")
+	expected := "🚀 `v1.2.0`\n🔥 `slow`\n"
+	if sb.String() != expected {
+		t.Errorf("Badge() = %q, want %q", sb.String(), expected)
+	}
+
+	if got := DefaultTheme.Prefix("warning"); got != "⚠️" {
+		t.Errorf("Clone modified DefaultTheme: warning prefix is %q", got)
+	}
+	if got := DefaultTheme.Prefix("deployed"); got != "🧷" {
+		t.Errorf("Clone modified DefaultTheme: deployed prefix is %q", got)
+	}
+}
+
+func TestPlainTheme(t *testing.T) {
+	var sb strings.Builder
+	PlainTheme.Badge(&sb, "disk 91%", "warning")
+	PlainTheme.Badge(&sb, "other", "custom")
+	expected := "[WARN] `disk 91%`\n[NOTE] `other`\n"
+	if sb.String() != expected {
+		t.Errorf("Badge() = %q, want %q", sb.String(), expected)
+	}
+}
+
+func TestNewTheme(t *testing.T) {
+	theme := NewTheme("minimal", "-").Register("ok", "+")
+	if theme.Name() != "minimal" {
+		t.Errorf("Name() = %q, want %q", theme.Name(), "minimal")
+	}
+	if theme.Prefix("ok") != "+" || theme.Prefix("missing") != "-" {
+		t.Errorf("Unexpected prefixes: %q %q", theme.Prefix("ok"), theme.Prefix("missing"))
+	}
+}
+
+func TestPlainTheme_Shortcuts(t *testing.T) {
+	write := func() string {
+		var sb strings.Builder
+		Successf(&sb, "deployed %s", "v2")
+		Warnf(&sb, "disk %d%%", 91)
+		Debugf(&sb, "cache miss")
+		NoErr(&sb, errors.New("boom"))
+		Right(&sb, "build", "test", "deploy")
+		LeftDotted(&sb, "a", "b")
+		return sb.String()
+	}
+
+	tests := []struct {
+		name     string
+		theme    *Theme
+		expected string
+	}{
+		{
+			name:     "Emoji",
+			theme:    nil,
+			expected: "✅ deployed v2\n⚠️ disk 91%\n🔍 cache miss\n❌ boom\nbuild → test → deploy\na ⬸ b\n",
+		},
+		{
+			name:     "Plain",
+			theme:    PlainTheme,
+			expected: "[OK] deployed v2\n[WARN] disk 91%\n[DEBUG] cache miss\n[ERROR] boom\nbuild -> test -> deploy\na <.. b\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			SetDefaultConfig(Config{Theme: tt.theme})
+			t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+			if got := write(); got != tt.expected {
+				t.Errorf("shortcuts wrote %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTheme_Statusf(t *testing.T) {
+	var sb strings.Builder
+	PlainTheme.Statusf(&sb, "info", "%d jobs queued", 3)
+	if expected := "[INFO] 3 jobs queued\n"; sb.String() != expected {
+		t.Errorf("Statusf() = %q, want %q", sb.String(), expected)
+	}
+}
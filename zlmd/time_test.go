@@ -24,3 +24,131 @@ func TestZLFormatTime_ZeroTime(t *testing.T) {
 		t.Errorf("ZLFormatTime(%v) = %q; want %q", zeroTime, result, expected)
 	}
 }
+
+func TestZLFormatTimeRange(t *testing.T) {
+	start := time.Date(2024, time.May, 15, 14, 0, 0, 0, time.UTC)
+	end := start.Add(90 * time.Minute)
+
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Range", ZLFormatTimeRange(start, end), "<time:2024-05-15T14:00:00Z> – <time:2024-05-15T15:30:00Z>"},
+		{"Duration", ZLFormatTimeRange(start, end, WithDuration(true)), "<time:2024-05-15T14:00:00Z> – <time:2024-05-15T15:30:00Z> (1h 30m)"},
+		{"Reversed", ZLFormatTimeRange(end, start, WithDuration(true)), "<time:2024-05-15T15:30:00Z> – <time:2024-05-15T14:00:00Z>"},
+		{"Empty range", ZLFormatTimeRange(start, start, WithDuration(true)), "<time:2024-05-15T14:00:00Z> – <time:2024-05-15T14:00:00Z> (0ns)"},
+		{"Portable", ZLFormatTimeRange(start, end, WithFlavor(FlavorPortable), WithDuration(true)), "2024-05-15 14:00 UTC – 2024-05-15 15:30 UTC (1h 30m)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("ZLFormatTimeRange() = %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZLFormatTimeOpt(t *testing.T) {
+	at := time.Date(2024, time.May, 15, 23, 30, 45, 0, time.UTC)
+	berlin := time.FixedZone("CEST", 2*60*60)
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{"Default", nil, "<time:2024-05-15T23:30:45Z>"},
+		{"Minute precision", []Option{WithMinutePrecision(true)}, "<time:2024-05-15T23:30Z>"},
+		{"Date only", []Option{WithDateOnly(true)}, "<time:2024-05-15>"},
+		{"Time zone", []Option{WithTimeZone(berlin)}, "<time:2024-05-16T01:30:45+02:00>"},
+		{"Date in time zone", []Option{WithTimeZone(berlin), WithDateOnly(true)}, "<time:2024-05-16>"},
+		{"Minutes in time zone", []Option{WithTimeZone(berlin), WithMinutePrecision(true)}, "<time:2024-05-16T01:30+02:00>"},
+		{"Portable", []Option{WithFlavor(FlavorPortable)}, "2024-05-15 23:30 UTC"},
+		{"Portable date only", []Option{WithFlavor(FlavorPortable), WithDateOnly(true)}, "2024-05-15"},
+		{"Portable time zone", []Option{WithFlavor(FlavorPortable), WithTimeZone(berlin)}, "2024-05-16 01:30 CEST"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ZLFormatTimeOpt(at, tt.opts...); got != tt.expected {
+				t.Errorf("ZLFormatTimeOpt() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseZLTime(t *testing.T) {
+	at := time.Date(2024, time.May, 15, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		markdown string
+		expected []TimeRef
+	}{
+		{"Tag", "Deploy at <time:2024-05-15T14:30:00Z>.", []TimeRef{
+			{Text: "<time:2024-05-15T14:30:00Z>", Time: at, Start: 10, End: 37},
+		}},
+		{"Second line", "a\r\nb <time:2024-05-15T14:30Z>", []TimeRef{
+			{Text: "<time:2024-05-15T14:30Z>", Time: at, Start: 5, End: 29},
+		}},
+		{"Offset", "<time:2024-05-15T16:30:00+02:00>", []TimeRef{
+			{Text: "<time:2024-05-15T16:30:00+02:00>", Time: at, Start: 0, End: 32},
+		}},
+		{"No zone", "<time:2024-05-15T14:30>", []TimeRef{
+			{Text: "<time:2024-05-15T14:30>", Time: at, Start: 0, End: 23},
+		}},
+		{"Date", "<time:2024-05-15>", []TimeRef{
+			{Text: "<time:2024-05-15>", Time: at.Truncate(24 * time.Hour), Start: 0, End: 17},
+		}},
+		{"Invalid", "<time:tomorrow>", nil},
+		{"Inline code", "`<time:2024-05-15>` <time:2024-05-15>", []TimeRef{
+			{Text: "<time:2024-05-15>", Time: at.Truncate(24 * time.Hour), Start: 20, End: 37},
+		}},
+		{"Code block", "```\n<time:2024-05-15>\n```\n<time:2024-05-15>", []TimeRef{
+			{Text: "<time:2024-05-15>", Time: at.Truncate(24 * time.Hour), Start: 26, End: 43},
+		}},
+		{"Spoiler", "```spoiler When\n<time:2024-05-15>\n```", []TimeRef{
+			{Text: "<time:2024-05-15>", Time: at.Truncate(24 * time.Hour), Start: 16, End: 33},
+		}},
+		{"Code in quote", "````quote\n```\n<time:2024-05-15>\n```\n<time:2024-05-15>\n````", []TimeRef{
+			{Text: "<time:2024-05-15>", Time: at.Truncate(24 * time.Hour), Start: 36, End: 53},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseZLTime(tt.markdown)
+			if len(got) != len(tt.expected) {
+				t.Fatalf("ParseZLTime() = %v, want %v", got, tt.expected)
+			}
+			for i, ref := range got {
+				want := tt.expected[i]
+				if ref.Text != want.Text || !ref.Time.Equal(want.Time) || ref.Start != want.Start || ref.End != want.End {
+					t.Errorf("ParseZLTime()[%d] = %+v, want %+v", i, ref, want)
+				}
+				if tt.markdown[ref.Start:ref.End] != ref.Text {
+					t.Errorf("markdown[%d:%d] = %q, want %q", ref.Start, ref.End, tt.markdown[ref.Start:ref.End], ref.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestParseZLTime_RoundTrip(t *testing.T) {
+	at := time.Date(2024, time.May, 15, 14, 30, 45, 0, time.UTC)
+	refs := ParseZLTime(ZLFormatTimeRange(at, at.Add(time.Hour)))
+	if len(refs) != 2 || !refs[0].Time.Equal(at) || !refs[1].Time.Equal(at.Add(time.Hour)) {
+		t.Errorf("ParseZLTime(ZLFormatTimeRange()) = %+v, want %v and %v", refs, at, at.Add(time.Hour))
+	}
+}
+
+func TestParseZLTime_QuoteReply(t *testing.T) {
+	at := time.Date(2024, time.May, 15, 14, 30, 45, 0, time.UTC)
+	reply := QuoteReply("Alice", "https://chat.example.com/#narrow/near/1", "Deploy at "+ZLFormatTime(at))
+	refs := ParseZLTime(reply)
+	if len(refs) != 1 || !refs[0].Time.Equal(at) || reply[refs[0].Start:refs[0].End] != refs[0].Text {
+		t.Errorf("ParseZLTime(QuoteReply()) = %+v, want one tag at %v", refs, at)
+	}
+}
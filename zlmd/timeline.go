@@ -0,0 +1,99 @@
+package zlmd
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimelineEvent is a single entry of a Timeline.
+type TimelineEvent struct {
+	Time time.Time
+	// Style is the theme style used as the event prefix, such as "info",
+	// "success", "warning" or "danger".
+	Style string
+	Text  string
+}
+
+// Timeline builds a chronological list of events, such as incident or deploy
+// timelines, with Zulip <time:> tags and the time elapsed between events.
+type Timeline struct {
+	events []TimelineEvent
+	opts   options
+}
+
+// NewTimeline creates an empty timeline.
+//
+// Parameters:
+//   - opts (...Option): Optional settings; WithTheme selects the event prefixes
+//
+// Returns:
+//   - *Timeline: A new initialized Timeline instance
+//
+// Example:
+//
+//	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+//	tl := NewTimeline().
+//	  Add(start, "info", "Deploy started").
+//	  Add(start.Add(5*time.Minute), "danger", "Error rate above 5%")
+//	// tl.Build() will be:
+//	// - ℹ️ <time:2024-01-02T15:00:00Z> Deploy started
+//	// - ❌ <time:2024-01-02T15:05:00Z> (+5m) Error rate above 5%
+func NewTimeline(opts ...Option) *Timeline {
+	return &Timeline{
+		events: []TimelineEvent{},
+		opts:   newOptions(opts...),
+	}
+}
+
+// Add appends an event.
+//
+// Returns:
+//   - *Timeline: The same Timeline instance (for method chaining)
+func (t *Timeline) Add(at time.Time, style string, text string) *Timeline {
+	t.events = append(t.events, TimelineEvent{Time: at, Style: style, Text: text})
+	return t
+}
+
+// AddEvent appends a prepared event.
+//
+// Returns:
+//   - *Timeline: The same Timeline instance (for method chaining)
+func (t *Timeline) AddEvent(event TimelineEvent) *Timeline {
+	t.events = append(t.events, event)
+	return t
+}
+
+// Build generates the markdown list of events in chronological order.
+//
+// Returns:
+//   - string: One list item per event, or "" for an empty timeline
+//
+// Notes:
+//   - Events added out of order are sorted; events with equal times keep their order
+//   - Every event after the first shows the time elapsed since the previous one
+func (t *Timeline) Build() string {
+	if len(t.events) == 0 {
+		return ""
+	}
+
+	events := append([]TimelineEvent(nil), t.events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.Before(events[j].Time)
+	})
+
+	var sb strings.Builder
+	for i, e := range events {
+		line := t.opts.theme.Prefix(e.Style) + " " + formatTime(e.Time, t.opts.target())
+		if i > 0 {
+			line += " (+" + t.opts.locale.Duration(e.Time.Sub(events[i-1].Time)) + ")"
+		}
+		WriteListItem(&sb, line+" "+e.Text, 0)
+	}
+	return sb.String()
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (t *Timeline) MarshalZulipMarkdown() (string, error) {
+	return t.Build(), nil
+}
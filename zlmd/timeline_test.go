@@ -0,0 +1,40 @@
+package zlmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeline(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	got := NewTimeline().
+		Add(start.Add(5*time.Minute), "danger", "Error rate above 5%").
+		Add(start, "info", "Deploy started").
+		Add(start.Add(95*time.Minute), "success", "Rolled back").
+		Build()
+
+	expected := "- ℹ️ <time:2024-01-02T15:00:00Z> Deploy started\n" +
+		"- ❌ <time:2024-01-02T15:05:00Z> (+5m) Error rate above 5%\n" +
+		"- ✅ <time:2024-01-02T16:35:00Z> (+1h 30m) Rolled back\n"
+	if got != expected {
+		t.Errorf("Build() = %q, want %q", got, expected)
+	}
+}
+
+func TestTimeline_Options(t *testing.T) {
+	at := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	got := NewTimeline(WithTheme(PlainTheme)).
+		AddEvent(TimelineEvent{Time: at, Style: "warning", Text: "Disk at 90%"}).
+		Build()
+
+	expected := "- [WARN] <time:2024-01-02T15:00:00Z> Disk at 90%\n"
+	if got != expected {
+		t.Errorf("Build() = %q, want %q", got, expected)
+	}
+
+	if NewTimeline().Build() != "" {
+		t.Error("Expected empty timeline to render empty string")
+	}
+}
@@ -0,0 +1,101 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// todoTask is one task of a TodoBuilder.
+type todoTask struct {
+	name        string
+	description string
+}
+
+// TodoBuilder builds a Zulip /todo widget: a message that Zulip shows as a
+// task list users can check off, unlike the static list of ChecklistItem.
+type TodoBuilder struct {
+	tasks []todoTask
+}
+
+// NewTodo creates a to-do list with no tasks.
+//
+// Returns:
+//   - *TodoBuilder: A new initialized TodoBuilder instance
+//
+// Example:
+//
+//	todo, err := NewTodo().
+//		Task("Write release notes").
+//		TaskWithDescription("Tag release", "after CI is green").
+//		Build()
+//	// todo will be:
+//	// "/todo\nWrite release notes\nTag release: after CI is green"
+func NewTodo() *TodoBuilder {
+	return &TodoBuilder{tasks: []todoTask{}}
+}
+
+// Task appends a task.
+//
+// Parameters:
+//   - name (string): The task name, on a single line
+//
+// Returns:
+//   - *TodoBuilder: The same TodoBuilder instance (for method chaining)
+func (t *TodoBuilder) Task(name string) *TodoBuilder {
+	return t.TaskWithDescription(name, "")
+}
+
+// TaskWithDescription appends a task with a description, which Zulip shows
+// after the name.
+//
+// Parameters:
+//   - name (string): The task name, on a single line
+//   - description (string): The description, on a single line
+//
+// Returns:
+//   - *TodoBuilder: The same TodoBuilder instance (for method chaining)
+func (t *TodoBuilder) TaskWithDescription(name, description string) *TodoBuilder {
+	t.tasks = append(t.tasks, todoTask{name: name, description: description})
+	return t
+}
+
+// Build generates the to-do list message.
+//
+// Returns:
+//   - string: The message, which must be sent on its own: Zulip only turns
+//     messages that start with /todo into to-do lists
+//   - error: Non-nil if a task name is blank or contains ":", which Zulip
+//     reads as the start of the description, or a name or description spans
+//     several lines
+//
+// Notes:
+//   - Tasks always start unchecked; the widget has no syntax for checked
+//     or nested tasks, so use ChecklistItem for a static list with those
+//   - A list without tasks is valid; users add tasks in the widget
+//   - Surrounding whitespace is trimmed from names and descriptions
+func (t *TodoBuilder) Build() (string, error) {
+	var sb strings.Builder
+	sb.WriteString("/todo")
+	for i, task := range t.tasks {
+		name := strings.TrimSpace(task.name)
+		description := strings.TrimSpace(task.description)
+		switch {
+		case name == "":
+			return "", fmt.Errorf("zlmd: todo task %d is empty", i+1)
+		case strings.Contains(name, ":"):
+			return "", fmt.Errorf("zlmd: todo task %q contains \":\"", name)
+		case strings.ContainsAny(name+description, "\r\n"):
+			return "", fmt.Errorf("zlmd: todo task %q spans several lines", name)
+		}
+		sb.WriteString("\n" + name)
+		if description != "" {
+			sb.WriteString(": " + description)
+		}
+	}
+	return sb.String(), nil
+}
+
+// MarshalZulipMarkdown implements Markdowner by returning Build.
+func (t *TodoBuilder) MarshalZulipMarkdown() (string, error) {
+	return t.Build()
+}
@@ -0,0 +1,57 @@
+package zlmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTodoBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name     string
+		todo     *TodoBuilder
+		expected string
+	}{
+		{"Tasks", NewTodo().Task("Write notes").Task("Tag release"), "/todo\nWrite notes\nTag release"},
+		{"Description", NewTodo().TaskWithDescription("Tag release", "after CI"), "/todo\nTag release: after CI"},
+		{"Blank description", NewTodo().TaskWithDescription("Deploy", " "), "/todo\nDeploy"},
+		{"No tasks", NewTodo(), "/todo"},
+		{"Trimmed", NewTodo().TaskWithDescription(" Deploy ", " prod "), "/todo\nDeploy: prod"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.todo.Build()
+			if err != nil {
+				t.Fatalf("Build() error = %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Build() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTodoBuilder_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		todo     *TodoBuilder
+		expected string
+	}{
+		{"Empty task", NewTodo().Task("a").Task(" "), "task 2 is empty"},
+		{"Colon in name", NewTodo().Task("Fix: login"), `contains ":"`},
+		{"Multi-line name", NewTodo().Task("a\nb"), "spans several lines"},
+		{"Multi-line description", NewTodo().TaskWithDescription("a", "b\nc"), "spans several lines"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.todo.Build()
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("Build() error = %v, want it to contain %q", err, tt.expected)
+			}
+			if got != "" {
+				t.Errorf("Build() = %q, want empty on error", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package zlmd
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TreeNode is a node in a hierarchy rendered by Tree.
+type TreeNode struct {
+	Label    string
+	Children []TreeNode
+}
+
+// Count returns the number of nodes in the subtree rooted at n, including n.
+func (n TreeNode) Count() int {
+	count := 1
+	for _, child := range n.Children {
+		count += child.Count()
+	}
+	return count
+}
+
+// Tree renders a hierarchy using box-drawing characters inside a code block.
+//
+// Parameters:
+//   - root (TreeNode): The root node of the hierarchy
+//   - opts (...Option): Optional settings; WithMaxDepth and WithMaxNodes limit the output
+//     and WithLanguage sets the code block language
+//
+// Returns:
+//   - string: The tree wrapped in a fenced code block
+//
+// Example:
+//
+//	root := TreeNode{Label: "cmd", Children: []TreeNode{
+//	  {Label: "zlmd", Children: []TreeNode{{Label: "main.go"}}},
+//	  {Label: "README.md"},
+//	}}
+//	out := Tree(root)
+//	// out will be:
+//	// ```text
+//	// cmd
+//	// ├── zlmd
+//	// │   └── main.go
+//	// └── README.md
+//	// ```
+//
+// Notes:
+//   - Children below the depth limit are collapsed into a "… N more" line
+//   - Once the node limit is reached, the remaining nodes are summarized on a final line
+func Tree(root TreeNode, opts ...Option) string {
+	o := newOptions(opts...)
+
+	r := treeRenderer{opts: o}
+	r.lines = append(r.lines, root.Label)
+	r.seen = 1
+	r.writeChildren(root.Children, "", 1)
+
+	if hidden := root.Count() - r.seen; r.truncated && hidden > 0 {
+		r.lines = append(r.lines, fmt.Sprintf("… %d more", hidden))
+	}
+
+	return CodeBlock(o.language, strings.Join(r.lines, "\n"))
+}
+
+// treeRenderer accumulates the lines of a tree while tracking the limits.
+type treeRenderer struct {
+	opts      options
+	lines     []string
+	seen      int // nodes rendered or already summarized
+	truncated bool
+}
+
+// writeChildren renders children at the given depth below prefix.
+func (r *treeRenderer) writeChildren(children []TreeNode, prefix string, depth int) {
+	for i, child := range children {
+		if r.truncated {
+			return
+		}
+
+		last := i == len(children)-1
+		branch, indent := "├── ", "│   "
+		if last {
+			branch, indent = "└── ", "    "
+		}
+
+		if r.opts.maxDepth > 0 && depth > r.opts.maxDepth {
+			hidden := 0
+			for _, c := range children[i:] {
+				hidden += c.Count()
+			}
+			r.lines = append(r.lines, fmt.Sprintf("%s└── … %d more", prefix, hidden))
+			r.seen += hidden
+			return
+		}
+
+		if r.opts.maxNodes > 0 && r.seen >= r.opts.maxNodes {
+			r.truncated = true
+			return
+		}
+
+		r.lines = append(r.lines, prefix+branch+child.Label)
+		r.seen++
+		r.writeChildren(child.Children, prefix+indent, depth+1)
+	}
+}
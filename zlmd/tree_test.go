@@ -0,0 +1,58 @@
+package zlmd
+
+import (
+	"testing"
+)
+
+func sampleTree() TreeNode {
+	return TreeNode{Label: "cmd", Children: []TreeNode{
+		{Label: "zlmd", Children: []TreeNode{
+			{Label: "main.go"},
+			{Label: "flags.go"},
+		}},
+		{Label: "README.md"},
+	}}
+}
+
+func TestTree(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "Full tree",
+			expected: "```text\ncmd\n├── zlmd\n│   ├── main.go\n│   └── flags.go\n└── README.md\n```",
+		},
+		{
+			name:     "Depth limit",
+			opts:     []Option{WithMaxDepth(1)},
+			expected: "```text\ncmd\n├── zlmd\n│   └── … 2 more\n└── README.md\n```",
+		},
+		{
+			name:     "Node limit",
+			opts:     []Option{WithMaxNodes(3)},
+			expected: "```text\ncmd\n├── zlmd\n│   ├── main.go\n… 2 more\n```",
+		},
+		{
+			name:     "Custom language",
+			opts:     []Option{WithLanguage("")},
+			expected: "```\ncmd\n├── zlmd\n│   ├── main.go\n│   └── flags.go\n└── README.md\n```",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Tree(sampleTree(), tt.opts...)
+			if got != tt.expected {
+				t.Errorf("Tree() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTreeNode_Count(t *testing.T) {
+	if got := sampleTree().Count(); got != 5 {
+		t.Errorf("Count() = %d, want 5", got)
+	}
+}
@@ -0,0 +1,198 @@
+package zlmd
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ServerVersion is a Zulip server release, such as 9.2, that generated
+// markdown has to render on. The zero ServerVersion means the latest
+// release, which supports everything.
+type ServerVersion struct {
+	Major int
+	Minor int
+}
+
+// ParseServerVersion parses a version as Zulip reports it ("9.2",
+// "8.0-dev+git") or as configured by hand ("7", "7.x").
+//
+// Example:
+//
+//	v, err := ParseServerVersion("7.x") // ServerVersion{Major: 7}
+func ParseServerVersion(s string) (ServerVersion, error) {
+	text, _, _ := strings.Cut(strings.TrimPrefix(strings.TrimSpace(s), "v"), "-")
+	major, minor, _ := strings.Cut(text, ".")
+	minor, _, _ = strings.Cut(minor, ".")
+
+	var v ServerVersion
+	var err error
+	if v.Major, err = strconv.Atoi(major); err != nil || v.Major < 1 {
+		return ServerVersion{}, fmt.Errorf("zlmd: invalid server version %q", s)
+	}
+	if minor != "" && minor != "x" {
+		if v.Minor, err = strconv.Atoi(minor); err != nil || v.Minor < 0 {
+			return ServerVersion{}, fmt.Errorf("zlmd: invalid server version %q", s)
+		}
+	}
+	return v, nil
+}
+
+// String returns the version as "major.minor", or "latest" for the zero
+// ServerVersion.
+func (v ServerVersion) String() string {
+	if v == (ServerVersion{}) {
+		return "latest"
+	}
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// Supports reports whether servers of version v render feature f.
+func (v ServerVersion) Supports(f Feature) bool {
+	since := f.Since()
+	return v == (ServerVersion{}) || v.Major > since.Major ||
+		(v.Major == since.Major && v.Minor >= since.Minor)
+}
+
+// Feature is a markdown construct that older Zulip servers don't render.
+type Feature int
+
+const (
+	// FeatureSpoiler is a "```spoiler" block.
+	FeatureSpoiler Feature = iota
+	// FeatureGlobalTime is a "<time:...>" tag, shown in the viewer's time
+	// zone.
+	FeatureGlobalTime
+	// FeatureTopicWildcard is the @**topic** wildcard mention.
+	FeatureTopicWildcard
+	// FeatureChannelWildcard is the @**channel** wildcard mention, which
+	// replaced @**stream**.
+	FeatureChannelWildcard
+	// FeatureMath is a "$$...$$" formula or a "```math" block.
+	FeatureMath
+	// FeatureTimeRange is a range of two "<time:...>" tags joined by a
+	// dash, as written by ZLFormatTimeRange.
+	FeatureTimeRange
+	// FeaturePoll is a /poll widget.
+	FeaturePoll
+	// FeatureTodo is a /todo widget.
+	FeatureTodo
+)
+
+// features describes each Feature.
+var features = []struct {
+	name  string
+	since ServerVersion
+}{
+	FeatureSpoiler:         {"spoiler blocks", ServerVersion{3, 0}},
+	FeatureGlobalTime:      {"<time:> tags", ServerVersion{3, 0}},
+	FeatureTopicWildcard:   {"@**topic** mentions", ServerVersion{8, 0}},
+	FeatureChannelWildcard: {"@**channel** mentions", ServerVersion{9, 0}},
+	FeatureMath:            {"math formulas", ServerVersion{1, 6}},
+	FeatureTimeRange:       {"<time:> ranges", ServerVersion{3, 0}},
+	FeaturePoll:            {"/poll widgets", ServerVersion{2, 0}},
+	FeatureTodo:            {"/todo widgets", ServerVersion{5, 0}},
+}
+
+var (
+	// mathInlinePattern matches an unescaped "$$...$$" formula.
+	mathInlinePattern = regexp.MustCompile(`(^|[^\\$])\$\$(?:\\\$|[^$\n])+\$\$`)
+	// timeRangePattern matches two time tags joined by a dash.
+	timeRangePattern = regexp.MustCompile(`<time:[^>\n]+>\s*[-–—]\s*<time:[^>\n]+>`)
+	// widgetPattern matches the command a widget message starts with; Zulip
+	// only reads the message's first word.
+	widgetPattern = regexp.MustCompile(`^/(poll|todo)(\s|$)`)
+)
+
+// String returns a short description of the feature.
+func (f Feature) String() string {
+	return features[f].name
+}
+
+// Since returns the first server version that renders the feature.
+func (f Feature) Since() ServerVersion {
+	return features[f].since
+}
+
+// WithTargetVersion sets the Zulip server version generated markdown must
+// render on. Builders replace constructs the server can't render with
+// fallbacks, such as a block quote for a spoiler.
+//
+// Example:
+//
+//	v, _ := ParseServerVersion(serverSettings.ZulipVersion)
+//	SetDefaultConfig(Config{TargetVersion: v})
+func WithTargetVersion(v ServerVersion) Option {
+	return func(o *options) {
+		o.targetVersion = v
+	}
+}
+
+// UnsupportedFeatures returns the features used in markdown that servers of
+// version target don't render, in the order of Feature, for linting
+// messages written by hand or by other tools. Spoilers and quotes are
+// searched too, including blocks nested in them.
+//
+// Example:
+//
+//	UnsupportedFeatures("```spoiler Logs\n...\n```", ServerVersion{Major: 2})
+//	// []Feature{FeatureSpoiler}
+func UnsupportedFeatures(markdown string, target ServerVersion) []Feature {
+	used := map[Feature]bool{}
+	for _, block := range nestedCode(markdown) {
+		switch name, _, _ := strings.Cut(block.Language, " "); name {
+		case "spoiler":
+			used[FeatureSpoiler] = true
+		case "math":
+			used[FeatureMath] = true
+		}
+	}
+	for _, line := range proseLines(markdown) {
+		if strings.Contains(line, "<time:") {
+			used[FeatureGlobalTime] = true
+		}
+		if timeRangePattern.MatchString(line) {
+			used[FeatureTimeRange] = true
+		}
+		if mathInlinePattern.MatchString(line) {
+			used[FeatureMath] = true
+		}
+	}
+	for _, m := range ExtractMentions(markdown) {
+		switch {
+		case m.Name == "topic" && m.Wildcard:
+			used[FeatureTopicWildcard] = true
+		case m.Name == "channel" && m.Wildcard:
+			used[FeatureChannelWildcard] = true
+		}
+	}
+	if m := widgetPattern.FindStringSubmatch(markdown); m != nil {
+		if m[1] == "poll" {
+			used[FeaturePoll] = true
+		} else {
+			used[FeatureTodo] = true
+		}
+	}
+
+	var unsupported []Feature
+	for f := range Feature(len(features)) {
+		if used[f] && !target.Supports(f) {
+			unsupported = append(unsupported, f)
+		}
+	}
+	return unsupported
+}
+
+// nestedCode returns the fenced blocks of markdown, followed by those of
+// each spoiler and quote in it.
+func nestedCode(markdown string) []FencedCode {
+	var blocks []FencedCode
+	for _, block := range ExtractCode(markdown) {
+		blocks = append(blocks, block)
+		if isProseBlock(block.Language) {
+			blocks = append(blocks, nestedCode(block.Code)...)
+		}
+	}
+	return blocks
+}
@@ -0,0 +1,143 @@
+package zlmd
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected ServerVersion
+		wantErr  bool
+	}{
+		{"9.2", ServerVersion{9, 2}, false},
+		{"7.x", ServerVersion{7, 0}, false},
+		{"8", ServerVersion{8, 0}, false},
+		{"8.0-dev+git", ServerVersion{8, 0}, false},
+		{"v10.1.2", ServerVersion{10, 1}, false},
+		{"", ServerVersion{}, true},
+		{"latest", ServerVersion{}, true},
+		{"9.beta", ServerVersion{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := ParseServerVersion(tt.input)
+			if (err != nil) != tt.wantErr || got != tt.expected {
+				t.Errorf("ParseServerVersion(%q) = %v, %v, want %v, error %v", tt.input, got, err, tt.expected, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestServerVersion_Supports(t *testing.T) {
+	tests := []struct {
+		version  ServerVersion
+		feature  Feature
+		expected bool
+	}{
+		{ServerVersion{}, FeatureChannelWildcard, true},
+		{ServerVersion{2, 1}, FeatureSpoiler, false},
+		{ServerVersion{3, 0}, FeatureSpoiler, true},
+		{ServerVersion{8, 1}, FeatureTopicWildcard, true},
+		{ServerVersion{8, 4}, FeatureChannelWildcard, false},
+		{ServerVersion{1, 6}, FeatureMath, true},
+		{ServerVersion{1, 9}, FeaturePoll, false},
+		{ServerVersion{4, 4}, FeatureTodo, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.version.Supports(tt.feature); got != tt.expected {
+			t.Errorf("%v.Supports(%v) = %v, want %v", tt.version, tt.feature, got, tt.expected)
+		}
+	}
+}
+
+func TestUnsupportedFeatures(t *testing.T) {
+	markdown := "```spoiler Logs\ntrace\n```\n@**channel** at <time:2024-01-01T00:00:00Z>\n`@**topic**`"
+
+	tests := []struct {
+		name     string
+		target   ServerVersion
+		expected []Feature
+	}{
+		{"Latest", ServerVersion{}, nil},
+		{"8.x", ServerVersion{8, 0}, []Feature{FeatureChannelWildcard}},
+		{"2.1", ServerVersion{2, 1}, []Feature{FeatureSpoiler, FeatureGlobalTime, FeatureChannelWildcard}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnsupportedFeatures(markdown, tt.target); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("UnsupportedFeatures() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUnsupportedFeatures_Detection(t *testing.T) {
+	start := time.Date(2024, 5, 15, 14, 0, 0, 0, time.UTC)
+	poll, _ := NewPoll("Lunch?").Options("pizza", "sushi").Build()
+	todo, _ := NewTodo().Task("ship it").Build()
+
+	tests := []struct {
+		name     string
+		markdown string
+		expected []Feature
+	}{
+		{"Inline math", "energy is " + MathInline("E = mc^2"), []Feature{FeatureMath}},
+		{"Escaped dollar in math", MathInline("cost $5"), []Feature{FeatureMath}},
+		{"Math block", MathBlock(`\int_0^1 x\,dx`), []Feature{FeatureMath}},
+		{"Escaped math", `\$$x$$`, nil},
+		{"Time range", ZLFormatTimeRange(start, start.Add(time.Hour)), []Feature{FeatureGlobalTime, FeatureTimeRange}},
+		{"Poll", poll, []Feature{FeaturePoll}},
+		{"Todo", todo, []Feature{FeatureTodo}},
+		{"Poll not first", "see\n/poll Lunch?", nil},
+		{"Spoiler in quote", "````quote\n```spoiler Logs\ntrace\n```\n````", []Feature{FeatureSpoiler}},
+		{"Math in spoiler", Spoiler("Proof", MathBlock("x^2")), []Feature{FeatureSpoiler, FeatureMath}},
+		{"Time in quote", QuoteReply("Alice", "", "at "+ZLFormatTime(start)), []Feature{FeatureGlobalTime}},
+		{"Mention in spoiler", Spoiler("cc", "@**topic**"), []Feature{FeatureSpoiler, FeatureTopicWildcard}},
+		{"Code in spoiler", "````spoiler\n```\n$$x$$ @**topic**\n```\n````", []Feature{FeatureSpoiler}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := UnsupportedFeatures(tt.markdown, ServerVersion{1, 0}); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("UnsupportedFeatures(%q) = %v, want %v", tt.markdown, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTargetVersion_Fallbacks(t *testing.T) {
+	SetDefaultConfig(Config{TargetVersion: ServerVersion{2, 1}})
+	t.Cleanup(func() { SetDefaultConfig(Config{}) })
+
+	at := time.Date(2024, 5, 15, 16, 30, 0, 0, time.FixedZone("CEST", 2*3600))
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"Spoiler", Spoiler("Logs", "trace"), "> **Logs**\n> trace"},
+		{"AppendSpoiler", string(AppendSpoiler(nil, "", []byte("x"))), "> x"},
+		{"ZLFormatTime", ZLFormatTime(at), "2024-05-15 14:30 UTC"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %q, want %q", tt.got, tt.expected)
+			}
+		})
+	}
+
+	// Options passed to a builder override the default Config.
+	got := NewTimeline(WithTargetVersion(ServerVersion{})).Add(at, "info", "up").Build()
+	if !strings.Contains(got, "<time:2024-05-15T16:30:00+02:00>") {
+		t.Errorf("Timeline.Build() = %q, want a <time:> tag", got)
+	}
+}
@@ -0,0 +1,250 @@
+// Package alertmanager renders Prometheus Alertmanager webhook notifications
+// as Zulip messages: one message per alert group, with a severity badge,
+// firing and resolved counts, the labels the group shares and a line per
+// alert linking to its source, runbook and a pre-filled silence.
+package alertmanager
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+// MaxAlerts is the number of alerts listed in a message before the rest of
+// an alert storm is summarized.
+const MaxAlerts = 15
+
+// Alert is a single alert in a notification.
+type Alert struct {
+	Status       string            `json:"status"` // "firing" or "resolved"
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+// Notification is the payload of an Alertmanager webhook.
+type Notification struct {
+	Version           string            `json:"version"`
+	GroupKey          string            `json:"groupKey"`
+	TruncatedAlerts   int               `json:"truncatedAlerts"`
+	Status            string            `json:"status"`
+	Receiver          string            `json:"receiver"`
+	GroupLabels       map[string]string `json:"groupLabels"`
+	CommonLabels      map[string]string `json:"commonLabels"`
+	CommonAnnotations map[string]string `json:"commonAnnotations"`
+	ExternalURL       string            `json:"externalURL"`
+	Alerts            []Alert           `json:"alerts"`
+}
+
+// severityStyles maps common severity label values to badge styles.
+var severityStyles = map[string]string{
+	"critical": "danger",
+	"error":    "danger",
+	"page":     "danger",
+	"warning":  "warning",
+	"info":     "info",
+	"none":     "info",
+}
+
+// Format renders an Alertmanager webhook payload.
+//
+// Parameters:
+//   - payload ([]byte): The JSON request body
+//
+// Returns:
+//   - webhooks.Message: The topic, named after the group labels, and content
+//   - error: An error if the payload is invalid
+//
+// Example:
+//
+//	msg, err := alertmanager.Format(body)
+//	client.SendStream(ctx, "alerts", msg.Topic, msg.Content)
+func Format(payload []byte) (webhooks.Message, error) {
+	var n Notification
+	if err := json.Unmarshal(payload, &n); err != nil {
+		return webhooks.Message{}, fmt.Errorf("alertmanager: invalid payload: %w", err)
+	}
+	return n.Message(), nil
+}
+
+// Message renders the notification.
+//
+// Example:
+//
+//	// ❌ **[FIRING:2] HighLatency** · 1 resolved
+//	//
+//	// > p99 latency above 2s
+//	//
+//	// | Label | Value |
+//	// | --- | --- |
+//	// | alertname | HighLatency |
+//	// | severity | critical |
+//	//
+//	// - 🔥 `instance=web-1` since <time:2024-03-01T09:00:00Z> · [source](...) · [silence](...)
+//	// - ...
+//
+// Notes:
+//   - Firing alerts are listed before resolved ones; after MaxAlerts the
+//     remainder, including alerts Alertmanager itself truncated, is counted
+func (n *Notification) Message() webhooks.Message {
+	var firing, resolved []Alert
+	for _, a := range n.Alerts {
+		if a.Status == "resolved" {
+			resolved = append(resolved, a)
+		} else {
+			firing = append(firing, a)
+		}
+	}
+
+	name := n.CommonLabels["alertname"]
+	if name == "" {
+		name = n.GroupLabels["alertname"]
+	}
+	if name == "" {
+		name = "Alerts"
+	}
+
+	var sb strings.Builder
+	style := "success"
+	if len(firing) > 0 {
+		style = severityStyles[strings.ToLower(n.CommonLabels["severity"])]
+		if style == "" {
+			style = "warning"
+		}
+	}
+	sb.WriteString(zlmd.DefaultTheme.Prefix(style) + " ")
+	if len(firing) > 0 {
+		zlmd.WriteBold(&sb, fmt.Sprintf("[FIRING:%d] %s", len(firing)+n.TruncatedAlerts, webhooks.Inline(name)))
+		if len(resolved) > 0 {
+			sb.WriteString(fmt.Sprintf(" · %d resolved", len(resolved)))
+		}
+	} else {
+		zlmd.WriteBold(&sb, fmt.Sprintf("[RESOLVED:%d] %s", len(resolved), webhooks.Inline(name)))
+	}
+	sb.WriteString("\n")
+
+	if summary := firstNonEmpty(n.CommonAnnotations, "summary", "description", "message"); summary != "" {
+		sb.WriteString("\n" + webhooks.Excerpt(summary, 500) + "\n")
+	}
+	if len(n.CommonLabels) > 0 {
+		table := zlmd.NewTableBuilder().WithHeaders("Label", "Value")
+		for _, k := range sortedKeys(n.CommonLabels) {
+			table.AddRow(webhooks.Inline(k), webhooks.Inline(n.CommonLabels[k]))
+		}
+		sb.WriteString("\n" + table.Build())
+	}
+	if link := firstNonEmpty(n.CommonAnnotations, "runbook_url", "runbook"); link != "" {
+		sb.WriteString("\n📖 " + zlmd.Link("Runbook", link) + "\n")
+	}
+
+	sb.WriteString("\n")
+	alerts := append(firing, resolved...)
+	for i, a := range alerts {
+		if i == MaxAlerts {
+			break
+		}
+		zlmd.WriteListItem(&sb, n.alertLine(a), 0)
+	}
+	if more := len(alerts) - min(len(alerts), MaxAlerts) + n.TruncatedAlerts; more > 0 {
+		zlmd.WriteListItem(&sb, zlmd.Italic(fmt.Sprintf("and %s", webhooks.Plural(more, "more alert"))), 0)
+	}
+
+	return webhooks.Message{Topic: n.topic(name), Content: strings.TrimSuffix(sb.String(), "\n")}
+}
+
+// alertLine renders one alert: the labels that set it apart from the group,
+// when it started or ended and links to act on it.
+func (n *Notification) alertLine(a Alert) string {
+	var labels []string
+	for _, k := range sortedKeys(a.Labels) {
+		if _, common := n.CommonLabels[k]; !common {
+			labels = append(labels, k+"="+a.Labels[k])
+		}
+	}
+
+	var head, parts []string
+	if len(labels) > 0 {
+		head = append(head, zlmd.Code(strings.Join(labels, " ")))
+	}
+	if a.Status == "resolved" && !a.EndsAt.IsZero() {
+		head = append(head, "resolved "+zlmd.ZLFormatTime(a.EndsAt))
+	} else if !a.StartsAt.IsZero() {
+		head = append(head, "since "+zlmd.ZLFormatTime(a.StartsAt))
+	}
+	if len(head) > 0 {
+		parts = append(parts, strings.Join(head, " "))
+	}
+
+	if _, common := n.CommonAnnotations["summary"]; !common {
+		if s := firstNonEmpty(a.Annotations, "summary", "description"); s != "" {
+			parts = append(parts, webhooks.Inline(s))
+		}
+	}
+	if a.GeneratorURL != "" {
+		parts = append(parts, zlmd.Link("source", a.GeneratorURL))
+	}
+	if _, common := n.CommonAnnotations["runbook_url"]; !common {
+		if link := firstNonEmpty(a.Annotations, "runbook_url", "runbook"); link != "" {
+			parts = append(parts, zlmd.Link("runbook", link))
+		}
+	}
+	if a.Status != "resolved" && n.ExternalURL != "" {
+		parts = append(parts, zlmd.Link("silence", silenceURL(n.ExternalURL, a.Labels)))
+	}
+	icon := "🔥"
+	if a.Status == "resolved" {
+		icon = zlmd.DefaultTheme.Prefix("success")
+	}
+	return strings.TrimSpace(icon + " " + strings.Join(parts, " · "))
+}
+
+// topic names the message after the group labels, alertname first.
+func (n *Notification) topic(name string) string {
+	parts := []string{name}
+	for _, k := range sortedKeys(n.GroupLabels) {
+		if k != "alertname" {
+			parts = append(parts, n.GroupLabels[k])
+		}
+	}
+	return webhooks.Topic(parts...)
+}
+
+// silenceURL returns the Alertmanager UI link that opens a new silence
+// matching labels.
+func silenceURL(externalURL string, labels map[string]string) string {
+	var matchers []string
+	for _, k := range sortedKeys(labels) {
+		matchers = append(matchers, fmt.Sprintf("%s=%q", k, labels[k]))
+	}
+	filter := "{" + strings.Join(matchers, ",") + "}"
+	return strings.TrimRight(externalURL, "/") + "/#/silences/new?filter=" + url.QueryEscape(filter)
+}
+
+// firstNonEmpty returns the first non-blank value in m among keys.
+func firstNonEmpty(m map[string]string, keys ...string) string {
+	for _, k := range keys {
+		if v := strings.TrimSpace(m[k]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// sortedKeys returns the keys of m in order.
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,83 @@
+package alertmanager
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const firingPayload = `{
+  "status": "firing",
+  "groupLabels": {"alertname": "HighLatency", "service": "api"},
+  "commonLabels": {"alertname": "HighLatency", "service": "api", "severity": "critical"},
+  "commonAnnotations": {"summary": "p99 latency above 2s", "runbook_url": "https://runbooks.example.com/latency"},
+  "externalURL": "https://am.example.com",
+  "alerts": [
+    {"status": "resolved", "labels": {"alertname": "HighLatency", "service": "api", "severity": "critical", "instance": "web-2"},
+     "startsAt": "2024-03-01T08:00:00Z", "endsAt": "2024-03-01T08:30:00Z"},
+    {"status": "firing", "labels": {"alertname": "HighLatency", "service": "api", "severity": "critical", "instance": "web-1"},
+     "startsAt": "2024-03-01T09:00:00Z", "generatorURL": "https://prom.example.com/graph?g0.expr=x"}
+  ]
+}`
+
+func TestFormat(t *testing.T) {
+	got, err := Format([]byte(firingPayload))
+	if err != nil {
+		t.Fatalf("Format() returned error: %v", err)
+	}
+
+	if got.Topic != "HighLatency / api" {
+		t.Errorf("Topic = %q, want %q", got.Topic, "HighLatency / api")
+	}
+	expected := "❌ **[FIRING:1] HighLatency** · 1 resolved\n\n" +
+		"> p99 latency above 2s\n\n" +
+		"| Label | Value |\n| --- | --- |\n| alertname | HighLatency |\n| service | api |\n| severity | critical |\n\n" +
+		"📖 [Runbook](https://runbooks.example.com/latency)\n\n" +
+		"- 🔥 `instance=web-1` since <time:2024-03-01T09:00:00Z> · [source](https://prom.example.com/graph?g0.expr=x) · " +
+		"[silence](https://am.example.com/#/silences/new?filter=%7Balertname%3D%22HighLatency%22%2Cinstance%3D%22web-1%22%2Cservice%3D%22api%22%2Cseverity%3D%22critical%22%7D)\n" +
+		"- ✅ `instance=web-2` resolved <time:2024-03-01T08:30:00Z>"
+	if got.Content != expected {
+		t.Errorf("Content = %q, want %q", got.Content, expected)
+	}
+}
+
+func TestFormat_Resolved(t *testing.T) {
+	payload := `{"status":"resolved","groupLabels":{"alertname":"DiskFull"},"commonLabels":{"alertname":"DiskFull","instance":"db-1"},` +
+		`"alerts":[{"status":"resolved","labels":{"alertname":"DiskFull","instance":"db-1"},"annotations":{"summary":"Disk 95% full"}}]}`
+	got, err := Format([]byte(payload))
+	if err != nil {
+		t.Fatalf("Format() returned error: %v", err)
+	}
+	expected := "✅ **[RESOLVED:1] DiskFull**\n\n| Label | Value |\n| --- | --- |\n| alertname | DiskFull |\n| instance | db-1 |\n\n- ✅ Disk 95% full"
+	if got.Topic != "DiskFull" || got.Content != expected {
+		t.Errorf("Format() = %+q, want content %q", got, expected)
+	}
+}
+
+func TestFormat_Storm(t *testing.T) {
+	var alerts []string
+	for i := range MaxAlerts + 5 {
+		alerts = append(alerts, fmt.Sprintf(`{"status":"firing","labels":{"alertname":"Down","instance":"host-%02d"}}`, i))
+	}
+	payload := `{"truncatedAlerts":10,"commonLabels":{"alertname":"Down","severity":"warning"},"alerts":[` + strings.Join(alerts, ",") + `]}`
+
+	got, err := Format([]byte(payload))
+	if err != nil {
+		t.Fatalf("Format() returned error: %v", err)
+	}
+	if !strings.HasPrefix(got.Content, "⚠️ **[FIRING:30] Down**") {
+		t.Errorf("Unexpected header: %q", got.Content)
+	}
+	if n := strings.Count(got.Content, "- 🔥"); n != MaxAlerts {
+		t.Errorf("Expected %d alerts listed, got %d", MaxAlerts, n)
+	}
+	if !strings.HasSuffix(got.Content, "- *and 15 more alerts*") {
+		t.Errorf("Expected overflow summary, got %q", got.Content)
+	}
+}
+
+func TestFormat_Invalid(t *testing.T) {
+	if _, err := Format([]byte(`[`)); err == nil {
+		t.Error("Expected an error for an invalid payload")
+	}
+}
@@ -0,0 +1,390 @@
+// Package github renders GitHub webhook deliveries as Zulip messages.
+//
+// Messages use one topic per repository for pushes and releases, and one per
+// pull request, issue or workflow so related events stay together. Text
+// taken from the payload is escaped so titles and descriptions can't
+// mention anyone in Zulip.
+package github
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+// maxExcerpt is the length descriptions and review bodies are cut to.
+const maxExcerpt = 500
+
+type user struct {
+	Login   string `json:"login"`
+	HTMLURL string `json:"html_url"`
+}
+
+type repository struct {
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	HTMLURL  string `json:"html_url"`
+}
+
+type pushEvent struct {
+	Ref        string            `json:"ref"`
+	Compare    string            `json:"compare"`
+	Created    bool              `json:"created"`
+	Deleted    bool              `json:"deleted"`
+	Forced     bool              `json:"forced"`
+	Commits    []webhooks.Commit `json:"commits"`
+	Repository repository        `json:"repository"`
+	Sender     user              `json:"sender"`
+}
+
+type branch struct {
+	Ref string `json:"ref"`
+}
+
+type pullRequest struct {
+	Number       int    `json:"number"`
+	Title        string `json:"title"`
+	Body         string `json:"body"`
+	HTMLURL      string `json:"html_url"`
+	Merged       bool   `json:"merged"`
+	Head         branch `json:"head"`
+	Base         branch `json:"base"`
+	Commits      int    `json:"commits"`
+	ChangedFiles int    `json:"changed_files"`
+	Additions    int    `json:"additions"`
+	Deletions    int    `json:"deletions"`
+}
+
+type pullRequestEvent struct {
+	Action      string      `json:"action"`
+	PullRequest pullRequest `json:"pull_request"`
+	Repository  repository  `json:"repository"`
+	Sender      user        `json:"sender"`
+}
+
+type review struct {
+	State   string `json:"state"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
+type reviewEvent struct {
+	Action      string      `json:"action"`
+	Review      review      `json:"review"`
+	PullRequest pullRequest `json:"pull_request"`
+	Repository  repository  `json:"repository"`
+	Sender      user        `json:"sender"`
+}
+
+type issue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	Body    string `json:"body"`
+	HTMLURL string `json:"html_url"`
+}
+
+type issuesEvent struct {
+	Action     string     `json:"action"`
+	Issue      issue      `json:"issue"`
+	Repository repository `json:"repository"`
+	Sender     user       `json:"sender"`
+}
+
+type releaseEvent struct {
+	Action  string `json:"action"`
+	Release struct {
+		TagName    string `json:"tag_name"`
+		Name       string `json:"name"`
+		Body       string `json:"body"`
+		HTMLURL    string `json:"html_url"`
+		Prerelease bool   `json:"prerelease"`
+	} `json:"release"`
+	Repository repository `json:"repository"`
+	Sender     user       `json:"sender"`
+}
+
+type workflowRunEvent struct {
+	Action      string `json:"action"`
+	WorkflowRun struct {
+		Name       string `json:"name"`
+		RunNumber  int    `json:"run_number"`
+		HeadBranch string `json:"head_branch"`
+		HeadSHA    string `json:"head_sha"`
+		Status     string `json:"status"`
+		Conclusion string `json:"conclusion"`
+		HTMLURL    string `json:"html_url"`
+	} `json:"workflow_run"`
+	Repository repository `json:"repository"`
+	Sender     user       `json:"sender"`
+}
+
+// Format renders a GitHub webhook delivery.
+//
+// Parameters:
+//   - event (string): The X-GitHub-Event header, such as "push" or "pull_request"
+//   - payload ([]byte): The JSON request body
+//
+// Returns:
+//   - webhooks.Message: The topic and content to post
+//   - error: webhooks.ErrUnsupportedEvent for events and actions that aren't
+//     rendered, or an error if the payload is invalid
+//
+// Example:
+//
+//	msg, err := github.Format(r.Header.Get("X-GitHub-Event"), body)
+//	if errors.Is(err, webhooks.ErrUnsupportedEvent) {
+//		return // acknowledge without posting
+//	}
+//	client.SendStream(ctx, "github", msg.Topic, msg.Content)
+//
+// Notes:
+//   - Supported events are push, pull_request, pull_request_review, issues,
+//     release and workflow_run; workflow runs are only reported once completed
+func Format(event string, payload []byte) (webhooks.Message, error) {
+	var format func([]byte) (webhooks.Message, error)
+	switch event {
+	case "push":
+		format = formatPush
+	case "pull_request":
+		format = formatPullRequest
+	case "pull_request_review":
+		format = formatReview
+	case "issues":
+		format = formatIssue
+	case "release":
+		format = formatRelease
+	case "workflow_run":
+		format = formatWorkflowRun
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: %q", webhooks.ErrUnsupportedEvent, event)
+	}
+	return format(payload)
+}
+
+// decode unmarshals payload into v, naming the event in errors.
+func decode(event string, payload []byte, v any) error {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("github: invalid %s payload: %w", event, err)
+	}
+	return nil
+}
+
+func formatPush(payload []byte) (webhooks.Message, error) {
+	var e pushEvent
+	if err := decode("push", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	name, isTag := strings.CutPrefix(e.Ref, "refs/tags/")
+	if !isTag {
+		name = strings.TrimPrefix(e.Ref, "refs/heads/")
+	}
+	ref := refLink(e.Repository, name)
+	kind := "branch"
+	if isTag {
+		kind = "tag"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(userLink(e.Sender) + " ")
+	switch {
+	case e.Deleted:
+		sb.WriteString(fmt.Sprintf("deleted %s %s.", kind, zlmd.Code(name)))
+		return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name), Content: sb.String()}, nil
+	case e.Created && isTag:
+		sb.WriteString(fmt.Sprintf("pushed tag %s.", ref))
+		return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name), Content: sb.String()}, nil
+	case e.Created:
+		sb.WriteString(fmt.Sprintf("created branch %s", ref))
+	case e.Forced:
+		sb.WriteString(fmt.Sprintf("force-pushed %s", ref))
+	default:
+		sb.WriteString(fmt.Sprintf("pushed %s to %s", zlmd.Link(webhooks.Plural(len(e.Commits), "commit"), e.Compare), ref))
+	}
+	if len(e.Commits) == 0 {
+		sb.WriteString(".")
+		return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name), Content: sb.String()}, nil
+	}
+	sb.WriteString(":\n")
+	sb.WriteString(webhooks.CommitList(e.Commits, len(e.Commits)))
+	return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name), Content: strings.TrimSuffix(sb.String(), "\n")}, nil
+}
+
+func formatPullRequest(payload []byte) (webhooks.Message, error) {
+	var e pullRequestEvent
+	if err := decode("pull_request", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	pr := e.PullRequest
+
+	var verb string
+	switch e.Action {
+	case "opened", "reopened":
+		verb = e.Action
+	case "closed":
+		verb = "closed"
+		if pr.Merged {
+			verb = "merged"
+		}
+	case "ready_for_review":
+		verb = "marked ready for review"
+	case "converted_to_draft":
+		verb = "converted to draft"
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: pull_request %q", webhooks.ErrUnsupportedEvent, e.Action)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s %s", userLink(e.Sender), verb, zlmd.Link(fmt.Sprintf("PR #%d", pr.Number), pr.HTMLURL)))
+	switch e.Action {
+	case "opened":
+		sb.WriteString(fmt.Sprintf(" from %s to %s", refLink(e.Repository, pr.Head.Ref), refLink(e.Repository, pr.Base.Ref)))
+	case "closed":
+		if pr.Merged {
+			sb.WriteString(" into " + refLink(e.Repository, pr.Base.Ref))
+		}
+	}
+	sb.WriteString(": " + zlmd.Bold(webhooks.Inline(pr.Title)))
+
+	if e.Action == "opened" || e.Action == "ready_for_review" {
+		sb.WriteString("\n\n")
+		sb.WriteString(strings.TrimSuffix(diffStats(pr), "\n"))
+		if body := webhooks.Excerpt(pr.Body, maxExcerpt); body != "" {
+			sb.WriteString("\n\n" + body)
+		}
+	}
+	return webhooks.Message{Topic: prTopic(e.Repository, pr), Content: sb.String()}, nil
+}
+
+func formatReview(payload []byte) (webhooks.Message, error) {
+	var e reviewEvent
+	if err := decode("pull_request_review", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	if e.Action != "submitted" {
+		return webhooks.Message{}, fmt.Errorf("%w: pull_request_review %q", webhooks.ErrUnsupportedEvent, e.Action)
+	}
+
+	var verb string
+	switch strings.ToLower(e.Review.State) {
+	case "approved":
+		verb = "✅ approved"
+	case "changes_requested":
+		verb = "🔁 requested changes on"
+	default:
+		verb = "💬 reviewed"
+	}
+	content := fmt.Sprintf("%s %s %s", userLink(e.Sender), verb, zlmd.Link(fmt.Sprintf("PR #%d", e.PullRequest.Number), e.Review.HTMLURL))
+	if body := webhooks.Excerpt(e.Review.Body, maxExcerpt); body != "" {
+		content += "\n\n" + body
+	}
+	return webhooks.Message{Topic: prTopic(e.Repository, e.PullRequest), Content: content}, nil
+}
+
+func formatIssue(payload []byte) (webhooks.Message, error) {
+	var e issuesEvent
+	if err := decode("issues", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	switch e.Action {
+	case "opened", "closed", "reopened":
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: issues %q", webhooks.ErrUnsupportedEvent, e.Action)
+	}
+
+	content := fmt.Sprintf("%s %s %s: %s", userLink(e.Sender), e.Action,
+		zlmd.Link(fmt.Sprintf("issue #%d", e.Issue.Number), e.Issue.HTMLURL), zlmd.Bold(webhooks.Inline(e.Issue.Title)))
+	if e.Action == "opened" {
+		if body := webhooks.Excerpt(e.Issue.Body, maxExcerpt); body != "" {
+			content += "\n\n" + body
+		}
+	}
+	topic := webhooks.Topic(e.Repository.Name, fmt.Sprintf("issue #%d %s", e.Issue.Number, e.Issue.Title))
+	return webhooks.Message{Topic: topic, Content: content}, nil
+}
+
+func formatRelease(payload []byte) (webhooks.Message, error) {
+	var e releaseEvent
+	if err := decode("release", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	if e.Action != "published" {
+		return webhooks.Message{}, fmt.Errorf("%w: release %q", webhooks.ErrUnsupportedEvent, e.Action)
+	}
+
+	r := e.Release
+	name := r.TagName
+	if r.Name != "" && r.Name != r.TagName {
+		name = r.Name + " (" + r.TagName + ")"
+	}
+	kind := "release"
+	if r.Prerelease {
+		kind = "pre-release"
+	}
+	content := fmt.Sprintf("🚀 %s published %s %s", userLink(e.Sender), kind, zlmd.Link(webhooks.Inline(name), r.HTMLURL))
+	if body := webhooks.Excerpt(r.Body, maxExcerpt); body != "" {
+		content += "\n\n" + body
+	}
+	return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name, "releases"), Content: content}, nil
+}
+
+func formatWorkflowRun(payload []byte) (webhooks.Message, error) {
+	var e workflowRunEvent
+	if err := decode("workflow_run", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	run := e.WorkflowRun
+	if e.Action != "completed" {
+		return webhooks.Message{}, fmt.Errorf("%w: workflow_run %q", webhooks.ErrUnsupportedEvent, e.Action)
+	}
+
+	var outcome string
+	switch run.Conclusion {
+	case "success":
+		outcome = "✅ succeeded"
+	case "failure", "startup_failure":
+		outcome = "❌ failed"
+	case "timed_out":
+		outcome = "⏱️ timed out"
+	case "cancelled":
+		outcome = "🚫 was cancelled"
+	default:
+		outcome = "⚪ finished (" + run.Conclusion + ")"
+	}
+	content := fmt.Sprintf("Workflow %s %s on %s at %s: %s",
+		zlmd.Bold(webhooks.Inline(run.Name)), outcome, refLink(e.Repository, run.HeadBranch),
+		zlmd.Link(zlmd.Code(webhooks.ShortSHA(run.HeadSHA)), e.Repository.HTMLURL+"/commit/"+run.HeadSHA),
+		zlmd.Link(fmt.Sprintf("run #%d", run.RunNumber), run.HTMLURL))
+	return webhooks.Message{Topic: webhooks.Topic(e.Repository.Name, run.Name), Content: content}, nil
+}
+
+// diffStats renders a pull request's size as a one-row table.
+func diffStats(pr pullRequest) string {
+	return zlmd.NewTableBuilder().
+		WithHeaders("Commits", "Files", "Additions", "Deletions").
+		SetAlignments(zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight).
+		AddRow(strconv.Itoa(pr.Commits), strconv.Itoa(pr.ChangedFiles), "+"+strconv.Itoa(pr.Additions), "-"+strconv.Itoa(pr.Deletions)).
+		Build()
+}
+
+// prTopic returns the topic shared by all events of a pull request.
+func prTopic(repo repository, pr pullRequest) string {
+	return webhooks.Topic(repo.Name, fmt.Sprintf("PR #%d %s", pr.Number, pr.Title))
+}
+
+// userLink links to a GitHub profile. The login is shown without an "@" so
+// it can't be mistaken for a Zulip mention.
+func userLink(u user) string {
+	if u.HTMLURL == "" {
+		return zlmd.Bold(webhooks.Inline(u.Login))
+	}
+	return zlmd.Link(webhooks.Inline(u.Login), u.HTMLURL)
+}
+
+// refLink links a branch or tag name to its tree on GitHub.
+func refLink(repo repository, ref string) string {
+	return zlmd.Link(zlmd.Code(ref), repo.HTMLURL+"/tree/"+ref)
+}
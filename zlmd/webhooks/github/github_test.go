@@ -0,0 +1,110 @@
+package github
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+const repo = `"repository":{"name":"api","full_name":"acme/api","html_url":"https://github.com/acme/api"},` +
+	`"sender":{"login":"octocat","html_url":"https://github.com/octocat"}`
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    string
+		payload  string
+		expected webhooks.Message
+	}{
+		{
+			"Push", "push",
+			`{"ref":"refs/heads/main","compare":"https://github.com/acme/api/compare/a...b","commits":[` +
+				`{"id":"0123456789abcdef","message":"Fix retries\n\nLong body","url":"https://github.com/acme/api/commit/0123456","added":["a.go"],"modified":["b.go","c.go"]},` +
+				`{"id":"fedcba9876543210","message":"Ping @**all**","url":"https://github.com/acme/api/commit/fedcba9","removed":["d.go"]}],` + repo + `}`,
+			webhooks.Message{Topic: "api", Content: "[octocat](https://github.com/octocat) pushed [2 commits](https://github.com/acme/api/compare/a...b) to [`main`](https://github.com/acme/api/tree/main):\n" +
+				"- [`0123456`](https://github.com/acme/api/commit/0123456) Fix retries\n" +
+				"- [`fedcba9`](https://github.com/acme/api/commit/fedcba9) Ping @\\*\\*all\\*\\*\n\n" +
+				"| Files added | Files modified | Files removed |\n| ---: | ---: | ---: |\n| 1 | 2 | 1 |"},
+		},
+		{
+			"Branch deleted", "push",
+			`{"ref":"refs/heads/old","deleted":true,` + repo + `}`,
+			webhooks.Message{Topic: "api", Content: "[octocat](https://github.com/octocat) deleted branch `old`."},
+		},
+		{
+			"Tag pushed", "push",
+			`{"ref":"refs/tags/v1.0","created":true,` + repo + `}`,
+			webhooks.Message{Topic: "api", Content: "[octocat](https://github.com/octocat) pushed tag [`v1.0`](https://github.com/acme/api/tree/v1.0)."},
+		},
+		{
+			"PR opened", "pull_request",
+			`{"action":"opened","pull_request":{"number":12,"title":"Fix retries","body":"Closes #3","html_url":"https://github.com/acme/api/pull/12",` +
+				`"head":{"ref":"fix"},"base":{"ref":"main"},"commits":2,"changed_files":3,"additions":40,"deletions":7},` + repo + `}`,
+			webhooks.Message{Topic: "api / PR #12 Fix retries", Content: "[octocat](https://github.com/octocat) opened [PR #12](https://github.com/acme/api/pull/12) " +
+				"from [`fix`](https://github.com/acme/api/tree/fix) to [`main`](https://github.com/acme/api/tree/main): **Fix retries**\n\n" +
+				"| Commits | Files | Additions | Deletions |\n| ---: | ---: | ---: | ---: |\n| 2 | 3 | +40 | -7 |\n\n> Closes #3"},
+		},
+		{
+			"PR merged", "pull_request",
+			`{"action":"closed","pull_request":{"number":12,"title":"Fix retries","html_url":"https://github.com/acme/api/pull/12","merged":true,"base":{"ref":"main"}},` + repo + `}`,
+			webhooks.Message{Topic: "api / PR #12 Fix retries", Content: "[octocat](https://github.com/octocat) merged [PR #12](https://github.com/acme/api/pull/12) into [`main`](https://github.com/acme/api/tree/main): **Fix retries**"},
+		},
+		{
+			"Review", "pull_request_review",
+			`{"action":"submitted","review":{"state":"changes_requested","body":"Needs tests","html_url":"https://github.com/acme/api/pull/12#r1"},"pull_request":{"number":12,"title":"Fix retries"},` + repo + `}`,
+			webhooks.Message{Topic: "api / PR #12 Fix retries", Content: "[octocat](https://github.com/octocat) 🔁 requested changes on [PR #12](https://github.com/acme/api/pull/12#r1)\n\n> Needs tests"},
+		},
+		{
+			"Issue", "issues",
+			`{"action":"opened","issue":{"number":3,"title":"Crash on @**everyone**","body":"","html_url":"https://github.com/acme/api/issues/3"},` + repo + `}`,
+			webhooks.Message{Topic: "api / issue #3 Crash on @**everyone**", Content: "[octocat](https://github.com/octocat) opened [issue #3](https://github.com/acme/api/issues/3): **Crash on @\\*\\*everyone\\*\\***"},
+		},
+		{
+			"Release", "release",
+			`{"action":"published","release":{"tag_name":"v1.2.0","name":"Spring","body":"- faster","html_url":"https://github.com/acme/api/releases/v1.2.0"},` + repo + `}`,
+			webhooks.Message{Topic: "api / releases", Content: "🚀 [octocat](https://github.com/octocat) published release [Spring (v1.2.0)](https://github.com/acme/api/releases/v1.2.0)\n\n> - faster"},
+		},
+		{
+			"Workflow run", "workflow_run",
+			`{"action":"completed","workflow_run":{"name":"CI","run_number":42,"head_branch":"main","head_sha":"0123456789","conclusion":"failure","html_url":"https://github.com/acme/api/actions/runs/9"},` + repo + `}`,
+			webhooks.Message{Topic: "api / CI", Content: "Workflow **CI** ❌ failed on [`main`](https://github.com/acme/api/tree/main) at [`0123456`](https://github.com/acme/api/commit/0123456789): [run #42](https://github.com/acme/api/actions/runs/9)"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.event, []byte(tt.payload))
+			if err != nil {
+				t.Fatalf("Format() returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Format() = %+q, want %+q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormat_Unsupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+	}{
+		{"Event", "star", `{}`},
+		{"PR action", "pull_request", `{"action":"labeled"}`},
+		{"Workflow in progress", "workflow_run", `{"action":"requested"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Format(tt.event, []byte(tt.payload)); !errors.Is(err, webhooks.ErrUnsupportedEvent) {
+				t.Errorf("Format() error = %v, want %v", err, webhooks.ErrUnsupportedEvent)
+			}
+		})
+	}
+
+	if _, err := Format("push", []byte(`{`)); err == nil || errors.Is(err, webhooks.ErrUnsupportedEvent) {
+		t.Errorf("Expected an invalid payload error, got %v", err)
+	}
+}
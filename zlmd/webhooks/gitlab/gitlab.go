@@ -0,0 +1,296 @@
+// Package gitlab renders GitLab webhook deliveries as Zulip messages, with
+// the same layout and topics as the github package so organizations using
+// both get consistent notifications.
+package gitlab
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+// maxExcerpt is the length merge request descriptions are cut to.
+const maxExcerpt = 500
+
+// zeroSHA is the before or after commit of a push that creates or deletes a ref.
+const zeroSHA = "0000000000000000000000000000000000000000"
+
+type project struct {
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	WebURL            string `json:"web_url"`
+}
+
+type user struct {
+	Name     string `json:"name"`
+	Username string `json:"username"`
+}
+
+type pushEvent struct {
+	ObjectKind        string            `json:"object_kind"`
+	Ref               string            `json:"ref"`
+	Before            string            `json:"before"`
+	After             string            `json:"after"`
+	UserUsername      string            `json:"user_username"`
+	Commits           []webhooks.Commit `json:"commits"`
+	TotalCommitsCount int               `json:"total_commits_count"`
+	Project           project           `json:"project"`
+}
+
+type mergeRequestEvent struct {
+	User             user    `json:"user"`
+	Project          project `json:"project"`
+	ObjectAttributes struct {
+		IID          int    `json:"iid"`
+		Title        string `json:"title"`
+		Description  string `json:"description"`
+		URL          string `json:"url"`
+		SourceBranch string `json:"source_branch"`
+		TargetBranch string `json:"target_branch"`
+		Action       string `json:"action"`
+	} `json:"object_attributes"`
+}
+
+type pipelineEvent struct {
+	User             user    `json:"user"`
+	Project          project `json:"project"`
+	ObjectAttributes struct {
+		ID       int    `json:"id"`
+		Ref      string `json:"ref"`
+		SHA      string `json:"sha"`
+		Status   string `json:"status"`
+		Duration int    `json:"duration"`
+		URL      string `json:"url"`
+	} `json:"object_attributes"`
+	Builds []struct {
+		Name   string `json:"name"`
+		Stage  string `json:"stage"`
+		Status string `json:"status"`
+	} `json:"builds"`
+}
+
+type deploymentEvent struct {
+	Status        string  `json:"status"`
+	DeployableURL string  `json:"deployable_url"`
+	Environment   string  `json:"environment"`
+	Ref           string  `json:"ref"`
+	ShortSHA      string  `json:"short_sha"`
+	CommitURL     string  `json:"commit_url"`
+	User          user    `json:"user"`
+	Project       project `json:"project"`
+}
+
+// Format renders a GitLab webhook delivery.
+//
+// Parameters:
+//   - event (string): The X-Gitlab-Event header, such as "Push Hook"
+//   - payload ([]byte): The JSON request body
+//
+// Returns:
+//   - webhooks.Message: The topic and content to post
+//   - error: webhooks.ErrUnsupportedEvent for events and actions that aren't
+//     rendered, or an error if the payload is invalid
+//
+// Example:
+//
+//	msg, err := gitlab.Format(r.Header.Get("X-Gitlab-Event"), body)
+//
+// Notes:
+//   - Supported events are Push Hook, Tag Push Hook, Merge Request Hook,
+//     Pipeline Hook and Deployment Hook; pipelines and deployments are only
+//     reported once they finish
+func Format(event string, payload []byte) (webhooks.Message, error) {
+	var format func([]byte) (webhooks.Message, error)
+	switch event {
+	case "Push Hook", "Tag Push Hook":
+		format = formatPush
+	case "Merge Request Hook":
+		format = formatMergeRequest
+	case "Pipeline Hook":
+		format = formatPipeline
+	case "Deployment Hook":
+		format = formatDeployment
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: %q", webhooks.ErrUnsupportedEvent, event)
+	}
+	return format(payload)
+}
+
+// decode unmarshals payload into v, naming the event in errors.
+func decode(event string, payload []byte, v any) error {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return fmt.Errorf("gitlab: invalid %s payload: %w", event, err)
+	}
+	return nil
+}
+
+func formatPush(payload []byte) (webhooks.Message, error) {
+	var e pushEvent
+	if err := decode("push", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	name, isTag := strings.CutPrefix(e.Ref, "refs/tags/")
+	if !isTag {
+		name = strings.TrimPrefix(e.Ref, "refs/heads/")
+	}
+	kind := "branch"
+	if isTag {
+		kind = "tag"
+	}
+	ref := refLink(e.Project, name)
+	topic := webhooks.Topic(e.Project.Name)
+
+	var sb strings.Builder
+	sb.WriteString(userLink(e.Project, e.UserUsername) + " ")
+	switch {
+	case e.After == zeroSHA:
+		sb.WriteString(fmt.Sprintf("deleted %s %s.", kind, zlmd.Code(name)))
+		return webhooks.Message{Topic: topic, Content: sb.String()}, nil
+	case e.Before == zeroSHA && isTag:
+		sb.WriteString(fmt.Sprintf("pushed tag %s.", ref))
+		return webhooks.Message{Topic: topic, Content: sb.String()}, nil
+	case e.Before == zeroSHA:
+		sb.WriteString(fmt.Sprintf("created branch %s", ref))
+	default:
+		compare := e.Project.WebURL + "/-/compare/" + e.Before + "..." + e.After
+		total := max(e.TotalCommitsCount, len(e.Commits))
+		sb.WriteString(fmt.Sprintf("pushed %s to %s", zlmd.Link(webhooks.Plural(total, "commit"), compare), ref))
+	}
+	if len(e.Commits) == 0 {
+		sb.WriteString(".")
+		return webhooks.Message{Topic: topic, Content: sb.String()}, nil
+	}
+	sb.WriteString(":\n")
+	sb.WriteString(webhooks.CommitList(e.Commits, e.TotalCommitsCount))
+	return webhooks.Message{Topic: topic, Content: strings.TrimSuffix(sb.String(), "\n")}, nil
+}
+
+func formatMergeRequest(payload []byte) (webhooks.Message, error) {
+	var e mergeRequestEvent
+	if err := decode("merge request", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	mr := e.ObjectAttributes
+
+	var verb string
+	switch mr.Action {
+	case "open":
+		verb = "opened"
+	case "reopen":
+		verb = "reopened"
+	case "close":
+		verb = "closed"
+	case "merge":
+		verb = "merged"
+	case "approved":
+		verb = "✅ approved"
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: merge request %q", webhooks.ErrUnsupportedEvent, mr.Action)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s %s %s", userLink(e.Project, e.User.Username), verb, zlmd.Link(fmt.Sprintf("MR !%d", mr.IID), mr.URL)))
+	switch mr.Action {
+	case "open":
+		sb.WriteString(fmt.Sprintf(" from %s to %s", refLink(e.Project, mr.SourceBranch), refLink(e.Project, mr.TargetBranch)))
+	case "merge":
+		sb.WriteString(" into " + refLink(e.Project, mr.TargetBranch))
+	}
+	sb.WriteString(": " + zlmd.Bold(webhooks.Inline(mr.Title)))
+	if mr.Action == "open" {
+		if body := webhooks.Excerpt(mr.Description, maxExcerpt); body != "" {
+			sb.WriteString("\n\n" + body)
+		}
+	}
+	topic := webhooks.Topic(e.Project.Name, fmt.Sprintf("MR !%d %s", mr.IID, mr.Title))
+	return webhooks.Message{Topic: topic, Content: sb.String()}, nil
+}
+
+func formatPipeline(payload []byte) (webhooks.Message, error) {
+	var e pipelineEvent
+	if err := decode("pipeline", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+	p := e.ObjectAttributes
+
+	var outcome string
+	switch p.Status {
+	case "success":
+		outcome = "✅ succeeded"
+	case "failed":
+		outcome = "❌ failed"
+	case "canceled":
+		outcome = "🚫 was cancelled"
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: pipeline %q", webhooks.ErrUnsupportedEvent, p.Status)
+	}
+	link := p.URL
+	if link == "" {
+		link = fmt.Sprintf("%s/-/pipelines/%d", e.Project.WebURL, p.ID)
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Pipeline %s %s on %s at %s", zlmd.Link(fmt.Sprintf("#%d", p.ID), link), outcome,
+		refLink(e.Project, p.Ref), zlmd.Link(zlmd.Code(webhooks.ShortSHA(p.SHA)), e.Project.WebURL+"/-/commit/"+p.SHA)))
+	if p.Duration > 0 {
+		sb.WriteString(" after " + zlmd.HumanDuration(time.Duration(p.Duration)*time.Second))
+	}
+	var failed []string
+	for _, b := range e.Builds {
+		if b.Status == "failed" {
+			failed = append(failed, zlmd.Code(b.Name)+" ("+webhooks.Inline(b.Stage)+")")
+		}
+	}
+	if len(failed) > 0 {
+		sb.WriteString("\n\nFailed jobs:\n")
+		for _, f := range failed {
+			zlmd.WriteListItem(&sb, f, 0)
+		}
+	}
+	return webhooks.Message{Topic: webhooks.Topic(e.Project.Name, "pipelines"), Content: strings.TrimSuffix(sb.String(), "\n")}, nil
+}
+
+func formatDeployment(payload []byte) (webhooks.Message, error) {
+	var e deploymentEvent
+	if err := decode("deployment", payload, &e); err != nil {
+		return webhooks.Message{}, err
+	}
+
+	var outcome string
+	switch e.Status {
+	case "success":
+		outcome = "🚀 deployed"
+	case "failed":
+		outcome = "❌ failed to deploy"
+	case "canceled":
+		outcome = "🚫 cancelled the deployment of"
+	default:
+		return webhooks.Message{}, fmt.Errorf("%w: deployment %q", webhooks.ErrUnsupportedEvent, e.Status)
+	}
+	content := fmt.Sprintf("%s %s %s at %s to %s", userLink(e.Project, e.User.Username), outcome,
+		refLink(e.Project, e.Ref), zlmd.Link(zlmd.Code(e.ShortSHA), e.CommitURL), zlmd.Bold(webhooks.Inline(e.Environment)))
+	if e.DeployableURL != "" {
+		content += " (" + zlmd.Link("job", e.DeployableURL) + ")"
+	}
+	return webhooks.Message{Topic: webhooks.Topic(e.Project.Name, "deployments"), Content: content}, nil
+}
+
+// userLink links to a GitLab profile on the project's instance. The username
+// is shown without an "@" so it can't be mistaken for a Zulip mention.
+func userLink(p project, username string) string {
+	u, err := url.Parse(p.WebURL)
+	if err != nil || u.Host == "" {
+		return zlmd.Bold(webhooks.Inline(username))
+	}
+	return zlmd.Link(webhooks.Inline(username), u.Scheme+"://"+u.Host+"/"+url.PathEscape(username))
+}
+
+// refLink links a branch or tag name to its tree on GitLab.
+func refLink(p project, ref string) string {
+	return zlmd.Link(zlmd.Code(ref), p.WebURL+"/-/tree/"+ref)
+}
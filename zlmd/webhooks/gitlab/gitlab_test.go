@@ -0,0 +1,93 @@
+package gitlab
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+const projectJSON = `"project":{"name":"api","path_with_namespace":"acme/api","web_url":"https://gitlab.com/acme/api"}`
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		event    string
+		payload  string
+		expected webhooks.Message
+	}{
+		{
+			"Push", "Push Hook",
+			`{"object_kind":"push","ref":"refs/heads/main","before":"aaa","after":"bbb","user_username":"jdoe","total_commits_count":12,"commits":[` +
+				`{"id":"0123456789abcdef","message":"Fix retries\n","url":"https://gitlab.com/acme/api/-/commit/0123456","modified":["b.go"]}],` + projectJSON + `}`,
+			webhooks.Message{Topic: "api", Content: "[jdoe](https://gitlab.com/jdoe) pushed [12 commits](https://gitlab.com/acme/api/-/compare/aaa...bbb) to [`main`](https://gitlab.com/acme/api/-/tree/main):\n" +
+				"- [`0123456`](https://gitlab.com/acme/api/-/commit/0123456) Fix retries\n" +
+				"- and 11 more commits\n\n" +
+				"| Files added | Files modified | Files removed |\n| ---: | ---: | ---: |\n| 0 | 1 | 0 |"},
+		},
+		{
+			"Tag", "Tag Push Hook",
+			`{"object_kind":"tag_push","ref":"refs/tags/v1.0","before":"0000000000000000000000000000000000000000","after":"ccc","user_username":"jdoe",` + projectJSON + `}`,
+			webhooks.Message{Topic: "api", Content: "[jdoe](https://gitlab.com/jdoe) pushed tag [`v1.0`](https://gitlab.com/acme/api/-/tree/v1.0)."},
+		},
+		{
+			"MR opened", "Merge Request Hook",
+			`{"user":{"name":"Jane","username":"jdoe"},"object_attributes":{"iid":7,"title":"Fix retries","description":"cc @**all**","url":"https://gitlab.com/acme/api/-/merge_requests/7",` +
+				`"source_branch":"fix","target_branch":"main","action":"open"},` + projectJSON + `}`,
+			webhooks.Message{Topic: "api / MR !7 Fix retries", Content: "[jdoe](https://gitlab.com/jdoe) opened [MR !7](https://gitlab.com/acme/api/-/merge_requests/7) " +
+				"from [`fix`](https://gitlab.com/acme/api/-/tree/fix) to [`main`](https://gitlab.com/acme/api/-/tree/main): **Fix retries**\n\n> cc @\\*\\*all\\*\\*"},
+		},
+		{
+			"MR merged", "Merge Request Hook",
+			`{"user":{"username":"jdoe"},"object_attributes":{"iid":7,"title":"Fix retries","url":"https://gitlab.com/acme/api/-/merge_requests/7","target_branch":"main","action":"merge"},` + projectJSON + `}`,
+			webhooks.Message{Topic: "api / MR !7 Fix retries", Content: "[jdoe](https://gitlab.com/jdoe) merged [MR !7](https://gitlab.com/acme/api/-/merge_requests/7) into [`main`](https://gitlab.com/acme/api/-/tree/main): **Fix retries**"},
+		},
+		{
+			"Pipeline failed", "Pipeline Hook",
+			`{"object_attributes":{"id":31,"ref":"main","sha":"0123456789","status":"failed","duration":95},` +
+				`"builds":[{"name":"test","stage":"test","status":"failed"},{"name":"lint","stage":"test","status":"success"}],` + projectJSON + `}`,
+			webhooks.Message{Topic: "api / pipelines", Content: "Pipeline [#31](https://gitlab.com/acme/api/-/pipelines/31) ❌ failed on [`main`](https://gitlab.com/acme/api/-/tree/main) " +
+				"at [`0123456`](https://gitlab.com/acme/api/-/commit/0123456789) after 1m 35s\n\nFailed jobs:\n- `test` (test)"},
+		},
+		{
+			"Deployment", "Deployment Hook",
+			`{"status":"success","deployable_url":"https://gitlab.com/acme/api/-/jobs/5","environment":"production","ref":"main","short_sha":"0123456",` +
+				`"commit_url":"https://gitlab.com/acme/api/-/commit/0123456","user":{"username":"jdoe"},` + projectJSON + `}`,
+			webhooks.Message{Topic: "api / deployments", Content: "[jdoe](https://gitlab.com/jdoe) 🚀 deployed [`main`](https://gitlab.com/acme/api/-/tree/main) " +
+				"at [`0123456`](https://gitlab.com/acme/api/-/commit/0123456) to **production** ([job](https://gitlab.com/acme/api/-/jobs/5))"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Format(tt.event, []byte(tt.payload))
+			if err != nil {
+				t.Fatalf("Format() returned error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Format() = %+q, want %+q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormat_Unsupported(t *testing.T) {
+	tests := []struct {
+		name    string
+		event   string
+		payload string
+	}{
+		{"Event", "Note Hook", `{}`},
+		{"MR update", "Merge Request Hook", `{"object_attributes":{"action":"update"}}`},
+		{"Pipeline running", "Pipeline Hook", `{"object_attributes":{"status":"running"}}`},
+		{"Deployment running", "Deployment Hook", `{"status":"running"}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Format(tt.event, []byte(tt.payload)); !errors.Is(err, webhooks.ErrUnsupportedEvent) {
+				t.Errorf("Format() error = %v, want %v", err, webhooks.ErrUnsupportedEvent)
+			}
+		})
+	}
+}
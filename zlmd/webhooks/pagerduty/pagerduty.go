@@ -0,0 +1,242 @@
+// Package pagerduty renders PagerDuty V3 webhook events as Zulip messages,
+// one topic per incident, with an urgency badge, the assigned responders
+// and a timeline of the incident's transitions so far.
+package pagerduty
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+// MaxTimeline is the number of timeline entries kept per incident.
+const MaxTimeline = 20
+
+// Reference is a PagerDuty object referenced from an event, such as a user
+// or service.
+type Reference struct {
+	ID      string `json:"id"`
+	Summary string `json:"summary"`
+	HTMLURL string `json:"html_url"`
+}
+
+// Incident is the incident an event is about.
+type Incident struct {
+	ID          string      `json:"id"`
+	Number      int         `json:"number"`
+	Title       string      `json:"title"`
+	Status      string      `json:"status"`
+	Urgency     string      `json:"urgency"`
+	HTMLURL     string      `json:"html_url"`
+	Service     Reference   `json:"service"`
+	Assignees   []Reference `json:"assignees"`
+	Priority    *Reference  `json:"priority"`
+	IncidentKey string      `json:"incident_key"`
+}
+
+// Event is a PagerDuty V3 webhook event.
+type Event struct {
+	ID         string
+	EventType  string // such as "incident.triggered"
+	OccurredAt time.Time
+	Agent      *Reference // who caused the event, if anyone
+	Incident   Incident
+	// Note is the text of an incident.annotated event.
+	Note string
+}
+
+// UnmarshalJSON decodes an event, whose data holds the incident itself or,
+// for annotations, the incident and the note.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		ID         string          `json:"id"`
+		EventType  string          `json:"event_type"`
+		OccurredAt time.Time       `json:"occurred_at"`
+		Agent      *Reference      `json:"agent"`
+		Data       json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	e.ID, e.EventType, e.OccurredAt, e.Agent = raw.ID, raw.EventType, raw.OccurredAt, raw.Agent
+	if len(raw.Data) == 0 {
+		return nil
+	}
+	if raw.EventType == "incident.annotated" {
+		var note struct {
+			Incident Incident `json:"incident"`
+			Content  string   `json:"content"`
+		}
+		if err := json.Unmarshal(raw.Data, &note); err != nil {
+			return err
+		}
+		e.Incident, e.Note = note.Incident, note.Content
+		return nil
+	}
+	return json.Unmarshal(raw.Data, &e.Incident)
+}
+
+// transition describes how an event type is rendered.
+type transition struct {
+	style string
+	verb  string
+}
+
+var transitions = map[string]transition{
+	"incident.triggered":        {"danger", "triggered"},
+	"incident.acknowledged":     {"warning", "acknowledged"},
+	"incident.unacknowledged":   {"danger", "unacknowledged"},
+	"incident.resolved":         {"success", "resolved"},
+	"incident.reopened":         {"danger", "reopened"},
+	"incident.escalated":        {"warning", "escalated"},
+	"incident.reassigned":       {"warning", "reassigned"},
+	"incident.delegated":        {"warning", "delegated"},
+	"incident.priority_updated": {"info", "priority updated"},
+	"incident.annotated":        {"info", "note added"},
+}
+
+// Formatter renders PagerDuty events. It remembers the events seen for each
+// open incident so every message carries the incident's timeline. The zero
+// value is ready to use and safe for concurrent use.
+type Formatter struct {
+	// Responder maps a PagerDuty user to the full name of their Zulip
+	// account, so assignees are shown as silent mentions. Users it doesn't
+	// map, or all users if it is nil, are shown as links to PagerDuty.
+	Responder func(user Reference) (zulipName string, ok bool)
+
+	mu       sync.Mutex
+	timeline map[string][]zlmd.TimelineEvent
+}
+
+// Format renders a PagerDuty webhook payload.
+//
+// Parameters:
+//   - payload ([]byte): The JSON request body, {"event": {...}}
+//
+// Returns:
+//   - webhooks.Message: The topic, named after the incident, and content
+//   - error: webhooks.ErrUnsupportedEvent for events other than incident
+//     transitions, or an error if the payload is invalid
+//
+// Example:
+//
+//	f := &pagerduty.Formatter{Responder: func(u pagerduty.Reference) (string, bool) {
+//		name, ok := oncallNames[u.ID]
+//		return name, ok
+//	}}
+//	msg, err := f.Format(body)
+//
+// Notes:
+//   - The timeline of an incident is forgotten once it is resolved
+func (f *Formatter) Format(payload []byte) (webhooks.Message, error) {
+	var body struct {
+		Event Event `json:"event"`
+	}
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return webhooks.Message{}, fmt.Errorf("pagerduty: invalid payload: %w", err)
+	}
+	return f.Message(body.Event)
+}
+
+// Message renders a decoded event and records it in the incident timeline.
+func (f *Formatter) Message(e Event) (webhooks.Message, error) {
+	t, ok := transitions[e.EventType]
+	if !ok {
+		return webhooks.Message{}, fmt.Errorf("%w: %q", webhooks.ErrUnsupportedEvent, e.EventType)
+	}
+	inc := e.Incident
+
+	entry := t.verb
+	if e.Agent != nil && e.Agent.Summary != "" {
+		entry += " by " + webhooks.Inline(e.Agent.Summary)
+	}
+	if e.EventType == "incident.priority_updated" && inc.Priority != nil {
+		entry += " to " + zlmd.Bold(webhooks.Inline(inc.Priority.Summary))
+	}
+	if note := strings.TrimSpace(e.Note); note != "" {
+		entry += ": " + webhooks.Inline(note)
+	}
+	timeline := f.record(inc.ID, e.EventType == "incident.resolved", zlmd.TimelineEvent{Time: e.OccurredAt, Style: t.style, Text: entry})
+
+	var sb strings.Builder
+	urgency := inc.Urgency
+	if urgency == "" {
+		urgency = "unknown"
+	}
+	style := "info"
+	if urgency == "high" {
+		style = "danger"
+	}
+	zlmd.DefaultTheme.Badge(&sb, urgency+" urgency", style)
+
+	sb.WriteString(fmt.Sprintf("%s Incident %s %s: %s\n", zlmd.DefaultTheme.Prefix(t.style),
+		zlmd.Link(fmt.Sprintf("#%d", inc.Number), inc.HTMLURL), zlmd.Bold(t.verb), webhooks.Inline(inc.Title)))
+
+	sb.WriteString("\n")
+	zlmd.WriteKeyValue(&sb, "Service", link(inc.Service))
+	if inc.Priority != nil && inc.Priority.Summary != "" {
+		zlmd.WriteKeyValue(&sb, "Priority", webhooks.Inline(inc.Priority.Summary))
+	}
+	if len(inc.Assignees) > 0 {
+		responders := make([]string, len(inc.Assignees))
+		for i, a := range inc.Assignees {
+			responders[i] = f.responder(a)
+		}
+		zlmd.WriteKeyValue(&sb, "Assigned to", strings.Join(responders, ", "))
+	}
+
+	sb.WriteString("\n")
+	tl := zlmd.NewTimeline()
+	for _, event := range timeline {
+		tl.AddEvent(event)
+	}
+	sb.WriteString(tl.Build())
+
+	topic := webhooks.Topic(fmt.Sprintf("incident #%d %s", inc.Number, inc.Title))
+	return webhooks.Message{Topic: topic, Content: strings.TrimSuffix(sb.String(), "\n")}, nil
+}
+
+// record appends event to the incident's timeline and returns a copy of it,
+// forgetting the incident afterwards if it is closed.
+func (f *Formatter) record(id string, closed bool, event zlmd.TimelineEvent) []zlmd.TimelineEvent {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.timeline == nil {
+		f.timeline = map[string][]zlmd.TimelineEvent{}
+	}
+	events := append(f.timeline[id], event)
+	if len(events) > MaxTimeline {
+		events = events[len(events)-MaxTimeline:]
+	}
+	if closed {
+		delete(f.timeline, id)
+	} else {
+		f.timeline[id] = events
+	}
+	return append([]zlmd.TimelineEvent(nil), events...)
+}
+
+// responder renders an assignee as a silent mention when Responder maps
+// them to a Zulip user.
+func (f *Formatter) responder(user Reference) string {
+	if f.Responder != nil {
+		if name, ok := f.Responder(user); ok && name != "" {
+			return "@_**" + name + "**"
+		}
+	}
+	return link(user)
+}
+
+// link renders a reference as a link to PagerDuty, or its summary alone.
+func link(ref Reference) string {
+	summary := webhooks.Inline(ref.Summary)
+	if ref.HTMLURL == "" {
+		return summary
+	}
+	return zlmd.Link(summary, ref.HTMLURL)
+}
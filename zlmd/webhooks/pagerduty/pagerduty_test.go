@@ -0,0 +1,81 @@
+package pagerduty
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd/webhooks"
+)
+
+const incident = `"id":"PINC1","number":42,"title":"API down","urgency":"high","html_url":"https://acme.pagerduty.com/incidents/PINC1",` +
+	`"service":{"id":"PSVC","summary":"api","html_url":"https://acme.pagerduty.com/services/PSVC"},` +
+	`"assignees":[{"id":"PU1","summary":"Alice","html_url":"https://acme.pagerduty.com/users/PU1"},{"id":"PU2","summary":"Bob","html_url":"https://acme.pagerduty.com/users/PU2"}]`
+
+func TestFormatter_Format(t *testing.T) {
+	f := &Formatter{Responder: func(u Reference) (string, bool) {
+		return map[string]string{"PU1": "Alice Smith"}[u.ID], u.ID == "PU1"
+	}}
+
+	steps := []struct {
+		name     string
+		payload  string
+		expected string
+	}{
+		{
+			"Triggered",
+			`{"event":{"event_type":"incident.triggered","occurred_at":"2024-03-01T09:00:00Z","data":{` + incident + `}}}`,
+			"❌ `high urgency`\n❌ Incident [#42](https://acme.pagerduty.com/incidents/PINC1) **triggered**: API down\n\n" +
+				"**Service**: [api](https://acme.pagerduty.com/services/PSVC)\n" +
+				"**Assigned to**: @_**Alice Smith**, [Bob](https://acme.pagerduty.com/users/PU2)\n\n" +
+				"- ❌ <time:2024-03-01T09:00:00Z> triggered",
+		},
+		{
+			"Annotated",
+			`{"event":{"event_type":"incident.annotated","occurred_at":"2024-03-01T09:02:00Z","agent":{"summary":"Bob"},` +
+				`"data":{"incident":{` + incident + `},"content":"Rolling back @**all**"}}}`,
+			"❌ `high urgency`\nℹ️ Incident [#42](https://acme.pagerduty.com/incidents/PINC1) **note added**: API down\n\n" +
+				"**Service**: [api](https://acme.pagerduty.com/services/PSVC)\n" +
+				"**Assigned to**: @_**Alice Smith**, [Bob](https://acme.pagerduty.com/users/PU2)\n\n" +
+				"- ❌ <time:2024-03-01T09:00:00Z> triggered\n" +
+				"- ℹ️ <time:2024-03-01T09:02:00Z> (+2m) note added by Bob: Rolling back @\\*\\*all\\*\\*",
+		},
+		{
+			"Resolved",
+			`{"event":{"event_type":"incident.resolved","occurred_at":"2024-03-01T09:30:00Z","agent":{"summary":"Alice"},"data":{` + incident + `}}}`,
+			"❌ `high urgency`\n✅ Incident [#42](https://acme.pagerduty.com/incidents/PINC1) **resolved**: API down\n\n" +
+				"**Service**: [api](https://acme.pagerduty.com/services/PSVC)\n" +
+				"**Assigned to**: @_**Alice Smith**, [Bob](https://acme.pagerduty.com/users/PU2)\n\n" +
+				"- ❌ <time:2024-03-01T09:00:00Z> triggered\n" +
+				"- ℹ️ <time:2024-03-01T09:02:00Z> (+2m) note added by Bob: Rolling back @\\*\\*all\\*\\*\n" +
+				"- ✅ <time:2024-03-01T09:30:00Z> (+28m) resolved by Alice",
+		},
+	}
+
+	for _, step := range steps {
+		got, err := f.Format([]byte(step.payload))
+		if err != nil {
+			t.Fatalf("%s: Format() returned error: %v", step.name, err)
+		}
+		if got.Topic != "incident #42 API down" {
+			t.Errorf("%s: Topic = %q", step.name, got.Topic)
+		}
+		if got.Content != step.expected {
+			t.Errorf("%s: Content = %q, want %q", step.name, got.Content, step.expected)
+		}
+	}
+
+	if len(f.timeline) != 0 {
+		t.Errorf("Expected resolved incidents to be forgotten, got %v", f.timeline)
+	}
+}
+
+func TestFormatter_Format_Unsupported(t *testing.T) {
+	var f Formatter
+	_, err := f.Format([]byte(`{"event":{"event_type":"service.updated","data":{}}}`))
+	if !errors.Is(err, webhooks.ErrUnsupportedEvent) {
+		t.Errorf("Format() error = %v, want %v", err, webhooks.ErrUnsupportedEvent)
+	}
+	if _, err := f.Format([]byte(`{"event":`)); err == nil {
+		t.Error("Expected an error for an invalid payload")
+	}
+}
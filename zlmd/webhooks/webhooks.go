@@ -0,0 +1,144 @@
+// Package webhooks holds what the service-specific webhook formatters in its
+// subpackages share: the Message they produce and helpers for embedding
+// untrusted text from payloads.
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+	"github.com/veiloq/zulip-markdown/zlmd/zulipapi"
+)
+
+// ErrUnsupportedEvent is returned by formatters for events they don't render.
+// Callers usually acknowledge such deliveries without posting anything.
+var ErrUnsupportedEvent = errors.New("webhooks: unsupported event")
+
+// Message is a rendered webhook notification.
+type Message struct {
+	// Topic groups related notifications, such as all events of one pull
+	// request. It is at most zulipapi.MaxTopicLength characters.
+	Topic   string `json:"topic"`
+	Content string `json:"content"`
+}
+
+// maxCommits is the number of commits CommitList shows.
+const maxCommits = 10
+
+// Commit is a pushed commit, as described in GitHub and GitLab push payloads.
+type Commit struct {
+	ID       string   `json:"id"`
+	Message  string   `json:"message"`
+	URL      string   `json:"url"`
+	Added    []string `json:"added"`
+	Removed  []string `json:"removed"`
+	Modified []string `json:"modified"`
+}
+
+// Topic joins parts with " / " into a topic name, truncated to fit Zulip's
+// topic length limit.
+//
+// Example:
+//
+//	Topic("api", "PR #12 Fix retries")
+//	// "api / PR #12 Fix retries"
+func Topic(parts ...string) string {
+	var nonEmpty []string
+	for _, p := range parts {
+		if p = strings.Join(strings.Fields(p), " "); p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+	return truncate(strings.Join(nonEmpty, " / "), zulipapi.MaxTopicLength)
+}
+
+// Inline prepares untrusted single-line text, such as an issue title, for
+// use inside a message: line breaks are collapsed and mentions neutralized
+// so the text can't notify anyone.
+//
+// Example:
+//
+//	Inline("Ping @**all**\nnow")
+//	// "Ping @\*\*all\*\* now"
+func Inline(text string) string {
+	return zlmd.Escape(strings.Join(strings.Fields(text), " "), zlmd.EscapeMentions)
+}
+
+// Excerpt prepares untrusted multi-line text, such as a pull request
+// description, as a quote of at most n characters. Mentions are neutralized
+// and fences escaped so the text can't notify anyone or swallow the rest of
+// the message.
+//
+// Returns:
+//   - string: The quoted excerpt without a trailing newline, or "" if text is blank
+func Excerpt(text string, n int) string {
+	text = strings.TrimSpace(strings.ReplaceAll(text, "\r\n", "\n"))
+	if text == "" {
+		return ""
+	}
+	text = truncate(text, n)
+	text = zlmd.Escape(zlmd.Escape(text, zlmd.EscapeFences), zlmd.EscapeMentions)
+	return strings.TrimSuffix(zlmd.QuoteBlock(text), "\n")
+}
+
+// CommitList renders pushed commits as a list of linked hashes and summary
+// lines, followed by a table counting the files they touch.
+//
+// Parameters:
+//   - commits ([]Commit): The commits in the payload
+//   - total (int): The number of commits pushed, which may exceed
+//     len(commits) when the service truncates the list
+//
+// Returns:
+//   - string: The list and table, with a trailing newline
+func CommitList(commits []Commit, total int) string {
+	var sb strings.Builder
+	var added, removed, modified int
+	for i, c := range commits {
+		added += len(c.Added)
+		removed += len(c.Removed)
+		modified += len(c.Modified)
+		if i < maxCommits {
+			summary, _, _ := strings.Cut(c.Message, "\n")
+			zlmd.WriteListItem(&sb, zlmd.Link(zlmd.Code(ShortSHA(c.ID)), c.URL)+" "+Inline(summary), 0)
+		}
+	}
+	if n := max(total, len(commits)) - min(len(commits), maxCommits); n > 0 {
+		zlmd.WriteListItem(&sb, "and "+Plural(n, "more commit"), 0)
+	}
+	sb.WriteString("\n")
+	sb.WriteString(zlmd.NewTableBuilder().
+		WithHeaders("Files added", "Files modified", "Files removed").
+		SetAlignments(zlmd.AlignRight, zlmd.AlignRight, zlmd.AlignRight).
+		AddRow(strconv.Itoa(added), strconv.Itoa(modified), strconv.Itoa(removed)).
+		Build())
+	return sb.String()
+}
+
+// ShortSHA abbreviates a commit hash to seven characters.
+func ShortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// Plural returns "1 word" or "n words".
+func Plural(n int, word string) string {
+	if n == 1 {
+		return "1 " + word
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+// truncate shortens text to at most n runes, ending it with "…" if cut.
+func truncate(text string, n int) string {
+	short := zlmd.TruncateGraphemes(text, n)
+	if short == text {
+		return text
+	}
+	return strings.TrimRight(strings.TrimSuffix(short, "…"), " ") + "…"
+}
@@ -0,0 +1,55 @@
+package webhooks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTopic(t *testing.T) {
+	tests := []struct {
+		name     string
+		parts    []string
+		expected string
+	}{
+		{"Joined", []string{"api", "PR #12 Fix retries"}, "api / PR #12 Fix retries"},
+		{"Empty parts skipped", []string{"api", " ", "releases"}, "api / releases"},
+		{"Whitespace collapsed", []string{"api", "Fix\n  retries"}, "api / Fix retries"},
+		{"Truncated", []string{"api", strings.Repeat("x", 70)}, "api / " + strings.Repeat("x", 53) + "…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Topic(tt.parts...); got != tt.expected {
+				t.Errorf("Topic() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestInline(t *testing.T) {
+	if got := Inline("Ping @**all**\nnow"); got != `Ping @\*\*all\*\* now` {
+		t.Errorf("Inline() = %q", got)
+	}
+}
+
+func TestExcerpt(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		n        int
+		expected string
+	}{
+		{"Blank", " \r\n ", 100, ""},
+		{"Quoted", "Fixes the retry loop.\r\n\r\ncc @**all**", 100, "> Fixes the retry loop.\n> \n> cc @\\*\\*all\\*\\*"},
+		{"Fence", "```\ncode", 100, "> \\```\n> code"},
+		{"Truncated", "one two three", 8, "> one two…"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Excerpt(tt.text, tt.n); got != tt.expected {
+				t.Errorf("Excerpt() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package zlmd
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// wideRanges are the East Asian Wide and Fullwidth ranges of Unicode 15,
+// which monospace fonts draw two columns wide. Most emoji are among them.
+var wideRanges = []struct{ lo, hi rune }{
+	{0x1100, 0x115F}, {0x231A, 0x231B}, {0x2329, 0x232A}, {0x23E9, 0x23EC},
+	{0x23F0, 0x23F0}, {0x23F3, 0x23F3}, {0x25FD, 0x25FE}, {0x2614, 0x2615},
+	{0x2648, 0x2653}, {0x267F, 0x267F}, {0x2693, 0x2693}, {0x26A1, 0x26A1},
+	{0x26AA, 0x26AB}, {0x26BD, 0x26BE}, {0x26C4, 0x26C5}, {0x26CE, 0x26CE},
+	{0x26D4, 0x26D4}, {0x26EA, 0x26EA}, {0x26F2, 0x26F3}, {0x26F5, 0x26F5},
+	{0x26FA, 0x26FA}, {0x26FD, 0x26FD}, {0x2705, 0x2705}, {0x270A, 0x270B},
+	{0x2728, 0x2728}, {0x274C, 0x274C}, {0x274E, 0x274E}, {0x2753, 0x2755},
+	{0x2757, 0x2757}, {0x2795, 0x2797}, {0x27B0, 0x27B0}, {0x27BF, 0x27BF},
+	{0x2B1B, 0x2B1C}, {0x2B50, 0x2B50}, {0x2B55, 0x2B55}, {0x2E80, 0x303E},
+	{0x3041, 0x33FF}, {0x3400, 0x4DBF}, {0x4E00, 0x9FFF}, {0xA000, 0xA4CF},
+	{0xA960, 0xA97F}, {0xAC00, 0xD7A3}, {0xF900, 0xFAFF}, {0xFE10, 0xFE19},
+	{0xFE30, 0xFE6F}, {0xFF00, 0xFF60}, {0xFFE0, 0xFFE6}, {0x16FE0, 0x16FE4},
+	{0x17000, 0x18CFF}, {0x1B000, 0x1B2FF}, {0x1F004, 0x1F004}, {0x1F0CF, 0x1F0CF},
+	{0x1F18E, 0x1F18E}, {0x1F191, 0x1F19A}, {0x1F200, 0x1F202}, {0x1F210, 0x1F23B},
+	{0x1F240, 0x1F248}, {0x1F250, 0x1F251}, {0x1F260, 0x1F265}, {0x1F300, 0x1F320},
+	{0x1F32D, 0x1F335}, {0x1F337, 0x1F37C}, {0x1F37E, 0x1F393}, {0x1F3A0, 0x1F3CA},
+	{0x1F3CF, 0x1F3D3}, {0x1F3E0, 0x1F3F0}, {0x1F3F4, 0x1F3F4}, {0x1F3F8, 0x1F43E},
+	{0x1F440, 0x1F440}, {0x1F442, 0x1F4FC}, {0x1F4FF, 0x1F53D}, {0x1F54B, 0x1F54E},
+	{0x1F550, 0x1F567}, {0x1F57A, 0x1F57A}, {0x1F595, 0x1F596}, {0x1F5A4, 0x1F5A4},
+	{0x1F5FB, 0x1F64F}, {0x1F680, 0x1F6C5}, {0x1F6CC, 0x1F6CC}, {0x1F6D0, 0x1F6D2},
+	{0x1F6D5, 0x1F6D7}, {0x1F6DC, 0x1F6DF}, {0x1F6EB, 0x1F6EC}, {0x1F6F4, 0x1F6FC},
+	{0x1F7E0, 0x1F7EB}, {0x1F7F0, 0x1F7F0}, {0x1F90C, 0x1F93A}, {0x1F93C, 0x1F945},
+	{0x1F947, 0x1F9FF}, {0x1FA70, 0x1FAFF}, {0x20000, 0x2FFFD}, {0x30000, 0x3FFFD},
+}
+
+// RuneWidth returns the number of monospace columns r occupies: 0 for
+// combining marks, format and control characters, 2 for East Asian wide
+// characters and most emoji, and 1 otherwise.
+func RuneWidth(r rune) int {
+	switch {
+	case r < 0x20 || (r >= 0x7F && r < 0xA0):
+		return 0
+	case r < 0x1100:
+		if unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf) {
+			return 0
+		}
+		return 1
+	case (r >= 0x1160 && r <= 0x11FF) || unicode.In(r, unicode.Mn, unicode.Me, unicode.Cf):
+		return 0
+	}
+	i := sort.Search(len(wideRanges), func(i int) bool { return wideRanges[i].hi >= r })
+	if i < len(wideRanges) && wideRanges[i].lo <= r {
+		return 2
+	}
+	return 1
+}
+
+// DisplayWidth returns the number of monospace columns text occupies, for
+// aligning columns in code blocks.
+//
+// Parameters:
+//   - text (string): A single line of text
+//
+// Returns:
+//   - int: The sum of the RuneWidth of its characters
+//
+// Example:
+//
+//	DisplayWidth("api")     // 3
+//	DisplayWidth("東京")    // 4
+//	DisplayWidth("café")    // 4, also when the accent is a combining mark
+//	DisplayWidth("✅ ok")   // 5
+//
+// Notes:
+//   - Each part of an emoji sequence joined with U+200D is counted, so such
+//     sequences come out wider than most fonts draw them
+func DisplayWidth(text string) int {
+	width := 0
+	for _, r := range text {
+		width += RuneWidth(r)
+	}
+	return width
+}
+
+// PadRight pads text with spaces to width columns, as measured by
+// DisplayWidth. Text already that wide is returned unchanged.
+//
+// Example:
+//
+//	PadRight("東京", 6) + "|" // "東京  |"
+func PadRight(text string, width int) string {
+	return padRight(text, width, WidthDefault)
+}
+
+// padRight pads text with spaces to width columns, as measured by
+// TextWidth in mode.
+func padRight(text string, width int, mode WidthMode) string {
+	if pad := width - TextWidth(text, mode); pad > 0 {
+		return text + strings.Repeat(" ", pad)
+	}
+	return text
+}
+
+// WidthMode selects how TextWidth measures emoji.
+type WidthMode int
+
+const (
+	// WidthDefault measures text like DisplayWidth, character by character.
+	WidthDefault WidthMode = iota
+	// WidthEmoji measures each emoji as two columns, the way Zulip's
+	// clients draw them: including symbols made emoji by a variation
+	// selector, such as "⚠️", keycaps, flags, and ZWJ sequences, which count
+	// once rather than per part.
+	WidthEmoji
+)
+
+// WithWidthMode sets how labels are measured when aligning columns.
+//
+// Example:
+//
+//	BarChart([]string{"✅ api", "⚠️ db"}, values, 10, WithWidthMode(WidthEmoji))
+func WithWidthMode(mode WidthMode) Option {
+	return func(o *options) {
+		o.widthMode = mode
+	}
+}
+
+// TextWidth returns the number of monospace columns text occupies when
+// measured in mode.
+//
+// Parameters:
+//   - text (string): A single line of text
+//   - mode (WidthMode): How to measure emoji
+//
+// Returns:
+//   - int: The width in columns
+//
+// Example:
+//
+//	TextWidth("⚠️ disk", WidthDefault) // 6: the warning sign is one column
+//	TextWidth("⚠️ disk", WidthEmoji)   // 7
+//	TextWidth("👩‍💻", WidthEmoji)       // 2
+func TextWidth(text string, mode WidthMode) int {
+	if mode != WidthEmoji {
+		return DisplayWidth(text)
+	}
+
+	width := 0
+	for len(text) > 0 {
+		n := graphemeLen(text)
+		width += clusterWidth(text[:n])
+		text = text[n:]
+	}
+	return width
+}
+
+// clusterWidth returns the width of a grapheme cluster in WidthEmoji mode.
+func clusterWidth(cluster string) int {
+	first, size := utf8.DecodeRuneInString(cluster)
+	if size == len(cluster) {
+		return RuneWidth(first)
+	}
+	switch {
+	case strings.Contains(cluster, "\ufe0e"):
+		// Text presentation selector: drawn as a plain symbol.
+		return 1
+	case isRegionalIndicator(first),
+		strings.ContainsAny(cluster, "\ufe0f\u200d\u20e3"),
+		strings.ContainsFunc(cluster, isEmojiModifier):
+		return 2
+	}
+	return DisplayWidth(cluster)
+}
+
+func isEmojiModifier(r rune) bool {
+	return r >= 0x1F3FB && r <= 0x1F3FF
+}
@@ -0,0 +1,98 @@
+package zlmd
+
+import "testing"
+
+func TestDisplayWidth(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected int
+	}{
+		{"Empty", "", 0},
+		{"ASCII", "api", 3},
+		{"CJK", "東京", 4},
+		{"Hangul", "한국어", 6},
+		{"Fullwidth", "ＡＢ", 4},
+		{"Precomposed accent", "café", 4},
+		{"Combining accent", "café", 4},
+		{"Emoji", "✅ ok", 5},
+		{"Astral emoji", "🚀", 2},
+		{"Zero width joiner", "a\u200db", 2},
+		{"Control characters", "a\tb\x7f", 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisplayWidth(tt.text); got != tt.expected {
+				t.Errorf("DisplayWidth(%q) = %d, want %d", tt.text, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPadRight(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		width    int
+		expected string
+	}{
+		{"ASCII", "ab", 4, "ab  "},
+		{"Wide", "東京", 6, "東京  "},
+		{"Already wide enough", "東京", 3, "東京"},
+		{"Combining", "é", 2, "é "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := PadRight(tt.text, tt.width); got != tt.expected {
+				t.Errorf("PadRight() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTextWidth(t *testing.T) {
+	tests := []struct {
+		name  string
+		text  string
+		plain int
+		emoji int
+	}{
+		{"ASCII", "api", 3, 3},
+		{"Wide emoji", "✅ ok", 5, 5},
+		{"Variation selector", "⚠\ufe0f disk", 6, 7},
+		{"Text presentation", "⚠\ufe0e disk", 6, 6},
+		{"Keycap", "1\ufe0f\u20e3", 1, 2},
+		{"ZWJ sequence", "👩\u200d💻", 4, 2},
+		{"Skin tone", "👍🏽", 4, 2},
+		{"Flag", "🇩🇪", 2, 2},
+		{"Combining accent", "café", 4, 4},
+		{"CJK", "東京", 4, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TextWidth(tt.text, WidthDefault); got != tt.plain {
+				t.Errorf("TextWidth(%q, WidthDefault) = %d, want %d", tt.text, got, tt.plain)
+			}
+			if got := TextWidth(tt.text, WidthEmoji); got != tt.emoji {
+				t.Errorf("TextWidth(%q, WidthEmoji) = %d, want %d", tt.text, got, tt.emoji)
+			}
+		})
+	}
+}
+
+func TestWidthMode_Alignment(t *testing.T) {
+	labels := []string{"ℹ\ufe0f info", "✅ ok"}
+
+	got := NewKVBlock().Add(labels[0], "1").Add(labels[1], "2").WithWidthMode(WidthEmoji).Build()
+	if expected := "```text\nℹ\ufe0f info: 1\n✅ ok:   2\n```"; got != expected {
+		t.Errorf("KVBlock.Build() = %q, want %q", got, expected)
+	}
+
+	got = BarChart(labels, []float64{1, 2}, 2, WithWidthMode(WidthEmoji))
+	if expected := "```text\nℹ\ufe0f info █ 1\n✅ ok   ██ 2\n```"; got != expected {
+		t.Errorf("BarChart() = %q, want %q", got, expected)
+	}
+}
@@ -0,0 +1,130 @@
+package zlmd
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	// wrapPrefixPattern matches the quote markers and list marker that start
+	// a line: the quote markers are repeated on continuation lines, and the
+	// list marker is replaced by spaces.
+	wrapPrefixPattern = regexp.MustCompile(`^( {0,3}(?:> ?)*)( *(?:[-*+]|\d{1,9}[.)])(?: \[[ xX]\])? +)?`)
+	// wrapBlockPattern matches words that would start a heading, list, quote
+	// or rule if a line began with them.
+	wrapBlockPattern = regexp.MustCompile(`^(#{1,6}|[-*+>]|\d{1,9}[.)]|[-=_*]{2,})$`)
+)
+
+// Wrap breaks the lines of markdown that are wider than width columns
+// between words, keeping the output's structure: code blocks, tables and
+// headings are left as they are, continuation lines of list items and
+// quotes are indented or quoted to stay part of them, and links and inline
+// code are never split.
+//
+// Parameters:
+//   - markdown (string): The markdown to wrap, such as builder output
+//   - width (int): The maximum line width in columns, measured with
+//     DisplayWidth; zero or less returns markdown unchanged
+//
+// Returns:
+//   - string: The wrapped markdown
+//
+// Example:
+//
+//	Wrap("- deploy finished for api, db and the [worker queue](https://x.io/q)", 30)
+//	// "- deploy finished for api, db\n  and the\n  [worker queue](https://x.io/q)"
+//
+// Notes:
+//   - Lines are broken but never joined: Zulip renders every line break in
+//     a paragraph, so the author's line breaks are kept
+//   - A word wider than width, such as a long URL, gets a line of its own
+func Wrap(markdown string, width int) string {
+	if width <= 0 {
+		return markdown
+	}
+
+	lines := strings.Split(markdown, "\n")
+	out := make([]string, 0, len(lines))
+	var fence string
+	inTable := false
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		switch {
+		case fence != "":
+			if isClosingFence(trimmed, fence) {
+				fence = ""
+			}
+		case openingFence(trimmed) != "":
+			fence = openingFence(trimmed)
+		case inTable && strings.Contains(line, "|"):
+		case strings.Contains(line, "|") && i+1 < len(lines) && isTableDelimiter(lines[i+1]):
+			inTable = true
+		case strings.HasPrefix(trimmed, "#"), strings.HasPrefix(line, "    "), strings.HasPrefix(line, "\t"):
+			inTable = false
+		default:
+			inTable = false
+			out = append(out, wrapLine(line, width)...)
+			continue
+		}
+		out = append(out, line)
+	}
+	return strings.Join(out, "\n")
+}
+
+// wrapLine breaks a single line of prose into lines at most width columns
+// wide.
+func wrapLine(line string, width int) []string {
+	if DisplayWidth(line) <= width {
+		return []string{line}
+	}
+
+	m := wrapPrefixPattern.FindStringSubmatch(line)
+	prefix, next := m[0], m[1]+strings.Repeat(" ", len(m[2]))
+	words := wrapWords(line[len(prefix):])
+
+	var lines []string
+	current := prefix
+	empty := true
+	for _, word := range words {
+		switch {
+		case empty:
+		case DisplayWidth(current)+1+DisplayWidth(word) > width && !wrapBlockPattern.MatchString(word):
+			lines = append(lines, current)
+			current, empty = next, true
+		default:
+			current += " "
+		}
+		current += word
+		empty = false
+	}
+	return append(lines, current)
+}
+
+// wrapWords splits text into words at spaces, keeping links and inline code
+// whole.
+func wrapWords(text string) []string {
+	var atomic [][]int
+	atomic = append(atomic, markdownLinkPattern.FindAllStringIndex(text, -1)...)
+	atomic = append(atomic, inlineCodePattern.FindAllStringIndex(text, -1)...)
+	inAtomic := func(i int) bool {
+		for _, span := range atomic {
+			if i > span[0] && i < span[1] {
+				return true
+			}
+		}
+		return false
+	}
+
+	var words []string
+	start := 0
+	for i := 0; i <= len(text); i++ {
+		if i < len(text) && (text[i] != ' ' || inAtomic(i)) {
+			continue
+		}
+		if i > start {
+			words = append(words, text[start:i])
+		}
+		start = i + 1
+	}
+	return words
+}
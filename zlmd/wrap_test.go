@@ -0,0 +1,36 @@
+package zlmd
+
+import "testing"
+
+func TestWrap(t *testing.T) {
+	tests := []struct {
+		name     string
+		markdown string
+		width    int
+		expected string
+	}{
+		{"Short line", "all good", 20, "all good"},
+		{"Zero width", "a b c d e f", 0, "a b c d e f"},
+		{"Paragraph", "the quick brown fox jumps over the lazy dog", 15, "the quick brown\nfox jumps over\nthe lazy dog"},
+		{"Line breaks kept", "one two\nthree", 20, "one two\nthree"},
+		{"List item", "- deploy finished for api and db", 16, "- deploy\n  finished for\n  api and db"},
+		{"Numbered item", "10. restart every worker", 12, "10. restart\n    every\n    worker"},
+		{"Quote", "> the quick brown fox", 12, "> the quick\n> brown fox"},
+		{"Link kept whole", "see [the docs](https://example.com/a) now", 12, "see\n[the docs](https://example.com/a)\nnow"},
+		{"Inline code kept whole", "run `go test ./...` first", 10, "run\n`go test ./...`\nfirst"},
+		{"Block marker not wrapped", "total is 5 - 3", 12, "total is 5 -\n3"},
+		{"Heading", "# a very long heading here", 10, "# a very long heading here"},
+		{"Code block", "```\nlong long long long\n```", 5, "```\nlong long long long\n```"},
+		{"Table", "| a long header |\n|---|\n| a long cell |", 5, "| a long header |\n|---|\n| a long cell |"},
+		{"Indented code", "    long long long", 5, "    long long long"},
+		{"Trailing newline", "aaa bbb\n", 4, "aaa\nbbb\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Wrap(tt.markdown, tt.width); got != tt.expected {
+				t.Errorf("Wrap(%q, %d) = %q, want %q", tt.markdown, tt.width, got, tt.expected)
+			}
+		})
+	}
+}
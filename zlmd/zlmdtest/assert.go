@@ -0,0 +1,84 @@
+package zlmdtest
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// AssertValidZulip fails the test if Zulip would reject msg or render it
+// broken: if it is empty, over zlmd.MaxMessageLength, not valid UTF-8,
+// contains a NUL byte, or has a code fence that is never closed and so
+// swallows the rest of the message.
+//
+// Example:
+//
+//	zlmdtest.AssertValidZulip(t, report.Build())
+func AssertValidZulip(t testing.TB, msg string) {
+	t.Helper()
+	for _, problem := range problems(msg) {
+		t.Errorf("zlmdtest: invalid message: %s", problem)
+	}
+}
+
+// AssertNoPings fails the test if msg mentions a user, user group or
+// wildcard such as @**all** in a way that notifies them. Silent mentions
+// (@_**Name**) and mentions in code are allowed; mentions in spoilers and
+// quotes notify like any other.
+//
+// Example:
+//
+//	// Untrusted commit messages must not notify anyone.
+//	zlmdtest.AssertNoPings(t, FormatPush(payloadWithMentions))
+func AssertNoPings(t testing.TB, msg string) {
+	t.Helper()
+	for _, m := range zlmd.ExtractMentions(msg) {
+		if !m.Silent {
+			t.Errorf("zlmdtest: message notifies %s", m.Text)
+		}
+	}
+}
+
+// AssertUnderLimit fails the test if msg is longer than zlmd.MaxMessageLength
+// characters, or than limit characters if one is given, such as the
+// max_message_length of a particular server.
+//
+// Example:
+//
+//	for _, part := range zlmd.SplitMessage(log) {
+//	  zlmdtest.AssertUnderLimit(t, part)
+//	}
+func AssertUnderLimit(t testing.TB, msg string, limit ...int) {
+	t.Helper()
+	maxLength := zlmd.MaxMessageLength
+	if len(limit) > 0 {
+		maxLength = limit[0]
+	}
+	if n := utf8.RuneCountInString(msg); n > maxLength {
+		t.Errorf("zlmdtest: message is %d characters, over the %d character limit", n, maxLength)
+	}
+}
+
+// problems returns the reasons AssertValidZulip rejects msg.
+func problems(msg string) []string {
+	var found []string
+	if strings.TrimSpace(msg) == "" {
+		found = append(found, "message is empty")
+	}
+	if n := utf8.RuneCountInString(msg); n > zlmd.MaxMessageLength {
+		found = append(found, fmt.Sprintf("message is %d characters, over the %d character limit", n, zlmd.MaxMessageLength))
+	}
+	if !utf8.ValidString(msg) {
+		found = append(found, "message is not valid UTF-8")
+	}
+	if strings.IndexByte(msg, 0) >= 0 {
+		found = append(found, "message contains a NUL byte")
+	}
+	if line := zlmd.UnclosedFence(msg); line > 0 {
+		found = append(found, fmt.Sprintf("code fence opened on line %d is never closed", line))
+	}
+	return found
+}
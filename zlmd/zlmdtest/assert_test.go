@@ -0,0 +1,90 @@
+package zlmdtest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestAssertValidZulip(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		expected []string
+	}{
+		{"Valid", "**deploy** done\n```go\nx := 1\n```", nil},
+		{"Empty", " \n", []string{"message is empty"}},
+		{"Too long", strings.Repeat("é", 10001), []string{"10001 characters, over the 10000"}},
+		{"Invalid UTF-8", "bad \xff byte", []string{"not valid UTF-8"}},
+		{"NUL", "a\x00b", []string{"NUL byte"}},
+		{"Unclosed fence", "text\n````\ncode\n```", []string{"opened on line 2 is never closed"}},
+		{"Unclosed tilde fence", "~~~\ncode", []string{"opened on line 1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures := run(func(r testing.TB) { AssertValidZulip(r, tt.msg) })
+			assertFailures(t, failures, tt.expected)
+		})
+	}
+}
+
+func TestAssertNoPings(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		expected []string
+	}{
+		{"No mentions", "hello", nil},
+		{"Silent", "thanks @_**Alice|12**", nil},
+		{"Escaped", `@\*\*all\*\*`, nil},
+		{"In code", "`@**all**`\n```\n@*oncall*\n```", nil},
+		{"User", "cc @**Alice**", []string{"notifies @**Alice**"}},
+		{"Wildcard and group", "@**all** @*oncall*", []string{"@**all**", "@*oncall*"}},
+		{"In spoiler", zlmd.Spoiler("Logs", "ping @**all**"), []string{"notifies @**all**"}},
+		{"In quote", "```quote\n@*oncall* wrote\n```", []string{"notifies @*oncall*"}},
+		{"Code in spoiler", "````spoiler\n```\n@**all**\n```\n````", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures := run(func(r testing.TB) { AssertNoPings(r, tt.msg) })
+			assertFailures(t, failures, tt.expected)
+		})
+	}
+}
+
+func TestAssertUnderLimit(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		limit    []int
+		expected []string
+	}{
+		{"Default limit", strings.Repeat("東", 10000), nil, nil},
+		{"Over default", strings.Repeat("x", 10001), nil, []string{"over the 10000 character limit"}},
+		{"Custom limit", "hello", []int{4}, []string{"5 characters, over the 4"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures := run(func(r testing.TB) { AssertUnderLimit(r, tt.msg, tt.limit...) })
+			assertFailures(t, failures, tt.expected)
+		})
+	}
+}
+
+// assertFailures checks that each recorded failure contains the expected
+// text at the same position.
+func assertFailures(t *testing.T, failures, expected []string) {
+	t.Helper()
+	if len(failures) != len(expected) {
+		t.Fatalf("failures = %q, want %d containing %q", failures, len(expected), expected)
+	}
+	for i, want := range expected {
+		if !strings.Contains(failures[i], want) {
+			t.Errorf("failure %d = %q, want it to contain %q", i, failures[i], want)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package zlmdtest
+
+import (
+	"math/rand/v2"
+	"strings"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// HostileInputs are untrusted strings that commonly break message
+// construction: fences that close a surrounding block, mentions that notify
+// people, table pipes, markdown metacharacters, right-to-left text, emoji
+// sequences, CRLF line endings and very long words. Feed them to code that
+// interpolates user input and check the result with AssertValidZulip and
+// AssertNoPings.
+var HostileInputs = []string{
+	"```\nrm -rf /\n```",
+	"~~~ spoiler\nsurprise",
+	"@**all** please look",
+	"@*oncall* @**Alice|12**",
+	"a | b | c",
+	"*bold* _italic_ [link](https://example.com) <b>html</b>",
+	"# not a heading\n> not a quote\n- not a list",
+	"שלום עולם",
+	"deploy 👩\u200d💻 done 🇩🇪",
+	"line one\r\nline two\r\n",
+	"$$\\sum$$ and <time:2024-01-01T00:00:00Z>",
+	"#**general>deploys** #**secret**",
+	strings.Repeat("x", 300),
+	"",
+}
+
+// fixtureWords are the words random messages are made of, mixing ASCII,
+// accented, wide and right-to-left text and emoji.
+var fixtureWords = []string{
+	"deploy", "api", "queue", "latency", "rollback", "build", "café",
+	"naïve", "東京", "שלום", "✅", "⚠\ufe0f", "👩\u200d💻", "v1.2.3", "99.9%",
+}
+
+// RandomMessage returns a random but valid Zulip message, built with the
+// zlmd builders from headings, paragraphs, lists, tables, code blocks,
+// quotes and spoilers holding random words and HostileInputs.
+//
+// The same seed always gives the same message, so a failing seed can be
+// reproduced. Every message passes AssertValidZulip and AssertNoPings.
+//
+// Example:
+//
+//	for seed := range uint64(200) {
+//	  msg := zlmdtest.RandomMessage(seed)
+//	  zlmdtest.AssertValidZulip(t, Reformat(msg))
+//	}
+func RandomMessage(seed uint64) string {
+	rng := rand.New(rand.NewPCG(seed, seed^0x5a17))
+	blocks := make([]string, 1+rng.IntN(8))
+	for i := range blocks {
+		blocks[i] = randomBlock(rng)
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// randomBlock returns one random markdown block.
+func randomBlock(rng *rand.Rand) string {
+	switch rng.IntN(7) {
+	case 0:
+		return zlmd.Heading(1+rng.IntN(3), randomText(rng, 4))
+	case 1:
+		var sb strings.Builder
+		for range 1 + rng.IntN(4) {
+			zlmd.WriteListItem(&sb, randomText(rng, 6), rng.IntN(2))
+		}
+		return strings.TrimSuffix(sb.String(), "\n")
+	case 2:
+		table := zlmd.NewTableBuilder(zlmd.WithConfig(zlmd.Config{Escape: zlmd.EscapeAll})).
+			WithHeaders("name", "value")
+		for range 1 + rng.IntN(4) {
+			table.AddRow(randomWord(rng), randomInput(rng))
+		}
+		return strings.TrimSuffix(table.Build(), "\n")
+	case 3:
+		return zlmd.FencedBlock("text", randomInput(rng))
+	case 4:
+		return strings.TrimSuffix(zlmd.QuoteBlock(randomText(rng, 10)), "\n")
+	case 5:
+		return zlmd.FencedBlock("spoiler "+randomWord(rng), randomText(rng, 12))
+	default:
+		return randomText(rng, 20) + " " + zlmd.Escape(randomInput(rng), zlmd.EscapeAll)
+	}
+}
+
+// randomText returns up to n random words.
+func randomText(rng *rand.Rand, n int) string {
+	words := make([]string, 1+rng.IntN(n))
+	for i := range words {
+		words[i] = randomWord(rng)
+	}
+	return strings.Join(words, " ")
+}
+
+func randomWord(rng *rand.Rand) string {
+	return fixtureWords[rng.IntN(len(fixtureWords))]
+}
+
+// randomInput returns one of HostileInputs on a single line.
+func randomInput(rng *rand.Rand) string {
+	text := HostileInputs[rng.IntN(len(HostileInputs))]
+	return strings.Join(strings.Fields(text), " ")
+}
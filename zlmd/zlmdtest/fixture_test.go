@@ -0,0 +1,35 @@
+package zlmdtest
+
+import (
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+func TestRandomMessage(t *testing.T) {
+	for seed := range uint64(500) {
+		msg := RandomMessage(seed)
+		if msg != RandomMessage(seed) {
+			t.Fatalf("RandomMessage(%d) is not deterministic", seed)
+		}
+		failures := run(func(r testing.TB) {
+			AssertValidZulip(r, msg)
+			AssertNoPings(r, msg)
+		})
+		if len(failures) > 0 {
+			t.Fatalf("RandomMessage(%d) = %q: %q", seed, msg, failures)
+		}
+	}
+	if RandomMessage(1) == RandomMessage(2) {
+		t.Errorf("RandomMessage(1) == RandomMessage(2), want different messages")
+	}
+}
+
+func TestHostileInputs(t *testing.T) {
+	for _, input := range HostileInputs {
+		msg := "Note: " + zlmd.Escape(input, zlmd.EscapeAll)
+		if failures := run(func(r testing.TB) { AssertNoPings(r, msg) }); len(failures) > 0 {
+			t.Errorf("Escape(%q, EscapeAll) still pings: %q", input, failures)
+		}
+	}
+}
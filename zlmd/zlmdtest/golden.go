@@ -0,0 +1,83 @@
+// Package zlmdtest helps test code that generates Zulip messages with zlmd:
+// it compares messages with golden files kept next to the tests, asserts
+// that messages are valid and don't notify anyone, and generates random
+// messages and hostile inputs to feed to the code under test.
+package zlmdtest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// update makes AssertGolden write golden files instead of comparing them.
+// It has a prefixed name so it can't clash with an -update flag defined by
+// the tests using this package.
+var update = flag.Bool("zlmdtest.update", false, "rewrite zlmdtest golden files with the current output")
+
+// GoldenDir is the directory, relative to the package under test, that
+// holds golden files.
+const GoldenDir = "testdata"
+
+// AssertGolden compares a generated message with the golden file
+// testdata/<name>.golden and fails the test, showing a diff, if they
+// differ.
+//
+// Run the tests with -zlmdtest.update to create or rewrite the golden files
+// from the current output, then review the changes with git diff:
+//
+//	go test ./... -args -zlmdtest.update
+//
+// Parameters:
+//   - t (testing.TB): The test
+//   - name (string): The golden file name without extension; may contain
+//     slashes to group files in directories
+//   - got (string): The generated message
+//
+// Example:
+//
+//	func TestDeployMessage(t *testing.T) {
+//	  zlmdtest.AssertGolden(t, "deploy/success", DeployMessage(build))
+//	}
+func AssertGolden(t testing.TB, name string, got string) {
+	t.Helper()
+
+	path, err := goldenPath(name)
+	if err != nil {
+		t.Fatalf("zlmdtest: %v", err)
+	}
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("zlmdtest: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatalf("zlmdtest: %v", err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("zlmdtest: golden file %s does not exist; run the test with -zlmdtest.update to create it", path)
+	}
+	if err != nil {
+		t.Fatalf("zlmdtest: %v", err)
+	}
+	if string(want) != got {
+		t.Errorf("zlmdtest: output differs from %s:\n%s", path, zlmd.DiffStrings(string(want), got))
+	}
+}
+
+// goldenPath returns the path of the golden file called name.
+func goldenPath(name string) (string, error) {
+	if name == "" || filepath.IsAbs(name) || strings.Contains(name, "..") {
+		return "", fmt.Errorf("golden file name %q must be a relative path inside %s", name, GoldenDir)
+	}
+	return filepath.Join(GoldenDir, filepath.FromSlash(name)+".golden"), nil
+}
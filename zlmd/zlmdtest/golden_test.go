@@ -0,0 +1,87 @@
+package zlmdtest
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// recorder is a testing.TB that records failures instead of reporting them.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.Errorf(format, args...)
+	runtime.Goexit()
+}
+
+// run calls fn with a recorder and returns the failures it recorded.
+func run(fn func(t testing.TB)) []string {
+	r := &recorder{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		fn(r)
+	}()
+	<-done
+	return r.failures
+}
+
+func TestAssertGolden(t *testing.T) {
+	t.Chdir(t.TempDir())
+	if err := os.MkdirAll(filepath.Join(GoldenDir, "deploy"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(GoldenDir, "deploy", "ok.golden"), []byte("**api** deployed\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		golden   string
+		got      string
+		expected string
+	}{
+		{"Match", "deploy/ok", "**api** deployed\n", ""},
+		{"Mismatch", "deploy/ok", "**db** deployed\n", "-**api** deployed\n+**db** deployed"},
+		{"Missing", "deploy/failed", "x", "run the test with -zlmdtest.update"},
+		{"Outside testdata", "../secret", "x", "must be a relative path"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failures := run(func(r testing.TB) { AssertGolden(r, tt.golden, tt.got) })
+			switch {
+			case tt.expected == "" && len(failures) > 0:
+				t.Errorf("AssertGolden() failed: %v", failures)
+			case tt.expected != "" && (len(failures) != 1 || !strings.Contains(failures[0], tt.expected)):
+				t.Errorf("AssertGolden() failures = %q, want one containing %q", failures, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAssertGolden_Update(t *testing.T) {
+	t.Chdir(t.TempDir())
+	*update = true
+	t.Cleanup(func() { *update = false })
+
+	if failures := run(func(r testing.TB) { AssertGolden(r, "new/message", "hello") }); len(failures) > 0 {
+		t.Fatalf("AssertGolden() failed: %v", failures)
+	}
+	data, err := os.ReadFile(filepath.Join(GoldenDir, "new", "message.golden"))
+	if err != nil || string(data) != "hello" {
+		t.Errorf("golden file = %q, %v, want %q", data, err, "hello")
+	}
+}
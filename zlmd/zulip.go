@@ -2,6 +2,8 @@ package zlmd
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -16,6 +18,263 @@ import (
 //	result := ZLFormatTime(t)
 //	// result will be something like:
 //	// <time:2023-05-15T14:30:00Z>
+//
+// Notes:
+//   - Servers older than 3.0 and GitHub don't render time tags; when the
+//     default Config targets one of them, with TargetVersion or
+//     FlavorPortable, the time is written out in UTC instead, such as
+//     "2023-05-15 14:30 UTC", in the format of the default Config's Locale
 func ZLFormatTime(t time.Time) string {
+	return formatTime(t, defaultTarget())
+}
+
+// formatTime is ZLFormatTime for target rt.
+func formatTime(t time.Time, rt renderTarget) string {
+	if !rt.supports(FeatureGlobalTime) {
+		if rt.locale != nil {
+			return rt.locale.FormatTime(t)
+		}
+		return English.FormatTime(t)
+	}
 	return fmt.Sprintf("<time:%s>", t.Format(time.RFC3339))
 }
+
+// ZLFormatTimeRange formats a time range, such as a meeting or maintenance
+// window, with Zulip time tags so both ends show in the viewer's time zone.
+//
+// Parameters:
+//   - start (time.Time): The start of the range
+//   - end (time.Time): The end of the range
+//   - opts (...Option): Optional settings; WithDuration appends the length of
+//     the range, and the options of ZLFormatTimeOpt format both ends
+//
+// Returns:
+//   - string: The range, with the ends separated by an en dash
+//
+// Example:
+//
+//	start := time.Date(2024, 5, 15, 14, 0, 0, 0, time.UTC)
+//	ZLFormatTimeRange(start, start.Add(90*time.Minute), WithDuration(true))
+//	// "<time:2024-05-15T14:00:00Z> – <time:2024-05-15T15:30:00Z> (1h 30m)"
+//
+// Notes:
+//   - The ends are written as given; when end is before start, no duration
+//     is appended
+func ZLFormatTimeRange(start, end time.Time, opts ...Option) string {
+	o := newOptions(opts...)
+	text := formatTimeOpts(start, &o) + " – " + formatTimeOpts(end, &o)
+	if o.showDuration && !end.Before(start) {
+		text += " (" + o.locale.Duration(end.Sub(start)) + ")"
+	}
+	return text
+}
+
+// WithDuration makes ZLFormatTimeRange append the humanized length of the
+// range, such as "(1h 30m)", when show is true.
+func WithDuration(show bool) Option {
+	return func(o *options) {
+		o.showDuration = show
+	}
+}
+
+// ZLFormatTimeOpt is ZLFormatTime with options for the precision and time
+// zone of the tag.
+//
+// Parameters:
+//   - t (time.Time): The time to format
+//   - opts (...Option): Optional settings; WithDateOnly writes only the
+//     date, WithMinutePrecision drops the seconds, and WithTimeZone converts
+//     t to a time zone first. WithTargetVersion, WithFlavor and WithLocale
+//     select the fallback for targets that don't render time tags
+//
+// Returns:
+//   - string: The time tag, or its fallback text
+//
+// Example:
+//
+//	t := time.Date(2024, 5, 15, 14, 30, 45, 0, time.UTC)
+//	ZLFormatTimeOpt(t, WithMinutePrecision(true)) // "<time:2024-05-15T14:30Z>"
+//	ZLFormatTimeOpt(t, WithDateOnly(true))        // "<time:2024-05-15>"
+//	ZLFormatTimeOpt(t, WithTimeZone(berlin))      // "<time:2024-05-15T16:30:45+02:00>"
+//
+// Notes:
+//   - Zulip still shows a date-only tag as a time: midnight UTC of the
+//     date, in the viewer's time zone. Combine WithDateOnly with
+//     WithTimeZone to choose the zone the date is taken in
+//   - The time zone changes the offset written in the tag, not the instant
+//     viewers see; it matters for the date of WithDateOnly and for the
+//     fallback text, which is written in that zone instead of UTC
+func ZLFormatTimeOpt(t time.Time, opts ...Option) string {
+	o := newOptions(opts...)
+	return formatTimeOpts(t, &o)
+}
+
+// formatTimeOpts is ZLFormatTimeOpt with the options already applied.
+func formatTimeOpts(t time.Time, o *options) string {
+	if o.timeZone != nil {
+		t = t.In(o.timeZone)
+	}
+	if o.minutePrecision {
+		t = t.Truncate(time.Minute)
+	}
+	rt := o.target()
+	if rt.supports(FeatureGlobalTime) {
+		layout := time.RFC3339
+		switch {
+		case o.dateOnly:
+			layout = time.DateOnly
+		case o.minutePrecision:
+			layout = "2006-01-02T15:04Z07:00"
+		}
+		return fmt.Sprintf("<time:%s>", t.Format(layout))
+	}
+	switch {
+	case o.dateOnly:
+		return t.Format(time.DateOnly)
+	case o.timeZone != nil:
+		layout := o.locale.TimeLayout
+		if layout == "" {
+			layout = English.TimeLayout
+		}
+		return t.Format(layout)
+	}
+	return formatTime(t, rt)
+}
+
+// WithDateOnly makes ZLFormatTimeOpt and ZLFormatTimeRange write dates
+// without the time of day when dateOnly is true.
+func WithDateOnly(dateOnly bool) Option {
+	return func(o *options) {
+		o.dateOnly = dateOnly
+	}
+}
+
+// WithMinutePrecision makes ZLFormatTimeOpt and ZLFormatTimeRange drop the
+// seconds of times when minutes is true.
+func WithMinutePrecision(minutes bool) Option {
+	return func(o *options) {
+		o.minutePrecision = minutes
+	}
+}
+
+// WithTimeZone makes ZLFormatTimeOpt and ZLFormatTimeRange convert times to
+// loc before formatting them; nil keeps each time's own location.
+//
+// Example:
+//
+//	berlin, _ := time.LoadLocation("Europe/Berlin")
+//	ZLFormatTimeOpt(t, WithTimeZone(berlin), WithDateOnly(true))
+func WithTimeZone(loc *time.Location) Option {
+	return func(o *options) {
+		o.timeZone = loc
+	}
+}
+
+// TimeRef is a <time:...> tag found by ParseZLTime.
+type TimeRef struct {
+	// Text is the tag as written, such as "<time:2024-05-15T14:30:00Z>".
+	Text string `json:"text"`
+	// Time is the time of the tag.
+	Time time.Time `json:"time"`
+	// Start and End are the byte offsets of Text in the message, so
+	// markdown[Start:End] == Text.
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// timeTagPattern matches a <time:...> tag.
+var timeTagPattern = regexp.MustCompile(`<time:([^<>\n]+)>`)
+
+// timeTagLayouts are the time formats ParseZLTime reads: the ones
+// ZLFormatTimeOpt writes, those written by the Zulip composer's time
+// picker, and the same without a zone, which Zulip reads as UTC.
+var timeTagLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02T15:04",
+	time.DateOnly,
+}
+
+// ParseZLTime returns the <time:...> tags in markdown, the inverse of
+// ZLFormatTime, so a bot can read the times out of an existing message such
+// as a scheduled announcement.
+//
+// Parameters:
+//   - markdown (string): The message
+//
+// Returns:
+//   - []TimeRef: The tags, in document order
+//
+// Example:
+//
+//	ParseZLTime("Deploy at <time:2024-05-15T14:30:00Z>.")
+//	// []TimeRef{{Text: "<time:2024-05-15T14:30:00Z>", Time: time.Date(2024, 5, 15, 14, 30, 0, 0, time.UTC), Start: 10, End: 37}}
+//
+// Notes:
+//   - Tags inside code blocks and inline code are ignored, as are tags
+//     whose time doesn't parse, which Zulip shows as an error
+//   - Times without a zone, and dates, are read as UTC, as Zulip does
+//   - Like the other Extract functions, tags inside spoilers and quotes,
+//     which Zulip renders as markdown, are included
+func ParseZLTime(markdown string) []TimeRef {
+	type block struct {
+		fence string
+		prose bool
+	}
+
+	var refs []TimeRef
+	var open []block
+	offset := 0
+	for _, line := range strings.SplitAfter(markdown, "\n") {
+		start := offset
+		offset += len(line)
+		trimmed := strings.TrimLeft(strings.TrimRight(line, "\r\n"), " ")
+		if n := len(open); n > 0 {
+			if isClosingFence(trimmed, open[n-1].fence) {
+				open = open[:n-1]
+				continue
+			}
+			if !open[n-1].prose {
+				continue
+			}
+		}
+		if fence := openingFence(trimmed); fence != "" {
+			open = append(open, block{fence, isProseBlock(strings.TrimSpace(trimmed[len(fence):]))})
+			continue
+		}
+
+		// Blank inline code without moving the tags after it.
+		prose := inlineCodePattern.ReplaceAllStringFunc(line, func(code string) string {
+			return strings.Repeat(" ", len(code))
+		})
+		for _, loc := range timeTagPattern.FindAllStringSubmatchIndex(prose, -1) {
+			t, ok := parseTimeTag(strings.TrimSpace(prose[loc[2]:loc[3]]))
+			if !ok {
+				continue
+			}
+			refs = append(refs, TimeRef{
+				Text:  prose[loc[0]:loc[1]],
+				Time:  t,
+				Start: start + loc[0],
+				End:   start + loc[1],
+			})
+		}
+	}
+	return refs
+}
+
+// parseTimeTag parses the time of a <time:...> tag.
+func parseTimeTag(text string) (time.Time, bool) {
+	for _, layout := range timeTagLayouts {
+		if t, err := time.Parse(layout, text); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// MaxMessageLength is the maximum number of characters Zulip accepts in a
+// single message body. Helpers that batch or split output use it as their
+// default budget.
+const MaxMessageLength = 10000
@@ -0,0 +1,135 @@
+package zulipapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// StreamID returns the ID of the stream with the given name.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the request
+//   - stream (string): The stream name
+//
+// Returns:
+//   - int: The stream ID
+//   - error: An *APIError if the stream doesn't exist, or a transport error
+func (c *Client) StreamID(ctx context.Context, stream string) (int, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "get_stream_id?"+url.Values{"stream": {stream}}.Encode(), nil)
+	if err != nil {
+		return 0, err
+	}
+	var resp struct {
+		StreamID int `json:"stream_id"`
+	}
+	if err := c.do(req, &resp); err != nil {
+		return 0, err
+	}
+	return resp.StreamID, nil
+}
+
+// ScheduleStream schedules content to be posted to a topic at a later time.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the requests
+//   - stream (string): The stream name
+//   - topic (string): The topic name
+//   - content (string): The message body
+//   - at (time.Time): When Zulip should post the message
+//
+// Returns:
+//   - int: The scheduled message ID, for DeleteScheduled
+//   - error: ErrEmptyMessage, ErrMessageTooLong, ErrTopicTooLong, a
+//     *ValidationError, an *APIError or a transport error
+//
+// Example:
+//
+//	at := zlmd.NextLocalTime(time.Now(), 9, 0, berlin)
+//	id, err := c.ScheduleStream(ctx, "ops", "daily", report, at)
+//
+// Notes:
+//   - Scheduled messages are delivered as a whole, so content must fit in
+//     one message
+func (c *Client) ScheduleStream(ctx context.Context, stream, topic, content string, at time.Time) (int, error) {
+	if utf8.RuneCountInString(topic) > MaxTopicLength {
+		return 0, ErrTopicTooLong
+	}
+	if err := c.checkSingle(content); err != nil {
+		return 0, err
+	}
+	id, err := c.StreamID(ctx, stream)
+	if err != nil {
+		return 0, err
+	}
+	return c.schedule(ctx, url.Values{"type": {"stream"}, "to": {strconv.Itoa(id)}, "topic": {topic}}, content, at)
+}
+
+// ScheduleDirect schedules content to be sent as a direct message at a
+// later time.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the request
+//   - userIDs ([]int): Recipient user IDs
+//   - content (string): The message body
+//   - at (time.Time): When Zulip should send the message
+//
+// Returns:
+//   - int: The scheduled message ID, for DeleteScheduled
+//   - error: ErrEmptyMessage, ErrMessageTooLong, a *ValidationError, an
+//     *APIError or a transport error
+func (c *Client) ScheduleDirect(ctx context.Context, userIDs []int, content string, at time.Time) (int, error) {
+	if err := c.checkSingle(content); err != nil {
+		return 0, err
+	}
+	to, err := json.Marshal(userIDs)
+	if err != nil {
+		return 0, err
+	}
+	return c.schedule(ctx, url.Values{"type": {"direct"}, "to": {string(to)}}, content, at)
+}
+
+// DeleteScheduled cancels a scheduled message before it is posted.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the request
+//   - id (int): The scheduled message ID
+//
+// Returns:
+//   - error: An *APIError or a transport error
+func (c *Client) DeleteScheduled(ctx context.Context, id int) error {
+	req, err := c.newRequest(ctx, http.MethodDelete, "scheduled_messages/"+strconv.Itoa(id), nil)
+	if err != nil {
+		return err
+	}
+	return c.do(req, &struct{}{})
+}
+
+// schedule posts a scheduled message with the addressing fields in params.
+func (c *Client) schedule(ctx context.Context, params url.Values, content string, at time.Time) (int, error) {
+	params.Set("content", content)
+	params.Set("scheduled_delivery_timestamp", strconv.FormatInt(at.Unix(), 10))
+	var resp struct {
+		ID int `json:"scheduled_message_id"`
+	}
+	if err := c.postForm(ctx, http.MethodPost, "scheduled_messages", params, &resp); err != nil {
+		return 0, err
+	}
+	return resp.ID, nil
+}
+
+// checkSingle reports whether content can be posted as a single message.
+func (c *Client) checkSingle(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(content) > c.maxLength() {
+		return ErrMessageTooLong
+	}
+	return c.validate(content)
+}
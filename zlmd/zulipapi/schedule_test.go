@@ -0,0 +1,61 @@
+package zulipapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestScheduleStream(t *testing.T) {
+	var scheduled url.Values
+	var deleted string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/api/v1/get_stream_id":
+			if r.URL.Query().Get("stream") != "ops" {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, `{"result":"error","msg":"Invalid channel name","code":"BAD_REQUEST"}`)
+				return
+			}
+			fmt.Fprint(w, `{"result":"success","msg":"","stream_id":15}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/scheduled_messages":
+			r.ParseForm()
+			scheduled = r.PostForm
+			fmt.Fprint(w, `{"result":"success","msg":"","scheduled_message_id":42}`)
+		case r.Method == http.MethodDelete:
+			deleted = r.URL.Path
+			fmt.Fprint(w, `{"result":"success","msg":""}`)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(srv.URL, "bot@example.com", "secret")
+	ctx := context.Background()
+	at := time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	id, err := c.ScheduleStream(ctx, "ops", "daily", "report", at)
+	if err != nil || id != 42 {
+		t.Fatalf("ScheduleStream() = %d, %v; want 42, nil", id, err)
+	}
+	expected := url.Values{"type": {"stream"}, "to": {"15"}, "topic": {"daily"}, "content": {"report"}, "scheduled_delivery_timestamp": {"1709283600"}}
+	if scheduled.Encode() != expected.Encode() {
+		t.Errorf("Scheduled %v, want %v", scheduled, expected)
+	}
+
+	if _, err := c.ScheduleStream(ctx, "missing", "daily", "report", at); err == nil {
+		t.Error("Expected an error for an unknown stream")
+	}
+	if _, err := c.ScheduleDirect(ctx, []int{7, 9}, "", at); err != ErrEmptyMessage {
+		t.Errorf("ScheduleDirect() error = %v, want %v", err, ErrEmptyMessage)
+	}
+	if _, err := c.ScheduleDirect(ctx, []int{7, 9}, "ping", at); err != nil || scheduled.Get("to") != "[7,9]" {
+		t.Errorf("ScheduleDirect() error = %v, to = %q", err, scheduled.Get("to"))
+	}
+
+	if err := c.DeleteScheduled(ctx, 42); err != nil || deleted != "/api/v1/scheduled_messages/42" {
+		t.Errorf("DeleteScheduled() = %v, deleted %q", err, deleted)
+	}
+}
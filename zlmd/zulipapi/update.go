@@ -0,0 +1,276 @@
+package zulipapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// ErrMessageTooLong is returned by UpdateOrCreate when content doesn't fit in
+// a single message, since an edited message can't be split.
+var ErrMessageTooLong = errors.New("zulipapi: message is too long")
+
+// scanDepth is how many of the account's latest messages in a topic
+// UpdateOrCreate searches when the store has no record.
+const scanDepth = 50
+
+// Record is the last known state of a message maintained by UpdateOrCreate.
+type Record struct {
+	MessageID int    `json:"message_id"`
+	Content   string `json:"content"`
+}
+
+// Store remembers the message UpdateOrCreate posted for each key.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Load returns the record for key, and false if there is none.
+	Load(key string) (Record, bool, error)
+	// Save stores rec under key.
+	Save(key string, rec Record) error
+}
+
+// MemoryStore is a Store kept in memory, for processes that live as long as
+// the dashboard they maintain.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+}
+
+// Load implements Store.
+func (s *MemoryStore) Load(key string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rec, ok := s.records[key]
+	return rec, ok, nil
+}
+
+// Save implements Store.
+func (s *MemoryStore) Save(key string, rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.records == nil {
+		s.records = map[string]Record{}
+	}
+	s.records[key] = rec
+	return nil
+}
+
+// FileStore is a Store persisted as a JSON object in a file, for cron jobs
+// and CLI runs that update the same message across invocations.
+type FileStore struct {
+	Path string
+
+	mu sync.Mutex
+}
+
+// Load implements Store. A missing file holds no records.
+func (s *FileStore) Load(key string) (Record, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.read()
+	rec, ok := records[key]
+	return rec, ok, err
+}
+
+// Save implements Store.
+func (s *FileStore) Save(key string, rec Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records, err := s.read()
+	if err != nil {
+		return err
+	}
+	records[key] = rec
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.Path, append(data, '\n'), 0o644)
+}
+
+// read returns the records in the file, or an empty map if it doesn't exist.
+func (s *FileStore) read() (map[string]Record, error) {
+	records := map[string]Record{}
+	data, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return records, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return records, json.Unmarshal(data, &records)
+}
+
+// UpdateMessage replaces the content of a message.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the request
+//   - id (int): The message ID
+//   - content (string): The new message body
+//
+// Returns:
+//   - error: ErrEmptyMessage, a *ValidationError, an *APIError or a
+//     transport error
+func (c *Client) UpdateMessage(ctx context.Context, id int, content string) error {
+	if strings.TrimSpace(content) == "" {
+		return ErrEmptyMessage
+	}
+	if err := c.validate(content); err != nil {
+		return err
+	}
+	return c.postForm(ctx, http.MethodPatch, "messages/"+strconv.Itoa(id), url.Values{"content": {content}}, &struct{}{})
+}
+
+// UpdateOrCreate keeps a single message in a topic up to date, for status
+// boards that are refreshed in place instead of posting a new message on
+// every run.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the requests
+//   - stream (string): The stream name
+//   - topic (string): The topic name
+//   - key (string): Identifies the message within the topic
+//   - content (string): The message body
+//
+// Returns:
+//   - int: The ID of the message that now holds content
+//   - bool: Whether a message was posted or edited; false if content was unchanged
+//   - error: ErrEmptyMessage, ErrMessageTooLong, ErrTopicTooLong, a
+//     *ValidationError, an *APIError or a store or transport error
+//
+// Example:
+//
+//	c.Store = &zulipapi.FileStore{Path: "dashboards.json"}
+//	id, changed, err := c.UpdateOrCreate(ctx, "ops", "status", "ci", board)
+//
+// Notes:
+//   - The message is looked up in the client's Store; without a Store, or
+//     without a record, the account's latest messages in the topic are
+//     scanned for the newest one that starts with key
+//   - Content is compared with zlmd.DiffMarkdown, so edits that only change
+//     whitespace between blocks are skipped
+//   - If the remembered message was deleted or is past the organization's
+//     edit time limit, a new one is posted; other errors, such as missing
+//     permission to edit, are returned
+func (c *Client) UpdateOrCreate(ctx context.Context, stream, topic, key, content string) (int, bool, error) {
+	if utf8.RuneCountInString(topic) > MaxTopicLength {
+		return 0, false, ErrTopicTooLong
+	}
+	if err := c.checkSingle(content); err != nil {
+		return 0, false, err
+	}
+
+	storeKey := stream + ">" + topic + ">" + key
+	rec, found, err := c.lookup(ctx, stream, topic, key, storeKey)
+	if err != nil {
+		return 0, false, err
+	}
+
+	if found {
+		if len(zlmd.DiffMarkdown(rec.Content, content)) == 0 {
+			return rec.MessageID, false, nil
+		}
+		err := c.UpdateMessage(ctx, rec.MessageID, content)
+		if err == nil {
+			return rec.MessageID, true, c.save(storeKey, Record{rec.MessageID, content})
+		}
+		if !isStaleMessage(err) {
+			return 0, false, err
+		}
+	}
+
+	var resp struct {
+		ID int `json:"id"`
+	}
+	params := url.Values{"type": {"stream"}, "to": {stream}, "topic": {topic}, "content": {content}}
+	if err := c.postForm(ctx, http.MethodPost, "messages", params, &resp); err != nil {
+		return 0, false, err
+	}
+	return resp.ID, true, c.save(storeKey, Record{resp.ID, content})
+}
+
+// isStaleMessage reports whether err says that a message can't be edited
+// because it no longer exists or is past the edit time limit, the cases
+// in which UpdateOrCreate posts a new message instead.
+func isStaleMessage(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode == http.StatusNotFound {
+		return true
+	}
+	msg := strings.ToLower(apiErr.Msg)
+	return strings.Contains(msg, "invalid message") || strings.Contains(msg, "time limit for editing")
+}
+
+// lookup finds the message UpdateOrCreate maintains for key, first in the
+// store and then by scanning the topic.
+func (c *Client) lookup(ctx context.Context, stream, topic, key, storeKey string) (Record, bool, error) {
+	if c.Store != nil {
+		rec, ok, err := c.Store.Load(storeKey)
+		if ok || err != nil {
+			return rec, ok, err
+		}
+	}
+	return c.scanTopic(ctx, stream, topic, key)
+}
+
+// save records rec in the store, if the client has one.
+func (c *Client) save(storeKey string, rec Record) error {
+	if c.Store == nil {
+		return nil
+	}
+	return c.Store.Save(storeKey, rec)
+}
+
+// scanTopic returns the newest message the account sent to the topic whose
+// raw content starts with key.
+func (c *Client) scanTopic(ctx context.Context, stream, topic, key string) (Record, bool, error) {
+	// "stream" rather than "channel", which servers before Zulip 9 reject.
+	narrow, err := json.Marshal([]map[string]string{
+		{"operator": "stream", "operand": stream},
+		{"operator": "topic", "operand": topic},
+		{"operator": "sender", "operand": c.Email},
+	})
+	if err != nil {
+		return Record{}, false, err
+	}
+	query := url.Values{
+		"anchor":          {"newest"},
+		"num_before":      {strconv.Itoa(scanDepth)},
+		"num_after":       {"0"},
+		"narrow":          {string(narrow)},
+		"apply_markdown":  {"false"},
+		"client_gravatar": {"true"},
+	}
+	req, err := c.newRequest(ctx, http.MethodGet, "messages?"+query.Encode(), nil)
+	if err != nil {
+		return Record{}, false, err
+	}
+	var resp struct {
+		Messages []struct {
+			ID      int    `json:"id"`
+			Content string `json:"content"`
+		} `json:"messages"`
+	}
+	if err := c.do(req, &resp); err != nil {
+		return Record{}, false, err
+	}
+
+	for i := len(resp.Messages) - 1; i >= 0; i-- {
+		if m := resp.Messages[i]; strings.HasPrefix(m.Content, key) {
+			return Record{m.ID, m.Content}, true, nil
+		}
+	}
+	return Record{}, false, nil
+}
@@ -0,0 +1,199 @@
+package zulipapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// fakeRealm stores messages in memory and serves the endpoints used by
+// UpdateOrCreate, counting the posts and edits it receives.
+type fakeRealm struct {
+	messages map[int]string
+	order    []int
+	posts    int
+	edits    int
+	// editError, if set, is the error message returned for every edit.
+	editError string
+	// narrow is the narrow of the last message fetch.
+	narrow string
+}
+
+func (f *fakeRealm) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v1/messages":
+		r.ParseForm()
+		f.posts++
+		id := 100 + f.posts
+		f.messages[id] = r.PostForm.Get("content")
+		f.order = append(f.order, id)
+		fmt.Fprintf(w, `{"result":"success","msg":"","id":%d}`, id)
+	case r.Method == http.MethodPatch:
+		r.ParseForm()
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/messages/"))
+		if f.editError != "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"result":"error","msg":%q,"code":"BAD_REQUEST"}`, f.editError)
+			return
+		}
+		if _, ok := f.messages[id]; !ok {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"result":"error","msg":"Invalid message(s)","code":"BAD_REQUEST"}`)
+			return
+		}
+		f.edits++
+		f.messages[id] = r.PostForm.Get("content")
+		fmt.Fprint(w, `{"result":"success","msg":""}`)
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v1/messages":
+		f.narrow = r.URL.Query().Get("narrow")
+		type message struct {
+			ID      int    `json:"id"`
+			Content string `json:"content"`
+		}
+		resp := struct {
+			Result   string    `json:"result"`
+			Messages []message `json:"messages"`
+		}{Result: "success", Messages: []message{}}
+		for _, id := range f.order {
+			resp.Messages = append(resp.Messages, message{id, f.messages[id]})
+		}
+		json.NewEncoder(w).Encode(resp)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func newFakeRealm(t *testing.T) (*fakeRealm, *Client) {
+	t.Helper()
+	realm := &fakeRealm{messages: map[int]string{}}
+	srv := httptest.NewServer(realm)
+	t.Cleanup(srv.Close)
+	return realm, NewClient(srv.URL, "bot@example.com", "secret")
+}
+
+func TestUpdateOrCreate(t *testing.T) {
+	ctx := context.Background()
+	realm, c := newFakeRealm(t)
+	c.Store = &MemoryStore{}
+
+	steps := []struct {
+		name    string
+		content string
+		id      int
+		changed bool
+		posts   int
+		edits   int
+	}{
+		{"Create", "**CI**\n\nall green", 101, true, 1, 0},
+		{"Unchanged", "**CI**\n\n\nall green  ", 101, false, 1, 0},
+		{"Edit", "**CI**\n\n1 failing", 101, true, 1, 1},
+	}
+	for _, step := range steps {
+		id, changed, err := c.UpdateOrCreate(ctx, "ops", "status", "**CI**", step.content)
+		if err != nil {
+			t.Fatalf("%s: UpdateOrCreate() returned error: %v", step.name, err)
+		}
+		if id != step.id || changed != step.changed || realm.posts != step.posts || realm.edits != step.edits {
+			t.Errorf("%s: UpdateOrCreate() = %d, %v with %d posts and %d edits", step.name, id, changed, realm.posts, realm.edits)
+		}
+	}
+
+	// A deleted message is replaced by a new one.
+	delete(realm.messages, 101)
+	realm.order = nil
+	if id, changed, err := c.UpdateOrCreate(ctx, "ops", "status", "**CI**", "**CI**\n\nfixed"); err != nil || id != 102 || !changed {
+		t.Errorf("UpdateOrCreate() after delete = %d, %v, %v", id, changed, err)
+	}
+}
+
+func TestUpdateOrCreate_Scan(t *testing.T) {
+	ctx := context.Background()
+	realm, c := newFakeRealm(t)
+	realm.messages = map[int]string{7: "**Deploys**\nold", 8: "unrelated", 9: "**Deploys**\nnewer"}
+	realm.order = []int{7, 8, 9}
+
+	id, changed, err := c.UpdateOrCreate(ctx, "ops", "status", "**Deploys**", "**Deploys**\nlatest")
+	if err != nil || id != 9 || !changed {
+		t.Errorf("UpdateOrCreate() = %d, %v, %v; want 9, true, nil", id, changed, err)
+	}
+	if realm.messages[9] != "**Deploys**\nlatest" || realm.posts != 0 {
+		t.Errorf("Expected message 9 to be edited in place, got %q with %d posts", realm.messages[9], realm.posts)
+	}
+	if !strings.Contains(realm.narrow, `{"operand":"ops","operator":"stream"}`) {
+		t.Errorf("Expected the scan to use the stream operator every server accepts, got narrow %s", realm.narrow)
+	}
+}
+
+func TestUpdateOrCreate_EditErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		msg      string
+		reposted bool
+	}{
+		{"Deleted", "Invalid message(s)", true},
+		{"Edit window", "The time limit for editing this message has passed", true},
+		{"No permission", "You don't have permission to edit this message", false},
+		{"Editing disabled", "Your organization has turned off message editing", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			realm, c := newFakeRealm(t)
+			c.Store = &MemoryStore{}
+			c.Store.Save("ops>status>**CI**", Record{7, "**CI**\nold"})
+			realm.messages[7] = "**CI**\nold"
+			realm.editError = tt.msg
+
+			id, _, err := c.UpdateOrCreate(context.Background(), "ops", "status", "**CI**", "**CI**\nnew")
+			switch {
+			case tt.reposted && (err != nil || id != 101 || realm.posts != 1):
+				t.Errorf("UpdateOrCreate() = %d, %v with %d posts; want a new message", id, err, realm.posts)
+			case !tt.reposted && (err == nil || realm.posts != 0):
+				t.Errorf("UpdateOrCreate() = %d, %v with %d posts; want the edit error", id, err, realm.posts)
+			}
+		})
+	}
+}
+
+func TestUpdateOrCreate_TooLong(t *testing.T) {
+	_, c := newFakeRealm(t)
+	c.MaxLength = 5
+	if _, _, err := c.UpdateOrCreate(context.Background(), "ops", "status", "", "too long"); err != ErrMessageTooLong {
+		t.Errorf("UpdateOrCreate() error = %v, want %v", err, ErrMessageTooLong)
+	}
+}
+
+func TestUpdateOrCreate_Invalid(t *testing.T) {
+	realm, c := newFakeRealm(t)
+	_, _, err := c.UpdateOrCreate(context.Background(), "ops", "status", "", "```\nunclosed")
+	var vErr *ValidationError
+	if !errors.As(err, &vErr) || vErr.UnclosedFence != 1 {
+		t.Errorf("UpdateOrCreate() error = %v, want a *ValidationError", err)
+	}
+	if realm.posts != 0 || realm.edits != 0 {
+		t.Errorf("Expected nothing posted or edited, got %d posts and %d edits", realm.posts, realm.edits)
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "ids.json")
+	s := &FileStore{Path: path}
+
+	if _, ok, err := s.Load("a"); ok || err != nil {
+		t.Errorf("Load() on a missing file = %v, %v", ok, err)
+	}
+	if err := s.Save("a", Record{MessageID: 1, Content: "x"}); err != nil {
+		t.Fatalf("Save() returned error: %v", err)
+	}
+	rec, ok, err := (&FileStore{Path: path}).Load("a")
+	if !ok || err != nil || rec != (Record{1, "x"}) {
+		t.Errorf("Load() = %+v, %v, %v", rec, ok, err)
+	}
+}
@@ -0,0 +1,291 @@
+// Package zulipapi is a minimal Zulip REST client for posting zlmd output:
+// it sends stream and direct messages, splitting bodies that are too long
+// with zlmd.SplitMessage, and uploads files for linking from messages.
+package zulipapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// MaxTopicLength is the maximum number of characters Zulip accepts in a
+// topic name.
+const MaxTopicLength = 60
+
+var (
+	// ErrEmptyMessage is returned when a message body is blank.
+	ErrEmptyMessage = errors.New("zulipapi: message is empty")
+	// ErrTopicTooLong is returned when a topic exceeds MaxTopicLength.
+	ErrTopicTooLong = errors.New("zulipapi: topic is too long")
+)
+
+// APIError is an error response returned by the Zulip server.
+type APIError struct {
+	StatusCode int    // HTTP status code
+	Code       string // Zulip error code, such as "BAD_REQUEST"
+	Msg        string // Human-readable message from the server
+}
+
+// Error implements error.
+func (e *APIError) Error() string {
+	if e.Code != "" {
+		return fmt.Sprintf("zulipapi: %s (%s, HTTP %d)", e.Msg, e.Code, e.StatusCode)
+	}
+	return fmt.Sprintf("zulipapi: %s (HTTP %d)", e.Msg, e.StatusCode)
+}
+
+// ValidationError is returned instead of posting a message that wouldn't
+// render as intended on the client's server.
+type ValidationError struct {
+	// UnclosedFence is the line of a code fence that is never closed, which
+	// would turn the rest of the message into code, or 0.
+	UnclosedFence int
+	// Unsupported lists the features ServerVersion doesn't render.
+	Unsupported []zlmd.Feature
+	// ServerVersion is the client's ServerVersion.
+	ServerVersion zlmd.ServerVersion
+}
+
+// Error implements error.
+func (e *ValidationError) Error() string {
+	var problems []string
+	if e.UnclosedFence > 0 {
+		problems = append(problems, fmt.Sprintf("code fence opened on line %d is never closed", e.UnclosedFence))
+	}
+	for _, f := range e.Unsupported {
+		problems = append(problems, fmt.Sprintf("%s are not rendered by Zulip %s (needs %s)", f, e.ServerVersion, f.Since()))
+	}
+	return "zulipapi: invalid message: " + strings.Join(problems, "; ")
+}
+
+// Client posts to a single Zulip realm as one user or bot, authenticating
+// with the account's API key.
+type Client struct {
+	Site       string       // Realm URL, such as "https://chat.example.com"
+	Email      string       // Account email
+	APIKey     string       // Account API key
+	HTTPClient *http.Client // Client used for requests; http.DefaultClient if nil
+	MaxLength  int          // Per-message limit for splitting; zlmd.MaxMessageLength if zero
+	Store      Store        // Message IDs for UpdateOrCreate; topics are scanned if nil
+	// ServerVersion is the realm's Zulip version; messages using features
+	// it doesn't render are rejected. The zero value means the latest.
+	ServerVersion zlmd.ServerVersion
+}
+
+// NewClient returns a client for the realm at site.
+//
+// Parameters:
+//   - site (string): The realm URL; a trailing slash is ignored
+//   - email (string): The account email
+//   - apiKey (string): The account API key
+//
+// Returns:
+//   - *Client: The client
+//
+// Example:
+//
+//	c := zulipapi.NewClient("https://chat.example.com", "deploy-bot@chat.example.com", key)
+//	ids, err := c.SendStream(ctx, "ops", "deploys", zlmd.Bold("api")+" deployed")
+func NewClient(site, email, apiKey string) *Client {
+	return &Client{Site: strings.TrimRight(site, "/"), Email: email, APIKey: apiKey}
+}
+
+// SendStream posts content to a topic in a stream.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the requests
+//   - stream (string): The stream name
+//   - topic (string): The topic name
+//   - content (string): The message body
+//
+// Returns:
+//   - []int: The IDs of the posted messages, one per part
+//   - error: ErrEmptyMessage, ErrTopicTooLong, a *ValidationError, an
+//     *APIError or a transport error
+//
+// Notes:
+//   - Content longer than the client's MaxLength is split with
+//     zlmd.SplitMessage and posted as consecutive messages; if a part fails,
+//     the IDs of the parts already posted are returned with the error
+//   - Content with an unclosed code fence, or using features the client's
+//     ServerVersion doesn't render, is rejected with a *ValidationError
+//     before anything is posted
+func (c *Client) SendStream(ctx context.Context, stream, topic, content string) ([]int, error) {
+	if utf8.RuneCountInString(topic) > MaxTopicLength {
+		return nil, ErrTopicTooLong
+	}
+	return c.send(ctx, url.Values{"type": {"stream"}, "to": {stream}, "topic": {topic}}, content)
+}
+
+// SendDirect posts content as a direct message to the given users.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the requests
+//   - to ([]string): Recipient emails
+//   - content (string): The message body
+//
+// Returns:
+//   - []int: The IDs of the posted messages, one per part
+//   - error: ErrEmptyMessage, a *ValidationError, an *APIError or a
+//     transport error
+//
+// Notes:
+//   - Long content is split and validated as in SendStream
+func (c *Client) SendDirect(ctx context.Context, to []string, content string) ([]int, error) {
+	recipients, err := json.Marshal(to)
+	if err != nil {
+		return nil, err
+	}
+	return c.send(ctx, url.Values{"type": {"direct"}, "to": {string(recipients)}}, content)
+}
+
+// send validates and splits content, then posts each part with the
+// addressing fields in params.
+func (c *Client) send(ctx context.Context, params url.Values, content string) ([]int, error) {
+	if strings.TrimSpace(content) == "" {
+		return nil, ErrEmptyMessage
+	}
+	if err := c.validate(content); err != nil {
+		return nil, err
+	}
+
+	var ids []int
+	for _, part := range zlmd.SplitMessage(content, zlmd.WithMaxLength(c.maxLength())) {
+		params.Set("content", part)
+		var resp struct {
+			ID int `json:"id"`
+		}
+		if err := c.postForm(ctx, http.MethodPost, "messages", params, &resp); err != nil {
+			return ids, err
+		}
+		ids = append(ids, resp.ID)
+	}
+	return ids, nil
+}
+
+// validate returns a *ValidationError if content has an unclosed code
+// fence or uses features the client's ServerVersion doesn't render.
+func (c *Client) validate(content string) error {
+	e := &ValidationError{
+		UnclosedFence: zlmd.UnclosedFence(content),
+		Unsupported:   zlmd.UnsupportedFeatures(content, c.ServerVersion),
+		ServerVersion: c.ServerVersion,
+	}
+	if e.UnclosedFence > 0 || len(e.Unsupported) > 0 {
+		return e
+	}
+	return nil
+}
+
+// maxLength returns the per-message limit.
+func (c *Client) maxLength() int {
+	if c.MaxLength <= 0 {
+		return zlmd.MaxMessageLength
+	}
+	return c.MaxLength
+}
+
+// Upload uploads a file and returns the path to link it from a message.
+//
+// Parameters:
+//   - ctx (context.Context): Cancels the request
+//   - name (string): The file name shown in Zulip
+//   - r (io.Reader): The file content
+//
+// Returns:
+//   - string: The upload path, such as "/user_uploads/2/ab/report.txt"
+//   - error: An *APIError or a transport error
+//
+// Example:
+//
+//	uri, err := c.Upload(ctx, "report.txt", f)
+//	msg := zlmd.Link("report.txt", uri)
+func (c *Client) Upload(ctx context.Context, name string, r io.Reader) (string, error) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("filename", name)
+	if err != nil {
+		return "", err
+	}
+	if _, err := io.Copy(part, r); err != nil {
+		return "", err
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	req, err := c.newRequest(ctx, http.MethodPost, "user_uploads", &body)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+	var resp struct {
+		URI string `json:"uri"`
+	}
+	if err := c.do(req, &resp); err != nil {
+		return "", err
+	}
+	return resp.URI, nil
+}
+
+// postForm sends params form-encoded to the API endpoint and decodes the
+// response into v.
+func (c *Client) postForm(ctx context.Context, method, endpoint string, params url.Values, v any) error {
+	req, err := c.newRequest(ctx, method, endpoint, strings.NewReader(params.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return c.do(req, v)
+}
+
+// newRequest returns an authenticated request to the API endpoint.
+func (c *Client) newRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimRight(c.Site, "/")+"/api/v1/"+endpoint, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.Email, c.APIKey)
+	return req, nil
+}
+
+// do sends req and decodes a successful response into v.
+func (c *Client) do(req *http.Request, v any) error {
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Result string `json:"result"`
+		Msg    string `json:"msg"`
+		Code   string `json:"code"`
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return &APIError{StatusCode: resp.StatusCode, Msg: strings.TrimSpace(string(data))}
+	}
+	if result.Result != "success" {
+		return &APIError{StatusCode: resp.StatusCode, Code: result.Code, Msg: result.Msg}
+	}
+	return json.Unmarshal(data, v)
+}
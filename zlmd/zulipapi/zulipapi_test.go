@@ -0,0 +1,167 @@
+package zulipapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/veiloq/zulip-markdown/zlmd"
+)
+
+// fakeServer records the form values of each message request and answers
+// with sequential message IDs.
+func fakeServer(t *testing.T, posted *[]map[string]string) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, ok := r.BasicAuth(); !ok || user != "bot@example.com" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprint(w, `{"result":"error","msg":"Invalid API key","code":"INVALID_API_KEY"}`)
+			return
+		}
+		switch r.URL.Path {
+		case "/api/v1/messages":
+			r.ParseForm()
+			fields := map[string]string{}
+			for k := range r.PostForm {
+				fields[k] = r.PostForm.Get(k)
+			}
+			*posted = append(*posted, fields)
+			fmt.Fprintf(w, `{"result":"success","msg":"","id":%d}`, 100+len(*posted))
+		case "/api/v1/user_uploads":
+			file, header, err := r.FormFile("filename")
+			if err != nil {
+				t.Errorf("FormFile() returned error: %v", err)
+				return
+			}
+			data, _ := io.ReadAll(file)
+			fmt.Fprintf(w, `{"result":"success","msg":"","uri":"/user_uploads/1/ab/%s-%d"}`, header.Filename, len(data))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestSendStream(t *testing.T) {
+	var posted []map[string]string
+	srv := fakeServer(t, &posted)
+	c := NewClient(srv.URL+"/", "bot@example.com", "secret")
+
+	ids, err := c.SendStream(context.Background(), "ops", "deploys", "**api** deployed")
+	if err != nil {
+		t.Fatalf("SendStream() returned error: %v", err)
+	}
+	expected := []map[string]string{{"type": "stream", "to": "ops", "topic": "deploys", "content": "**api** deployed"}}
+	if !reflect.DeepEqual(ids, []int{101}) || !reflect.DeepEqual(posted, expected) {
+		t.Errorf("SendStream() = %v, posted %v", ids, posted)
+	}
+}
+
+func TestSendStream_Split(t *testing.T) {
+	var posted []map[string]string
+	srv := fakeServer(t, &posted)
+	c := NewClient(srv.URL, "bot@example.com", "secret")
+	c.MaxLength = 12
+
+	ids, err := c.SendStream(context.Background(), "ops", "log", "first para\n\nsecond para")
+	if err != nil {
+		t.Fatalf("SendStream() returned error: %v", err)
+	}
+	if !reflect.DeepEqual(ids, []int{101, 102}) {
+		t.Errorf("SendStream() = %v, want [101 102]", ids)
+	}
+	if len(posted) != 2 || posted[0]["content"] != "first para" || posted[1]["content"] != "second para" {
+		t.Errorf("Unexpected parts posted: %v", posted)
+	}
+}
+
+func TestSendDirect(t *testing.T) {
+	var posted []map[string]string
+	srv := fakeServer(t, &posted)
+	c := NewClient(srv.URL, "bot@example.com", "secret")
+
+	if _, err := c.SendDirect(context.Background(), []string{"a@example.com", "b@example.com"}, "hi"); err != nil {
+		t.Fatalf("SendDirect() returned error: %v", err)
+	}
+	if got := posted[0]["to"]; got != `["a@example.com","b@example.com"]` || posted[0]["type"] != "direct" {
+		t.Errorf("Unexpected direct message fields: %v", posted[0])
+	}
+}
+
+func TestSend_Errors(t *testing.T) {
+	var posted []map[string]string
+	srv := fakeServer(t, &posted)
+	old := NewClient(srv.URL, "bot@example.com", "secret")
+	old.ServerVersion = zlmd.ServerVersion{Major: 2, Minor: 1}
+
+	tests := []struct {
+		name    string
+		client  *Client
+		topic   string
+		content string
+		check   func(error) bool
+	}{
+		{"Empty", NewClient(srv.URL, "bot@example.com", "secret"), "t", "  \n", func(err error) bool { return errors.Is(err, ErrEmptyMessage) }},
+		{"Long topic", NewClient(srv.URL, "bot@example.com", "secret"), strings.Repeat("x", 61), "hi", func(err error) bool { return errors.Is(err, ErrTopicTooLong) }},
+		{"API error", NewClient(srv.URL, "bot@example.com", "wrong"), "t", "hi", func(err error) bool {
+			var apiErr *APIError
+			return errors.As(err, &apiErr) && apiErr.Code == "INVALID_API_KEY" && apiErr.StatusCode == http.StatusUnauthorized
+		}},
+		{"Unclosed fence", NewClient(srv.URL, "bot@example.com", "secret"), "t", "log:\n```\nboom", func(err error) bool {
+			var vErr *ValidationError
+			return errors.As(err, &vErr) && vErr.UnclosedFence == 2 && vErr.Unsupported == nil
+		}},
+		{"Unsupported feature", old, "t", zlmd.Spoiler("Logs", "boom"), func(err error) bool {
+			var vErr *ValidationError
+			return errors.As(err, &vErr) && vErr.UnclosedFence == 0 &&
+				reflect.DeepEqual(vErr.Unsupported, []zlmd.Feature{zlmd.FeatureSpoiler}) &&
+				strings.Contains(err.Error(), "spoiler blocks are not rendered by Zulip 2.1 (needs 3.0)")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.client.SendStream(context.Background(), "ops", tt.topic, tt.content)
+			if !tt.check(err) {
+				t.Errorf("SendStream() error = %v", err)
+			}
+		})
+	}
+	if len(posted) != 0 {
+		t.Errorf("Expected nothing posted, got %v", posted)
+	}
+}
+
+func TestSend_ServerVersion(t *testing.T) {
+	var posted []map[string]string
+	srv := fakeServer(t, &posted)
+	c := NewClient(srv.URL, "bot@example.com", "secret")
+	c.ServerVersion = zlmd.ServerVersion{Major: 3}
+
+	if _, err := c.SendStream(context.Background(), "ops", "t", zlmd.Spoiler("Logs", "boom")); err != nil {
+		t.Fatalf("SendStream() returned error: %v", err)
+	}
+	if len(posted) != 1 {
+		t.Errorf("Expected one message posted, got %v", posted)
+	}
+}
+
+func TestUpload(t *testing.T) {
+	srv := fakeServer(t, new([]map[string]string))
+	c := NewClient(srv.URL, "bot@example.com", "secret")
+
+	uri, err := c.Upload(context.Background(), "report.txt", strings.NewReader("hello"))
+	if err != nil {
+		t.Fatalf("Upload() returned error: %v", err)
+	}
+	if uri != "/user_uploads/1/ab/report.txt-5" {
+		t.Errorf("Upload() = %q, want %q", uri, "/user_uploads/1/ab/report.txt-5")
+	}
+}